@@ -9,6 +9,17 @@ import (
 	bam "github.com/vipernet-xyz/viper-network/baseapp"
 	"github.com/vipernet-xyz/viper-network/codec"
 	"github.com/vipernet-xyz/viper-network/crypto/keys"
+	ica "github.com/vipernet-xyz/viper-network/modules/apps/27-interchain-accounts"
+	icaController "github.com/vipernet-xyz/viper-network/modules/apps/27-interchain-accounts/controller"
+	icaControllerKeeper "github.com/vipernet-xyz/viper-network/modules/apps/27-interchain-accounts/controller/keeper"
+	icaControllerTypes "github.com/vipernet-xyz/viper-network/modules/apps/27-interchain-accounts/controller/types"
+	icaHost "github.com/vipernet-xyz/viper-network/modules/apps/27-interchain-accounts/host"
+	icaHostKeeper "github.com/vipernet-xyz/viper-network/modules/apps/27-interchain-accounts/host/keeper"
+	icaHostTypes "github.com/vipernet-xyz/viper-network/modules/apps/27-interchain-accounts/host/types"
+	icaTypes "github.com/vipernet-xyz/viper-network/modules/apps/27-interchain-accounts/types"
+	ibcFee "github.com/vipernet-xyz/viper-network/modules/apps/29-fee"
+	ibcFeeKeeper "github.com/vipernet-xyz/viper-network/modules/apps/29-fee/keeper"
+	ibcFeeTypes "github.com/vipernet-xyz/viper-network/modules/apps/29-fee/types"
 	ibc "github.com/vipernet-xyz/viper-network/modules/core"
 	port "github.com/vipernet-xyz/viper-network/modules/core/05-port/types"
 	ibcexported "github.com/vipernet-xyz/viper-network/modules/core/exported"
@@ -52,6 +63,8 @@ func NewViperCoreApp(genState GenesisState, keybase keys.Keybase, tmClient clien
 	viperSubspace := sdk.NewSubspace(viperTypes.DefaultParamspace)
 	ibcSubspace := sdk.NewSubspace(ibcexported.DefaultParamspace)
 	capabilitySubspace := sdk.NewSubspace(capabilityTypes.DefaultParamspace)
+	icaControllerSubspace := sdk.NewSubspace(icaControllerTypes.SubModuleName)
+	icaHostSubspace := sdk.NewSubspace(icaHostTypes.SubModuleName)
 	app.capabilityKeeper = capabilityKeeper.NewKeeper(
 		app.cdc,
 		app.Keys[capabilityTypes.StoreKey],
@@ -60,6 +73,8 @@ func NewViperCoreApp(genState GenesisState, keybase keys.Keybase, tmClient clien
 
 	scopedIBCKeeper := app.capabilityKeeper.ScopeToModule(ibcexported.ModuleName)
 	scopedTransferKeeper := app.capabilityKeeper.ScopeToModule(transferTypes.ModuleName)
+	scopedICAControllerKeeper := app.capabilityKeeper.ScopeToModule(icaControllerTypes.SubModuleName)
+	scopedICAHostKeeper := app.capabilityKeeper.ScopeToModule(icaHostTypes.SubModuleName)
 
 	// The AuthKeeper handles address -> account lookups
 	app.accountKeeper = authentication.NewKeeper(
@@ -97,11 +112,22 @@ func NewViperCoreApp(genState GenesisState, keybase keys.Keybase, tmClient clien
 		scopedIBCKeeper,
 	)
 
+	// The fee keeper sits between the ibc applications and the channel keeper to escrow and pay relayer fees
+	app.ibcFeeKeeper = ibcFeeKeeper.NewKeeper(
+		app.cdc,
+		app.Keys[ibcFeeTypes.StoreKey],
+		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		&app.IBCKeeper.PortKeeper,
+		app.accountKeeper,
+		app.accountKeeper,
+	)
+
 	app.transferKeeper = transferKeeper.NewKeeper(
 		app.cdc,
 		app.Keys[transferTypes.StoreKey],
 		transferSubspace,
-		app.IBCKeeper.ChannelKeeper,
+		app.ibcFeeKeeper,
 		app.IBCKeeper.ChannelKeeper,
 		&app.IBCKeeper.PortKeeper,
 		app.accountKeeper,
@@ -109,6 +135,33 @@ func NewViperCoreApp(genState GenesisState, keybase keys.Keybase, tmClient clien
 		scopedTransferKeeper,
 	)
 
+	// The interchain accounts keepers let counterparty chains control accounts on viper and vice versa
+	app.msgServiceRouter = bam.NewMsgServiceRouter()
+	app.msgServiceRouter.SetInterfaceRegistry(app.cdc.InterfaceRegistry())
+	transferTypes.RegisterInterfaces(app.cdc.InterfaceRegistry())
+	transferTypes.RegisterMsgServer(app.msgServiceRouter, app.transferKeeper)
+	app.icaControllerKeeper = icaControllerKeeper.NewKeeper(
+		app.cdc,
+		app.Keys[icaControllerTypes.StoreKey],
+		icaControllerSubspace,
+		app.ibcFeeKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		&app.IBCKeeper.PortKeeper,
+		scopedICAControllerKeeper,
+		app.msgServiceRouter,
+	)
+	app.icaHostKeeper = icaHostKeeper.NewKeeper(
+		app.cdc,
+		app.Keys[icaHostTypes.StoreKey],
+		icaHostSubspace,
+		app.ibcFeeKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		&app.IBCKeeper.PortKeeper,
+		app.accountKeeper,
+		scopedICAHostKeeper,
+		app.msgServiceRouter,
+	)
+
 	// The main viper core
 	app.viperKeeper = viperKeeper.NewKeeper(
 		app.Keys[viperTypes.StoreKey],
@@ -123,18 +176,26 @@ func NewViperCoreApp(genState GenesisState, keybase keys.Keybase, tmClient clien
 	// The governance keeper
 	app.governanceKeeper = governanceKeeper.NewKeeper(
 		app.cdc,
-		app.Keys[viperTypes.StoreKey],
+		app.Keys[governanceTypes.StoreKey],
 		app.Tkeys[viperTypes.StoreKey],
 		app.Keys[viperTypes.StoreKey],
 		governanceTypes.DefaultCodespace,
 		app.accountKeeper,
-		capabilitySubspace, authSubspace, servicersSubspace, requestorsSubspace, transferSubspace, ibcSubspace, viperSubspace,
+		capabilitySubspace, authSubspace, servicersSubspace, requestorsSubspace, transferSubspace, ibcSubspace, icaControllerSubspace, icaHostSubspace, viperSubspace,
 	)
 
 	transferModule := transfer.NewAppModule(app.transferKeeper)
-	transferIBCModule := transfer.NewIBCModule(app.transferKeeper)
+	// every ibc application is wrapped with the fee middleware so relayers can be incentivized on any channel
+	var transferStack port.IBCModule = transfer.NewIBCModule(app.transferKeeper)
+	transferStack = ibcFee.NewIBCMiddleware(transferStack, app.ibcFeeKeeper)
+	var icaControllerStack port.IBCModule = icaController.NewIBCMiddleware(nil, app.icaControllerKeeper)
+	icaControllerStack = ibcFee.NewIBCMiddleware(icaControllerStack, app.ibcFeeKeeper)
+	var icaHostStack port.IBCModule = icaHost.NewIBCModule(app.icaHostKeeper)
+	icaHostStack = ibcFee.NewIBCMiddleware(icaHostStack, app.ibcFeeKeeper)
 	ibcRouter := port.NewRouter()
-	ibcRouter.AddRoute(transferTypes.ModuleName, transferIBCModule)
+	ibcRouter.AddRoute(transferTypes.ModuleName, transferStack)
+	ibcRouter.AddRoute(icaControllerTypes.SubModuleName, icaControllerStack)
+	ibcRouter.AddRoute(icaHostTypes.SubModuleName, icaHostStack)
 	// Setting Router will finalize all routes by sealing router
 	app.IBCKeeper.SetRouter(ibcRouter)
 	// add the keybase to the viper core keeper
@@ -143,8 +204,15 @@ func NewViperCoreApp(genState GenesisState, keybase keys.Keybase, tmClient clien
 	app.servicersKeeper.ViperKeeper = app.viperKeeper
 	app.requestorsKeeper.ViperKeeper = app.viperKeeper
 	app.accountKeeper.POSKeeper = app.servicersKeeper
+	// give the message router to authentication to execute the authorized messages
+	app.accountKeeper.Router = app.Router()
+	// give the servicers and requestors keepers to governance to weigh proposal votes by stake
+	app.governanceKeeper.ServicersKeeper = app.servicersKeeper
+	app.governanceKeeper.RequestorsKeeper = app.requestorsKeeper
 	app.ScopedIBCKeeper = scopedIBCKeeper
 	app.ScopedTransferKeeper = scopedTransferKeeper
+	app.ScopedICAControllerKeeper = scopedICAControllerKeeper
+	app.ScopedICAHostKeeper = scopedICAHostKeeper
 
 	// setup module manager
 	app.mm = module.NewManager(
@@ -154,12 +222,14 @@ func NewViperCoreApp(genState GenesisState, keybase keys.Keybase, tmClient clien
 		requestors.NewAppModule(app.requestorsKeeper),
 		ibc.NewAppModule(app.IBCKeeper),
 		transferModule,
+		ica.NewAppModule(&app.icaControllerKeeper, &app.icaHostKeeper),
+		ibcFee.NewAppModule(app.ibcFeeKeeper),
 		viper.NewAppModule(app.viperKeeper),
 		governance.NewAppModule(app.governanceKeeper),
 	)
 	// setup the order of begin and end blockers
-	app.mm.SetOrderBeginBlockers(capabilityTypes.ModuleName, servicersTypes.ModuleName, requestorsTypes.ModuleName, transferTypes.ModuleName, ibcexported.ModuleName, viperTypes.ModuleName, governanceTypes.ModuleName)
-	app.mm.SetOrderEndBlockers(capabilityTypes.ModuleName, servicersTypes.ModuleName, requestorsTypes.ModuleName, transferTypes.ModuleName, ibcexported.ModuleName, viperTypes.ModuleName, governanceTypes.ModuleName)
+	app.mm.SetOrderBeginBlockers(capabilityTypes.ModuleName, servicersTypes.ModuleName, requestorsTypes.ModuleName, transferTypes.ModuleName, ibcexported.ModuleName, icaTypes.ModuleName, ibcFeeTypes.ModuleName, viperTypes.ModuleName, governanceTypes.ModuleName)
+	app.mm.SetOrderEndBlockers(capabilityTypes.ModuleName, servicersTypes.ModuleName, requestorsTypes.ModuleName, transferTypes.ModuleName, ibcexported.ModuleName, icaTypes.ModuleName, ibcFeeTypes.ModuleName, viperTypes.ModuleName, governanceTypes.ModuleName)
 	// setup the order of Genesis
 	app.mm.SetOrderInitGenesis(
 		capabilityTypes.ModuleName,
@@ -168,6 +238,8 @@ func NewViperCoreApp(genState GenesisState, keybase keys.Keybase, tmClient clien
 		requestorsTypes.ModuleName,
 		transferTypes.ModuleName,
 		ibcexported.ModuleName,
+		icaTypes.ModuleName,
+		ibcFeeTypes.ModuleName,
 		viperTypes.ModuleName,
 		governance.ModuleName,
 	)
@@ -36,9 +36,12 @@ import (
 	types2 "github.com/vipernet-xyz/viper-network/codec/types"
 	crypto "github.com/vipernet-xyz/viper-network/crypto/codec"
 	kb "github.com/vipernet-xyz/viper-network/crypto/keys"
+	ica "github.com/vipernet-xyz/viper-network/modules/apps/27-interchain-accounts"
+	ibcFee "github.com/vipernet-xyz/viper-network/modules/apps/29-fee"
 	ibc "github.com/vipernet-xyz/viper-network/modules/core"
 	ibctm "github.com/vipernet-xyz/viper-network/modules/light-clients/07-tendermint"
 	"github.com/vipernet-xyz/viper-network/store"
+	snapshottypes "github.com/vipernet-xyz/viper-network/store/snapshots/types"
 	sdk "github.com/vipernet-xyz/viper-network/types"
 	"github.com/vipernet-xyz/viper-network/types/module"
 	"github.com/vipernet-xyz/viper-network/x/authentication"
@@ -100,8 +103,8 @@ func InitApp(datadir, tmNode, persistentPeers, seeds, remoteCLIURL string, keyba
 	if GlobalConfig.ViperConfig.SamplePoolHotReload {
 		// hot reload sample pool
 		HotReloadSamplePools(samplePools)
-
 	}
+	types.SetSamplePools(samplePools)
 	// create logger
 	logger := InitLogger()
 	// prestart hook, so users don't have to create their own set-validator prestart script
@@ -376,8 +379,13 @@ func InitTendermint(keybase bool, chains *types.HostedBlockchains, geoZone *type
 	default:
 		keys = MustGetKeybase()
 	}
+	snapshotStore, err := OpenSnapshotStore(GlobalConfig)
+	if err != nil {
+		log2.Fatal(err)
+	}
+	snapshotOpts := snapshottypes.NewSnapshotOptions(GlobalConfig.ViperConfig.SnapshotInterval, GlobalConfig.ViperConfig.SnapshotKeepRecent)
 	appCreatorFunc := func(logger log.Logger, db dbm.DB, _ io.Writer) *ViperCoreApp {
-		return NewViperCoreApp(nil, keys, getTMClient(), chains, geoZone, logger, db, GlobalConfig.ViperConfig.Cache, GlobalConfig.ViperConfig.IavlCacheSize, baseapp.SetPruning(store.PruneNothing))
+		return NewViperCoreApp(nil, keys, getTMClient(), chains, geoZone, logger, db, GlobalConfig.ViperConfig.Cache, GlobalConfig.ViperConfig.IavlCacheSize, baseapp.SetPruning(store.PruneNothing), baseapp.SetSnapshot(snapshotStore, snapshotOpts))
 	}
 	tmNode, app, err := NewClient(config(c), appCreatorFunc)
 	if err != nil {
@@ -487,6 +495,9 @@ func InitViperCoreConfig(chains *types.HostedBlockchains, geozone *types.HostedG
 }
 
 func ShutdownViperCore() {
+	// let the running sampling rounds finish before the result db closes
+	types.GlobalSamplingScheduler().Stop()
+	types.GlobalUpstreamTracker().StopUpstreamHealthChecks()
 	types.FlushSessionCache()
 	types.StopServiceMetrics()
 }
@@ -822,6 +833,8 @@ func MakeCodec() {
 		servicers.AppModuleBasic{},
 		ibc.AppModuleBasic{},
 		transfer.AppModuleBasic{},
+		ica.AppModuleBasic{},
+		ibcFee.AppModuleBasic{},
 		ibctm.AppModuleBasic{},
 		viper.AppModuleBasic{},
 	).RegisterCodec(cdc)
@@ -1432,11 +1445,11 @@ func HotReloadSamplePools(samplePools *types.SamplePools) {
 			}
 			jsonFile.Close()
 
-			// Unmarshal into a map directly
-			var samplePoolMap map[string]types.SamplePool
-			err = json.Unmarshal(bz, &samplePoolMap)
+			// keep serving the previous pools until the file is fixed
+			samplePoolMap, err := types.ParseSamplePools(bz)
 			if err != nil {
-				log2.Fatal(NewInvalidSamplePoolError(err))
+				log2.Println(NewInvalidSamplePoolError(err))
+				continue
 			}
 
 			samplePools.L.Lock()
@@ -1453,9 +1466,9 @@ func NewSamplePools(generate bool) *types.SamplePools {
 
 	if _, err := os.Stat(samplePoolsPath); os.IsNotExist(err) {
 		if !generate {
-			log2.Println(fmt.Sprintf("no samplepool.json found @ %s, defaulting to empty SamplePool", samplePoolsPath))
+			log2.Println(fmt.Sprintf("no samplepool.json found @ %s, creating the default pool", samplePoolsPath))
 			createMissingSamplePoolJson(samplePoolsPath)
-			return &types.SamplePools{}
+			return NewSamplePools(false)
 		}
 		return generateSamplePoolsJson(samplePoolsPath)
 	}
@@ -1470,20 +1483,14 @@ func NewSamplePools(generate bool) *types.SamplePools {
 		log2.Fatal("Error reading samplepool.json: ", err)
 	}
 
-	var SamplePoolsSlice []types.SamplePool
-	err = json.Unmarshal(bz, &SamplePoolsSlice)
-	if err != nil {
-		log2.Fatal("Error unmarshaling data from samplepool.json: ", err)
-	}
-
 	err = jsonFile.Close()
 	if err != nil {
 		log2.Fatal("Error closing samplepool.json: ", err)
 	}
 
-	m := make(map[string]types.SamplePool)
-	for _, pool := range SamplePoolsSlice {
-		m[pool.Blockchain] = pool
+	m, err := types.ParseSamplePools(bz)
+	if err != nil {
+		log2.Fatal(NewInvalidSamplePoolError(err))
 	}
 
 	return &types.SamplePools{
@@ -1528,17 +1535,19 @@ func generateSamplePoolsJson(samplePoolsPath string) *types.SamplePools {
 func GenerateHostedSamplePool() []types.SamplePool {
 	var samplepools []types.SamplePool
 	// Define a common Ethereum relay payload
-	ethSamplePayload := &types.RelayPayload{
-		Data:    "0x12345678", // Dummy data
-		Method:  "eth_call",
-		Path:    "/",
-		Headers: types.RelayHeaders{},
+	ethSamplePayload := types.SamplePayload{
+		RelayPayload: types.RelayPayload{
+			Data:    "0x12345678", // Dummy data
+			Method:  "eth_call",
+			Path:    "/",
+			Headers: types.RelayHeaders{},
+		},
 	}
 
 	// Add this payload to Ethereum's sample pool
 	ethSamplePool := types.SamplePool{
 		Blockchain: "0002", // Ethereum
-		Payloads:   []types.RelayPayload{*ethSamplePayload},
+		Payloads:   []types.SamplePayload{ethSamplePayload},
 	}
 	samplepools = append(samplepools, ethSamplePool)
 	return samplepools
@@ -1562,59 +1571,62 @@ func createMissingSamplePoolJson(samplePoolPath string) {
 		log2.Fatal(NewInvalidSamplePoolError(err))
 	}
 
-	// Define Ethereum sample payloads
-	ethSamplePayloads := []types.RelayPayload{
+	jsonHeaders := types.RelayHeaders{"Content-Type": "application/json"}
+	// Define Ethereum sample payloads, templates are filled with the synced head and recent transactions
+	ethSamplePayloads := []types.SamplePayload{
+		{
+			RelayPayload: types.RelayPayload{Data: `{"jsonrpc":"2.0","method":"eth_blockNumber","params":[],"id":1}`, Method: "POST", Headers: jsonHeaders},
+			Weight:       3,
+		},
 		{
-			Data:    `{"jsonrpc":"2.0","method":"eth_blockNumber","params":[],"id":1}`,
-			Method:  "POST",
-			Path:    "",
-			Headers: types.RelayHeaders{"Content-Type": "application/json"},
+			RelayPayload: types.RelayPayload{Data: `{"jsonrpc":"2.0","method":"eth_chainId","params":[],"id": 1}`, Method: "POST", Headers: jsonHeaders},
 		},
 		{
-			Data:    `{"jsonrpc":"2.0","method":"eth_chainId","params":[],"id": 1}`,
-			Method:  "POST",
-			Path:    "",
-			Headers: types.RelayHeaders{"Content-Type": "application/json"},
+			RelayPayload: types.RelayPayload{Data: `{"jsonrpc":"2.0","method":"eth_protocolVersion","params":[],"id":1}`, Method: "POST", Headers: jsonHeaders},
 		},
 		{
-			Data:    `{"jsonrpc":"2.0","method":"eth_protocolVersion","params":[],"id":1}`,
-			Method:  "POST",
-			Path:    "",
-			Headers: types.RelayHeaders{"Content-Type": "application/json"},
+			RelayPayload: types.RelayPayload{Data: `{"jsonrpc":"2.0","method":"eth_getTransactionByHash","params":["{{recent_tx}}"],"id":1}`, Method: "POST", Headers: jsonHeaders},
+			Weight:       2,
 		},
 	}
 
 	// Define Solana sample payloads
-	solSamplePayloads := []types.RelayPayload{
+	solSamplePayloads := []types.SamplePayload{
 		{
-			Data:    `{"jsonrpc":"2.0","method":"getBlockHeight","params":[],"id":1}`,
-			Method:  "POST",
-			Path:    "",
-			Headers: types.RelayHeaders{"Content-Type": "application/json"},
+			RelayPayload: types.RelayPayload{Data: `{"jsonrpc":"2.0","method":"getBlockHeight","params":[],"id":1}`, Method: "POST", Headers: jsonHeaders},
 		},
 		{
-			Data:    `{"jsonrpc":"2.0","method":"getBlockTime","params":[165768577],"id":1}`,
-			Method:  "POST",
-			Path:    "",
-			Headers: types.RelayHeaders{"Content-Type": "application/json"},
+			RelayPayload: types.RelayPayload{Data: `{"jsonrpc":"2.0","method":"getBlockTime","params":[165768577],"id":1}`, Method: "POST", Headers: jsonHeaders},
+			Category:     types.SampleCategoryArchive,
 		},
 		{
-			Data:    `{"jsonrpc":"2.0","method": "getLargestAccounts","params":[],"id": 1}`,
-			Method:  "POST",
-			Path:    "",
-			Headers: types.RelayHeaders{"Content-Type": "application/json"},
+			RelayPayload: types.RelayPayload{Data: `{"jsonrpc":"2.0","method": "getLargestAccounts","params":[],"id": 1}`, Method: "POST", Headers: jsonHeaders},
+			Category:     types.SampleCategoryTrace,
 		},
 	}
 
 	// Create the sample pool array
+	// head queries of honest servicers may be a few blocks apart
 	samplePoolArray := []types.SamplePool{
 		{
 			Blockchain: "0002",
 			Payloads:   ethSamplePayloads,
+			TxSource: &types.TxSource{
+				Payload: types.RelayPayload{Data: `{"jsonrpc":"2.0","method":"eth_getBlockByNumber","params":["{{head}}",false],"id":1}`, Method: "POST", Headers: jsonHeaders},
+			},
+			Comparator: &types.ComparatorConfig{
+				IgnorePaths: []string{"id"},
+				Methods:     map[string]types.ComparatorConfig{"eth_blockNumber": {Mode: types.ComparatorModeHead, Blocks: 2}},
+			},
 		},
 		{
 			Blockchain: "0003",
 			Payloads:   solSamplePayloads,
+			Categories: map[string]int{types.SampleCategoryRead: 80, types.SampleCategoryArchive: 15, types.SampleCategoryTrace: 5},
+			Comparator: &types.ComparatorConfig{
+				IgnorePaths: []string{"id"},
+				Methods:     map[string]types.ComparatorConfig{"getBlockHeight": {Mode: types.ComparatorModeHead, Blocks: 10}},
+			},
 		},
 	}
 
@@ -0,0 +1,442 @@
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	sdk "github.com/vipernet-xyz/viper-network/types"
+	requestorsTypes "github.com/vipernet-xyz/viper-network/x/requestors/types"
+	servicersTypes "github.com/vipernet-xyz/viper-network/x/servicers/types"
+	viperTypes "github.com/vipernet-xyz/viper-network/x/viper-main/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/rpc/client"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+// the types of the events streamed to the subscribers
+const (
+	EventStake        = "stake"
+	EventBeginUnstake = "begin_unstake"
+	EventUnstake      = "unstake"
+	EventJail         = "jail"
+	EventUnjail       = "unjail"
+	EventPause        = "pause"
+	EventUnpause      = "unpause"
+	EventSlash        = "slash"
+	EventReportCard   = "report_card"
+	EventClaim        = "claim"
+	EventProof        = "proof"
+	EventSession      = "session"
+)
+
+// the results of the claims, proofs and report cards
+const (
+	EventResultAccepted = "accepted"
+	EventResultRejected = "rejected"
+)
+
+const (
+	eventsSubscriber     = "viper-events"
+	eventsBufferSize     = 100 // events buffered per subscription before it is dropped
+	eventsClientCapacity = 100 // tendermint events buffered before they are dropped
+)
+
+var (
+	EventSubscriptionsDisabledError = errors.New("the event subscriptions are disabled")
+	MaxEventSubscriptionsError      = errors.New("the max number of event subscriptions is reached")
+)
+
+// Event - A typed event of the chain, decoded from the events of the blocks and the results of the transactions
+type Event struct {
+	Type       string            `json:"type"`
+	Height     int64             `json:"height"`
+	TxHash     string            `json:"txhash,omitempty"`
+	Module     string            `json:"module,omitempty"`
+	Address    string            `json:"address,omitempty"`
+	Chains     []string          `json:"chains,omitempty"`
+	GeoZones   []string          `json:"geo_zones,omitempty"`
+	Result     string            `json:"result,omitempty"`
+	Attributes map[string]string `json:"attributes,omitempty"`
+}
+
+// EventFilter - Filters the events of a subscription, an empty field matches all the events
+type EventFilter struct {
+	Types     []string `json:"types"`
+	Addresses []string `json:"addresses"`
+	Chains    []string `json:"chains"`
+	GeoZones  []string `json:"geo_zones"`
+}
+
+// Matches returns true if the event has one of the values of every non-empty field of the filter
+func (f EventFilter) Matches(e Event) bool {
+	if len(f.Types) != 0 && !containsFold(f.Types, e.Type) {
+		return false
+	}
+	if len(f.Addresses) != 0 && !containsFold(f.Addresses, e.Address) {
+		return false
+	}
+	if len(f.Chains) != 0 && !containsAnyFold(f.Chains, e.Chains) {
+		return false
+	}
+	if len(f.GeoZones) != 0 && !containsAnyFold(f.GeoZones, e.GeoZones) {
+		return false
+	}
+	return true
+}
+
+func containsFold(values []string, value string) bool {
+	if value == "" {
+		return false
+	}
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAnyFold(values []string, others []string) bool {
+	for _, o := range others {
+		if containsFold(values, o) {
+			return true
+		}
+	}
+	return false
+}
+
+// EventSubscription - A subscription to the events of the feed
+type EventSubscription struct {
+	filter EventFilter
+	events chan Event
+	feed   *EventFeed
+}
+
+// Events returns the events of the subscription, the channel is closed when the subscription is closed or when the
+// subscriber is too slow to consume them
+func (s *EventSubscription) Events() <-chan Event {
+	return s.events
+}
+
+// Close closes the subscription
+func (s *EventSubscription) Close() {
+	s.feed.remove(s)
+}
+
+// EventFeed - Streams the events of the committed blocks to its subscriptions. The feed subscribes to the tendermint
+// events with its first subscription and unsubscribes with its last one
+type EventFeed struct {
+	client        func() client.EventsClient
+	zones         func(height int64, address string) (chains, geoZones []string)
+	decodeTx      func(txBytes []byte, height int64) ([]sdk.Msg, error)
+	mu            sync.Mutex
+	subscriptions map[*EventSubscription]struct{}
+	cancel        context.CancelFunc
+}
+
+// NewEventFeed returns a feed of the events of the tendermint client, the chains and geozones of the addresses of the
+// events are looked up with zones
+func NewEventFeed(client func() client.EventsClient, zones func(height int64, address string) (chains, geoZones []string), decodeTx func(txBytes []byte, height int64) ([]sdk.Msg, error)) *EventFeed {
+	return &EventFeed{
+		client:        client,
+		zones:         zones,
+		decodeTx:      decodeTx,
+		subscriptions: make(map[*EventSubscription]struct{}),
+	}
+}
+
+// Subscribe returns a new subscription to the events matching the filter, max limits the number of subscriptions
+func (f *EventFeed) Subscribe(filter EventFilter, max int) (*EventSubscription, error) {
+	if max <= 0 {
+		return nil, EventSubscriptionsDisabledError
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if len(f.subscriptions) >= max {
+		return nil, MaxEventSubscriptionsError
+	}
+	if len(f.subscriptions) == 0 {
+		if err := f.start(); err != nil {
+			return nil, err
+		}
+	}
+	s := &EventSubscription{filter: filter, events: make(chan Event, eventsBufferSize), feed: f}
+	f.subscriptions[s] = struct{}{}
+	return s, nil
+}
+
+// start subscribes to the blocks and the transactions of tendermint, must be called with the lock
+func (f *EventFeed) start() error {
+	c := f.client()
+	if c == nil {
+		return fmt.Errorf("the tendermint node is not running")
+	}
+	blocks, err := c.Subscribe(context.Background(), eventsSubscriber, tmTypes.QueryForEvent(tmTypes.EventNewBlock).String(), eventsClientCapacity)
+	if err != nil {
+		return err
+	}
+	txs, err := c.Subscribe(context.Background(), eventsSubscriber, tmTypes.QueryForEvent(tmTypes.EventTx).String(), eventsClientCapacity)
+	if err != nil {
+		_ = c.UnsubscribeAll(context.Background(), eventsSubscriber)
+		return err
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	f.cancel = func() {
+		cancel()
+		_ = c.UnsubscribeAll(context.Background(), eventsSubscriber)
+	}
+	go func() {
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case e := <-blocks:
+				if data, ok := e.Data.(tmTypes.EventDataNewBlock); ok && data.Block != nil {
+					events := DecodeEvents(data.Block.Height, "", data.ResultBeginBlock.Events)
+					f.Publish(append(events, DecodeEvents(data.Block.Height, "", data.ResultEndBlock.Events)...))
+				}
+			case e := <-txs:
+				if data, ok := e.Data.(tmTypes.EventDataTx); ok {
+					f.Publish(f.txEvents(data.TxResult))
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// remove closes the subscription and stops the feed after the last one
+func (f *EventFeed) remove(s *EventSubscription) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.removeLocked(s)
+}
+
+func (f *EventFeed) removeLocked(s *EventSubscription) {
+	if _, ok := f.subscriptions[s]; !ok {
+		return
+	}
+	delete(f.subscriptions, s)
+	close(s.events)
+	if len(f.subscriptions) == 0 && f.cancel != nil {
+		f.cancel()
+		f.cancel = nil
+	}
+}
+
+// Publish sends the events to the matching subscriptions, after adding the chains and geozones of their addresses.
+// The subscriptions that cannot keep up are closed
+func (f *EventFeed) Publish(events []Event) {
+	for i, e := range events {
+		if e.Address != "" && len(e.Chains) == 0 && len(e.GeoZones) == 0 && f.zones != nil {
+			events[i].Chains, events[i].GeoZones = f.zones(e.Height, e.Address)
+		}
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for s := range f.subscriptions {
+		for _, e := range events {
+			if !s.filter.Matches(e) {
+				continue
+			}
+			select {
+			case s.events <- e:
+			default:
+				f.removeLocked(s)
+			}
+			if _, ok := f.subscriptions[s]; !ok {
+				break
+			}
+		}
+	}
+}
+
+// txEvents returns the events of a transaction result, the claims, proofs and report cards of the failed transactions
+// are returned as rejected
+func (f *EventFeed) txEvents(res tmTypes.TxResult) []Event {
+	txHash := fmt.Sprintf("%X", tmTypes.Tx(res.Tx).Hash())
+	if res.Result.Code == abci.CodeTypeOK {
+		return DecodeEvents(res.Height, txHash, res.Result.Events)
+	}
+	if f.decodeTx == nil {
+		return nil
+	}
+	msgs, err := f.decodeTx(res.Tx, res.Height)
+	if err != nil {
+		return nil
+	}
+	var events []Event
+	for _, msg := range msgs {
+		e, ok := rejectedEvent(msg)
+		if !ok {
+			continue
+		}
+		e.Height = res.Height
+		e.TxHash = txHash
+		e.Result = EventResultRejected
+		e.Attributes["code"] = fmt.Sprintf("%d", res.Result.Code)
+		e.Attributes["codespace"] = res.Result.Codespace
+		e.Attributes["log"] = res.Result.Log
+		events = append(events, e)
+	}
+	return events
+}
+
+// rejectedEvent returns the event of a claim, proof or report card message
+func rejectedEvent(msg sdk.Msg) (Event, bool) {
+	var (
+		eventType string
+		address   sdk.Address
+		header    viperTypes.SessionHeader
+	)
+	switch m := msg.(type) {
+	case viperTypes.MsgClaim:
+		eventType, address, header = EventClaim, m.FromAddress, m.SessionHeader
+	case *viperTypes.MsgClaim:
+		eventType, address, header = EventClaim, m.FromAddress, m.SessionHeader
+	case viperTypes.MsgProof:
+		if m.ClaimLeaf == nil {
+			return Event{}, false
+		}
+		eventType, address, header = EventProof, m.ClaimLeaf.GetSigner(), m.ClaimLeaf.SessionHeader()
+	case *viperTypes.MsgProof:
+		if m.ClaimLeaf == nil {
+			return Event{}, false
+		}
+		eventType, address, header = EventProof, m.ClaimLeaf.GetSigner(), m.ClaimLeaf.SessionHeader()
+	case viperTypes.MsgSubmitQoSReport:
+		eventType, address, header = EventReportCard, m.ServicerAddress, m.SessionHeader
+	case *viperTypes.MsgSubmitQoSReport:
+		eventType, address, header = EventReportCard, m.ServicerAddress, m.SessionHeader
+	default:
+		return Event{}, false
+	}
+	return Event{
+		Type:     eventType,
+		Module:   viperTypes.ModuleName,
+		Address:  address.String(),
+		Chains:   nonEmpty(header.Chain),
+		GeoZones: nonEmpty(header.GeoZone),
+		Attributes: map[string]string{
+			viperTypes.AttributeKeySessionHeight: fmt.Sprintf("%d", header.SessionBlockHeight),
+		},
+	}, true
+}
+
+// DecodeEvents returns the typed events of the abci events of a block or of a transaction, the other events are ignored
+func DecodeEvents(height int64, txHash string, events []abci.Event) []Event {
+	var result []Event
+	for _, ev := range events {
+		attributes := make(map[string]string, len(ev.Attributes))
+		for _, a := range ev.Attributes {
+			attributes[string(a.Key)] = string(a.Value)
+		}
+		e := Event{Height: height, TxHash: txHash, Module: attributes[sdk.AttributeKeyModule], Attributes: attributes}
+		delete(attributes, sdk.AttributeKeyModule)
+		switch ev.Type {
+		case servicersTypes.EventTypeStake, servicersTypes.EventTypeBeginUnstake, servicersTypes.EventTypeUnstake:
+			// the staking events of the servicers and the requestors share their types
+			if e.Module != servicersTypes.ModuleName && e.Module != requestorsTypes.ModuleName {
+				continue
+			}
+			e.Type = map[string]string{
+				servicersTypes.EventTypeStake:        EventStake,
+				servicersTypes.EventTypeBeginUnstake: EventBeginUnstake,
+				servicersTypes.EventTypeUnstake:      EventUnstake,
+			}[ev.Type]
+			e.Address = takeAttribute(attributes, sdk.AttributeKeySender)
+		case servicersTypes.EventTypeJail, servicersTypes.EventTypeUnjail, servicersTypes.EventTypePause,
+			servicersTypes.EventTypeUnpause, servicersTypes.EventTypeSlash:
+			e.Type = map[string]string{
+				servicersTypes.EventTypeJail:    EventJail,
+				servicersTypes.EventTypeUnjail:  EventUnjail,
+				servicersTypes.EventTypePause:   EventPause,
+				servicersTypes.EventTypeUnpause: EventUnpause,
+				servicersTypes.EventTypeSlash:   EventSlash,
+			}[ev.Type]
+			e.Module = servicersTypes.ModuleName
+			e.Address = takeAttribute(attributes, servicersTypes.AttributeKeyAddress)
+		case viperTypes.EventTypeClaim, viperTypes.EventTypeProof, viperTypes.EventTypeSubmitReportCard:
+			e.Type = map[string]string{
+				viperTypes.EventTypeClaim:            EventClaim,
+				viperTypes.EventTypeProof:            EventProof,
+				viperTypes.EventTypeSubmitReportCard: EventReportCard,
+			}[ev.Type]
+			e.Module = viperTypes.ModuleName
+			e.Result = EventResultAccepted
+			e.Address = takeAttribute(attributes, viperTypes.AttributeKeyValidator)
+			e.Chains = nonEmpty(takeAttribute(attributes, viperTypes.AttributeKeyChain))
+			e.GeoZones = nonEmpty(takeAttribute(attributes, viperTypes.AttributeKeyGeoZone))
+		case viperTypes.EventTypeNewSession:
+			e.Type = EventSession
+			e.Module = viperTypes.ModuleName
+		default:
+			continue
+		}
+		if len(attributes) == 0 {
+			e.Attributes = nil
+		}
+		result = append(result, e)
+	}
+	return result
+}
+
+func takeAttribute(attributes map[string]string, key string) string {
+	v := attributes[key]
+	delete(attributes, key)
+	return v
+}
+
+func nonEmpty(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return []string{s}
+}
+
+// SubscribeEvents returns a new subscription to the events of the committed blocks matching the filter
+func (app *ViperCoreApp) SubscribeEvents(filter EventFilter) (*EventSubscription, error) {
+	return app.events.Subscribe(filter, GlobalConfig.ViperConfig.RPCMaxEventSubscriptions)
+}
+
+// eventZones returns the chains and geozones of the servicer or requestor of the address at the height, or at the
+// previous height when it was removed at the height
+func (app *ViperCoreApp) eventZones(height int64, address string) (chains, geoZones []string) {
+	addr, err := sdk.AddressFromHex(address)
+	if err != nil {
+		return nil, nil
+	}
+	for _, h := range []int64{height, height - 1} {
+		ctx, err := app.NewContext(h)
+		if err != nil {
+			continue
+		}
+		if validator, found := app.servicersKeeper.GetValidator(ctx, addr); found {
+			return validator.Chains, validator.GeoZone
+		}
+		if requestor, found := app.requestorsKeeper.GetRequestor(ctx, addr); found {
+			return requestor.Chains, requestor.GeoZones
+		}
+	}
+	return nil, nil
+}
+
+// eventsClient returns the tendermint client of the node
+func (app *ViperCoreApp) eventsClient() client.EventsClient {
+	if app.viperKeeper.TmNode == nil {
+		return nil
+	}
+	return app.viperKeeper.TmNode
+}
+
+func decodeTxMsgs(txBytes []byte, height int64) ([]sdk.Msg, error) {
+	tx, err := UnmarshalTx(txBytes, height)
+	if err != nil {
+		return nil, err
+	}
+	return tx.GetMsgs(), nil
+}
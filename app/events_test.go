@@ -0,0 +1,177 @@
+package app
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	sdk "github.com/vipernet-xyz/viper-network/types"
+	requestorsTypes "github.com/vipernet-xyz/viper-network/x/requestors/types"
+	servicersTypes "github.com/vipernet-xyz/viper-network/x/servicers/types"
+	viperTypes "github.com/vipernet-xyz/viper-network/x/viper-main/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmTypes "github.com/tendermint/tendermint/types"
+)
+
+// eventsClientMock serves the tendermint events sent on its channels
+type eventsClientMock struct {
+	mu           sync.Mutex
+	channels     map[string]chan ctypes.ResultEvent
+	unsubscribed int
+}
+
+func newEventsClientMock() *eventsClientMock {
+	return &eventsClientMock{channels: make(map[string]chan ctypes.ResultEvent)}
+}
+
+func (c *eventsClientMock) Subscribe(_ context.Context, _, query string, _ ...int) (<-chan ctypes.ResultEvent, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	ch := make(chan ctypes.ResultEvent, 10)
+	c.channels[query] = ch
+	return ch, nil
+}
+
+func (c *eventsClientMock) Unsubscribe(context.Context, string, string) error { return nil }
+
+func (c *eventsClientMock) UnsubscribeAll(context.Context, string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.unsubscribed++
+	return nil
+}
+
+func (c *eventsClientMock) send(event string, data tmTypes.TMEventData) {
+	c.mu.Lock()
+	ch := c.channels[tmTypes.QueryForEvent(event).String()]
+	c.mu.Unlock()
+	ch <- ctypes.ResultEvent{Data: data}
+}
+
+func TestDecodeEvents(t *testing.T) {
+	events := DecodeEvents(10, "ABCD", []abci.Event{
+		sdk.NewEvent(servicersTypes.EventTypeStake,
+			sdk.NewAttribute(sdk.AttributeKeyModule, servicersTypes.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, "a1"),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, "100")),
+		sdk.NewEvent(sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, servicersTypes.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, "a1")),
+		sdk.NewEvent(requestorsTypes.EventTypeUnstake,
+			sdk.NewAttribute(sdk.AttributeKeyModule, requestorsTypes.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeySender, "a2")),
+		sdk.NewEvent(servicersTypes.EventTypeSlash,
+			sdk.NewAttribute(servicersTypes.AttributeKeyAddress, "a3"),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, "5"),
+			sdk.NewAttribute(servicersTypes.AttributeKeyReason, servicersTypes.AttributeValueNoActivity)),
+		sdk.NewEvent(viperTypes.EventTypeProof,
+			sdk.NewAttribute(viperTypes.AttributeKeyValidator, "a4"),
+			sdk.NewAttribute(viperTypes.AttributeKeyChain, "0001"),
+			sdk.NewAttribute(viperTypes.AttributeKeyGeoZone, "0002"),
+			sdk.NewAttribute(viperTypes.AttributeKeyMinted, "42")),
+		sdk.NewEvent(viperTypes.EventTypeNewSession,
+			sdk.NewAttribute(viperTypes.AttributeKeySessionHeight, "10")),
+	})
+	require.Len(t, events, 5)
+	assert.Equal(t, Event{Type: EventStake, Height: 10, TxHash: "ABCD", Module: servicersTypes.ModuleName, Address: "a1",
+		Attributes: map[string]string{sdk.AttributeKeyAmount: "100"}}, events[0])
+	assert.Equal(t, Event{Type: EventUnstake, Height: 10, TxHash: "ABCD", Module: requestorsTypes.ModuleName, Address: "a2"}, events[1])
+	assert.Equal(t, Event{Type: EventSlash, Height: 10, TxHash: "ABCD", Module: servicersTypes.ModuleName, Address: "a3",
+		Attributes: map[string]string{sdk.AttributeKeyAmount: "5", servicersTypes.AttributeKeyReason: servicersTypes.AttributeValueNoActivity}}, events[2])
+	assert.Equal(t, Event{Type: EventProof, Height: 10, TxHash: "ABCD", Module: viperTypes.ModuleName, Address: "a4",
+		Chains: []string{"0001"}, GeoZones: []string{"0002"}, Result: EventResultAccepted,
+		Attributes: map[string]string{viperTypes.AttributeKeyMinted: "42"}}, events[3])
+	assert.Equal(t, Event{Type: EventSession, Height: 10, TxHash: "ABCD", Module: viperTypes.ModuleName,
+		Attributes: map[string]string{viperTypes.AttributeKeySessionHeight: "10"}}, events[4])
+}
+
+func TestEventFilter_Matches(t *testing.T) {
+	e := Event{Type: EventJail, Address: "ABCD", Chains: []string{"0001", "0002"}, GeoZones: []string{"0003"}}
+	tests := []struct {
+		name   string
+		filter EventFilter
+		want   bool
+	}{
+		{"empty filter", EventFilter{}, true},
+		{"type", EventFilter{Types: []string{EventSlash, EventJail}}, true},
+		{"other type", EventFilter{Types: []string{EventSlash}}, false},
+		{"address of another case", EventFilter{Addresses: []string{"abcd"}}, true},
+		{"other address", EventFilter{Addresses: []string{"abce"}}, false},
+		{"chain", EventFilter{Chains: []string{"0002"}}, true},
+		{"geozone and other chain", EventFilter{Chains: []string{"0003"}, GeoZones: []string{"0003"}}, false},
+		{"geozone", EventFilter{GeoZones: []string{"0003"}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.filter.Matches(e))
+		})
+	}
+	assert.False(t, EventFilter{Addresses: []string{"abcd"}}.Matches(Event{Type: EventSession}))
+}
+
+func TestEventFeed(t *testing.T) {
+	c := newEventsClientMock()
+	zones := func(height int64, address string) ([]string, []string) {
+		return []string{"0001"}, []string{"0002"}
+	}
+	feed := NewEventFeed(func() client.EventsClient { return c }, zones, nil)
+
+	_, err := feed.Subscribe(EventFilter{}, 0)
+	assert.Equal(t, EventSubscriptionsDisabledError, err)
+	jails, err := feed.Subscribe(EventFilter{Types: []string{EventJail}, Chains: []string{"0001"}}, 2)
+	require.Nil(t, err)
+	sessions, err := feed.Subscribe(EventFilter{Types: []string{EventSession}}, 2)
+	require.Nil(t, err)
+	_, err = feed.Subscribe(EventFilter{}, 2)
+	assert.Equal(t, MaxEventSubscriptionsError, err)
+
+	c.send(tmTypes.EventNewBlock, tmTypes.EventDataNewBlock{
+		Block: &tmTypes.Block{Header: tmTypes.Header{Height: 11}},
+		ResultBeginBlock: abci.ResponseBeginBlock{Events: []abci.Event{
+			sdk.NewEvent(viperTypes.EventTypeNewSession, sdk.NewAttribute(viperTypes.AttributeKeySessionHeight, "11")),
+		}},
+		ResultEndBlock: abci.ResponseEndBlock{Events: []abci.Event{
+			sdk.NewEvent(servicersTypes.EventTypeJail, sdk.NewAttribute(servicersTypes.AttributeKeyAddress, "a1")),
+		}},
+	})
+	select {
+	case e := <-jails.Events():
+		assert.Equal(t, EventJail, e.Type)
+		assert.Equal(t, int64(11), e.Height)
+		assert.Equal(t, []string{"0001"}, e.Chains)
+		assert.Equal(t, []string{"0002"}, e.GeoZones)
+	case <-time.After(time.Second):
+		t.Fatal("the jail event was not received")
+	}
+	select {
+	case e := <-sessions.Events():
+		assert.Equal(t, EventSession, e.Type)
+	case <-time.After(time.Second):
+		t.Fatal("the session event was not received")
+	}
+
+	// the subscription is dropped once its buffer is full
+	for i := 0; i <= eventsBufferSize; i++ {
+		c.send(tmTypes.EventTx, tmTypes.EventDataTx{TxResult: tmTypes.TxResult{Height: 12, Result: abci.ResponseDeliverTx{
+			Events: []abci.Event{sdk.NewEvent(viperTypes.EventTypeNewSession)},
+		}}})
+	}
+	assert.Eventually(t, func() bool {
+		feed.mu.Lock()
+		defer feed.mu.Unlock()
+		_, ok := feed.subscriptions[sessions]
+		return !ok
+	}, time.Second, 10*time.Millisecond)
+	sessions.Close()
+
+	jails.Close()
+	c.mu.Lock()
+	assert.Equal(t, 1, c.unsubscribed)
+	c.mu.Unlock()
+}
@@ -9,6 +9,8 @@ import (
 	tmType "github.com/tendermint/tendermint/types"
 
 	crypto "github.com/vipernet-xyz/viper-network/crypto/codec"
+	ica "github.com/vipernet-xyz/viper-network/modules/apps/27-interchain-accounts"
+	ibcFee "github.com/vipernet-xyz/viper-network/modules/apps/29-fee"
 	ibc "github.com/vipernet-xyz/viper-network/modules/core"
 	sdk "github.com/vipernet-xyz/viper-network/types"
 	"github.com/vipernet-xyz/viper-network/types/module"
@@ -56,6 +58,8 @@ func newDefaultGenesisState() []byte {
 		governance.AppModuleBasic{},
 		servicers.AppModuleBasic{},
 		transfer.AppModuleBasic{},
+		ica.AppModuleBasic{},
+		ibcFee.AppModuleBasic{},
 		viper.AppModuleBasic{},
 	).DefaultGenesis() // setup account genesis
 	rawAuth := defaultGenesis[authentication.ModuleName]
@@ -164,6 +168,11 @@ func createDummyACL(kp crypto.PublicKey) governanceTypes.ACL {
 	acl.SetOwner("governance/acl", addr)
 	acl.SetOwner("governance/daoOwner", addr)
 	acl.SetOwner("governance/upgrade", addr)
+	acl.SetOwner("governance/minProposalDeposit", addr)
+	acl.SetOwner("governance/votingPeriod", addr)
+	acl.SetOwner("governance/quorum", addr)
+	acl.SetOwner("governance/threshold", addr)
+	acl.SetOwner("governance/vetoThreshold", addr)
 	acl.SetOwner("vipernet/ClaimExpiration", addr)
 	acl.SetOwner("vipernet/ReplayAttackBurnMultiplier", addr)
 	acl.SetOwner("vipernet/ClaimSubmissionWindow", addr)
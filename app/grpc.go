@@ -0,0 +1,80 @@
+package app
+
+import (
+	"context"
+
+	"github.com/vipernet-xyz/viper-network/codec"
+	authKeeper "github.com/vipernet-xyz/viper-network/x/authentication/keeper"
+	authTypes "github.com/vipernet-xyz/viper-network/x/authentication/types"
+	"github.com/vipernet-xyz/viper-network/x/authentication/util"
+	governanceKeeper "github.com/vipernet-xyz/viper-network/x/governance/keeper"
+	governanceTypes "github.com/vipernet-xyz/viper-network/x/governance/types"
+	requestorsKeeper "github.com/vipernet-xyz/viper-network/x/requestors/keeper"
+	requestorsTypes "github.com/vipernet-xyz/viper-network/x/requestors/types"
+	servicersKeeper "github.com/vipernet-xyz/viper-network/x/servicers/keeper"
+	servicersTypes "github.com/vipernet-xyz/viper-network/x/servicers/types"
+	viperKeeper "github.com/vipernet-xyz/viper-network/x/viper-main/keeper"
+	viperTypes "github.com/vipernet-xyz/viper-network/x/viper-main/types"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/status"
+)
+
+// GRPCCodec - Returns the codec of the grpc services, the messages are gogoproto messages
+func GRPCCodec() encoding.Codec {
+	return codec.NewProtoCodec1(Codec().InterfaceRegistry()).GRPCCodec()
+}
+
+// NewGRPCServer - Returns a grpc server serving the query services of the modules and the tx service.
+// The queries are answered at the height of the request, or at the last block height when it is 0
+func (app *ViperCoreApp) NewGRPCServer() *grpc.Server {
+	server := grpc.NewServer(grpc.ForceServerCodec(GRPCCodec()), grpc.UnaryInterceptor(app.queryContextInterceptor))
+	servicersTypes.RegisterQueryServer(server, servicersKeeper.NewQueryServer(app.servicersKeeper))
+	requestorsTypes.RegisterQueryServer(server, requestorsKeeper.NewQueryServer(app.requestorsKeeper))
+	viperTypes.RegisterQueryServer(server, viperKeeper.NewQueryServer(app.viperKeeper))
+	governanceTypes.RegisterQueryServer(server, governanceKeeper.NewQueryServer(app.governanceKeeper))
+	authTypes.RegisterQueryServer(server, authKeeper.NewQueryServer(app.accountKeeper))
+	authTypes.RegisterTxServer(server, txServer{})
+	return server
+}
+
+// queryContextInterceptor attaches the context of the height of the query request to the call
+func (app *ViperCoreApp) queryContextInterceptor(c context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	r, ok := req.(interface{ GetHeight() int64 })
+	if !ok {
+		return handler(c, req)
+	}
+	height := r.GetHeight()
+	if height == 0 {
+		height = app.LastBlockHeight()
+	}
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return handler(ctx.WithContext(c), req)
+}
+
+// txServer implements the grpc tx service with the tendermint node of the app
+type txServer struct{}
+
+// BroadcastTx implements the Tx/BroadcastTx gRPC method
+func (txServer) BroadcastTx(_ context.Context, req *authTypes.BroadcastTxRequest) (*authTypes.BroadcastTxResponse, error) {
+	if req == nil || len(req.TxBytes) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "empty transaction")
+	}
+	tmClient := getTMClient()
+	defer func() { _ = tmClient.Stop() }()
+	cliCtx := util.CLIContext{
+		Codec:         cdc,
+		Client:        tmClient,
+		BroadcastMode: util.BroadcastSync,
+	}
+	res, err := cliCtx.BroadcastTx(req.TxBytes)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+	return &authTypes.BroadcastTxResponse{TxResponse: res}, nil
+}
@@ -7,6 +7,7 @@ import (
 	"log"
 	"math"
 	"reflect"
+	"sort"
 	"strconv"
 
 	"github.com/gorilla/websocket"
@@ -14,6 +15,7 @@ import (
 
 	sdk "github.com/vipernet-xyz/viper-network/types"
 	"github.com/vipernet-xyz/viper-network/x/authentication/exported"
+	authTypes "github.com/vipernet-xyz/viper-network/x/authentication/types"
 	"github.com/vipernet-xyz/viper-network/x/authentication/util"
 	"github.com/vipernet-xyz/viper-network/x/governance/types"
 
@@ -170,6 +172,100 @@ func (app ViperCoreApp) QueryAccount(addr string, height int64) (res *exported.A
 	return &acc, nil
 }
 
+// VestingBalances are the vested, still vesting and spendable coins of a vesting account
+type VestingBalances struct {
+	VestedCoins    sdk.Coins `json:"vested_coins"`
+	VestingCoins   sdk.Coins `json:"vesting_coins"`
+	SpendableCoins sdk.Coins `json:"spendable_coins"`
+}
+
+// QueryVestingBalances returns the balances of a vesting account at the block time of the height,
+// nil if the account is not a vesting account
+func (app ViperCoreApp) QueryVestingBalances(addr string, height int64) (*VestingBalances, error) {
+	a, err := sdk.AddressFromHex(addr)
+	if err != nil {
+		return nil, err
+	}
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return nil, err
+	}
+	vacc, ok := app.accountKeeper.GetAccount(ctx, a).(exported.VestingAccount)
+	if !ok {
+		return nil, nil
+	}
+	blockTime := ctx.BlockHeader().Time
+	return &VestingBalances{
+		VestedCoins:    vacc.GetVestedCoins(blockTime),
+		VestingCoins:   vacc.GetVestingCoins(blockTime),
+		SpendableCoins: vacc.SpendableCoins(blockTime),
+	}, nil
+}
+
+// QueryAccountSequence returns the sequence the next transaction signed by the address must carry,
+// zero while the account sequences are not activated
+func (app ViperCoreApp) QueryAccountSequence(addr string, height int64) (uint64, error) {
+	a, err := sdk.AddressFromHex(addr)
+	if err != nil {
+		return 0, err
+	}
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return 0, err
+	}
+	return app.accountKeeper.GetNextSequence(ctx, a), nil
+}
+
+// QueryFeeAllowances returns the fee allowances granted to the grantee, only the one of the granter if not empty
+func (app ViperCoreApp) QueryFeeAllowances(grantee, granter string, height int64) ([]authTypes.FeeAllowance, error) {
+	ge, err := sdk.AddressFromHex(grantee)
+	if err != nil {
+		return nil, err
+	}
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return nil, err
+	}
+	if granter == "" {
+		return app.accountKeeper.GetFeeAllowances(ctx, ge), nil
+	}
+	gr, err := sdk.AddressFromHex(granter)
+	if err != nil {
+		return nil, err
+	}
+	allowance, found := app.accountKeeper.GetFeeAllowance(ctx, gr, ge)
+	if !found {
+		return nil, authTypes.ErrNoFeeAllowance(authTypes.DefaultCodespace, gr, ge)
+	}
+	return []authTypes.FeeAllowance{allowance}, nil
+}
+
+// QueryAuthorizations returns the authorizations granted to the grantee, only the ones of the granter if not empty
+func (app ViperCoreApp) QueryAuthorizations(grantee, granter string, height int64) ([]authTypes.Authorization, error) {
+	ge, err := sdk.AddressFromHex(grantee)
+	if err != nil {
+		return nil, err
+	}
+	var gr sdk.Address
+	if granter != "" {
+		gr, err = sdk.AddressFromHex(granter)
+		if err != nil {
+			return nil, err
+		}
+	}
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return nil, err
+	}
+	authorizations := make([]authTypes.Authorization, 0)
+	for _, authorization := range app.accountKeeper.GetAuthorizations(ctx, ge) {
+		if gr == nil || authorization.Granter.Equals(gr) {
+			authorizations = append(authorizations, authorization)
+		}
+	}
+	return authorizations, nil
+}
+
 func (app ViperCoreApp) QueryAccounts(height int64, page, perPage int) (res Page, err error) {
 	ctx, err := app.NewContext(height)
 	if err != nil {
@@ -206,6 +302,47 @@ func (app ViperCoreApp) QueryServicer(addr string, height int64) (res servicersT
 	return
 }
 
+func (app ViperCoreApp) QueryServicerUnbondingStakes(addr string, height int64) (res []servicersTypes.UnbondingStake, err error) {
+	a, err := sdk.AddressFromHex(addr)
+	if err != nil {
+		return res, err
+	}
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	return app.servicersKeeper.GetUnbondingStakes(ctx, a), nil
+}
+
+func (app ViperCoreApp) QueryServicerDelegations(addr string, height int64) (res servicersTypes.QueryDelegationsResponse, err error) {
+	a, err := sdk.AddressFromHex(addr)
+	if err != nil {
+		return res, err
+	}
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	res.Delegations = app.servicersKeeper.GetValidatorDelegations(ctx, a)
+	res.Commission = app.servicersKeeper.GetCommission(ctx, a)
+	return res, nil
+}
+
+func (app ViperCoreApp) QueryDelegatorDelegations(addr string, height int64) (res servicersTypes.QueryDelegatorDelegationsResponse, err error) {
+	a, err := sdk.AddressFromHex(addr)
+	if err != nil {
+		return res, err
+	}
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	res.Delegations = app.servicersKeeper.GetDelegatorDelegations(ctx, a)
+	res.UnbondingDelegations = app.servicersKeeper.GetDelegatorUnbondingDelegations(ctx, a)
+	res.Redelegations = app.servicersKeeper.GetDelegatorRedelegations(ctx, a)
+	return res, nil
+}
+
 func (app ViperCoreApp) QueryServicerParams(height int64) (res servicersTypes.Params, err error) {
 	ctx, err := app.NewContext(height)
 	if err != nil {
@@ -214,8 +351,35 @@ func (app ViperCoreApp) QueryServicerParams(height int64) (res servicersTypes.Pa
 	return app.servicersKeeper.GetParams(ctx), nil
 }
 
+func (app ViperCoreApp) QuerySamplingSessions() (res []viperTypes.SamplingStatus, err error) {
+	return viperTypes.GlobalSamplingScheduler().List(), nil
+}
+
+// QuerySubmissions returns the reportcards, claims and proofs of the local nodes that are pending or failed
+func (app ViperCoreApp) QuerySubmissions() (res []viperTypes.Submission, err error) {
+	res = make([]viperTypes.Submission, 0)
+	for _, node := range viperTypes.GlobalViperNodes {
+		res = append(res, viperTypes.GetSubmissions(node.SubmissionStore)...)
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].SessionHeader.SessionBlockHeight < res[j].SessionHeader.SessionBlockHeight
+	})
+	return res, nil
+}
+
 func (app ViperCoreApp) QueryHostedChains() (res map[string]viperTypes.HostedBlockchain, err error) {
-	return app.viperKeeper.GetHostedBlockchains().M, nil
+	hostedBlockchains := app.viperKeeper.GetHostedBlockchains()
+	hostedBlockchains.L.Lock()
+	defer hostedBlockchains.L.Unlock()
+	res = make(map[string]viperTypes.HostedBlockchain, len(hostedBlockchains.M))
+	for id, chain := range hostedBlockchains.M {
+		// attach the latest sync status of the chain
+		if status, found := viperTypes.GlobalSyncMonitor().Status(id); found {
+			chain.SyncStatus = &status
+		}
+		res[id] = chain
+	}
+	return res, nil
 }
 
 func (app ViperCoreApp) QueryHostedGeoZone() (res map[string]viperTypes.GeoZone, err error) {
@@ -315,6 +479,57 @@ func (app ViperCoreApp) QueryACL(height int64) (res types.ACL, err error) {
 	return app.governanceKeeper.GetACL(ctx), nil
 }
 
+func (app ViperCoreApp) QueryProposal(proposalID uint64, height int64) (res types.Proposal, err error) {
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	res, found := app.governanceKeeper.GetProposal(ctx, proposalID)
+	if !found {
+		return res, types.ErrProposalNotFound(types.ModuleName, proposalID)
+	}
+	return res, nil
+}
+
+func (app ViperCoreApp) QueryProposals(status string, height int64) (res types.Proposals, err error) {
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	s := types.StatusNil
+	if status != "" {
+		var er sdk.Error
+		s, er = types.ProposalStatusFromString(status)
+		if er != nil {
+			return nil, er
+		}
+	}
+	return app.governanceKeeper.GetAllProposals(ctx, s), nil
+}
+
+func (app ViperCoreApp) QueryVotes(proposalID uint64, height int64) (res []types.Vote, err error) {
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	if _, found := app.governanceKeeper.GetProposal(ctx, proposalID); !found {
+		return nil, types.ErrProposalNotFound(types.ModuleName, proposalID)
+	}
+	return app.governanceKeeper.GetVotes(ctx, proposalID), nil
+}
+
+func (app ViperCoreApp) QueryTally(proposalID uint64, height int64) (res types.TallyResult, err error) {
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	res, er := app.governanceKeeper.GetTally(ctx, proposalID)
+	if er != nil {
+		return res, er
+	}
+	return res, nil
+}
+
 type AllParamsReturn struct {
 	AppParams   []SingleParamReturn `json:"app_params"`
 	NodeParams  []SingleParamReturn `json:"node_params"`
@@ -425,6 +640,18 @@ func (app ViperCoreApp) QueryRequestor(addr string, height int64) (res requestor
 	return
 }
 
+func (app ViperCoreApp) QueryRequestorUnbondingStakes(addr string, height int64) (res []requestorsTypes.UnbondingStake, err error) {
+	a, err := sdk.AddressFromHex(addr)
+	if err != nil {
+		return res, err
+	}
+	ctx, err := app.NewContext(height)
+	if err != nil {
+		return
+	}
+	return app.requestorsKeeper.GetUnbondingStakes(ctx, a), nil
+}
+
 func (app ViperCoreApp) QueryTotalRequestorCoins(height int64) (staked sdk.BigInt, err error) {
 	ctx, err := app.NewContext(height)
 	if err != nil {
@@ -644,7 +871,49 @@ func (app ViperCoreApp) HandleRelay(r viperTypes.Relay) (res *viperTypes.RelayRe
 	return
 }
 
-func (app *ViperCoreApp) HandleWebsocketRelay(r viperTypes.Relay, conn *websocket.Conn) (dispatch *viperTypes.DispatchResponse, err error) {
+// HandleWebsocketSession serves a websocket relay session until the client disconnects
+func (app *ViperCoreApp) HandleWebsocketSession(conn *websocket.Conn) {
+	session := viperTypes.NewWebsocketSession()
+	defer session.Close()
+	// write the responses and subscription messages of the session to the client
+	go func() {
+		for {
+			select {
+			case m := <-session.Messages():
+				j, er := json.Marshal(m)
+				if er != nil {
+					log.Println("Failed to marshal response:", er)
+					continue
+				}
+				if err := conn.WriteMessage(websocket.TextMessage, j); err != nil {
+					log.Println("Failed to write message to WebSocket:", err)
+					session.Close()
+					return
+				}
+			case <-session.Done():
+				_ = conn.Close()
+				return
+			}
+		}
+	}()
+	for {
+		_, bz, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		var frame viperTypes.WebsocketFrame
+		if err := json.Unmarshal(bz, &frame); err != nil {
+			session.SendError(viperTypes.NewInvalidWebsocketFrameError(viperTypes.ModuleName), nil)
+			continue
+		}
+		dispatch, err := app.handleWebsocketFrame(session, frame)
+		if err != nil {
+			session.SendError(err, dispatch)
+		}
+	}
+}
+
+func (app *ViperCoreApp) handleWebsocketFrame(session *viperTypes.WebsocketSession, frame viperTypes.WebsocketFrame) (dispatch *viperTypes.DispatchResponse, err error) {
 	ctx, err := app.NewContext(app.LastBlockHeight())
 	if err != nil {
 		return nil, err
@@ -659,34 +928,29 @@ func (app *ViperCoreApp) HandleWebsocketRelay(r viperTypes.Relay, conn *websocke
 		return nil, fmt.Errorf("viper node is currently syncing to the blockchain, cannot service in this state")
 	}
 
-	// Assuming HandleWebsocketRelay returns a channel
-	resChan, err := app.viperKeeper.HandleWebsocketRelay(ctx, r)
-	if err != nil {
-		return dispatch, err
-	}
-
-	go func() {
-		defer close(resChan)
-
-		for res := range resChan {
-			response := viperTypes.RelayResponse{
-				Signature: res.Signature,
-				Response:  res.Response,
-			}
-			j, er := json.Marshal(response)
-			if er != nil {
-				log.Println("Failed to marshal response:", er)
-				continue
-			}
-
-			if err := conn.WriteMessage(websocket.TextMessage, j); err != nil {
-				log.Println("Failed to write message to WebSocket:", err)
-				break
+	switch frame.Type {
+	case viperTypes.WebsocketFrameRelay:
+		if frame.Relay == nil {
+			return nil, viperTypes.NewInvalidWebsocketFrameError(viperTypes.ModuleName)
+		}
+		if er := app.viperKeeper.HandleWebsocketRelay(ctx, session, *frame.Relay); er != nil {
+			if viperTypes.ErrorWarrantsDispatch(er) {
+				dispatch, _ = app.HandleDispatch(frame.Relay.Proof.SessionHeader())
 			}
+			return dispatch, er
 		}
-	}()
-
-	return dispatch, nil
+	case viperTypes.WebsocketFrameCredit:
+		if er := app.viperKeeper.HandleWebsocketCredits(ctx, session, frame.Proofs); er != nil {
+			return nil, er
+		}
+		if len(frame.Proofs) > 0 {
+			chain := frame.Proofs[0].Blockchain
+			session.Send(viperTypes.WebsocketMessage{Type: viperTypes.WebsocketMessageCredit, Chain: chain, Credits: session.Credits(chain)})
+		}
+	default:
+		return nil, viperTypes.NewInvalidWebsocketFrameError(viperTypes.ModuleName)
+	}
+	return nil, nil
 }
 
 func checkPagination(page, limit int) (int, int) {
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/vipernet-xyz/viper-network/codec"
+	"github.com/vipernet-xyz/viper-network/store/snapshots"
 	sdk "github.com/vipernet-xyz/viper-network/types"
 
 	cfg "github.com/tendermint/tendermint/config"
@@ -151,6 +152,12 @@ func OpenApplicationDB(config sdk.Config) (dbm.DB, error) {
 	return sdk.NewLevelDB(sdk.RequestorDBName, dataDir, config.TendermintConfig.LevelDBOptions.ToGoLevelDBOpts())
 }
 
+// OpenSnapshotStore opens the store of the state-sync snapshots, next to the application db
+func OpenSnapshotStore(config sdk.Config) (*snapshots.Store, error) {
+	dataDir := filepath.Join(config.TendermintConfig.RootDir, GlobalConfig.TendermintConfig.DBPath)
+	return snapshots.NewStore(filepath.Join(dataDir, "snapshots"))
+}
+
 func OpenTxIndexerDB(config sdk.Config) (dbm.DB, error) {
 	dataDir := filepath.Join(config.TendermintConfig.RootDir, GlobalConfig.TendermintConfig.DBPath)
 	return sdk.NewLevelDB(sdk.TransactionIndexerDBName, dataDir, config.TendermintConfig.LevelDBOptions.ToGoLevelDBOpts())
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log"
 	"reflect"
+	"strings"
 
 	crypto "github.com/vipernet-xyz/viper-network/crypto/codec"
 	sdk "github.com/vipernet-xyz/viper-network/types"
@@ -23,20 +24,25 @@ func GenerateAAT(requestorPubKey, clientPubKey string, key crypto.PrivateKey) (a
 	return json.MarshalIndent(aat, "", "  ")
 }
 
-func BuildMultisig(fromAddr, jsonMessage, passphrase, chainID string, pk crypto.PublicKeyMultiSig, fees int64, legacyCodec bool) ([]byte, error) {
+func GenerateScopedAAT(requestorPubKey, clientPubKey string, expirationHeight int64, chains, geoZones []string, maxRelays int64, key crypto.PrivateKey) (aatjson []byte, err error) {
+	aat, er := viperKeeper.ScopedAATGeneration(requestorPubKey, clientPubKey, expirationHeight, chains, geoZones, maxRelays, key)
+	if er != nil {
+		return nil, er
+	}
+	return json.MarshalIndent(aat, "", "  ")
+}
+
+// BuildMultisig builds a multisig transaction from a json message, or a json array of messages
+// executed in order, and adds the first signature. The sequence is the multisig account one.
+func BuildMultisig(fromAddr, jsonMessage, passphrase, chainID string, pk crypto.PublicKeyMultiSig, fees int64, sequence uint64, legacyCodec bool) ([]byte, error) {
 	fa, err := sdk.AddressFromHex(fromAddr)
 	if err != nil {
 		return nil, err
 	}
-	var m sdk.Msg
-	if err := Codec().UnmarshalJSON([]byte(jsonMessage), &m); err != nil {
+	protoMsgs, err := UnmarshalMsgs(jsonMessage)
+	if err != nil {
 		return nil, err
 	}
-	// use reflection to convert to proto msg
-	val := reflect.ValueOf(m)
-	vp := reflect.New(val.Type())
-	vp.Elem().Set(val)
-	protoMsg := vp.Interface().(sdk.ProtoMsg)
 	kb, err := GetKeybase()
 	if err != nil {
 		return nil, err
@@ -45,8 +51,69 @@ func BuildMultisig(fromAddr, jsonMessage, passphrase, chainID string, pk crypto.
 		authentication.DefaultTxEncoder(cdc),
 		authentication.DefaultTxDecoder(cdc),
 		chainID,
-		"", nil).WithKeybase(kb)
-	return txBuilder.BuildAndSignMultisigTransaction(fa, pk, protoMsg, passphrase, fees, legacyCodec)
+		"", nil).WithKeybase(kb).WithSequence(sequence)
+	return txBuilder.BuildAndSignMultisigMsgsTransaction(fa, pk, protoMsgs, passphrase, fees, legacyCodec)
+}
+
+// BuildTx builds and signs a transaction from a json message, or a json array of messages
+// executed in order, to be broadcasted with SendRawTx. A non empty fee granter pays the fees
+// through its allowance to the signer.
+func BuildTx(fromAddr, jsonMessage, passphrase, chainID string, fees int64, sequence uint64, feeGranter string, legacyCodec bool) ([]byte, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	var granter sdk.Address
+	if feeGranter != "" {
+		granter, err = sdk.AddressFromHex(feeGranter)
+		if err != nil {
+			return nil, err
+		}
+	}
+	protoMsgs, err := UnmarshalMsgs(jsonMessage)
+	if err != nil {
+		return nil, err
+	}
+	kb, err := GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	txBuilder := authentication.NewTxBuilder(
+		authentication.DefaultTxEncoder(cdc),
+		authentication.DefaultTxDecoder(cdc),
+		chainID,
+		"", sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(fees)))).WithKeybase(kb).WithSequence(sequence).WithFeeGranter(granter)
+	return txBuilder.BuildAndSignMsgsWithKeyBase(fa, passphrase, protoMsgs, legacyCodec)
+}
+
+// UnmarshalMsgs unmarshals a json message, or a json array of messages
+func UnmarshalMsgs(jsonMessage string) ([]sdk.ProtoMsg, error) {
+	jsonMessage = strings.TrimSpace(jsonMessage)
+	rawMsgs := []json.RawMessage{json.RawMessage(jsonMessage)}
+	if strings.HasPrefix(jsonMessage, "[") {
+		if err := json.Unmarshal([]byte(jsonMessage), &rawMsgs); err != nil {
+			return nil, err
+		}
+	}
+	if len(rawMsgs) == 0 {
+		return nil, fmt.Errorf("no messages to build the transaction with")
+	}
+	if len(rawMsgs) > types.MaxMsgsPerTx {
+		return nil, fmt.Errorf("too many messages: %d, max %d", len(rawMsgs), types.MaxMsgsPerTx)
+	}
+	protoMsgs := make([]sdk.ProtoMsg, 0, len(rawMsgs))
+	for _, rawMsg := range rawMsgs {
+		var m sdk.Msg
+		if err := Codec().UnmarshalJSON(rawMsg, &m); err != nil {
+			return nil, err
+		}
+		// use reflection to convert to proto msg
+		val := reflect.ValueOf(m)
+		vp := reflect.New(val.Type())
+		vp.Elem().Set(val)
+		protoMsgs = append(protoMsgs, vp.Interface().(sdk.ProtoMsg))
+	}
+	return protoMsgs, nil
 }
 
 func SignMultisigNext(fromAddr, txHex, passphrase, chainID string, legacyCodec bool) ([]byte, error) {
@@ -8,6 +8,13 @@ import (
 
 	bam "github.com/vipernet-xyz/viper-network/baseapp"
 	"github.com/vipernet-xyz/viper-network/codec"
+	icaControllerKeeper "github.com/vipernet-xyz/viper-network/modules/apps/27-interchain-accounts/controller/keeper"
+	icaControllerTypes "github.com/vipernet-xyz/viper-network/modules/apps/27-interchain-accounts/controller/types"
+	icaHostKeeper "github.com/vipernet-xyz/viper-network/modules/apps/27-interchain-accounts/host/keeper"
+	icaHostTypes "github.com/vipernet-xyz/viper-network/modules/apps/27-interchain-accounts/host/types"
+	icaTypes "github.com/vipernet-xyz/viper-network/modules/apps/27-interchain-accounts/types"
+	ibcFeeKeeper "github.com/vipernet-xyz/viper-network/modules/apps/29-fee/keeper"
+	ibcFeeTypes "github.com/vipernet-xyz/viper-network/modules/apps/29-fee/types"
 	ibcExported "github.com/vipernet-xyz/viper-network/modules/core/exported"
 	ibckeeper "github.com/vipernet-xyz/viper-network/modules/core/keeper"
 	sdk "github.com/vipernet-xyz/viper-network/types"
@@ -57,8 +64,18 @@ type ViperCoreApp struct {
 	ScopedTransferKeeper capabilityKeeper.ScopedKeeper
 	viperKeeper          viperKeeper.Keeper
 	governanceKeeper     governanceKeeper.Keeper
+	// Keepers for the interchain accounts and fee middleware ibc applications
+	icaControllerKeeper       icaControllerKeeper.Keeper
+	icaHostKeeper             icaHostKeeper.Keeper
+	ibcFeeKeeper              ibcFeeKeeper.Keeper
+	ScopedICAControllerKeeper capabilityKeeper.ScopedKeeper
+	ScopedICAHostKeeper       capabilityKeeper.ScopedKeeper
+	// routes the messages executed by the interchain accounts hosted on this chain
+	msgServiceRouter *bam.MsgServiceRouter
 	// Module Manager
 	mm *module.Manager
+	// the feed of the events streamed by the rpc
+	events *EventFeed
 }
 
 // new viper core base
@@ -70,20 +87,22 @@ func NewViperBaseApp(logger log.Logger, db db.DB, cache bool, iavlCacheSize int6
 	// set version of the baseapp
 	bApp.SetAppVersion(AppVersion)
 	// setup the key value store Keys
-	k := sdk.NewKVStoreKeys(bam.MainStoreKey, capabilityTypes.StoreKey, authentication.StoreKey, servicersTypes.StoreKey, requestorsTypes.StoreKey, transferTypes.StoreKey, ibcExported.StoreKey, viperTypes.StoreKey, governance.StoreKey)
+	k := sdk.NewKVStoreKeys(bam.MainStoreKey, capabilityTypes.StoreKey, authentication.StoreKey, servicersTypes.StoreKey, requestorsTypes.StoreKey, transferTypes.StoreKey, ibcExported.StoreKey, icaControllerTypes.StoreKey, icaHostTypes.StoreKey, ibcFeeTypes.StoreKey, viperTypes.StoreKey, governance.StoreKey)
 	// setup the transient store KeysibcExported.StoreKey, transferTypes.StoreKey)
 	tkeys := sdk.NewTransientStoreKeys(capabilityTypes.TStoreKey, servicersTypes.TStoreKey, requestorsTypes.TStoreKey, transferTypes.TStoreKey, ibcExported.TStoreKey, viperTypes.TStoreKey, governance.TStoreKey)
 
 	memkeys := sdk.NewMemoryStoreKeys(capabilityTypes.MemStoreKey)
 	// add params Keys too
 	// Create the application
-	return &ViperCoreApp{
+	app := &ViperCoreApp{
 		BaseApp: bApp,
 		cdc:     cdc,
 		Keys:    k,
 		Tkeys:   tkeys,
 		memKeys: memkeys,
 	}
+	app.events = NewEventFeed(app.eventsClient, app.eventZones, decodeTxMsgs)
+	return app
 }
 
 // inits from genesis
@@ -223,9 +242,12 @@ var (
 		servicersTypes.StakedPoolName:   {authentication.Burner, authentication.Minter, authentication.Staking},
 		requestorsTypes.StakedPoolName:  {authentication.Burner, authentication.Minter, authentication.Staking},
 		governanceTypes.DAOAccountName:  {authentication.Burner, authentication.Minter, authentication.Staking},
+		governanceTypes.ModuleName:      {authentication.Burner},
 		servicersTypes.ModuleName:       {authentication.Burner, authentication.Minter, authentication.Staking},
 		requestorsTypes.ModuleName:      nil,
 		transferTypes.ModuleName:        {authentication.Burner, authentication.Minter},
+		icaTypes.ModuleName:             nil,
+		ibcFeeTypes.ModuleName:          nil,
 	}
 )
 
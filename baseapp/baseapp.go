@@ -39,6 +39,8 @@ import (
 
 	"github.com/vipernet-xyz/viper-network/codec"
 	"github.com/vipernet-xyz/viper-network/store"
+	"github.com/vipernet-xyz/viper-network/store/snapshots"
+	snapshottypes "github.com/vipernet-xyz/viper-network/store/snapshots/types"
 	sdk "github.com/vipernet-xyz/viper-network/types"
 )
 
@@ -118,6 +120,11 @@ type BaseApp struct {
 
 	// application's version string
 	appVersion string
+
+	// manages the state-sync snapshots, nil if they are disabled
+	snapshotStore   *snapshots.Store
+	snapshotOpts    snapshottypes.SnapshotOptions
+	snapshotManager *snapshots.Manager
 }
 
 var _ abci.Application = (*BaseApp)(nil)
@@ -286,7 +293,11 @@ func (app *BaseApp) LoadLatestVersion(baseKey *sdk.KVStoreKey) error {
 	if err != nil {
 		return err
 	}
-	return app.initFromMainStore(baseKey)
+	err = app.initFromMainStore(baseKey)
+	if err != nil {
+		return err
+	}
+	return app.initSnapshotManager()
 }
 
 // LastCommitID returns the last CommitID of the multistore.
@@ -317,6 +328,19 @@ func (app *BaseApp) initFromMainStore(baseKey *sdk.KVStoreKey) error {
 	// nil, it will be saved later during InitChain.
 	//
 	// TODO: assert that InitChain hasn't yet been called.
+	if err := app.loadConsensusParams(mainStore); err != nil {
+		return err
+	}
+
+	// needed for the export command which inits from store but never calls initchain
+	app.setCheckState(abci.Header{})
+	app.Seal()
+
+	return nil
+}
+
+// loadConsensusParams memoizes the consensus params stored in the main store, if any
+func (app *BaseApp) loadConsensusParams(mainStore sdk.KVStore) error {
 	consensusParamsBz, _ := mainStore.Get(mainConsensusParamsKey)
 	if consensusParamsBz != nil {
 		var consensusParams = &abci.ConsensusParams{}
@@ -328,11 +352,6 @@ func (app *BaseApp) initFromMainStore(baseKey *sdk.KVStoreKey) error {
 
 		app.setConsensusParams(consensusParams)
 	}
-
-	// needed for the export command which inits from store but never calls initchain
-	app.setCheckState(abci.Header{})
-	app.Seal()
-
 	return nil
 }
 
@@ -818,9 +837,13 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliv
 		} else {
 			result, signerPK = app.runTx(runTxModeDeliver, req.Tx, tx)
 		}
+		// the result holds the tags of the first message, the index events carry the ones of every message
 		msg := tx.GetMsg()
 		messageType = msg.Type()
 		recipient = msg.GetRecipient()
+		for _, m := range tx.GetMsgs() {
+			result.Events = result.Events.AppendEvent(sdk.NewIndexEvent(m.Type(), m.GetRecipient()))
+		}
 		if signerPK == nil || messageType == types2.MsgRequestorStakeName {
 			signers := msg.GetSigners()
 			if len(signers) >= 1 {
@@ -846,14 +869,16 @@ func (app *BaseApp) DeliverTx(req abci.RequestDeliverTx) (res abci.ResponseDeliv
 }
 
 // validateBasicTxMsgs executes basic validator calls for messages.
-func validateBasicTxMsgs(msg sdk.Msg) sdk.Error {
-	if msg == nil {
-		return sdk.ErrUnknownRequest("Tx.GetMsg() must return at least one message")
+func validateBasicTxMsgs(msgs []sdk.Msg) sdk.Error {
+	if len(msgs) == 0 || msgs[0] == nil {
+		return sdk.ErrUnknownRequest("Tx.GetMsgs() must return at least one message")
 	}
-	// Validate the ProtoMsg.
-	err := msg.ValidateBasic()
-	if err != nil {
-		return err
+	for _, msg := range msgs {
+		// Validate the ProtoMsg.
+		err := msg.ValidateBasic()
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -872,13 +897,13 @@ func (app *BaseApp) getContextForTx(mode runTxMode, txBytes []byte) (ctx sdk.Ctx
 	return
 }
 
-// runMsg iterates through all the messages and executes them.
+// runMsgs iterates through all the messages and executes them.
 // nolint: gocyclo
-func (app *BaseApp) runMsg(ctx sdk.Ctx, msg sdk.Msg, mode runTxMode, signer crypto.PublicKey) (result sdk.Result) {
+func (app *BaseApp) runMsgs(ctx sdk.Ctx, msgs []sdk.Msg, mode runTxMode, signer crypto.PublicKey) (result sdk.Result) {
 	var msgLogs sdk.ABCIMessageLogs
 
 	if GetABCILogging() {
-		msgLogs = make(sdk.ABCIMessageLogs, 0, 1)
+		msgLogs = make(sdk.ABCIMessageLogs, 0, len(msgs))
 	}
 
 	var (
@@ -888,33 +913,37 @@ func (app *BaseApp) runMsg(ctx sdk.Ctx, msg sdk.Msg, mode runTxMode, signer cryp
 	)
 	events := sdk.EmptyEvents()
 	// NOTE: GasWanted is determined by ante handler and GasUsed by the GasMeter.
-	// match message route
-	msgRoute := msg.Route()
-	handler := app.router.Route(msgRoute)
-	if handler == nil {
-		return sdk.ErrUnknownRequest("unrecognized ProtoMsg type: " + msgRoute).Result()
-	}
-	var msgResult sdk.Result
-	// skip actual execution for CheckTx mode
-	if mode != runTxModeCheck {
-		msgResult = handler(ctx, msg, signer)
-	}
-	// Each message result's Data must be length prefixed in order to separate
-	// each result.
-	data = append(data, msgResult.Data...)
-	// append events from the message's execution and a message action event
-	events = events.AppendEvent(sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type())))
-	events = events.AppendEvents(msgResult.Events)
-	// stop execution and return on first failed message
-	if !msgResult.IsOK() {
+	for i, msg := range msgs {
+		// match message route
+		msgRoute := msg.Route()
+		handler := app.router.Route(msgRoute)
+		if handler == nil {
+			return sdk.ErrUnknownRequest("unrecognized ProtoMsg type: " + msgRoute).Result()
+		}
+		var msgResult sdk.Result
+		// skip actual execution for CheckTx mode
+		if mode != runTxModeCheck {
+			msgResult = handler(ctx, msg, signer)
+		}
+		// Each message result's Data must be length prefixed in order to separate
+		// each result.
+		data = append(data, msgResult.Data...)
+		// append events from the message's execution and a message action event
+		msgEvents := sdk.Events{sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyAction, msg.Type()))}
+		msgEvents = msgEvents.AppendEvents(msgResult.Events)
+		events = events.AppendEvents(msgEvents)
+		// stop execution and return on first failed message
+		if !msgResult.IsOK() {
+			if GetABCILogging() {
+				msgLogs = append(msgLogs, sdk.NewABCIMessageLog(uint32(i), false, msgResult.Log, msgEvents))
+			}
+			code = msgResult.Code
+			codespace = msgResult.Codespace
+			break
+		}
 		if GetABCILogging() {
-			msgLogs = append(msgLogs, sdk.NewABCIMessageLog(uint32(0), false, msgResult.Log, events))
+			msgLogs = append(msgLogs, sdk.NewABCIMessageLog(uint32(i), true, msgResult.Log, msgEvents))
 		}
-		code = msgResult.Code
-		codespace = msgResult.Codespace
-	}
-	if GetABCILogging() {
-		msgLogs = append(msgLogs, sdk.NewABCIMessageLog(uint32(0), true, msgResult.Log, events))
 	}
 	result = sdk.Result{
 		Code:      code,
@@ -1035,7 +1064,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 			}
 		}
 	}()
-	var msgs = tx.GetMsg()
+	var msgs = tx.GetMsgs()
 	if err := validateBasicTxMsgs(msgs); err != nil {
 		return err.Result(), nil
 	}
@@ -1053,6 +1082,8 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 		// writes do not happen if aborted/failed.  This may have some
 		// performance benefits, but it'll be more difficult to get right.
 		anteCtx, msCache = app.cacheTxContext(ctx, txBytes)
+		// the check and deliver states share the check flag, the sequence check of the ante handler reads the mode
+		anteCtx = sdk.WithDeliverTx(anteCtx, mode == runTxModeDeliver)
 		newCtx, result, signer, abort = app.anteHandler(anteCtx, tx, txBytes, app.txIndexer, mode == runTxModeSimulate)
 		if newCtx != nil && !newCtx.IsZero() {
 			// At this point, newCtx.MultiStore() is cache-wrapped, or something else
@@ -1079,7 +1110,7 @@ func (app *BaseApp) runTx(mode runTxMode, txBytes []byte, tx sdk.Tx) (result sdk
 	// Create a new context based off of the existing context with a cache wrapped
 	// multi-store in case message processing fails.
 	runMsgCtx, newMS := app.txContext(ctx, txBytes) // todo edit here!!!
-	result = app.runMsg(runMsgCtx, msgs, mode, signer)
+	result = app.runMsgs(runMsgCtx, msgs, mode, signer)
 	result.GasWanted = gasWanted
 
 	// Safety check: don't write the cache state unless we're in DeliverTx.
@@ -1143,6 +1174,11 @@ func (app *BaseApp) Commit() (res abci.ResponseCommit) {
 	commitID := app.cms.Commit()
 	app.logger.Debug("Commit synced", "commit", fmt.Sprintf("%X", commitID))
 
+	// snapshot the committed height in the background
+	if app.snapshotManager != nil {
+		app.snapshotManager.SnapshotIfApplicable(commitID.Version)
+	}
+
 	// Reset the Check state to the latest committed.
 	//
 	// NOTE: This is safe because Tendermint holds a lock on the mempool for
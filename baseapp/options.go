@@ -5,6 +5,8 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	"github.com/vipernet-xyz/viper-network/store"
+	"github.com/vipernet-xyz/viper-network/store/snapshots"
+	snapshottypes "github.com/vipernet-xyz/viper-network/store/snapshots/types"
 	sdk "github.com/vipernet-xyz/viper-network/types"
 )
 
@@ -16,6 +18,12 @@ func SetPruning(opts sdk.PruningOptions) func(*BaseApp) {
 	return func(bap *BaseApp) { bap.cms.SetPruning(opts) }
 }
 
+// SetSnapshot sets the state-sync snapshot store and options, the snapshots are taken once the
+// latest version is loaded
+func SetSnapshot(snapshotStore *snapshots.Store, opts snapshottypes.SnapshotOptions) func(*BaseApp) {
+	return func(bap *BaseApp) { bap.setSnapshot(snapshotStore, opts) }
+}
+
 // SetHaltHeight returns a BaseApp option function that sets the halt block height.
 func SetHaltHeight(blockHeight uint64) func(*BaseApp) {
 	return func(bap *BaseApp) { bap.setHaltHeight(blockHeight) }
@@ -0,0 +1,59 @@
+package baseapp
+
+import (
+	"errors"
+
+	"github.com/vipernet-xyz/viper-network/store/snapshots"
+	snapshottypes "github.com/vipernet-xyz/viper-network/store/snapshots/types"
+)
+
+func (app *BaseApp) setSnapshot(snapshotStore *snapshots.Store, opts snapshottypes.SnapshotOptions) {
+	app.snapshotStore = snapshotStore
+	app.snapshotOpts = opts
+}
+
+// initSnapshotManager creates the snapshot manager once the multistore is loaded
+func (app *BaseApp) initSnapshotManager() error {
+	if app.snapshotStore == nil {
+		return nil
+	}
+	snapshotter, ok := app.cms.(snapshottypes.Snapshotter)
+	if !ok {
+		return errors.New("state-sync snapshots require a multistore that can be snapshotted")
+	}
+	app.snapshotManager = snapshots.NewManager(app.snapshotStore, snapshotter, app.snapshotOpts, app.logger.With("module", "snapshots"))
+	return nil
+}
+
+// SnapshotManager returns the state-sync snapshot manager, nil if the snapshots are disabled
+func (app *BaseApp) SnapshotManager() *snapshots.Manager {
+	return app.snapshotManager
+}
+
+// ListSnapshots implements the ABCI state-sync call, it returns the local snapshots.
+func (app *BaseApp) ListSnapshots(_ snapshottypes.RequestListSnapshots) snapshottypes.ResponseListSnapshots {
+	resp := snapshottypes.ResponseListSnapshots{Snapshots: []*snapshottypes.Snapshot{}}
+	if app.snapshotManager == nil {
+		return resp
+	}
+	list, err := app.snapshotManager.List()
+	if err != nil {
+		app.logger.Error("failed to list snapshots", "err", err)
+		return resp
+	}
+	resp.Snapshots = list
+	return resp
+}
+
+// LoadSnapshotChunk implements the ABCI state-sync call, it loads a chunk of a local snapshot.
+func (app *BaseApp) LoadSnapshotChunk(req snapshottypes.RequestLoadSnapshotChunk) snapshottypes.ResponseLoadSnapshotChunk {
+	if app.snapshotManager == nil {
+		return snapshottypes.ResponseLoadSnapshotChunk{}
+	}
+	chunk, err := app.snapshotManager.LoadChunk(req.Height, req.Format, req.Chunk)
+	if err != nil {
+		app.logger.Error("failed to load snapshot chunk", "height", req.Height, "format", req.Format, "chunk", req.Chunk, "err", err)
+		return snapshottypes.ResponseLoadSnapshotChunk{}
+	}
+	return snapshottypes.ResponseLoadSnapshotChunk{Chunk: chunk}
+}
@@ -0,0 +1,239 @@
+// Package gateway serves a plain JSON-RPC endpoint per chain, over http and websocket, relaying every request to the
+// servicers of the chain session, so that the existing chain tooling can be pointed at a local url.
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/vipernet-xyz/viper-network/client/relay"
+)
+
+const (
+	// DefaultMaxBodySize is the maximum size of a request body
+	DefaultMaxBodySize = 1 << 20
+	// DefaultMaxInFlight is the maximum number of requests relayed at once for a websocket connection
+	DefaultMaxInFlight = 16
+	// jsonRPCInternalError is the json-rpc error code of a request that couldn't be relayed
+	jsonRPCInternalError = -32603
+)
+
+// Config is the configuration of a Gateway
+type Config struct {
+	Chains      []string // the network identifiers of the chains served, every chain when empty
+	GeoZone     string   // the geozone of the servicers, the client geozone when empty
+	Quorum      int      // the number of servicers that must agree on a response, disabled when one or less
+	MaxBodySize int64    // the maximum size of a request body
+	// the origins allowed besides the gateway host, like https://app.example.com, every origin when it holds "*"
+	AllowedOrigins []string
+	MaxInFlight    int // the maximum number of requests relayed at once for a websocket connection
+}
+
+// Gateway is an http handler serving the chains at /<chainID>[/<path>]
+type Gateway struct {
+	client   *relay.Client
+	config   Config
+	chains   map[string]struct{}
+	origins  map[string]struct{}
+	upgrader websocket.Upgrader
+}
+
+// NewGateway returns a gateway relaying the requests with the client
+func NewGateway(client *relay.Client, config Config) *Gateway {
+	if config.MaxBodySize <= 0 {
+		config.MaxBodySize = DefaultMaxBodySize
+	}
+	if config.MaxInFlight <= 0 {
+		config.MaxInFlight = DefaultMaxInFlight
+	}
+	chains := make(map[string]struct{}, len(config.Chains))
+	for _, c := range config.Chains {
+		chains[c] = struct{}{}
+	}
+	origins := make(map[string]struct{}, len(config.AllowedOrigins))
+	for _, o := range config.AllowedOrigins {
+		origins[strings.ToLower(strings.TrimSuffix(o, "/"))] = struct{}{}
+	}
+	g := &Gateway{
+		client:  client,
+		config:  config,
+		chains:  chains,
+		origins: origins,
+	}
+	g.upgrader = websocket.Upgrader{CheckOrigin: g.checkOrigin}
+	return g
+}
+
+// ServeHTTP relays the request to the chain of the path, upgrading it to a websocket when requested
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	// the requests are relayed with the AAT of the user, the web pages of other sites must not be able to spend them
+	if !g.checkOrigin(r) {
+		http.Error(w, fmt.Sprintf("the origin %s is not allowed", r.Header.Get("Origin")), http.StatusForbidden)
+		return
+	}
+	chain, path := splitPath(r.URL.Path)
+	if chain == "" {
+		http.Error(w, "the path must start with a chain id: /<chainID>", http.StatusNotFound)
+		return
+	}
+	if !g.servesChain(chain) {
+		http.Error(w, fmt.Sprintf("the chain %s is not served by the gateway", chain), http.StatusNotFound)
+		return
+	}
+	if websocket.IsWebSocketUpgrade(r) {
+		g.serveWebsocket(w, r, chain, path)
+		return
+	}
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, g.config.MaxBodySize))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+		return
+	}
+	res, err := g.relay(r.Context(), chain, path, r.Method, string(body))
+	w.Header().Set("Content-Type", "application/json")
+	if err != nil {
+		w.WriteHeader(http.StatusBadGateway)
+		_, _ = w.Write(jsonRPCError(body, err))
+		return
+	}
+	_, _ = w.Write([]byte(res))
+}
+
+// serveWebsocket relays every message of the websocket as a request, writing back the responses as they arrive.
+// Every message is relayed on its own: subscriptions are not supported. At most MaxInFlight messages are relayed at
+// once, the next messages are not read until a relay completes.
+func (g *Gateway) serveWebsocket(w http.ResponseWriter, r *http.Request, chain, path string) {
+	conn, err := g.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	conn.SetReadLimit(g.config.MaxBodySize)
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	var (
+		writeMu  sync.Mutex
+		wg       sync.WaitGroup
+		inFlight = make(chan struct{}, g.config.MaxInFlight)
+	)
+	defer wg.Wait()
+	for {
+		messageType, msg, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		if messageType != websocket.TextMessage {
+			continue
+		}
+		select {
+		case inFlight <- struct{}{}:
+		case <-ctx.Done():
+			return
+		}
+		wg.Add(1)
+		go func(msg []byte) {
+			defer func() {
+				<-inFlight
+				wg.Done()
+			}()
+			res, err := g.relay(ctx, chain, path, http.MethodPost, string(msg))
+			out := []byte(res)
+			if err != nil {
+				out = jsonRPCError(msg, err)
+			}
+			writeMu.Lock()
+			defer writeMu.Unlock()
+			_ = conn.WriteMessage(websocket.TextMessage, out)
+		}(msg)
+	}
+}
+
+func (g *Gateway) relay(ctx context.Context, chain, path, method, data string) (string, error) {
+	req := relay.Request{
+		Chain:   chain,
+		GeoZone: g.config.GeoZone,
+		Data:    data,
+		Method:  method,
+		Path:    path,
+	}
+	res, err := g.client.RelayWithQuorum(ctx, req, g.config.Quorum)
+	if err != nil {
+		return "", err
+	}
+	return res.Response, nil
+}
+
+// checkOrigin accepts the requests without an origin, like the ones of the chain tooling, the requests of the
+// gateway host and the requests of the allowed origins
+func (g *Gateway) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return true
+	}
+	if _, ok := g.origins["*"]; ok {
+		return true
+	}
+	if _, ok := g.origins[strings.ToLower(strings.TrimSuffix(origin, "/"))]; ok {
+		return true
+	}
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(u.Host, r.Host)
+}
+
+func (g *Gateway) servesChain(chain string) bool {
+	if len(g.chains) == 0 {
+		return true
+	}
+	_, ok := g.chains[chain]
+	return ok
+}
+
+// splitPath splits /<chainID>/<path> into the chain id and the REST path of the chain
+func splitPath(urlPath string) (chain, path string) {
+	parts := strings.SplitN(strings.TrimPrefix(urlPath, "/"), "/", 2)
+	chain = parts[0]
+	if len(parts) == 2 && parts[1] != "" {
+		path = "/" + parts[1]
+	}
+	return
+}
+
+// jsonRPCErrorResponse is the json-rpc response of a request that couldn't be relayed
+type jsonRPCErrorResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Error   jsonRPCErrorObj `json:"error"`
+}
+
+type jsonRPCErrorObj struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// jsonRPCError returns the json-rpc error response to the request, with its id when the request has one
+func jsonRPCError(request []byte, err error) []byte {
+	req := struct {
+		ID json.RawMessage `json:"id"`
+	}{}
+	_ = json.Unmarshal(request, &req)
+	if len(req.ID) == 0 {
+		req.ID = json.RawMessage("null")
+	}
+	bz, _ := json.Marshal(jsonRPCErrorResponse{
+		JSONRPC: "2.0",
+		ID:      req.ID,
+		Error:   jsonRPCErrorObj{Code: jsonRPCInternalError, Message: err.Error()},
+	})
+	return bz
+}
@@ -0,0 +1,194 @@
+package gateway
+
+import (
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vipernet-xyz/viper-network/client/relay"
+	"github.com/vipernet-xyz/viper-network/client/relay/relaytest"
+	crypto "github.com/vipernet-xyz/viper-network/crypto/codec"
+	sdk "github.com/vipernet-xyz/viper-network/types"
+	"github.com/vipernet-xyz/viper-network/x/viper-main/types"
+)
+
+func newTestGateway(t *testing.T, network *relaytest.Network, config Config) *httptest.Server {
+	clientKey := crypto.GenerateEd25519PrivKey()
+	aat := types.AAT{
+		Version:            "0.0.1",
+		RequestorPublicKey: crypto.GenerateEd25519PrivKey().PublicKey().RawString(),
+		ClientPublicKey:    clientKey.PublicKey().RawString(),
+	}
+	sig, err := clientKey.Sign(aat.Hash())
+	require.NoError(t, err)
+	aat.RequestorSignature = hex.EncodeToString(sig)
+	clientConfig := relay.DefaultConfig(network.URLs()...)
+	clientConfig.Timeout = 5 * time.Second
+	client, err := relay.NewClient(aat, clientKey, clientConfig)
+	require.NoError(t, err)
+	return httptest.NewServer(NewGateway(client, config))
+}
+
+func post(t *testing.T, url, body string) (int, string) {
+	res, err := http.Post(url, "application/json", strings.NewReader(body))
+	require.NoError(t, err)
+	defer res.Body.Close()
+	bz, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+	return res.StatusCode, string(bz)
+}
+
+func postWithOrigin(t *testing.T, url, origin, body string) int {
+	req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(body))
+	require.NoError(t, err)
+	req.Header.Set("Origin", origin)
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	return res.StatusCode
+}
+
+func TestGateway_HTTP(t *testing.T) {
+	network := relaytest.NewNetwork(3)
+	defer network.Close()
+	gw := newTestGateway(t, network, Config{Chains: []string{"0001"}})
+	defer gw.Close()
+
+	var path string
+	for _, s := range network.Servicers {
+		s.SetHandler(func(payload types.Payload) (string, sdk.Error) {
+			path = payload.Path
+			return `{"jsonrpc":"2.0","id":1,"result":"0x10"}`, nil
+		})
+	}
+	status, body := post(t, gw.URL+"/0001", `{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[]}`)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, `{"jsonrpc":"2.0","id":1,"result":"0x10"}`, body)
+	assert.Equal(t, "", path)
+	// the rest of the path is the REST path of the chain
+	status, _ = post(t, gw.URL+"/0001/v1/status", `{}`)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, "/v1/status", path)
+	// unserved chains
+	status, _ = post(t, gw.URL+"/0002", `{}`)
+	assert.Equal(t, http.StatusNotFound, status)
+	status, _ = post(t, gw.URL+"/", `{}`)
+	assert.Equal(t, http.StatusNotFound, status)
+	// the relay errors are json-rpc errors
+	for _, s := range network.Servicers {
+		s.SetHandler(func(payload types.Payload) (string, sdk.Error) {
+			return "", types.NewHTTPExecutionError(types.ModuleName, io.EOF)
+		})
+	}
+	status, body = post(t, gw.URL+"/0001", `{"jsonrpc":"2.0","id":7,"method":"eth_blockNumber"}`)
+	assert.Equal(t, http.StatusBadGateway, status)
+	assert.Contains(t, body, `"id":7`)
+	assert.Contains(t, body, `"code":-32603`)
+}
+
+func TestGateway_Quorum(t *testing.T) {
+	network := relaytest.NewNetwork(3)
+	defer network.Close()
+	gw := newTestGateway(t, network, Config{Quorum: 3})
+	defer gw.Close()
+
+	network.Servicers[0].SetHandler(func(payload types.Payload) (string, sdk.Error) { return `{"result":"0x0"}`, nil })
+	status, body := post(t, gw.URL+"/0001", `{"result":"0x1"}`)
+	assert.Equal(t, http.StatusOK, status)
+	assert.Equal(t, `{"result":"0x1"}`, body)
+	network.Servicers[1].SetHandler(func(payload types.Payload) (string, sdk.Error) { return `{"result":"0x2"}`, nil })
+	status, _ = post(t, gw.URL+"/0001", `{"result":"0x1"}`)
+	assert.Equal(t, http.StatusBadGateway, status)
+}
+
+func TestGateway_Websocket(t *testing.T) {
+	network := relaytest.NewNetwork(2)
+	defer network.Close()
+	gw := newTestGateway(t, network, Config{})
+	defer gw.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(gw.URL, "http")+"/0001", nil)
+	require.NoError(t, err)
+	defer conn.Close()
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_chainId"}`)))
+	_ = conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	_, msg, err := conn.ReadMessage()
+	require.NoError(t, err)
+	assert.Equal(t, `{"jsonrpc":"2.0","id":1,"method":"eth_chainId"}`, string(msg))
+}
+
+func TestGateway_Origin(t *testing.T) {
+	network := relaytest.NewNetwork(2)
+	defer network.Close()
+	gw := newTestGateway(t, network, Config{AllowedOrigins: []string{"https://app.example.com/"}})
+	defer gw.Close()
+
+	body := `{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber"}`
+	assert.Equal(t, http.StatusForbidden, postWithOrigin(t, gw.URL+"/0001", "https://evil.example.com", body))
+	assert.Equal(t, http.StatusForbidden, postWithOrigin(t, gw.URL+"/0001", "null", body))
+	assert.Equal(t, http.StatusOK, postWithOrigin(t, gw.URL+"/0001", gw.URL, body))
+	assert.Equal(t, http.StatusOK, postWithOrigin(t, gw.URL+"/0001", "https://APP.example.com", body))
+	// the websockets of other origins are refused
+	wsURL := "ws" + strings.TrimPrefix(gw.URL, "http") + "/0001"
+	_, res, err := websocket.DefaultDialer.Dial(wsURL, http.Header{"Origin": {"https://evil.example.com"}})
+	require.Error(t, err)
+	assert.Equal(t, http.StatusForbidden, res.StatusCode)
+	conn, _, err := websocket.DefaultDialer.Dial(wsURL, http.Header{"Origin": {gw.URL}})
+	require.NoError(t, err)
+	_ = conn.Close()
+	// every origin is allowed with *
+	open := newTestGateway(t, network, Config{AllowedOrigins: []string{"*"}})
+	defer open.Close()
+	assert.Equal(t, http.StatusOK, postWithOrigin(t, open.URL+"/0001", "https://evil.example.com", body))
+}
+
+func TestGateway_WebsocketInFlight(t *testing.T) {
+	network := relaytest.NewNetwork(2)
+	defer network.Close()
+	gw := newTestGateway(t, network, Config{MaxInFlight: 2})
+	defer gw.Close()
+
+	var (
+		mu                sync.Mutex
+		inFlight, maxSeen int
+	)
+	for _, s := range network.Servicers {
+		s.SetHandler(func(payload types.Payload) (string, sdk.Error) {
+			mu.Lock()
+			inFlight++
+			if inFlight > maxSeen {
+				maxSeen = inFlight
+			}
+			mu.Unlock()
+			time.Sleep(50 * time.Millisecond)
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+			return payload.Data, nil
+		})
+	}
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(gw.URL, "http")+"/0001", nil)
+	require.NoError(t, err)
+	defer conn.Close()
+	const messages = 8
+	for i := 0; i < messages; i++ {
+		require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":1}`)))
+	}
+	_ = conn.SetReadDeadline(time.Now().Add(10 * time.Second))
+	for i := 0; i < messages; i++ {
+		_, _, err := conn.ReadMessage()
+		require.NoError(t, err)
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	assert.Equal(t, 2, maxSeen)
+}
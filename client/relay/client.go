@@ -0,0 +1,466 @@
+// Package relay implements a client that relays requests to the servicers of a session: it dispatches and caches
+// the sessions of every chain and geozone, signs the relay proofs with the client key of an AAT, rotates through
+// the session servicers and dispatches a new session when a servicer reports the session is no longer valid.
+package relay
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	crypto "github.com/vipernet-xyz/viper-network/crypto/codec"
+	sdk "github.com/vipernet-xyz/viper-network/types"
+	servicersTypes "github.com/vipernet-xyz/viper-network/x/servicers/types"
+	"github.com/vipernet-xyz/viper-network/x/viper-main/types"
+)
+
+const (
+	DispatchRoute = "/v1/client/dispatch"
+	RelayRoute    = "/v1/client/relay"
+)
+
+var (
+	// ErrNoDispatchers is returned when the client is configured without dispatchers
+	ErrNoDispatchers = errors.New("no dispatchers")
+	// ErrNoServicers is returned when a dispatched session has no servicers
+	ErrNoServicers = errors.New("the session has no servicers")
+	// ErrInvalidResponseSignature is returned when a servicer response isn't signed by the servicer
+	ErrInvalidResponseSignature = errors.New("the response is not signed by the servicer")
+	// ErrNoQuorum is returned when not enough servicers agree on the response of a quorum relay
+	ErrNoQuorum = errors.New("the servicers didn't reach a quorum on the response")
+)
+
+// Config is the configuration of a Client
+type Config struct {
+	Dispatchers      []string      // the urls of the nodes the sessions are dispatched from
+	GeoZone          string        // the geozone of the requests that don't specify one
+	NumServicers     int64         // the number of servicers of the dispatched sessions
+	MaxRetries       int           // the maximum number of attempts of a relay
+	Timeout          time.Duration // the timeout of a single dispatch or relay request
+	BlocksPerSession int64         // the number of blocks of a session
+	BlockTime        time.Duration // the expected time between blocks, used to track the session rollover
+}
+
+// DefaultConfig returns the default client configuration with the dispatchers
+func DefaultConfig(dispatchers ...string) Config {
+	return Config{
+		Dispatchers:      dispatchers,
+		GeoZone:          "0001",
+		NumServicers:     5,
+		MaxRetries:       5,
+		Timeout:          20 * time.Second,
+		BlocksPerSession: servicersTypes.DefaultSessionBlocktime,
+		BlockTime:        15 * time.Minute,
+	}
+}
+
+// Validate checks the client configuration
+func (c Config) Validate() error {
+	if len(c.Dispatchers) == 0 {
+		return ErrNoDispatchers
+	}
+	if err := types.GeoZoneIdentifierVerification(c.GeoZone); err != nil {
+		return fmt.Errorf("invalid geozone %q: %s", c.GeoZone, err.Error())
+	}
+	if c.NumServicers < 1 {
+		return fmt.Errorf("invalid number of servicers: %d", c.NumServicers)
+	}
+	if c.MaxRetries < 1 {
+		return fmt.Errorf("invalid max retries: %d", c.MaxRetries)
+	}
+	if c.BlocksPerSession < 1 {
+		return fmt.Errorf("invalid blocks per session: %d", c.BlocksPerSession)
+	}
+	return nil
+}
+
+// Request is a request relayed to a chain
+type Request struct {
+	Chain   string            // the network identifier of the chain
+	GeoZone string            // the geozone of the servicers, the configured geozone if empty
+	Data    string            // the body of the request
+	Method  string            // the http method
+	Path    string            // the REST path
+	Headers map[string]string // the http headers
+}
+
+// Response is the response of a servicer to a relayed request
+type Response struct {
+	Response  string                   // the raw response of the chain
+	Signature string                   // the signature of the servicer over the response
+	Proof     types.RelayProof         // the relay proof sent with the request
+	Servicer  servicersTypes.Validator // the servicer that relayed the request
+}
+
+// Client relays requests on behalf of the requestor of an AAT, signing the relay proofs with the client key
+type Client struct {
+	aat        types.AAT
+	clientKey  crypto.PrivateKey
+	config     Config
+	httpClient *http.Client
+	sessions   *sessionCache
+	mu         sync.Mutex
+	dispatcher int // the index of the next dispatcher
+	rand       *rand.Rand
+	now        func() time.Time
+}
+
+// NewClient returns a client relaying with the AAT, whose client public key must be the one of the client key
+func NewClient(aat types.AAT, clientKey crypto.PrivateKey, config Config) (*Client, error) {
+	if err := config.Validate(); err != nil {
+		return nil, err
+	}
+	if err := aat.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid aat: %s", err.Error())
+	}
+	if clientKey == nil || aat.ClientPublicKey != clientKey.PublicKey().RawString() {
+		return nil, errors.New("the client key doesn't match the client public key of the aat")
+	}
+	return &Client{
+		aat:        aat,
+		clientKey:  clientKey,
+		config:     config,
+		httpClient: &http.Client{Timeout: config.Timeout},
+		sessions:   newSessionCache(),
+		rand:       rand.New(rand.NewSource(time.Now().UnixNano())),
+		now:        time.Now,
+	}, nil
+}
+
+// Relay relays the request to a servicer of the session of its chain and geozone
+func (c *Client) Relay(req Request) (*Response, error) {
+	return c.RelayWithCtx(context.Background(), req)
+}
+
+// RelayWithCtx relays the request to a servicer of the session of its chain and geozone. The servicers of the
+// session are tried in turn and a new session is dispatched when a servicer warrants it, up to MaxRetries attempts.
+func (c *Client) RelayWithCtx(ctx context.Context, req Request) (*Response, error) {
+	key := c.sessionKey(req.Chain, req.GeoZone)
+	var lastErr error
+	for attempt := 0; attempt < c.config.MaxRetries; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		session, err := c.session(ctx, key)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		servicer, ok := c.sessions.servicer(session)
+		if !ok {
+			c.sessions.delete(key)
+			lastErr = ErrNoServicers
+			continue
+		}
+		res, dispatch, err := c.relay(ctx, session, servicer, req)
+		if err == nil {
+			return res, nil
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		lastErr = err
+		var relayErr *types.RelayError
+		if !errors.As(err, &relayErr) {
+			// the servicer is unreachable or misbehaved, try the next one
+			continue
+		}
+		switch {
+		case warrantsDispatch(relayErr):
+			c.renewSession(key, dispatch)
+		case !isServicerError(relayErr):
+			return nil, err
+		}
+	}
+	return nil, fmt.Errorf("relay failed after %d attempts: %w", c.config.MaxRetries, lastErr)
+}
+
+// RelayWithQuorum relays the request to the number of distinct servicers of the session and returns the response
+// of the majority of them. A quorum of one or less is a regular relay.
+func (c *Client) RelayWithQuorum(ctx context.Context, req Request, quorum int) (*Response, error) {
+	if quorum <= 1 {
+		return c.RelayWithCtx(ctx, req)
+	}
+	type result struct {
+		res      *Response
+		dispatch *dispatchResponse
+		err      error
+	}
+	key := c.sessionKey(req.Chain, req.GeoZone)
+	lastErr := ErrNoQuorum
+	for attempt := 0; attempt < c.config.MaxRetries; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		session, err := c.session(ctx, key)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		servicers := c.sessions.servicers(session, quorum)
+		if len(servicers) < quorum {
+			return nil, fmt.Errorf("%w: the session has %d servicers, %d are required", ErrNoQuorum, len(servicers), quorum)
+		}
+		results := make([]result, len(servicers))
+		var wg sync.WaitGroup
+		for i, servicer := range servicers {
+			wg.Add(1)
+			go func(i int, servicer servicersTypes.Validator) {
+				defer wg.Done()
+				res, dispatch, err := c.relay(ctx, session, servicer, req)
+				results[i] = result{res: res, dispatch: dispatch, err: err}
+			}(i, servicer)
+		}
+		wg.Wait()
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		responses := make(map[string][]*Response)
+		failed := false
+		for _, r := range results {
+			if r.err != nil {
+				failed = true
+				lastErr = r.err
+				var relayErr *types.RelayError
+				if errors.As(r.err, &relayErr) && warrantsDispatch(relayErr) {
+					c.renewSession(key, r.dispatch)
+				}
+				continue
+			}
+			responses[r.res.Response] = append(responses[r.res.Response], r.res)
+		}
+		for _, agreeing := range responses {
+			if len(agreeing) > quorum/2 {
+				return agreeing[0], nil
+			}
+		}
+		if !failed {
+			// every servicer responded, asking again won't change the outcome
+			return nil, fmt.Errorf("%w: %d different responses from %d servicers", ErrNoQuorum, len(responses), quorum)
+		}
+	}
+	return nil, fmt.Errorf("quorum relay failed after %d attempts: %w", c.config.MaxRetries, lastErr)
+}
+
+// Dispatch returns the current session of the chain in the geozone, dispatching it when it isn't cached or rolled over
+func (c *Client) Dispatch(chain, geoZone string) (*Session, error) {
+	return c.DispatchWithCtx(context.Background(), chain, geoZone)
+}
+
+// DispatchWithCtx returns the current session of the chain in the geozone, dispatching it when it isn't cached or rolled over
+func (c *Client) DispatchWithCtx(ctx context.Context, chain, geoZone string) (*Session, error) {
+	return c.session(ctx, c.sessionKey(chain, geoZone))
+}
+
+// InvalidateSession removes the cached session of the chain in the geozone, the next relay dispatches a new one
+func (c *Client) InvalidateSession(chain, geoZone string) {
+	c.sessions.delete(c.sessionKey(chain, geoZone))
+}
+
+func (c *Client) sessionKey(chain, geoZone string) sessionKey {
+	if geoZone == "" {
+		geoZone = c.config.GeoZone
+	}
+	return sessionKey{chain: chain, geoZone: geoZone}
+}
+
+// renewSession replaces the session of the key with the one returned by a servicer, or drops it to be dispatched again
+func (c *Client) renewSession(key sessionKey, dispatch *dispatchResponse) {
+	if dispatch != nil && len(dispatch.Session.Servicers) != 0 {
+		c.sessions.set(key, newSession(*dispatch, c.now()))
+		return
+	}
+	c.sessions.delete(key)
+}
+
+// session returns the cached session of the key, dispatching a new one when missing or rolled over
+func (c *Client) session(ctx context.Context, key sessionKey) (*Session, error) {
+	now := c.now()
+	if s, found := c.sessions.get(key); found && !s.IsExpired(now, c.config.BlockTime, c.config.BlocksPerSession) {
+		return s, nil
+	}
+	header := types.SessionHeader{
+		RequestorPubKey: c.aat.RequestorPublicKey,
+		Chain:           key.chain,
+		GeoZone:         key.geoZone,
+		NumServicers:    c.config.NumServicers,
+	}
+	var lastErr error
+	for range c.config.Dispatchers {
+		res, err := c.dispatch(ctx, c.nextDispatcher(), header)
+		if err != nil {
+			lastErr = err
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			continue
+		}
+		if len(res.Session.Servicers) == 0 {
+			return nil, ErrNoServicers
+		}
+		s := newSession(*res, c.now())
+		c.sessions.set(key, s)
+		return s, nil
+	}
+	return nil, fmt.Errorf("unable to dispatch a session for chain %s in geozone %s: %w", key.chain, key.geoZone, lastErr)
+}
+
+func (c *Client) nextDispatcher() string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	dispatcher := c.config.Dispatchers[c.dispatcher%len(c.config.Dispatchers)]
+	c.dispatcher = (c.dispatcher + 1) % len(c.config.Dispatchers)
+	return dispatcher
+}
+
+func (c *Client) entropy() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.rand.Int63n(math.MaxInt64)
+}
+
+func (c *Client) dispatch(ctx context.Context, dispatcher string, header types.SessionHeader) (*dispatchResponse, error) {
+	body, status, err := c.post(ctx, dispatcher, DispatchRoute, header)
+	if err != nil {
+		return nil, err
+	}
+	if status != http.StatusOK {
+		rpcErr := types.RPCError{}
+		if err := json.Unmarshal(body, &rpcErr); err != nil || rpcErr.Message == "" {
+			return nil, fmt.Errorf("dispatch responded with status %d", status)
+		}
+		return nil, &rpcErr
+	}
+	res := dispatchResponse{}
+	if err := json.Unmarshal(body, &res); err != nil {
+		return nil, err
+	}
+	return &res, nil
+}
+
+// relay sends the signed relay to the servicer, returning the new session of the servicer along with a relay error
+func (c *Client) relay(ctx context.Context, session *Session, servicer servicersTypes.Validator, req Request) (*Response, *dispatchResponse, error) {
+	relay := types.Relay{
+		Payload: types.Payload{
+			Data:    req.Data,
+			Method:  req.Method,
+			Path:    req.Path,
+			Headers: req.Headers,
+		},
+		Meta: types.RelayMeta{BlockHeight: session.EstimatedHeight(c.now(), c.config.BlockTime)},
+	}
+	relay.Proof = types.RelayProof{
+		RequestHash:        relay.RequestHashString(),
+		Entropy:            c.entropy(),
+		SessionBlockHeight: session.Header.SessionBlockHeight,
+		ServicerPubKey:     servicer.PublicKey.RawString(),
+		Blockchain:         session.Header.Chain,
+		Token:              c.aat,
+		GeoZone:            session.Header.GeoZone,
+		NumServicers:       session.Header.NumServicers,
+	}
+	sig, err := c.clientKey.Sign(relay.Proof.Hash())
+	if err != nil {
+		return nil, nil, err
+	}
+	relay.Proof.Signature = hex.EncodeToString(sig)
+	body, status, err := c.post(ctx, servicer.ServiceURL, RelayRoute, relay)
+	if err != nil {
+		return nil, nil, err
+	}
+	if status == http.StatusBadRequest || status == http.StatusTooManyRequests {
+		output := struct {
+			types.RelayErrorOutput
+			Dispatch *dispatchResponse `json:"dispatch"`
+		}{}
+		if err := json.Unmarshal(body, &output); err != nil {
+			return nil, nil, err
+		}
+		return nil, output.Dispatch, &types.RelayError{
+			Code:           output.Error.Code,
+			Codespace:      output.Error.Codespace,
+			Message:        output.Error.Message,
+			ServicerPubKey: relay.Proof.ServicerPubKey,
+		}
+	}
+	if status != http.StatusOK {
+		return nil, nil, fmt.Errorf("servicer %s responded with status %d", servicer.ServiceURL, status)
+	}
+	output := types.RelayOutput{}
+	if err := json.Unmarshal(body, &output); err != nil {
+		return nil, nil, err
+	}
+	if err := verifyResponse(servicer.PublicKey, output.Response, output.Signature, relay.Proof); err != nil {
+		return nil, nil, err
+	}
+	return &Response{
+		Response:  output.Response,
+		Signature: output.Signature,
+		Proof:     relay.Proof,
+		Servicer:  servicer,
+	}, nil, nil
+}
+
+func (c *Client) post(ctx context.Context, url, route string, params interface{}) ([]byte, int, error) {
+	bz, err := json.Marshal(params)
+	if err != nil {
+		return nil, 0, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, strings.TrimSuffix(url, "/")+route, bytes.NewReader(bz))
+	if err != nil {
+		return nil, 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer types.CloseOrLog(res.Body)
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, 0, err
+	}
+	return body, res.StatusCode, nil
+}
+
+// verifyResponse checks the signature of the servicer over the response to the relay proof
+func verifyResponse(servicer crypto.PublicKey, response, signature string, proof types.RelayProof) error {
+	sig, err := hex.DecodeString(signature)
+	if err != nil {
+		return ErrInvalidResponseSignature
+	}
+	rr := types.RelayResponse{Response: response, Proof: proof}
+	if !servicer.VerifyBytes(rr.Hash(), sig) {
+		return ErrInvalidResponseSignature
+	}
+	return nil
+}
+
+// warrantsDispatch returns true if the relay error means the session of the client is no longer valid
+func warrantsDispatch(err *types.RelayError) bool {
+	return types.ErrorWarrantsDispatch(sdk.NewError(sdk.CodespaceType(err.Codespace), sdk.CodeType(err.Code), err.Message))
+}
+
+// isServicerError returns true if the relay error is specific to the servicer, so another servicer may succeed
+func isServicerError(err *types.RelayError) bool {
+	if err.Code == 0 {
+		// not a relay error, e.g. the servicer is syncing
+		return true
+	}
+	switch sdk.CodeType(err.Code) {
+	case types.CodeChainOutOfSyncError, types.CodeHTTPExecutionError, types.CodeUnsupportedBlockchainNodeError,
+		types.CodeRateLimitedError:
+		return true
+	}
+	return false
+}
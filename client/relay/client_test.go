@@ -0,0 +1,183 @@
+package relay
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/vipernet-xyz/viper-network/client/relay/relaytest"
+	crypto "github.com/vipernet-xyz/viper-network/crypto/codec"
+	sdk "github.com/vipernet-xyz/viper-network/types"
+	"github.com/vipernet-xyz/viper-network/x/viper-main/types"
+)
+
+const testChain = "0001"
+
+func newTestClient(t *testing.T, network *relaytest.Network) *Client {
+	clientKey := crypto.GenerateEd25519PrivKey()
+	aat := types.AAT{
+		Version:            "0.0.1",
+		RequestorPublicKey: crypto.GenerateEd25519PrivKey().PublicKey().RawString(),
+		ClientPublicKey:    clientKey.PublicKey().RawString(),
+	}
+	sig, err := clientKey.Sign(aat.Hash())
+	require.NoError(t, err)
+	aat.RequestorSignature = hex.EncodeToString(sig)
+	config := DefaultConfig(network.URLs()...)
+	config.Timeout = 5 * time.Second
+	c, err := NewClient(aat, clientKey, config)
+	require.NoError(t, err)
+	return c
+}
+
+func TestNewClient(t *testing.T) {
+	clientKey := crypto.GenerateEd25519PrivKey()
+	aat := types.AAT{
+		Version:            "0.0.1",
+		RequestorPublicKey: crypto.GenerateEd25519PrivKey().PublicKey().RawString(),
+		ClientPublicKey:    clientKey.PublicKey().RawString(),
+	}
+	_, err := NewClient(aat, clientKey, DefaultConfig("http://localhost:8082"))
+	assert.NotNil(t, err, "unsigned aat")
+	sig, _ := clientKey.Sign(aat.Hash())
+	aat.RequestorSignature = hex.EncodeToString(sig)
+	_, err = NewClient(aat, clientKey, DefaultConfig())
+	assert.Equal(t, ErrNoDispatchers, err)
+	_, err = NewClient(aat, crypto.GenerateEd25519PrivKey(), DefaultConfig("http://localhost:8082"))
+	assert.NotNil(t, err, "mismatched client key")
+	_, err = NewClient(aat, clientKey, DefaultConfig("http://localhost:8082"))
+	assert.Nil(t, err)
+}
+
+func TestClient_Relay(t *testing.T) {
+	network := relaytest.NewNetwork(3)
+	defer network.Close()
+	c := newTestClient(t, network)
+	for i := 0; i < 3; i++ {
+		res, err := c.Relay(Request{Chain: testChain, Data: `{"method":"eth_blockNumber"}`, Method: "POST"})
+		require.NoError(t, err)
+		assert.Equal(t, `{"method":"eth_blockNumber"}`, res.Response)
+		assert.Equal(t, int64(1), res.Proof.SessionBlockHeight)
+		assert.Equal(t, res.Servicer.PublicKey.RawString(), res.Proof.ServicerPubKey)
+	}
+	// the session is dispatched once and the servicers are rotated
+	assert.Equal(t, 1, network.Dispatches())
+	for _, s := range network.Servicers {
+		assert.Len(t, s.Relays(), 1)
+	}
+	// the sessions are cached per geozone
+	_, err := c.Relay(Request{Chain: testChain, GeoZone: "0002", Data: "{}"})
+	require.NoError(t, err)
+	assert.Equal(t, 2, network.Dispatches())
+}
+
+func TestClient_RelayRetries(t *testing.T) {
+	network := relaytest.NewNetwork(3)
+	defer network.Close()
+	c := newTestClient(t, network)
+	session, err := c.Dispatch(testChain, "")
+	require.NoError(t, err)
+	require.Len(t, session.Servicers, 3)
+
+	// an unreachable servicer is skipped
+	network.Servicers[0].Close()
+	res, err := c.Relay(Request{Chain: testChain, Data: "{}"})
+	require.NoError(t, err)
+	assert.Equal(t, network.Servicers[1].URL, res.Servicer.ServiceURL)
+
+	// an error of the servicer is retried with the next one
+	network.Servicers[2].FailNext(types.NewChainOutOfSyncError(types.ModuleName))
+	res, err = c.Relay(Request{Chain: testChain, Data: "{}"})
+	require.NoError(t, err)
+	assert.Equal(t, network.Servicers[1].URL, res.Servicer.ServiceURL)
+
+	// a rate limited relay is retried with the next servicer, without a new dispatch
+	network.Servicers[2].FailNext(types.NewRateLimitedError(types.ModuleName, types.RateLimitClient))
+	res, err = c.Relay(Request{Chain: testChain, Data: "{}"})
+	require.NoError(t, err)
+	assert.Equal(t, network.Servicers[1].URL, res.Servicer.ServiceURL)
+
+	// an error of the request is returned
+	network.Servicers[2].FailNext(types.NewEmptyPayloadDataError(types.ModuleName))
+	_, err = c.Relay(Request{Chain: testChain, Data: "{}"})
+	var relayErr *types.RelayError
+	require.True(t, errors.As(err, &relayErr))
+	assert.Equal(t, int(types.CodeEmptyPayloadDataError), int(relayErr.Code))
+
+	// the relays fail after the max retries
+	network.Servicers[1].FailNext(types.NewChainOutOfSyncError(types.ModuleName), types.NewChainOutOfSyncError(types.ModuleName))
+	network.Servicers[2].FailNext(types.NewChainOutOfSyncError(types.ModuleName), types.NewChainOutOfSyncError(types.ModuleName))
+	_, err = c.Relay(Request{Chain: testChain, Data: "{}"})
+	require.True(t, errors.As(err, &relayErr))
+	assert.Equal(t, int(types.CodeChainOutOfSyncError), int(relayErr.Code))
+	assert.Equal(t, 1, network.Dispatches())
+}
+
+func TestClient_RelayNewSession(t *testing.T) {
+	network := relaytest.NewNetwork(2)
+	defer network.Close()
+	c := newTestClient(t, network)
+	_, err := c.Relay(Request{Chain: testChain, Data: "{}"})
+	require.NoError(t, err)
+
+	// the servicer rejects the old session and returns the new one
+	network.SetHeight(5, 5)
+	res, err := c.Relay(Request{Chain: testChain, Data: "{}"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), res.Proof.SessionBlockHeight)
+	assert.Equal(t, 1, network.Dispatches())
+
+	// the session rolls over with the estimated height
+	now := time.Now()
+	c.now = func() time.Time { return now }
+	c.InvalidateSession(testChain, "")
+	session, err := c.Dispatch(testChain, "")
+	require.NoError(t, err)
+	assert.False(t, session.IsExpired(now, c.config.BlockTime, c.config.BlocksPerSession))
+	network.SetHeight(9, 9)
+	now = now.Add(time.Duration(c.config.BlocksPerSession) * c.config.BlockTime)
+	res, err = c.Relay(Request{Chain: testChain, Data: "{}"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(9), res.Proof.SessionBlockHeight)
+	assert.Equal(t, 3, network.Dispatches())
+}
+
+func TestClient_RelayWithCtx(t *testing.T) {
+	network := relaytest.NewNetwork(1)
+	defer network.Close()
+	c := newTestClient(t, network)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := c.RelayWithCtx(ctx, Request{Chain: testChain, Data: "{}"})
+	assert.Equal(t, context.Canceled, err)
+	assert.Equal(t, 0, network.Dispatches())
+}
+
+func TestClient_RelayWithQuorum(t *testing.T) {
+	network := relaytest.NewNetwork(3)
+	defer network.Close()
+	c := newTestClient(t, network)
+	req := Request{Chain: testChain, Data: `{"id":1}`}
+	lying := func(payload types.Payload) (string, sdk.Error) { return `{"id":2}`, nil }
+
+	// the majority agrees
+	network.Servicers[2].SetHandler(lying)
+	res, err := c.RelayWithQuorum(context.Background(), req, 3)
+	require.NoError(t, err)
+	assert.Equal(t, `{"id":1}`, res.Response)
+	for _, s := range network.Servicers {
+		assert.Len(t, s.Relays(), 1)
+	}
+	// no majority
+	network.Servicers[1].SetHandler(func(payload types.Payload) (string, sdk.Error) { return `{"id":3}`, nil })
+	_, err = c.RelayWithQuorum(context.Background(), req, 3)
+	assert.True(t, errors.Is(err, ErrNoQuorum))
+	// not enough servicers
+	_, err = c.RelayWithQuorum(context.Background(), req, 4)
+	assert.True(t, errors.Is(err, ErrNoQuorum))
+}
@@ -0,0 +1,273 @@
+// Package relaytest provides an in-process mock of the viper network to test relay clients against: every mock
+// servicer serves the dispatch and relay routes, validates the relay proofs and signs its responses.
+package relaytest
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	crypto "github.com/vipernet-xyz/viper-network/crypto/codec"
+	sdk "github.com/vipernet-xyz/viper-network/types"
+	"github.com/vipernet-xyz/viper-network/x/servicers/exported"
+	servicersTypes "github.com/vipernet-xyz/viper-network/x/servicers/types"
+	"github.com/vipernet-xyz/viper-network/x/viper-main/types"
+)
+
+// ClientBlockSyncAllowance is the number of blocks the relay meta height may differ from the network height
+const ClientBlockSyncAllowance = 10
+
+// Handler returns the response of the chain to the relayed payload
+type Handler func(payload types.Payload) (string, sdk.Error)
+
+// EchoHandler responds with the data of the payload
+func EchoHandler(payload types.Payload) (string, sdk.Error) {
+	return payload.Data, nil
+}
+
+// Network is a mock network of servicers sharing the same session
+type Network struct {
+	Servicers []*Servicer
+
+	mu                 sync.Mutex
+	blockHeight        int64
+	sessionBlockHeight int64
+	dispatches         int
+}
+
+// NewNetwork starts a network of mock servicers at block height 1, all of them serving the session
+func NewNetwork(numServicers int) *Network {
+	n := &Network{blockHeight: 1, sessionBlockHeight: 1}
+	for i := 0; i < numServicers; i++ {
+		s := &Servicer{
+			PrivateKey: crypto.GenerateEd25519PrivKey(),
+			network:    n,
+			handler:    EchoHandler,
+		}
+		mux := http.NewServeMux()
+		mux.HandleFunc("/v1/client/dispatch", s.serveDispatch)
+		mux.HandleFunc("/v1/client/relay", s.serveRelay)
+		s.Server = httptest.NewServer(mux)
+		n.Servicers = append(n.Servicers, s)
+	}
+	return n
+}
+
+// URLs returns the urls of the servicers, to use as dispatchers
+func (n *Network) URLs() (urls []string) {
+	for _, s := range n.Servicers {
+		urls = append(urls, s.URL)
+	}
+	return
+}
+
+// SetHeight sets the block height of the network and the height of its current session
+func (n *Network) SetHeight(blockHeight, sessionBlockHeight int64) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	n.blockHeight = blockHeight
+	n.sessionBlockHeight = sessionBlockHeight
+}
+
+// Dispatches returns the number of sessions dispatched by the network
+func (n *Network) Dispatches() int {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.dispatches
+}
+
+// Close stops the servicers of the network
+func (n *Network) Close() {
+	for _, s := range n.Servicers {
+		s.Close()
+	}
+}
+
+func (n *Network) heights() (blockHeight, sessionBlockHeight int64) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	return n.blockHeight, n.sessionBlockHeight
+}
+
+// dispatch returns the current session of the header
+func (n *Network) dispatch(header types.SessionHeader) types.DispatchResponse {
+	blockHeight, sessionBlockHeight := n.heights()
+	header.SessionBlockHeight = sessionBlockHeight
+	servicers := make([]exported.ValidatorI, 0, len(n.Servicers))
+	for _, s := range n.Servicers {
+		if int64(len(servicers)) == header.NumServicers {
+			break
+		}
+		servicers = append(servicers, s.Validator())
+	}
+	return types.DispatchResponse{
+		Session: types.DispatchSession{
+			SessionHeader:    header,
+			SessionServicers: servicers,
+		},
+		BlockHeight: blockHeight,
+	}
+}
+
+// Servicer is a mock servicer of the network
+type Servicer struct {
+	*httptest.Server
+	PrivateKey crypto.PrivateKey
+
+	network *Network
+	mu      sync.Mutex
+	handler Handler
+	fails   []sdk.Error
+	relays  []types.Relay
+}
+
+// Validator returns the validator of the servicer, as dispatched in the sessions
+func (s *Servicer) Validator() servicersTypes.Validator {
+	pk := s.PrivateKey.PublicKey()
+	return servicersTypes.Validator{
+		Address:      sdk.Address(pk.Address()),
+		PublicKey:    pk,
+		Status:       sdk.Staked,
+		ServiceURL:   s.URL,
+		StakedTokens: sdk.NewInt(1000000),
+	}
+}
+
+// SetHandler sets the handler responding to the relays of the servicer
+func (s *Servicer) SetHandler(handler Handler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handler = handler
+}
+
+// FailNext makes the servicer respond to its next valid relays with the errors, in order
+func (s *Servicer) FailNext(errs ...sdk.Error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fails = append(s.fails, errs...)
+}
+
+// Relays returns the relays successfully served by the servicer
+func (s *Servicer) Relays() []types.Relay {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]types.Relay(nil), s.relays...)
+}
+
+func (s *Servicer) serveDispatch(w http.ResponseWriter, r *http.Request) {
+	header := types.SessionHeader{}
+	if err := readJSON(r, &header); err != nil {
+		writeJSON(w, http.StatusBadRequest, types.RPCError{Code: http.StatusBadRequest, Message: err.Error()})
+		return
+	}
+	_, header.SessionBlockHeight = s.network.heights()
+	if err := header.ValidateHeader(); err != nil {
+		writeJSON(w, http.StatusBadRequest, types.RPCError{Code: http.StatusBadRequest, Message: err.Error()})
+		return
+	}
+	s.network.mu.Lock()
+	s.network.dispatches++
+	s.network.mu.Unlock()
+	writeJSON(w, http.StatusOK, s.network.dispatch(header))
+}
+
+func (s *Servicer) serveRelay(w http.ResponseWriter, r *http.Request) {
+	relay := types.Relay{}
+	if err := readJSON(r, &relay); err != nil {
+		writeJSON(w, http.StatusBadRequest, relayErrorResponse{Error: sdk.ErrInternal(err.Error())})
+		return
+	}
+	res, err := s.relay(relay)
+	if err != nil {
+		var dispatch *types.DispatchResponse
+		if types.ErrorWarrantsDispatch(err) {
+			d := s.network.dispatch(relay.Proof.SessionHeader())
+			dispatch = &d
+		}
+		status := http.StatusBadRequest
+		if types.ErrorIsRateLimited(err) {
+			status = http.StatusTooManyRequests
+		}
+		writeJSON(w, status, relayErrorResponse{Error: err, Dispatch: dispatch})
+		return
+	}
+	writeJSON(w, http.StatusOK, types.RelayOutput{Signature: res.Signature, Response: res.Response})
+}
+
+// relay validates the relay like a servicer and responds with the handler
+func (s *Servicer) relay(relay types.Relay) (*types.RelayResponse, sdk.Error) {
+	blockHeight, sessionBlockHeight := s.network.heights()
+	if err := relay.Payload.Validate(); err != nil {
+		return nil, err
+	}
+	if relay.Meta.BlockHeight > blockHeight+ClientBlockSyncAllowance || relay.Meta.BlockHeight < blockHeight-ClientBlockSyncAllowance {
+		return nil, types.NewOutOfSyncRequestError(types.ModuleName)
+	}
+	if relay.Proof.RequestHash != relay.RequestHashString() {
+		return nil, types.NewRequestHashError(types.ModuleName)
+	}
+	if err := relay.Proof.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	if relay.Proof.SessionBlockHeight != sessionBlockHeight {
+		return nil, types.NewInvalidBlockHeightError(types.ModuleName)
+	}
+	if relay.Proof.ServicerPubKey != s.PrivateKey.PublicKey().RawString() {
+		return nil, types.NewInvalidSessionError(types.ModuleName)
+	}
+	s.mu.Lock()
+	if len(s.fails) != 0 {
+		err := s.fails[0]
+		s.fails = s.fails[1:]
+		s.mu.Unlock()
+		return nil, err
+	}
+	handler := s.handler
+	s.mu.Unlock()
+	response, err := handler(relay.Payload)
+	if err != nil {
+		return nil, err
+	}
+	res := &types.RelayResponse{Response: response, Proof: relay.Proof}
+	sig, er := s.PrivateKey.Sign(res.Hash())
+	if er != nil {
+		return nil, sdk.ErrInternal(er.Error())
+	}
+	res.Signature = hex.EncodeToString(sig)
+	s.mu.Lock()
+	s.relays = append(s.relays, relay)
+	s.mu.Unlock()
+	return res, nil
+}
+
+// relayErrorResponse is the error response of the relay route
+type relayErrorResponse struct {
+	Error    error                   `json:"error"`
+	Dispatch *types.DispatchResponse `json:"dispatch"`
+}
+
+func readJSON(r *http.Request, model interface{}) error {
+	if r.Method != http.MethodPost {
+		return fmt.Errorf("unsupported method %s", r.Method)
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, model)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		status = http.StatusInternalServerError
+		bz = []byte(fmt.Sprintf(`{"code":%d,"message":%q}`, status, err.Error()))
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_, _ = w.Write(bz)
+}
@@ -0,0 +1,121 @@
+package relay
+
+import (
+	"sync"
+	"time"
+
+	servicersTypes "github.com/vipernet-xyz/viper-network/x/servicers/types"
+	"github.com/vipernet-xyz/viper-network/x/viper-main/types"
+)
+
+// dispatchResponse is the client side view of types.DispatchResponse, with concrete servicers to unmarshal into
+type dispatchResponse struct {
+	Session struct {
+		Header    types.SessionHeader        `json:"header"`
+		Servicers []servicersTypes.Validator `json:"servicers"`
+	} `json:"session"`
+	BlockHeight int64 `json:"block_height"`
+}
+
+// sessionKey identifies the cached session of a chain in a geozone
+type sessionKey struct {
+	chain   string
+	geoZone string
+}
+
+// Session is a dispatched session of the client
+type Session struct {
+	Header      types.SessionHeader        // the header of the session
+	Servicers   []servicersTypes.Validator // the servicers of the session
+	BlockHeight int64                      // the block height of the dispatch
+	dispatched  time.Time                  // when the session was dispatched
+	next        int                        // the index of the next servicer to relay to
+}
+
+func newSession(res dispatchResponse, now time.Time) *Session {
+	return &Session{
+		Header:      res.Session.Header,
+		Servicers:   res.Session.Servicers,
+		BlockHeight: res.BlockHeight,
+		dispatched:  now,
+	}
+}
+
+// EstimatedHeight returns the block height estimated from the dispatch height and the elapsed time
+func (s *Session) EstimatedHeight(now time.Time, blockTime time.Duration) int64 {
+	if blockTime <= 0 {
+		return s.BlockHeight
+	}
+	return s.BlockHeight + int64(now.Sub(s.dispatched)/blockTime)
+}
+
+// IsExpired returns true if the session rolled over at the estimated height
+func (s *Session) IsExpired(now time.Time, blockTime time.Duration, blocksPerSession int64) bool {
+	return s.EstimatedHeight(now, blockTime) >= s.Header.SessionBlockHeight+blocksPerSession
+}
+
+// nextServicer rotates through the servicers of the session
+func (s *Session) nextServicer() (servicersTypes.Validator, bool) {
+	if len(s.Servicers) == 0 {
+		return servicersTypes.Validator{}, false
+	}
+	servicer := s.Servicers[s.next%len(s.Servicers)]
+	s.next = (s.next + 1) % len(s.Servicers)
+	return servicer, true
+}
+
+// nextServicers returns n distinct servicers of the session, rotating through them
+func (s *Session) nextServicers(n int) []servicersTypes.Validator {
+	if n > len(s.Servicers) {
+		n = len(s.Servicers)
+	}
+	servicers := make([]servicersTypes.Validator, 0, n)
+	for i := 0; i < n; i++ {
+		servicer, _ := s.nextServicer()
+		servicers = append(servicers, servicer)
+	}
+	return servicers
+}
+
+// sessionCache holds the dispatched sessions per chain and geozone
+type sessionCache struct {
+	mu       sync.Mutex
+	sessions map[sessionKey]*Session
+}
+
+func newSessionCache() *sessionCache {
+	return &sessionCache{sessions: make(map[sessionKey]*Session)}
+}
+
+func (c *sessionCache) get(key sessionKey) (*Session, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	s, found := c.sessions[key]
+	return s, found
+}
+
+func (c *sessionCache) set(key sessionKey, s *Session) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.sessions[key] = s
+}
+
+func (c *sessionCache) delete(key sessionKey) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.sessions, key)
+}
+
+// servicer returns the next servicer of the session under the cache lock
+func (c *sessionCache) servicer(s *Session) (servicersTypes.Validator, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return s.nextServicer()
+}
+
+// servicers returns n distinct servicers of the session under the cache lock
+func (c *sessionCache) servicers(s *Session, n int) []servicersTypes.Validator {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return s.nextServicers(n)
+}
@@ -36,6 +36,12 @@ func init() {
 	accountsCmd.AddCommand(exportRawCmd)
 	accountsCmd.AddCommand(sendTxCmd)
 	accountsCmd.AddCommand(sendRawTxCmd)
+	accountsCmd.AddCommand(grantFeeAllowanceCmd)
+	accountsCmd.AddCommand(revokeFeeAllowanceCmd)
+	accountsCmd.AddCommand(grantAuthorizationCmd)
+	accountsCmd.AddCommand(revokeAuthorizationCmd)
+	accountsCmd.AddCommand(execCmd)
+	accountsCmd.AddCommand(buildTxCmd)
 	accountsCmd.AddCommand(newMultiPublicKey)
 	accountsCmd.AddCommand(signMS)
 	accountsCmd.AddCommand(signNexMS)
@@ -53,15 +59,27 @@ var accountsCmd = &cobra.Command{
 from creating and deleting accounts; to importing and exporting accounts.`,
 }
 
-var pwd, oldPwd, decryptPwd, encryptPwd, stakePwd string
+var pwd, oldPwd, decryptPwd, encryptPwd, stakePwd, feeGranter string
 
 func init() {
 	buildMultisig.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	buildTxCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	buildTxCmd.Flags().StringVar(&feeGranter, "fee-granter", "", "address paying the fees through its fee allowance to the signer")
 	createCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	deleteCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	sendTxCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	sendTxCmd.Flags().StringVar(&feeGranter, "fee-granter", "", "address paying the fees through its fee allowance to the signer")
 	setValidator.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	signCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	grantFeeAllowanceCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	grantFeeAllowanceCmd.Flags().Int64Var(&allowanceExpiration, "expiration", 0, "unix time the allowance expires at, never if 0")
+	grantFeeAllowanceCmd.Flags().StringSliceVar(&allowedMsgs, "allowed-msgs", nil, "comma separated message types the allowance pays for, any if empty")
+	revokeFeeAllowanceCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	grantAuthorizationCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	grantAuthorizationCmd.Flags().Int64Var(&authorizationExpiration, "expiration", 0, "unix time the authorization expires at, never if 0")
+	revokeAuthorizationCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	execCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	execCmd.Flags().StringVar(&feeGranter, "fee-granter", "", "address paying the fees through its fee allowance to the signer")
 	signMS.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	signNexMS.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 
@@ -525,6 +543,191 @@ Prompts the user for <fromAddr> account passphrase.`,
 	},
 }
 
+var allowanceExpiration int64
+var allowedMsgs []string
+
+// grantFeeAllowanceCmd represents the grant-fee-allowance command
+var grantFeeAllowanceCmd = &cobra.Command{
+	Use:   "grant-fee-allowance <granterAddr> <granteeAddr> <spendLimit> <networkID> <fee>",
+	Short: "Grant a fee allowance",
+	Long: `Lets <granteeAddr> pay its transaction fees from <granterAddr>, up to <spendLimit> uvipr (unlimited if 0).
+The allowance is restricted with the --expiration and --allowed-msgs flags, and replaces any previous one from <granterAddr> to <granteeAddr>.
+The grantee transactions name their fee granter with the --fee-granter flag.
+Prompts the user for <granterAddr> account passphrase.`,
+	Args: cobra.ExactArgs(5),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		spendLimit, ok := types.NewIntFromString(args[2])
+		if !ok {
+			fmt.Println("invalid spend limit: " + args[2])
+			return
+		}
+		fees, err := strconv.Atoi(args[4])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter passphrase: ")
+		res, err := GrantFeeAllowance(args[0], args[1], app.Credentials(pwd), args[3], spendLimit, allowanceExpiration, allowedMsgs, int64(fees), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
+
+// revokeFeeAllowanceCmd represents the revoke-fee-allowance command
+var revokeFeeAllowanceCmd = &cobra.Command{
+	Use:   "revoke-fee-allowance <granterAddr> <granteeAddr> <networkID> <fee>",
+	Short: "Revoke a fee allowance",
+	Long: `Removes the fee allowance from <granterAddr> to <granteeAddr>.
+Prompts the user for <granterAddr> account passphrase.`,
+	Args: cobra.ExactArgs(4),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		fees, err := strconv.Atoi(args[3])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter passphrase: ")
+		res, err := RevokeFeeAllowance(args[0], args[1], app.Credentials(pwd), args[2], int64(fees), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
+
+var authorizationExpiration int64
+
+// grantAuthorizationCmd represents the grant-authorization command
+var grantAuthorizationCmd = &cobra.Command{
+	Use:   "grant-authorization <granterAddr> <granteeAddr> <msgType> <networkID> <fee>",
+	Short: "Grant an authorization",
+	Long: `Lets <granteeAddr> execute the messages of type <msgType> (e.g. unjail_validator, pause_node, claim) on behalf of <granterAddr>.
+The authorization is restricted with the --expiration flag, and replaces any previous one from <granterAddr> to <granteeAddr> for <msgType>.
+The grantee executes the authorized messages with the exec command.
+Prompts the user for <granterAddr> account passphrase.`,
+	Args: cobra.ExactArgs(5),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		fees, err := strconv.Atoi(args[4])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter passphrase: ")
+		res, err := GrantAuthorization(args[0], args[1], args[2], app.Credentials(pwd), args[3], authorizationExpiration, int64(fees), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
+
+// revokeAuthorizationCmd represents the revoke-authorization command
+var revokeAuthorizationCmd = &cobra.Command{
+	Use:   "revoke-authorization <granterAddr> <granteeAddr> <msgType> <networkID> <fee>",
+	Short: "Revoke an authorization",
+	Long: `Removes the authorization from <granterAddr> to <granteeAddr> for the messages of type <msgType>.
+Prompts the user for <granterAddr> account passphrase.`,
+	Args: cobra.ExactArgs(5),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		fees, err := strconv.Atoi(args[4])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter passphrase: ")
+		res, err := RevokeAuthorization(args[0], args[1], args[2], app.Credentials(pwd), args[3], int64(fees), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
+
+// execCmd represents the exec command
+var execCmd = &cobra.Command{
+	Use:   "exec <granteeAddr> <msgsJSON> <networkID> <fee>",
+	Short: "Execute messages on behalf of other accounts",
+	Long: `Executes the messages of <msgsJSON>, a json message or array of json messages, signed by <granteeAddr>
+on behalf of the accounts that granted it an authorization for their type.
+Prompts the user for <granteeAddr> account passphrase.`,
+	Args: cobra.ExactArgs(4),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		fees, err := strconv.Atoi(args[3])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter passphrase: ")
+		res, err := Exec(args[0], args[1], app.Credentials(pwd), args[2], int64(fees), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
+
 // sendRawTxCmd represents the sendTx command
 var sendRawTxCmd = &cobra.Command{
 	Use:   "send-raw-tx <fromAddr> <txBytes>",
@@ -556,6 +759,30 @@ var sendRawTxCmd = &cobra.Command{
 	},
 }
 
+var buildTxCmd = &cobra.Command{
+	Use:   "build-tx <signer-address> <json-messages> <networkID> <fees>",
+	Short: "Build and sign a tx",
+	Args:  cobra.ExactArgs(4),
+	Long: `Build and sign a transaction from a json message, or a json array of messages executed in order and atomically.
+The fees must cover the fee of each message. The result is the hex encoded std tx object, to be sent with send-raw-tx.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		fees, err := strconv.Atoi(args[3])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		sequence := nextSequence(args[0])
+		fmt.Println("Enter passphrase: ")
+		bz, err := app.BuildTx(args[0], args[1], app.Credentials(pwd), args[2], int64(fees), sequence, feeGranter, false)
+		if err != nil {
+			fmt.Println(fmt.Errorf("error building the transaction: %v", err))
+			return
+		}
+		fmt.Println("Transaction: \n" + hex.EncodeToString(bz))
+	},
+}
+
 // importCmd represents the import command
 var importCmd = &cobra.Command{
 	Use:   "import-raw <private-key-hex>",
@@ -611,7 +838,8 @@ var buildMultisig = &cobra.Command{
 	Use:   "build-MS-Tx <signer-address> <json-message> <ordered-comma-separated-hex-pubkeys> <networkID> <fees>",
 	Short: "Build and sign a multisig tx",
 	Args:  cobra.ExactArgs(5),
-	Long:  `Build and sign a multisignature transaction from scratch: result is hex encoded std tx object.`,
+	Long: `Build and sign a multisignature transaction from scratch: result is hex encoded std tx object.
+The <json-message> may be a json array of messages, executed in order and atomically.`,
 	Run: func(cmd *cobra.Command, args []string) {
 		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
 		msg := args[1]
@@ -627,13 +855,14 @@ var buildMultisig = &cobra.Command{
 		}
 
 		multiSigPubKey := crypto.PublicKeyMultiSignature{PublicKeys: pks}
-		fmt.Println("Enter passphrase: ")
 		fees, err := strconv.Atoi(args[4])
 		if err != nil {
 			fmt.Println(err)
 			return
 		}
-		bz, err := app.BuildMultisig(args[0], msg, app.Credentials(pwd), args[3], multiSigPubKey, int64(fees), false)
+		sequence := nextSequence(multiSigPubKey.Address().String())
+		fmt.Println("Enter passphrase: ")
+		bz, err := app.BuildMultisig(args[0], msg, app.Credentials(pwd), args[3], multiSigPubKey, int64(fees), sequence, false)
 		if err != nil {
 			fmt.Println(fmt.Errorf("error building the multisig: %v", err))
 		}
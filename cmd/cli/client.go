@@ -19,7 +19,9 @@ func init() {
 	rootCmd.AddCommand(clientCmd)
 	clientCmd.AddCommand(clientStakeCmd)
 	clientCmd.AddCommand(clientUnstakeCmd)
+	clientCmd.AddCommand(clientUnstakePartialCmd)
 	clientCmd.AddCommand(createAATCmd)
+	clientCmd.AddCommand(revokeClientCmd)
 }
 
 var clientCmd = &cobra.Command{
@@ -32,9 +34,22 @@ from staking and unstaking; to generating AATs.`,
 func init() {
 	clientStakeCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	clientUnstakeCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	clientUnstakePartialCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	createAATCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	createAATCmd.Flags().Int64Var(&aatExpiration, "expiration", 0, "the block height the AAT expires at, never if zero")
+	createAATCmd.Flags().StringVar(&aatChains, "chains", "", "a comma separated list of the chain ids the AAT is valid on, all of them if empty")
+	createAATCmd.Flags().StringVar(&aatGeoZones, "geozones", "", "a comma separated list of the geozones the AAT is valid in, all of them if empty")
+	createAATCmd.Flags().Int64Var(&aatMaxRelays, "maxRelays", 0, "the maximum number of relays of the client per session, no cap if zero")
+	revokeClientCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 }
 
+var (
+	aatExpiration int64
+	aatChains     string
+	aatGeoZones   string
+	aatMaxRelays  int64
+)
+
 var clientStakeCmd = &cobra.Command{
 	Use:   "stake <fromAddr> <amount> <relayChainIDs> <networkID> <geoZones> <numServicers> <fee>",
 	Short: "Stake a client into the network",
@@ -123,10 +138,51 @@ Prompts the user for the <fromAddr> account passphrase.`,
 	},
 }
 
+var clientUnstakePartialCmd = &cobra.Command{
+	Use:   "unstake-partial <fromAddr> <amount> <networkID> <fee>",
+	Short: "Remove part of the stake of a client",
+	Long: `Remove the amount from the stake of a client, the stake can't go below the minimum stake.
+The amount is sent back to the client once the unstaking time is over.
+Prompts the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(4),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		amount, err := strconv.Atoi(args[1])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fee, err := strconv.Atoi(args[3])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter Password: ")
+		res, err := UnstakePartialClient(args[0], app.Credentials(pwd), args[2], types.NewInt(int64(amount)), int64(fee), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
+
 var createAATCmd = &cobra.Command{
 	Use:   "create-aat <clientAddr> <clientPubKey>",
 	Short: "Creates an application authentication token",
 	Long: `Creates a signed application authentication token (version 0.0.1 of the AAT spec), that can be embedded into application software for Relay servicing.
+With any of --expiration, --chains, --geozones or --maxRelays, creates a scoped token (version 0.0.2 of the AAT spec)
+that expires at the block height, is only valid on the chains and in the geozones, and caps the relays of the client per session.
 Will prompt the user for the <clientAddr> account passphrase.`,
 	Args: cobra.ExactArgs(2),
 	Run: func(cmd *cobra.Command, args []string) {
@@ -152,7 +208,14 @@ Will prompt the user for the <clientAddr> account passphrase.`,
 		if err != nil {
 			return
 		}
-		aat, err := app.GenerateAAT(hex.EncodeToString(kp.PublicKey.RawBytes()), args[1], privkey)
+		requestorPubKey := hex.EncodeToString(kp.PublicKey.RawBytes())
+		chains, geoZones := splitList(aatChains), splitList(aatGeoZones)
+		var aat []byte
+		if aatExpiration != 0 || len(chains) != 0 || len(geoZones) != 0 || aatMaxRelays != 0 {
+			aat, err = app.GenerateScopedAAT(requestorPubKey, args[1], aatExpiration, chains, geoZones, aatMaxRelays, privkey)
+		} else {
+			aat, err = app.GenerateAAT(requestorPubKey, args[1], privkey)
+		}
 		if err != nil {
 			fmt.Println(err)
 			return
@@ -160,3 +223,37 @@ Will prompt the user for the <clientAddr> account passphrase.`,
 		fmt.Println(string(aat))
 	},
 }
+
+var revokeClientCmd = &cobra.Command{
+	Use:   "revoke-client <fromAddr> <clientPubKey> <networkID> <fee>",
+	Short: "Revokes the AATs of a client",
+	Long: `Revokes every application authentication token issued by the <fromAddr> client to the <clientPubKey> key,
+so that a leaked client key can no longer be used. The servicers reject the relays of the key from the next session on.
+Prompts the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(4),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		fee, err := strconv.Atoi(args[3])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter Password: ")
+		res, err := RevokeClient(args[0], args[1], app.Credentials(pwd), args[2], int64(fee), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
@@ -0,0 +1,124 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/vipernet-xyz/viper-network/app"
+	"github.com/vipernet-xyz/viper-network/client/gateway"
+	"github.com/vipernet-xyz/viper-network/client/relay"
+	"github.com/vipernet-xyz/viper-network/crypto/keys/mintkey"
+	"github.com/vipernet-xyz/viper-network/types"
+	viperTypes "github.com/vipernet-xyz/viper-network/x/viper-main/types"
+)
+
+var (
+	gatewayListenAddr   string
+	gatewayDispatchers  string
+	gatewayChains       string
+	gatewayGeoZone      string
+	gatewayNumServicers int64
+	gatewayQuorum       int
+	gatewayOrigins      string
+)
+
+func init() {
+	rootCmd.AddCommand(gatewayCmd)
+	gatewayCmd.AddCommand(gatewayStartCmd)
+	gatewayStartCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	gatewayStartCmd.Flags().StringVar(&gatewayListenAddr, "listen", "127.0.0.1:8545", "the address the gateway listens on")
+	gatewayStartCmd.Flags().StringVar(&gatewayDispatchers, "dispatchers", "", "a comma separated list of the urls the sessions are dispatched from (default is the remoteCLIURL)")
+	gatewayStartCmd.Flags().StringVar(&gatewayChains, "chains", "", "a comma separated list of the chain ids served by the gateway (default is every chain)")
+	gatewayStartCmd.Flags().StringVar(&gatewayGeoZone, "geozone", "0001", "the geozone of the session servicers")
+	gatewayStartCmd.Flags().Int64Var(&gatewayNumServicers, "numServicers", 5, "the number of servicers of the sessions")
+	gatewayStartCmd.Flags().IntVar(&gatewayQuorum, "quorum", 0, "the number of servicers that must return the same response, disabled when 1 or less")
+	gatewayStartCmd.Flags().StringVar(&gatewayOrigins, "allowed-origins", "", "a comma separated list of the origins allowed to use the gateway from a browser, * for every origin (default is the gateway host)")
+}
+
+var gatewayCmd = &cobra.Command{
+	Use:   "gateway",
+	Short: "local relay gateway",
+	Long: `The gateway namespace handles the local gateway, serving a plain JSON-RPC endpoint per chain
+that relays every request over the network.`,
+}
+
+var gatewayStartCmd = &cobra.Command{
+	Use:   "start <aatFile> <clientAddr>",
+	Short: "Starts a local JSON-RPC gateway relaying over the network",
+	Long: `Starts a local http and websocket endpoint at http://<listen>/<chainID> for every chain.
+Every request is relayed to the servicers of the chain session, signed with the AAT of <aatFile>
+and the key of <clientAddr>, whose public key must be the client public key of the AAT.
+With --quorum, the request is relayed to that many servicers and the response of the majority is returned.
+The browser requests of other origins are rejected, unless they are listed in --allowed-origins.
+Will prompt the user for the <clientAddr> account passphrase.`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		bz, err := os.ReadFile(args[0])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		var aat viperTypes.AAT
+		if err := json.Unmarshal(bz, &aat); err != nil {
+			fmt.Printf("AAT Error %s\n", err)
+			return
+		}
+		kb := app.MustGetKeybase()
+		if kb == nil {
+			fmt.Println(app.UninitializedKeybaseError)
+			return
+		}
+		addr, err := types.AddressFromHex(args[1])
+		if err != nil {
+			fmt.Printf("Address Error %s", err)
+			return
+		}
+		kp, err := kb.Get(addr)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter passphrase: ")
+		clientKey, err := mintkey.UnarmorDecryptPrivKey(kp.PrivKeyArmor, app.Credentials(pwd))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		dispatchers := splitList(gatewayDispatchers)
+		if len(dispatchers) == 0 {
+			dispatchers = []string{app.GlobalConfig.ViperConfig.RemoteCLIURL}
+		}
+		config := relay.DefaultConfig(dispatchers...)
+		config.GeoZone = gatewayGeoZone
+		config.NumServicers = gatewayNumServicers
+		client, err := relay.NewClient(aat, clientKey, config)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		gw := gateway.NewGateway(client, gateway.Config{
+			Chains:         splitList(gatewayChains),
+			Quorum:         gatewayQuorum,
+			AllowedOrigins: splitList(gatewayOrigins),
+		})
+		fmt.Printf("Gateway listening on http://%s/<chainID>\n", gatewayListenAddr)
+		if err := http.ListenAndServe(gatewayListenAddr, gw); err != nil {
+			fmt.Println(err)
+		}
+	},
+}
+
+// splitList splits a comma separated list, dropping the empty elements
+func splitList(list string) (res []string) {
+	for _, s := range strings.Split(list, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			res = append(res, s)
+		}
+	}
+	return
+}
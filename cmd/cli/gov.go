@@ -22,6 +22,7 @@ func init() {
 	governanceCmd.AddCommand(governanceUpgrade)
 	governanceCmd.AddCommand(governanceFeatureEnable)
 	governanceCmd.AddCommand(governanceGenDiscountKey)
+	governanceCmd.AddCommand(governanceCreateVesting)
 }
 
 var governanceCmd = &cobra.Command{
@@ -36,8 +37,12 @@ func init() {
 	governanceDAOBurn.Flags().StringVar(&pwd, "pwd", "", "defines the passphrase used by the cmd non empty usage bypass interactive prompt ")
 	governanceChangeParam.Flags().StringVar(&pwd, "pwd", "", "defines the passphrase used by the cmd non empty usage bypass interactive prompt ")
 	governanceUpgrade.Flags().StringVar(&pwd, "pwd", "", "defines the passphrase used by the cmd non empty usage bypass interactive prompt ")
+	governanceCreateVesting.Flags().StringVar(&pwd, "pwd", "", "defines the passphrase used by the cmd non empty usage bypass interactive prompt ")
+	governanceCreateVesting.Flags().StringVar(&vestingPeriods, "periods", "", "the periods of a periodic vesting as comma separated <length in seconds>:<amount> pairs")
 }
 
+var vestingPeriods string
+
 var governanceDAOTransfer = &cobra.Command{
 	Use:   "transfer <amount> <fromAddr> <toAddr> <networkID> <fees>",
 	Short: "Transfer from DAO",
@@ -122,6 +127,95 @@ Actions: [burn, transfer]`,
 		fmt.Println(resp)
 	},
 }
+var governanceCreateVesting = &cobra.Command{
+	Use:   "create-vesting <vestingType> <amount> <fromAddr> <toAddr> <startTime> <endTime> <networkID> <fees>",
+	Short: "Create a vesting account from the DAO",
+	Long: `If authorized, create a vesting account funded by the DAO.
+Vesting types: [continuous, delayed, periodic]
+The start and end times are unix times in seconds, a delayed vesting ignores the start time and a periodic vesting
+ignores the end time and vests the amounts of the --periods flag, which must add up to the amount.`,
+	Args: cobra.ExactArgs(8),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		vestingType := args[0]
+		amount, ok := types.NewIntFromString(args[1])
+		if !ok {
+			fmt.Println("invalid amount: " + args[1])
+			return
+		}
+		fromAddr := args[2]
+		toAddr := args[3]
+		startTime, err := strconv.ParseInt(args[4], 10, 64)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		endTime, err := strconv.ParseInt(args[5], 10, 64)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fees, err := strconv.Atoi(args[7])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		periods, err := parseVestingPeriods(vestingPeriods)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		if vestingType == governanceTypes.DelayedVestingString {
+			startTime = 0
+		}
+		if vestingType == governanceTypes.PeriodicVestingString {
+			endTime = 0
+		}
+		fmt.Println("Enter Password: ")
+		pass := app.Credentials(pwd)
+		res, err := CreateVestingAccountTx(fromAddr, toAddr, pass, amount, vestingType, startTime, endTime, periods, args[6], int64(fees), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
+
+// parseVestingPeriods parses comma separated <length>:<amount> vesting periods
+func parseVestingPeriods(s string) ([]governanceTypes.VestingPeriod, error) {
+	if s == "" {
+		return nil, nil
+	}
+	var periods []governanceTypes.VestingPeriod
+	for _, p := range strings.Split(s, ",") {
+		parts := strings.Split(strings.TrimSpace(p), ":")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid vesting period %s, expected <length>:<amount>", p)
+		}
+		length, err := strconv.ParseInt(parts[0], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		amount, ok := types.NewIntFromString(parts[1])
+		if !ok {
+			return nil, fmt.Errorf("invalid vesting period amount: %s", parts[1])
+		}
+		periods = append(periods, governanceTypes.VestingPeriod{Length: length, Amount: amount})
+	}
+	return periods, nil
+}
+
 var governanceChangeParam = &cobra.Command{
 	Use:   "change_param <fromAddr> <networkID> <paramKey module/param> <paramValue (jsonObj)> <fees>",
 	Short: "Edit a param in the network",
@@ -0,0 +1,165 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/vipernet-xyz/viper-network/app"
+	"github.com/vipernet-xyz/viper-network/client"
+	"github.com/vipernet-xyz/viper-network/client/tx"
+	"github.com/vipernet-xyz/viper-network/codec"
+	icacontrollertypes "github.com/vipernet-xyz/viper-network/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/vipernet-xyz/viper-network/modules/apps/27-interchain-accounts/types"
+	feetypes "github.com/vipernet-xyz/viper-network/modules/apps/29-fee/types"
+	channeltypes "github.com/vipernet-xyz/viper-network/modules/core/04-channel/types"
+	sdk "github.com/vipernet-xyz/viper-network/types"
+)
+
+func init() {
+	rootCmd.AddCommand(icaCmd)
+	rootCmd.AddCommand(feeCmd)
+	icaCmd.AddCommand(NewRegisterInterchainAccountTxCmd)
+	icaCmd.AddCommand(NewSendInterchainAccountTxCmd)
+	feeCmd.AddCommand(NewPayPacketFeeTxCmd)
+}
+
+// icaCmd represents the interchain accounts namespace command
+var icaCmd = &cobra.Command{
+	Use:   "ica",
+	Short: "Interchain Accounts",
+	Long:  `Interchain accounts controller subcommands, used to control accounts on counterparty chains`,
+}
+
+// feeCmd represents the ibc relayer incentivization namespace command
+var feeCmd = &cobra.Command{
+	Use:   "fee",
+	Short: "IBC-Fee",
+	Long:  `IBC relayer incentivization subcommands`,
+}
+
+const (
+	flagVersion               = "version"
+	flagRelativePacketTimeout = "relative-packet-timeout"
+	flagRecvFee               = "recv-fee"
+	flagAckFee                = "ack-fee"
+	flagTimeoutFee            = "timeout-fee"
+)
+
+// NewRegisterInterchainAccountTxCmd returns the command to create a MsgRegisterInterchainAccount transaction
+var NewRegisterInterchainAccountTxCmd = &cobra.Command{
+	Use:   "register [connection-id]",
+	Short: "Register an interchain account on the provided connection",
+	Long: strings.TrimSpace(`Register an account on the counterparty chain via the connection id from this chain.
+The interchain account is owned by the sender and created on the counterparty chain. Callers are expected to
+provide the appropriate application version string via the --version flag.`),
+	Example: fmt.Sprintf("%s ica register connection-0", version.Version),
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		clientCtx, err := client.GetClientTxContext(cmd)
+		if err != nil {
+			return err
+		}
+		owner := clientCtx.GetFromAddress().String()
+		channelVersion, err := cmd.Flags().GetString(flagVersion)
+		if err != nil {
+			return err
+		}
+		msg := icacontrollertypes.NewMsgRegisterInterchainAccount(args[0], owner, channelVersion)
+		return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+	},
+}
+
+// NewSendInterchainAccountTxCmd returns the command to create a MsgSendTx transaction
+var NewSendInterchainAccountTxCmd = &cobra.Command{
+	Use:   "send-tx [connection-id] [path/to/packet_msg.json]",
+	Short: "Send an interchain account tx on the provided connection",
+	Long: strings.TrimSpace(`Submits pre-built packet data containing messages to be executed by the interchain account
+on the host chain. Packet data is provided as a json string or file. The packet times out after the relative
+timeout provided with the --relative-packet-timeout flag.`),
+	Example: fmt.Sprintf("%s ica send-tx connection-0 packet_msg.json", version.Version),
+	Args:    cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		clientCtx, err := client.GetClientTxContext(cmd)
+		if err != nil {
+			return err
+		}
+		cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+		owner := clientCtx.GetFromAddress().String()
+		// the packet data is either the json itself or a path to a json file
+		var packetData icatypes.InterchainAccountPacketData
+		if err := cdc.UnmarshalJSON([]byte(args[1]), &packetData); err != nil {
+			contents, err := os.ReadFile(args[1])
+			if err != nil {
+				return fmt.Errorf("neither JSON input nor path to .json file for packet data with messages were provided: %w", err)
+			}
+			if err := cdc.UnmarshalJSON(contents, &packetData); err != nil {
+				return fmt.Errorf("error unmarshalling packet data with messages file: %w", err)
+			}
+		}
+		relativeTimeout, err := cmd.Flags().GetUint64(flagRelativePacketTimeout)
+		if err != nil {
+			return err
+		}
+		msg := icacontrollertypes.NewMsgSendTx(owner, args[0], relativeTimeout, packetData)
+		return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+	},
+}
+
+// NewPayPacketFeeTxCmd returns the command to create a MsgPayPacketFeeAsync transaction
+var NewPayPacketFeeTxCmd = &cobra.Command{
+	Use:   "pay-packet-fee [src-port] [src-channel] [sequence]",
+	Short: "Pay a fee to incentivize an existing IBC packet",
+	Long: strings.TrimSpace(`Escrow a fee to incentivize the relaying of an existing IBC packet.
+The fees are paid out to the relayers of the packet and any remainder is refunded to the sender.`),
+	Example: fmt.Sprintf("%s fee pay-packet-fee transfer channel-0 1 --recv-fee 10uvipr --ack-fee 10uvipr --timeout-fee 10uvipr", version.Version),
+	Args:    cobra.ExactArgs(3),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		clientCtx, err := client.GetClientTxContext(cmd)
+		if err != nil {
+			return err
+		}
+		sender := clientCtx.GetFromAddress().String()
+		seq, err := strconv.ParseUint(args[2], 10, 64)
+		if err != nil {
+			return err
+		}
+		fee, err := feeFromFlags(cmd)
+		if err != nil {
+			return err
+		}
+		// NOTE: specifying non-nil relayers is currently unsupported
+		packetFee := feetypes.NewPacketFee(fee, sender, nil)
+		msg := feetypes.NewMsgPayPacketFeeAsync(channeltypes.NewPacketID(args[0], args[1], seq), packetFee)
+		return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+	},
+}
+
+// feeFromFlags parses the receive, acknowledgement and timeout fees from the command flags
+func feeFromFlags(cmd *cobra.Command) (feetypes.Fee, error) {
+	var coins [3]sdk.Coins
+	for i, flag := range []string{flagRecvFee, flagAckFee, flagTimeoutFee} {
+		s, err := cmd.Flags().GetString(flag)
+		if err != nil {
+			return feetypes.Fee{}, err
+		}
+		coins[i], err = sdk.ParseCoinsNormalized(s)
+		if err != nil {
+			return feetypes.Fee{}, err
+		}
+	}
+	return feetypes.NewFee(coins[0], coins[1], coins[2]), nil
+}
+
+func init() {
+	NewRegisterInterchainAccountTxCmd.Flags().String(flagVersion, "", "Controller chain channel version")
+	NewSendInterchainAccountTxCmd.Flags().Uint64(flagRelativePacketTimeout, icatypes.DefaultRelativePacketTimeoutTimestamp, "Relative packet timeout in nanoseconds from now. Default is 10 minutes.")
+	NewPayPacketFeeTxCmd.Flags().String(flagRecvFee, "", "Fee paid to a relayer for relaying a packet receive.")
+	NewPayPacketFeeTxCmd.Flags().String(flagAckFee, "", "Fee paid to a relayer for relaying a packet acknowledgement.")
+	NewPayPacketFeeTxCmd.Flags().String(flagTimeoutFee, "", "Fee paid to a relayer for relaying a packet timeout.")
+}
@@ -0,0 +1,274 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/vipernet-xyz/viper-network/app"
+	"github.com/vipernet-xyz/viper-network/rpc"
+	"github.com/vipernet-xyz/viper-network/types"
+	governanceTypes "github.com/vipernet-xyz/viper-network/x/governance/types"
+
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	governanceCmd.AddCommand(governanceProposeParamChange)
+	governanceCmd.AddCommand(governanceProposeUpgrade)
+	governanceCmd.AddCommand(governanceProposeDAOTransfer)
+	governanceCmd.AddCommand(governanceVote)
+	governanceCmd.AddCommand(governanceQueryProposal)
+	governanceCmd.AddCommand(governanceQueryProposals)
+	governanceCmd.AddCommand(governanceQueryVotes)
+	governanceCmd.AddCommand(governanceQueryTally)
+	governanceProposeParamChange.Flags().StringVar(&pwd, "pwd", "", "defines the passphrase used by the cmd non empty usage bypass interactive prompt ")
+	governanceProposeUpgrade.Flags().StringVar(&pwd, "pwd", "", "defines the passphrase used by the cmd non empty usage bypass interactive prompt ")
+	governanceProposeDAOTransfer.Flags().StringVar(&pwd, "pwd", "", "defines the passphrase used by the cmd non empty usage bypass interactive prompt ")
+	governanceVote.Flags().StringVar(&pwd, "pwd", "", "defines the passphrase used by the cmd non empty usage bypass interactive prompt ")
+}
+
+var governanceProposeParamChange = &cobra.Command{
+	Use:   "propose_param_change <fromAddr> <title> <description> <paramKey module/param> <paramValue (jsonObj)> <deposit> <networkID> <fees>",
+	Short: "Propose a param change",
+	Long: `Submit a proposal to change any param from any module, escrowing <deposit> from <fromAddr>.
+Staked servicers and requestors vote on the proposal during the voting period.
+Will prompt the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(8),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		valueBytes, err := app.Codec().MarshalJSON(json.RawMessage(args[4]))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		content := governanceTypes.NewParamChangeContent(args[3], valueBytes)
+		submitProposal(args[0], args[1], args[2], content, args[5], args[6], args[7])
+	},
+}
+
+var governanceProposeUpgrade = &cobra.Command{
+	Use:   "propose_upgrade <fromAddr> <title> <description> <atHeight> <version> <deposit> <networkID> <fees>",
+	Short: "Propose a protocol upgrade",
+	Long: `Submit a proposal to upgrade the protocol, escrowing <deposit> from <fromAddr>.
+Staked servicers and requestors vote on the proposal during the voting period.
+Will prompt the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(8),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		height, err := strconv.Atoi(args[3])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		content := governanceTypes.NewUpgradeContent(governanceTypes.Upgrade{
+			Height:  int64(height),
+			Version: dropTag(args[4]),
+		})
+		submitProposal(args[0], args[1], args[2], content, args[5], args[6], args[7])
+	},
+}
+
+var governanceProposeDAOTransfer = &cobra.Command{
+	Use:   "propose_dao_transfer <fromAddr> <title> <description> <action> <amount> <toAddr> <deposit> <networkID> <fees>",
+	Short: "Propose a DAO transfer or burn",
+	Long: `Submit a proposal to move or burn funds from the DAO, escrowing <deposit> from <fromAddr>.
+Actions: [dao_transfer, dao_burn] (<toAddr> is ignored when burning)
+Will prompt the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(9),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		amount, ok := types.NewIntFromString(args[4])
+		if !ok {
+			fmt.Println("invalid amount: " + args[4])
+			return
+		}
+		var toAddr types.Address
+		if args[3] == governanceTypes.DAOTransferString {
+			var err error
+			toAddr, err = types.AddressFromHex(args[5])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		content := governanceTypes.NewDAOTransferContent(args[3], toAddr, amount)
+		submitProposal(args[0], args[1], args[2], content, args[6], args[7], args[8])
+	},
+}
+
+func submitProposal(fromAddr, title, description string, content governanceTypes.ProposalContent, deposit, networkID, fees string) {
+	d, ok := types.NewIntFromString(deposit)
+	if !ok {
+		fmt.Println("invalid deposit: " + deposit)
+		return
+	}
+	f, err := strconv.Atoi(fees)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println("Enter Password: ")
+	res, err := SubmitProposal(fromAddr, title, description, content, d, app.Credentials(pwd), networkID, int64(f), false)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	resp, err := QueryRPC(SendRawTxPath, j)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(resp)
+}
+
+var governanceVote = &cobra.Command{
+	Use:   "vote <fromAddr> <proposalID> <option> <networkID> <fees>",
+	Short: "Vote on a proposal",
+	Long: `Vote on a proposal in its voting period with the stake of <fromAddr> as a servicer and/or requestor.
+Options: [yes, abstain, no, no_with_veto]
+Will prompt the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(5),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		proposalID, err := strconv.ParseUint(args[1], 10, 64)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		option, er := governanceTypes.VoteOptionFromString(args[2])
+		if er != nil {
+			fmt.Println(er)
+			return
+		}
+		fees, err := strconv.Atoi(args[4])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter Password: ")
+		res, err := Vote(args[0], proposalID, option, app.Credentials(pwd), args[3], int64(fees), false)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
+
+var governanceQueryProposal = &cobra.Command{
+	Use:   "proposal <proposalID> [<height>]",
+	Short: "Gets a proposal",
+	Long:  `Retrieves the proposal with <proposalID> at the specified <height>.`,
+	Args:  cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		queryProposalPath(GetProposalPath, args)
+	},
+}
+
+var governanceQueryVotes = &cobra.Command{
+	Use:   "votes <proposalID> [<height>]",
+	Short: "Gets the votes of a proposal",
+	Long:  `Retrieves the votes on the proposal with <proposalID> at the specified <height>.`,
+	Args:  cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		queryProposalPath(GetProposalVotesPath, args)
+	},
+}
+
+var governanceQueryTally = &cobra.Command{
+	Use:   "tally <proposalID> [<height>]",
+	Short: "Gets the tally of a proposal",
+	Long: `Retrieves the stake weighted tally of the proposal with <proposalID> at the specified <height>.
+The tally is final once the voting period is over.`,
+	Args: cobra.RangeArgs(1, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		queryProposalPath(GetProposalTallyPath, args)
+	},
+}
+
+func queryProposalPath(path string, args []string) {
+	app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+	proposalID, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	var height int
+	if len(args) == 2 {
+		height, err = strconv.Atoi(args[1])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+	}
+	params := rpc.HeightAndProposalParams{
+		Height:     int64(height),
+		ProposalID: proposalID,
+	}
+	j, err := json.Marshal(params)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	res, err := QueryRPC(path, j)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println(res)
+}
+
+var governanceQueryProposals = &cobra.Command{
+	Use:   "proposals [<status>] [<height>]",
+	Short: "Gets the proposals",
+	Long: `Retrieves all of the proposals at the specified <height>, optionally filtered by <status>.
+Statuses: [voting_period, passed, rejected, failed]`,
+	Args: cobra.MaximumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		var status string
+		var height int
+		if len(args) >= 1 {
+			status = args[0]
+		}
+		if len(args) == 2 {
+			var err error
+			height, err = strconv.Atoi(args[1])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightAndProposalStatusParams{
+			Height: int64(height),
+			Status: status,
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetProposalsPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
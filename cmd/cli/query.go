@@ -26,9 +26,16 @@ func init() {
 	queryCmd.AddCommand(queryServicers)
 	queryCmd.AddCommand(queryBalance)
 	queryCmd.AddCommand(queryAccount)
+	queryCmd.AddCommand(queryAccountSequence)
+	queryCmd.AddCommand(queryFeeAllowances)
+	queryCmd.AddCommand(queryAuthorizations)
 	queryCmd.AddCommand(queryServicer)
+	queryCmd.AddCommand(queryServicerUnbonding)
+	queryCmd.AddCommand(queryServicerDelegations)
+	queryCmd.AddCommand(queryDelegatorDelegations)
 	queryCmd.AddCommand(queryClients)
 	queryCmd.AddCommand(queryClient)
+	queryCmd.AddCommand(queryClientUnbonding)
 	queryCmd.AddCommand(queryServicerParams)
 	queryCmd.AddCommand(queryClientParams)
 	queryCmd.AddCommand(queryServicerClaims)
@@ -42,6 +49,7 @@ func init() {
 	queryCmd.AddCommand(queryParam)
 	queryCmd.AddCommand(queryDAOOwner)
 	queryCmd.AddCommand(querySigningInfo)
+	queryCmd.AddCommand(querySampling)
 }
 
 var queryCmd = &cobra.Command{
@@ -262,6 +270,21 @@ var queryHeight = &cobra.Command{
 	},
 }
 
+var querySampling = &cobra.Command{
+	Use:   "sampling",
+	Short: "Get the active sampling sessions of the fisherman",
+	Long:  `Retrieves the sessions sampled by the local fisherman and the progress of their sampling, requires the auth token of the node.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		res, err := QuerySecuredRPC(GetSamplingPath, []byte{}, app.GetAuthTokenFromFile())
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
 var queryBalance = &cobra.Command{
 	Use:   "balance <address> [<height>]",
 	Short: "Gets account balance",
@@ -334,6 +357,103 @@ var queryAccount = &cobra.Command{
 	},
 }
 
+var queryAccountSequence = &cobra.Command{
+	Use:   "account-sequence <address>",
+	Short: "Gets the next sequence of an account",
+	Long: `Retrieves the sequence the next transaction signed by the address must carry.
+It is 0 until the account sequences are activated, the transactions are then built without sequence.`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		sequence, err := QueryNextSequence(args[0])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(sequence)
+	},
+}
+
+var queryFeeAllowances = &cobra.Command{
+	Use:   "fee-allowances <grantee> [<granter>] [<height>]",
+	Short: "Gets the fee allowances of an account",
+	Long: `Retrieves the fee allowances granted to the grantee, only the one of the granter if provided.
+The transactions of the grantee name their fee granter with the --fee-granter flag.`,
+	Args: cobra.RangeArgs(1, 3),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		var height int
+		var granter string
+		if len(args) > 1 {
+			granter = args[1]
+		}
+		if len(args) > 2 {
+			var err error
+			height, err = strconv.Atoi(args[2])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.QueryFeeAllowancesParams{
+			Height:  int64(height),
+			Grantee: args[0],
+			Granter: granter,
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetFeeAllowancesPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryAuthorizations = &cobra.Command{
+	Use:   "authorizations <grantee> [<granter>] [<height>]",
+	Short: "Gets the authorizations of an account",
+	Long: `Retrieves the authorizations granted to the grantee, only the ones of the granter if provided.
+The grantee executes the authorized messages on behalf of the granter with the accounts exec command.`,
+	Args: cobra.RangeArgs(1, 3),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		var height int
+		var granter string
+		if len(args) > 1 {
+			granter = args[1]
+		}
+		if len(args) > 2 {
+			var err error
+			height, err = strconv.Atoi(args[2])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.QueryAuthorizationsParams{
+			Height:  int64(height),
+			Grantee: args[0],
+			Granter: granter,
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetAuthorizationsPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
 var servicerStakingStatus string
 var servicerJailedStatus string
 var blockchain string
@@ -448,6 +568,114 @@ var queryServicer = &cobra.Command{
 	},
 }
 
+var queryServicerUnbonding = &cobra.Command{
+	Use:   "servicer-unbonding <address> [<height>]",
+	Short: "Gets the unbonding stakes of a servicer",
+	Long:  `Retrieves the stakes removed from the servicer by partial unstakes that are not released yet, at the specified <height>.`,
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		var height int
+		if len(args) == 1 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[1])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightAndAddrParams{
+			Height:  int64(height),
+			Address: args[0],
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetNodeUnbondingStakesPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryServicerDelegations = &cobra.Command{
+	Use:   "servicer-delegations <address> [<height>]",
+	Short: "Gets the delegations to a servicer",
+	Long:  `Retrieves the delegations to the servicer and the commission of its operator, at the specified <height>.`,
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		var height int
+		if len(args) == 1 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[1])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightAndAddrParams{
+			Height:  int64(height),
+			Address: args[0],
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetNodeDelegationsPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
+var queryDelegatorDelegations = &cobra.Command{
+	Use:   "delegator-delegations <address> [<height>]",
+	Short: "Gets the delegations of a delegator",
+	Long:  `Retrieves the delegations, the unbonding delegations and the redelegations of the delegator, at the specified <height>.`,
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		var height int
+		if len(args) == 1 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[1])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightAndAddrParams{
+			Height:  int64(height),
+			Address: args[0],
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetDelegatorDelegationsPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
 var queryServicerParams = &cobra.Command{
 	Use:   "servicer-params <height>",
 	Short: "Gets servicer parameters",
@@ -577,6 +805,42 @@ var queryClient = &cobra.Command{
 	},
 }
 
+var queryClientUnbonding = &cobra.Command{
+	Use:   "client-unbonding <address> [<height>]",
+	Short: "Gets the unbonding stakes of a client",
+	Long:  `Retrieves the stakes removed from the client by partial unstakes that are not released yet, at the specified <height>.`,
+	Args:  cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		var height int
+		if len(args) == 1 {
+			height = 0 // latest
+		} else {
+			var err error
+			height, err = strconv.Atoi(args[1])
+			if err != nil {
+				fmt.Println(err)
+				return
+			}
+		}
+		params := rpc.HeightAndAddrParams{
+			Height:  int64(height),
+			Address: args[0],
+		}
+		j, err := json.Marshal(params)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		res, err := QueryRPC(GetRequestorUnbondingStakesPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
+
 var queryClientParams = &cobra.Command{
 	Use:   "client-params [<height>]",
 	Short: "Gets client parameters",
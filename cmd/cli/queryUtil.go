@@ -30,8 +30,15 @@ var (
 	GetSupportedChainsPath,
 	GetBalancePath,
 	GetAccountTxsPath,
+	GetAccountSequencePath,
+	GetFeeAllowancesPath,
+	GetAuthorizationsPath,
 	GetNodeParamsPath,
 	GetServicersPath,
+	GetNodeUnbondingStakesPath,
+	GetNodeDelegationsPath,
+	GetDelegatorDelegationsPath,
+	GetRequestorUnbondingStakesPath,
 	GetSigningInfoPath,
 	GetRequestorsPath,
 	GetRequestorParamsPath,
@@ -42,8 +49,14 @@ var (
 	GetSupplyPath,
 	GetAllParamsPath,
 	GetParamPath,
+	GetProposalPath,
+	GetProposalsPath,
+	GetProposalVotesPath,
+	GetProposalTallyPath,
 	GetStopPath,
 	GetQueryChains,
+	GetSamplingPath,
+	GetSubmissionsPath,
 	GetAccountsPath string
 )
 
@@ -79,10 +92,24 @@ func init() {
 			GetBalancePath = route.Path
 		case "QueryAccountTxs":
 			GetAccountTxsPath = route.Path
+		case "QueryAccountSequence":
+			GetAccountSequencePath = route.Path
+		case "QueryFeeAllowances":
+			GetFeeAllowancesPath = route.Path
+		case "QueryAuthorizations":
+			GetAuthorizationsPath = route.Path
 		case "QueryNodeParams":
 			GetNodeParamsPath = route.Path
 		case "QueryServicers":
 			GetServicersPath = route.Path
+		case "QueryNodeUnbondingStakes":
+			GetNodeUnbondingStakesPath = route.Path
+		case "QueryNodeDelegations":
+			GetNodeDelegationsPath = route.Path
+		case "QueryDelegatorDelegations":
+			GetDelegatorDelegationsPath = route.Path
+		case "QueryRequestorUnbondingStakes":
+			GetRequestorUnbondingStakesPath = route.Path
 		case "QuerySigningInfo":
 			GetSigningInfoPath = route.Path
 		case "QueryRequestors":
@@ -103,10 +130,22 @@ func init() {
 			GetAllParamsPath = route.Path
 		case "QueryParam":
 			GetParamPath = route.Path
+		case "QueryProposal":
+			GetProposalPath = route.Path
+		case "QueryProposals":
+			GetProposalsPath = route.Path
+		case "QueryProposalVotes":
+			GetProposalVotesPath = route.Path
+		case "QueryProposalTally":
+			GetProposalTallyPath = route.Path
 		case "Stop":
 			GetStopPath = route.Path
 		case "QueryChains":
 			GetQueryChains = route.Path
+		case "QuerySampling":
+			GetSamplingPath = route.Path
+		case "QuerySubmissions":
+			GetSubmissionsPath = route.Path
 		default:
 			continue
 		}
@@ -150,6 +189,36 @@ func QueryRPC(path string, jsonArgs []byte) (string, error) {
 	return "", fmt.Errorf("the http status code was not okay: %d, and the status was: %s, with a response of %v", resp.StatusCode, resp.Status, string(bz))
 }
 
+// QueryNextSequence queries the sequence the next transaction signed by the address must carry,
+// zero while the account sequences are not activated
+func QueryNextSequence(addr string) (uint64, error) {
+	j, err := json.Marshal(rpc.HeightAndAddrParams{Address: addr})
+	if err != nil {
+		return 0, err
+	}
+	res, err := QueryRPC(GetAccountSequencePath, j)
+	if err != nil {
+		return 0, err
+	}
+	var seq struct {
+		Sequence uint64 `json:"sequence"`
+	}
+	if err := json.Unmarshal([]byte(res), &seq); err != nil {
+		return 0, err
+	}
+	return seq.Sequence, nil
+}
+
+// nextSequence is QueryNextSequence falling back to no sequence, for the nodes not serving it
+func nextSequence(addr string) uint64 {
+	seq, err := QueryNextSequence(addr)
+	if err != nil {
+		fmt.Println(fmt.Errorf("could not query the account sequence, building the transaction without: %v", err))
+		return 0
+	}
+	return seq
+}
+
 func QuerySecuredRPC(path string, jsonArgs []byte, token sdk.AuthToken) (string, error) {
 	//cliURL := app.GlobalConfig.ViperConfig.RemoteCLIURL + ":" + app.GlobalConfig.ViperConfig.RPCPort + path
 	cliURL := app.GlobalConfig.ViperConfig.RemoteCLIURL + path
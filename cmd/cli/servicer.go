@@ -6,6 +6,7 @@ import (
 	"strconv"
 
 	"github.com/vipernet-xyz/viper-network/app"
+	"github.com/vipernet-xyz/viper-network/types"
 
 	"github.com/spf13/cobra"
 )
@@ -13,9 +14,15 @@ import (
 func init() {
 	rootCmd.AddCommand(servicersCmd)
 	servicersCmd.AddCommand(servicerUnstakeCmd)
+	servicersCmd.AddCommand(servicerUnstakePartialCmd)
+	servicersCmd.AddCommand(servicerDelegateCmd)
+	servicersCmd.AddCommand(servicerUndelegateCmd)
+	servicersCmd.AddCommand(servicerRedelegateCmd)
+	servicersCmd.AddCommand(servicerSetCommissionCmd)
 	servicersCmd.AddCommand(servicerUnjailCmd)
 	servicersCmd.AddCommand(servicerPauseCmd)
 	servicersCmd.AddCommand(servicerUnpauseCmd)
+	servicersCmd.AddCommand(servicerSubmissionsCmd)
 }
 
 var servicersCmd = &cobra.Command{
@@ -27,6 +34,11 @@ from staking and unstaking; to unjailing.`,
 
 func init() {
 	servicerUnstakeCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	servicerUnstakePartialCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	servicerDelegateCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	servicerUndelegateCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	servicerRedelegateCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
+	servicerSetCommissionCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 	servicerUnjailCmd.Flags().StringVar(&pwd, "pwd", "", "passphrase used by the cmd, non empty usage bypass interactive prompt")
 }
 
@@ -63,6 +75,201 @@ Will prompt the user for the <fromAddr> account passphrase.`,
 	},
 }
 
+var servicerUnstakePartialCmd = &cobra.Command{
+	Use:   "unstake-partial <operatorAddr> <fromAddr> <amount> <networkID> <fee>",
+	Short: "Remove part of the stake of a servicer",
+	Long: `Remove the amount from the stake of a servicer, the stake can't go below the minimum stake.
+The amount is sent to the output address once the unstaking time is over, until then it can be slashed.
+Will prompt the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(5),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		amount, err := strconv.Atoi(args[2])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fee, err := strconv.Atoi(args[4])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter Password: ")
+		res, err := UnstakePartialNode(args[0], args[1], app.Credentials(pwd), args[3], types.NewInt(int64(amount)), int64(fee))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
+
+var servicerDelegateCmd = &cobra.Command{
+	Use:   "delegate <fromAddr> <servicerAddr> <amount> <networkID> <fee>",
+	Short: "Delegate to a servicer",
+	Long: `Delegate the amount to the stake of a servicer, the delegation shares the relay rewards of the servicer
+after the commission of its operator and the slashes of the servicer.
+Will prompt the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(5),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		amount, err := strconv.Atoi(args[2])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fee, err := strconv.Atoi(args[4])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter Password: ")
+		res, err := Delegate(args[0], args[1], app.Credentials(pwd), args[3], types.NewInt(int64(amount)), int64(fee))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
+
+var servicerUndelegateCmd = &cobra.Command{
+	Use:   "undelegate <fromAddr> <servicerAddr> <amount> <networkID> <fee>",
+	Short: "Remove a delegation to a servicer",
+	Long: `Remove the amount from the delegation to a servicer.
+The amount is sent back to the delegator once the unstaking time is over, until then it can be slashed.
+Will prompt the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(5),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		amount, err := strconv.Atoi(args[2])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fee, err := strconv.Atoi(args[4])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter Password: ")
+		res, err := Undelegate(args[0], args[1], app.Credentials(pwd), args[3], types.NewInt(int64(amount)), int64(fee))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
+
+var servicerRedelegateCmd = &cobra.Command{
+	Use:   "redelegate <fromAddr> <srcServicerAddr> <dstServicerAddr> <amount> <networkID> <fee>",
+	Short: "Move a delegation to another servicer",
+	Long: `Move the amount from the delegation to the source servicer to the destination servicer.
+The amount can be slashed for the infractions of the source servicer until the unstaking time is over.
+Will prompt the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(6),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		amount, err := strconv.Atoi(args[3])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fee, err := strconv.Atoi(args[5])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter Password: ")
+		res, err := Redelegate(args[0], args[1], args[2], app.Credentials(pwd), args[4], types.NewInt(int64(amount)), int64(fee))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
+
+var servicerSetCommissionCmd = &cobra.Command{
+	Use:   "set-commission <operatorAddr> <fromAddr> <rate> <networkID> <fee>",
+	Short: "Set the commission of a servicer",
+	Long: `Set the part of the relay rewards of a servicer taken by its operator before they are shared with the delegators.
+The <rate> is a decimal between 0 and 1.
+Will prompt the user for the <fromAddr> account passphrase.`,
+	Args: cobra.ExactArgs(5),
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		rate, err := types.NewDecFromStr(args[2])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fee, err := strconv.Atoi(args[4])
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println("Enter Password: ")
+		res, err := SetCommission(args[0], args[1], app.Credentials(pwd), args[3], rate, int64(fee))
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		resp, err := QueryRPC(SendRawTxPath, j)
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(resp)
+	},
+}
+
 var servicerUnjailCmd = &cobra.Command{
 	Use:   "unjail <operatorAddr> <fromAddr> <networkID> <fee>",
 	Short: "Unjails a servicer in the network",
@@ -161,3 +368,20 @@ Will prompt the user for the <fromAddr> account passphrase.`,
 		fmt.Println(resp)
 	},
 }
+
+var servicerSubmissionsCmd = &cobra.Command{
+	Use:   "submissions",
+	Short: "Get the pending and failed reportcards, claims and proofs of the node",
+	Long: `Retrieves the reportcard, claim and proof transactions of the local nodes that are not included yet,
+with their number of attempts and last error, and the ones that failed as their submission window closed.
+Requires the auth token of the node.`,
+	Run: func(cmd *cobra.Command, args []string) {
+		app.InitConfig(datadir, tmNode, persistentPeers, seeds, remoteCLIURL)
+		res, err := QuerySecuredRPC(GetSubmissionsPath, []byte{}, app.GetAuthTokenFromFile())
+		if err != nil {
+			fmt.Println(err)
+			return
+		}
+		fmt.Println(res)
+	},
+}
@@ -61,6 +61,175 @@ func SendTransaction(fromAddr, toAddr, passphrase, chainID string, amount sdk.Bi
 	}, nil
 }
 
+// GrantFeeAllowance - Deliver a fee allowance grant from the granter to the grantee
+func GrantFeeAllowance(granter, grantee, passphrase, chainID string, spendLimit sdk.BigInt, expiration int64, allowedMsgs []string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+	gr, err := sdk.AddressFromHex(granter)
+	if err != nil {
+		return nil, err
+	}
+	ge, err := sdk.AddressFromHex(grantee)
+	if err != nil {
+		return nil, err
+	}
+	kb, err := app.GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	msg := authTypes.MsgGrantFeeAllowance{
+		Granter:     gr,
+		Grantee:     ge,
+		Expiration:  expiration,
+		AllowedMsgs: allowedMsgs,
+	}
+	if !spendLimit.IsZero() {
+		msg.SpendLimit = sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, spendLimit))
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), &msg, gr, chainID, kb, passphrase, fees, "", legacyCodec)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        granter,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+// RevokeFeeAllowance - Deliver the revocation of the fee allowance from the granter to the grantee
+func RevokeFeeAllowance(granter, grantee, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+	gr, err := sdk.AddressFromHex(granter)
+	if err != nil {
+		return nil, err
+	}
+	ge, err := sdk.AddressFromHex(grantee)
+	if err != nil {
+		return nil, err
+	}
+	kb, err := app.GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	msg := authTypes.MsgRevokeFeeAllowance{
+		Granter: gr,
+		Grantee: ge,
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), &msg, gr, chainID, kb, passphrase, fees, "", legacyCodec)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        granter,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+// GrantAuthorization - Deliver an authorization grant from the granter to the grantee for the message type
+func GrantAuthorization(granter, grantee, msgType, passphrase, chainID string, expiration int64, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+	gr, err := sdk.AddressFromHex(granter)
+	if err != nil {
+		return nil, err
+	}
+	ge, err := sdk.AddressFromHex(grantee)
+	if err != nil {
+		return nil, err
+	}
+	kb, err := app.GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	msg := authTypes.MsgGrantAuthorization{
+		Granter:    gr,
+		Grantee:    ge,
+		MsgType:    msgType,
+		Expiration: expiration,
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), &msg, gr, chainID, kb, passphrase, fees, "", legacyCodec)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        granter,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+// RevokeAuthorization - Deliver the revocation of the authorization from the granter to the grantee for the message type
+func RevokeAuthorization(granter, grantee, msgType, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+	gr, err := sdk.AddressFromHex(granter)
+	if err != nil {
+		return nil, err
+	}
+	ge, err := sdk.AddressFromHex(grantee)
+	if err != nil {
+		return nil, err
+	}
+	kb, err := app.GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	msg := authTypes.MsgRevokeAuthorization{
+		Granter: gr,
+		Grantee: ge,
+		MsgType: msgType,
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), &msg, gr, chainID, kb, passphrase, fees, "", legacyCodec)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        granter,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+// Exec - Deliver the json messages executed by the grantee on behalf of the accounts that authorized it
+func Exec(grantee, jsonMessages, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+	ge, err := sdk.AddressFromHex(grantee)
+	if err != nil {
+		return nil, err
+	}
+	protoMsgs, err := app.UnmarshalMsgs(jsonMessages)
+	if err != nil {
+		return nil, err
+	}
+	msgs := make([]sdk.Msg, 0, len(protoMsgs))
+	for _, m := range protoMsgs {
+		msgs = append(msgs, m)
+	}
+	kb, err := app.GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	msg := authTypes.NewMsgExec(ge, msgs)
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), &msg, ge, chainID, kb, passphrase, fees, "", legacyCodec)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        grantee,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
 // LegacyStakeNode - Deliver Stake message to servicer
 func LegacyStakeNode(chains []string, serviceURL, fromAddr, passphrase, chainID string, geoZone []string, amount sdk.BigInt, fees int64) (*rpc.SendRawTxParams, error) {
 	fa, err := sdk.AddressFromHex(fromAddr)
@@ -237,6 +406,181 @@ func UnstakeNode(operatorAddr, fromAddr, passphrase, chainID string, fees int64)
 	}, nil
 }
 
+// UnstakePartialNode - Remove part of the stake of the servicer
+func UnstakePartialNode(operatorAddr, fromAddr, passphrase, chainID string, amount sdk.BigInt, fees int64) (*rpc.SendRawTxParams, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	oa, err := sdk.AddressFromHex(operatorAddr)
+	if err != nil {
+		return nil, err
+	}
+	var msg sdk.ProtoMsg
+	msg = &servicerTypes.MsgUnstakePartial{
+		Address: oa,
+		Amount:  amount,
+		Signer:  fa,
+	}
+	kb, err := app.GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), msg, fa, chainID, kb, passphrase, fees, "", false)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+// Delegate - Delegate the amount to the servicer
+func Delegate(fromAddr, validatorAddr, passphrase, chainID string, amount sdk.BigInt, fees int64) (*rpc.SendRawTxParams, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	va, err := sdk.AddressFromHex(validatorAddr)
+	if err != nil {
+		return nil, err
+	}
+	var msg sdk.ProtoMsg
+	msg = &servicerTypes.MsgDelegate{
+		Delegator: fa,
+		Validator: va,
+		Amount:    amount,
+	}
+	kb, err := app.GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), msg, fa, chainID, kb, passphrase, fees, "", false)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+// Undelegate - Remove the amount from the delegation to the servicer
+func Undelegate(fromAddr, validatorAddr, passphrase, chainID string, amount sdk.BigInt, fees int64) (*rpc.SendRawTxParams, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	va, err := sdk.AddressFromHex(validatorAddr)
+	if err != nil {
+		return nil, err
+	}
+	var msg sdk.ProtoMsg
+	msg = &servicerTypes.MsgUndelegate{
+		Delegator: fa,
+		Validator: va,
+		Amount:    amount,
+	}
+	kb, err := app.GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), msg, fa, chainID, kb, passphrase, fees, "", false)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+// Redelegate - Move the amount from the delegation to the source servicer to the destination servicer
+func Redelegate(fromAddr, srcAddr, dstAddr, passphrase, chainID string, amount sdk.BigInt, fees int64) (*rpc.SendRawTxParams, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	sa, err := sdk.AddressFromHex(srcAddr)
+	if err != nil {
+		return nil, err
+	}
+	da, err := sdk.AddressFromHex(dstAddr)
+	if err != nil {
+		return nil, err
+	}
+	var msg sdk.ProtoMsg
+	msg = &servicerTypes.MsgRedelegate{
+		Delegator:    fa,
+		SrcValidator: sa,
+		DstValidator: da,
+		Amount:       amount,
+	}
+	kb, err := app.GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), msg, fa, chainID, kb, passphrase, fees, "", false)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+// SetCommission - Set the part of the relay rewards the operator of the servicer takes before the delegators
+func SetCommission(operatorAddr, fromAddr, passphrase, chainID string, rate sdk.BigDec, fees int64) (*rpc.SendRawTxParams, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	oa, err := sdk.AddressFromHex(operatorAddr)
+	if err != nil {
+		return nil, err
+	}
+	var msg sdk.ProtoMsg
+	msg = &servicerTypes.MsgSetCommission{
+		Address: oa,
+		Rate:    rate,
+		Signer:  fa,
+	}
+	kb, err := app.GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), msg, fa, chainID, kb, passphrase, fees, "", false)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
 // UnjailNode - Remove servicer from jail
 func UnjailNode(operatorAddr, fromAddr, passphrase, chainID string, fees int64) (*rpc.SendRawTxParams, error) {
 	fa, err := sdk.AddressFromHex(fromAddr)
@@ -345,6 +689,60 @@ func UnstakeClient(fromAddr, passphrase, chainID string, fees int64, legacyCodec
 	}, nil
 }
 
+func UnstakePartialClient(fromAddr, passphrase, chainID string, amount sdk.BigInt, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	kb, err := app.GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	msg := requestorsType.MsgUnstakePartial{
+		Address: fa,
+		Amount:  amount,
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+func RevokeClient(fromAddr, clientPubKey, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	kb, err := app.GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	msg := requestorsType.MsgRevokeClient{
+		RequestorAddress: fa,
+		ClientPublicKey:  clientPubKey,
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
 func DAOTx(fromAddr, toAddr, passphrase string, amount sdk.BigInt, action, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
 	fa, err := sdk.AddressFromHex(fromAddr)
 	if err != nil {
@@ -378,6 +776,42 @@ func DAOTx(fromAddr, toAddr, passphrase string, amount sdk.BigInt, action, chain
 	}, nil
 }
 
+func CreateVestingAccountTx(fromAddr, toAddr, passphrase string, amount sdk.BigInt, vestingType string, startTime, endTime int64, periods []governanceTypes.VestingPeriod, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	ta, err := sdk.AddressFromHex(toAddr)
+	if err != nil {
+		return nil, err
+	}
+	kb, err := app.GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	msg := governanceTypes.MsgCreateVestingAccount{
+		FromAddress: fa,
+		ToAddress:   ta,
+		Amount:      amount,
+		VestingType: vestingType,
+		StartTime:   startTime,
+		EndTime:     endTime,
+		Periods:     periods,
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
 func ChangeParam(fromAddr, paramACLKey string, paramValue json.RawMessage, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
 	fa, err := sdk.AddressFromHex(fromAddr)
 	if err != nil {
@@ -439,13 +873,83 @@ func Upgrade(fromAddr string, upgrade governanceTypes.Upgrade, passphrase, chain
 	}, nil
 }
 
+func SubmitProposal(fromAddr, title, description string, content governanceTypes.ProposalContent, deposit sdk.BigInt, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	kb, err := app.GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	msg := governanceTypes.MsgSubmitProposal{
+		Proposer:    fa,
+		Title:       title,
+		Description: description,
+		Content:     content,
+		Deposit:     deposit,
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
+func Vote(fromAddr string, proposalID uint64, option governanceTypes.VoteOption, passphrase, chainID string, fees int64, legacyCodec bool) (*rpc.SendRawTxParams, error) {
+	fa, err := sdk.AddressFromHex(fromAddr)
+	if err != nil {
+		return nil, err
+	}
+	kb, err := app.GetKeybase()
+	if err != nil {
+		return nil, err
+	}
+	msg := governanceTypes.MsgVote{
+		ProposalID: proposalID,
+		Voter:      fa,
+		Option:     option,
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	txBz, err := newTxBz(app.Codec(), &msg, fa, chainID, kb, passphrase, fees, "", legacyCodec)
+	if err != nil {
+		return nil, err
+	}
+	return &rpc.SendRawTxParams{
+		Addr:        fromAddr,
+		RawHexBytes: hex.EncodeToString(txBz),
+	}, nil
+}
+
 func newTxBz(cdc *codec.Codec, msg sdk.ProtoMsg, fromAddr sdk.Address, chainID string, keybase keys.Keybase, passphrase string, fee int64, memo string, legacyCodec bool) (transactionBz []byte, err error) {
 	// fees
 	fees := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(fee)))
 	// entroyp
 	entropy := rand.Int64()
 
-	signBytes, err := authentication.StdSignBytes(chainID, entropy, fees, msg, memo)
+	// sequence
+	sequence := nextSequence(fromAddr.String())
+
+	// fee granter, set by the --fee-granter flag
+	var granter sdk.Address
+	if feeGranter != "" {
+		granter, err = sdk.AddressFromHex(feeGranter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	signBytes, err := authentication.StdMultiSignBytes(chainID, entropy, fees, []sdk.Msg{msg}, memo, sequence, granter)
 	if err != nil {
 		return nil, err
 	}
@@ -454,7 +958,9 @@ func newTxBz(cdc *codec.Codec, msg sdk.ProtoMsg, fromAddr sdk.Address, chainID s
 		return nil, err
 	}
 	s := authTypes.StdSignature{PublicKey: pubKey, Signature: sig}
-	tx := authTypes.NewTx(msg, fees, s, memo, entropy)
+	tx := authTypes.NewTx(msg, fees, s, memo, entropy).(authTypes.StdTx)
+	tx.Sequence = sequence
+	tx.FeeGranter = granter
 
 	if legacyCodec {
 		return authentication.DefaultTxEncoder(cdc)(tx, 0)
@@ -128,9 +128,20 @@ var decodeTxCmd = &cobra.Command{
 			fmt.Println("an error occurred unmarshalling the transaction string", err.Error())
 			return
 		}
+		msgs := stdTx.GetMsgs()
+		if len(msgs) == 1 {
+			fmt.Printf(
+				"Type:\t\t%s\nMsg:\t\t%v\nFee:\t\t%s\nEntropy:\t%d\nMemo:\t\t%s\nSigners\t\t%v\nSig:\t\t%s\n",
+				stdTx.GetMsg().Type(), stdTx.GetMsg(), stdTx.GetFee().String(), stdTx.GetEntropy(), stdTx.GetMemo(), stdTx.GetMsg().GetSigners(),
+				stdTx.GetSignature().GetPublicKey())
+			return
+		}
+		for i, msg := range msgs {
+			fmt.Printf("Msg %d:\nType:\t\t%s\nMsg:\t\t%v\nSigners\t\t%v\n", i, msg.Type(), msg, msg.GetSigners())
+		}
 		fmt.Printf(
-			"Type:\t\t%s\nMsg:\t\t%v\nFee:\t\t%s\nEntropy:\t%d\nMemo:\t\t%s\nSigners\t\t%v\nSig:\t\t%s\n",
-			stdTx.GetMsg().Type(), stdTx.GetMsg(), stdTx.GetFee().String(), stdTx.GetEntropy(), stdTx.GetMemo(), stdTx.GetMsg().GetSigners(),
+			"Fee:\t\t%s\nEntropy:\t%d\nMemo:\t\t%s\nSigners\t\t%v\nSig:\t\t%s\n",
+			stdTx.GetFee().String(), stdTx.GetEntropy(), stdTx.GetMemo(), stdTx.GetSigners(),
 			stdTx.GetSignature().GetPublicKey())
 	},
 }
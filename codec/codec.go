@@ -43,6 +43,16 @@ const (
 	BlockSizeModifyKey         = "BLOCK"
 	VEDITKey                   = "VEDIT"
 	ClearUnjailedValSessionKey = "CRVAL"
+	MultiMsgTxKey              = "MMSG"
+	AccountSequenceKey         = "SEQ"
+	FeeGrantKey                = "FEEG"
+	AuthorizationKey           = "AUTHZ"
+	TokenRevocationKey         = "AATREV"
+	PartialUnstakeKey          = "PUNSTAKE"
+	DelegationKey              = "DELEGATION"
+	GovernanceKey              = "GOV"
+	UnavailableServicerKey     = "UNAVAIL"
+	VestingAccountKey          = "VESTING"
 )
 
 func (cdc *Codec) RegisterStructure(o interface{}, name string) {
@@ -70,6 +80,15 @@ func (cdc *Codec) RegisterInterface(name string, iface interface{}, impls ...pro
 	cdc.legacyCdc.Amino.RegisterInterface(iface, nil)
 }
 
+// InterfaceRegistry returns the interface registry backing the proto codec
+func (cdc *Codec) InterfaceRegistry() types.InterfaceRegistry {
+	res, ok := cdc.protoCdc.AnyUnpacker.(types.InterfaceRegistry)
+	if !ok {
+		panic("unable to convert protocodec.anyUnpacker into types.InterfaceRegistry")
+	}
+	return res
+}
+
 func (cdc *Codec) RegisterImplementation(iface interface{}, impls ...proto.Message) {
 	res, ok := cdc.protoCdc.AnyUnpacker.(types.InterfaceRegistry)
 	if !ok {
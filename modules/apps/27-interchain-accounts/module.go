@@ -146,7 +146,11 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 // It returns no validator updates.
 func (am AppModule) InitGenesis(ctx sdk.Ctx, data json.RawMessage) []abci.ValidatorUpdate {
 	var genesisState genesistypes.GenesisState
-	types.ModuleCdc.MustUnmarshalJSON(data, &genesisState)
+	if data == nil {
+		genesisState = *genesistypes.DefaultGenesis()
+	} else {
+		types.ModuleCdc.MustUnmarshalJSON(data, &genesisState)
+	}
 
 	if am.controllerKeeper != nil {
 		controllerkeeper.InitGenesis(ctx, *am.controllerKeeper, genesisState.ControllerGenesisState)
@@ -200,14 +204,14 @@ func (am AppModule) NewHandler() sdk.Handler {
 	return NewHandler(*am.controllerKeeper, *am.hostKeeper)
 }
 
-// NewQuerierHandler returns the staking module sdk.Querier.
+// NewQuerierHandler returns no sdk.Querier, interchain accounts are only queried over grpc.
 func (am AppModule) NewQuerierHandler() sdk.Querier {
 	return nil
 }
 
-// QuerierRoute returns the staking module's querier route name.
+// QuerierRoute returns an empty route so no legacy querier is registered for interchain accounts.
 func (AppModule) QuerierRoute() string {
-	return types.QuerierRoute
+	return ""
 }
 
 // RegisterCodec registers the staking module's types for the given codec.
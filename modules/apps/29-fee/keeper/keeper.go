@@ -84,7 +84,7 @@ func (k Keeper) GetFeeModuleAddress() sdk.Address {
 // EscrowAccountHasBalance verifies if the escrow account has the provided fee.
 func (k Keeper) EscrowAccountHasBalance(ctx sdk.Ctx, coins sdk.Coins) bool {
 	for _, coin := range coins {
-		if !k.bankKeeper.HasBalance(ctx, k.GetFeeModuleAddress(), coin) {
+		if !k.bankKeeper.HasCoins(ctx, k.GetFeeModuleAddress(), sdk.NewCoins(coin)) {
 			return false
 		}
 	}
@@ -93,7 +93,7 @@ func (k Keeper) PayPacketFee(goCtx context.Context, msg *types.MsgPayPacketFee)
 		return nil, err
 	}
 
-	if err := k.bankKeeper.IsSendEnabledCoins(ctx, msg.Fee.Total()...); err != nil {
+	if err := k.isSendEnabledCoins(ctx, msg.Fee.Total()...); err != nil {
 		return nil, err
 	}
 
@@ -138,7 +138,7 @@ func (k Keeper) PayPacketFeeAsync(goCtx context.Context, msg *types.MsgPayPacket
 		return nil, err
 	}
 
-	if err := k.bankKeeper.IsSendEnabledCoins(ctx, msg.PacketFee.Fee.Total()...); err != nil {
+	if err := k.isSendEnabledCoins(ctx, msg.PacketFee.Fee.Total()...); err != nil {
 		return nil, err
 	}
 
@@ -167,3 +167,14 @@ func (k Keeper) PayPacketFeeAsync(goCtx context.Context, msg *types.MsgPayPacket
 
 	return &types.MsgPayPacketFeeAsyncResponse{}, nil
 }
+
+// isSendEnabledCoins returns an error if any of the coins are not enabled for sending
+func (k Keeper) isSendEnabledCoins(ctx sdk.Ctx, coins ...sdk.Coin) error {
+	for _, coin := range coins {
+		if !k.bankKeeper.IsSendEnabledCoin(ctx, coin) {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "%s transfers are currently disabled", coin.Denom)
+		}
+	}
+
+	return nil
+}
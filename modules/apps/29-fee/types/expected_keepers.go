@@ -28,9 +28,9 @@ type PortKeeper interface {
 
 // BankKeeper defines the expected bank keeper
 type BankKeeper interface {
-	HasBalance(ctx sdk.Ctx, addr sdk.Address, amt sdk.Coin) bool
-	SendCoinsFromAccountToModule(ctx sdk.Ctx, senderAddr sdk.Address, recipientModule string, amt sdk.Coins) error
-	SendCoinsFromModuleToAccount(ctx sdk.Ctx, senderModule string, recipientAddr sdk.Address, amt sdk.Coins) error
+	HasCoins(ctx sdk.Ctx, addr sdk.Address, amt sdk.Coins) bool
+	SendCoinsFromAccountToModule(ctx sdk.Ctx, senderAddr sdk.Address, recipientModule string, amt sdk.Coins) sdk.Error
+	SendCoinsFromModuleToAccount(ctx sdk.Ctx, senderModule string, recipientAddr sdk.Address, amt sdk.Coins) sdk.Error
 	BlockedAddr(sdk.Address) bool
-	IsSendEnabledCoins(ctx sdk.Ctx, coins ...sdk.Coin) error
+	IsSendEnabledCoin(ctx sdk.Ctx, coin sdk.Coin) bool
 }
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"strings"
@@ -28,11 +29,19 @@ func Dispatch(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	if cors(&w, r) {
 		return
 	}
+	if err := types.GlobalRateLimiter().AllowIP(sourceIP(r)); err != nil {
+		writeRateLimitedResponse(w, err)
+		return
+	}
 	d := types.SessionHeader{}
 	if err := PopModel(w, r, ps, &d); err != nil {
 		WriteErrorResponse(w, 400, err.Error())
 		return
 	}
+	if err := types.GlobalRateLimiter().AllowDispatch(d); err != nil {
+		writeRateLimitedResponse(w, err)
+		return
+	}
 	res, err := app.VCA.HandleDispatch(d)
 	if err != nil {
 		WriteErrorResponse(w, 400, err.Error())
@@ -63,6 +72,10 @@ func Relay(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	if cors(&w, r) {
 		return
 	}
+	if err := types.GlobalRateLimiter().AllowIP(sourceIP(r)); err != nil {
+		writeRateLimitedRelayResponse(w, r, err)
+		return
+	}
 	if err := PopModel(w, r, ps, &relay); err != nil {
 		response := RPCRelayErrorResponse{
 			Error: err,
@@ -71,7 +84,17 @@ func Relay(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		WriteJSONResponseWithCode(w, string(j), r.URL.Path, r.Host, 400)
 		return
 	}
+	release, limitErr := types.GlobalRateLimiter().AcquireRelay(relay.Proof.Blockchain)
+	if limitErr != nil {
+		writeRateLimitedRelayResponse(w, r, limitErr)
+		return
+	}
+	defer release()
 	res, dispatch, err := app.VCA.HandleRelay(relay)
+	if types.ErrorIsRateLimited(err) {
+		writeRateLimitedRelayResponse(w, r, err)
+		return
+	}
 	if err != nil {
 		response := RPCRelayErrorResponse{
 			Error:    err,
@@ -430,6 +453,10 @@ func FishermanTrigger(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 	if cors(&w, r) {
 		return
 	}
+	if err := types.GlobalRateLimiter().AllowIP(sourceIP(r)); err != nil {
+		writeRateLimitedRelayResponse(w, r, err)
+		return
+	}
 	if err := PopModel(w, r, ps, &trigger); err != nil {
 		response := RPCRelayErrorResponse{
 			Error: err,
@@ -438,7 +465,17 @@ func FishermanTrigger(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 		WriteJSONResponseWithCode(w, string(j), r.URL.Path, r.Host, 400)
 		return
 	}
+	release, limitErr := types.GlobalRateLimiter().AcquireRelay(trigger.Proof.Blockchain)
+	if limitErr != nil {
+		writeRateLimitedRelayResponse(w, r, limitErr)
+		return
+	}
+	defer release()
 	res, dispatch, err := app.VCA.HandleFishermanTrigger(trigger)
+	if types.ErrorIsRateLimited(err) {
+		writeRateLimitedRelayResponse(w, r, err)
+		return
+	}
 	if err != nil {
 		response := RPCRelayErrorResponse{
 			Error:    err,
@@ -460,16 +497,40 @@ func FishermanTrigger(w http.ResponseWriter, r *http.Request, ps httprouter.Para
 	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
 }
 
-type RelayWebsocketResponse struct {
-	Signature string `json:"signature"`
-	Response  string `json:"response"`
+// sourceIP returns the ip the request comes from, the last address of the X-Forwarded-For header when the servicer
+// trusts the proxy in front of it
+func sourceIP(r *http.Request) string {
+	if types.GlobalViperConfig.RPCRateLimitTrustProxy {
+		if forwarded := r.Header.Get("X-Forwarded-For"); forwarded != "" {
+			addrs := strings.Split(forwarded, ",")
+			return strings.TrimSpace(addrs[len(addrs)-1])
+		}
+	}
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
 }
 
-type RelayWebsocketErrorResponse struct {
-	Error    error                   `json:"error"`
-	Dispatch *types.DispatchResponse `json:"dispatch"`
+// writeRateLimitedResponse answers a dispatch rejected by the rate limits of the servicer
+func writeRateLimitedResponse(w http.ResponseWriter, err error) {
+	w.Header().Set("Retry-After", "1")
+	WriteErrorResponse(w, http.StatusTooManyRequests, err.Error())
+}
+
+// writeRateLimitedRelayResponse answers a relay rejected by the rate limits of the servicer
+func writeRateLimitedRelayResponse(w http.ResponseWriter, r *http.Request, err error) {
+	response := RPCRelayErrorResponse{
+		Error: err,
+	}
+	j, _ := json.Marshal(response)
+	w.Header().Set("Retry-After", "1")
+	WriteJSONResponseWithCode(w, string(j), r.URL.Path, r.Host, http.StatusTooManyRequests)
 }
 
+// RelayWebsocket upgrades the request to a websocket relay session. The client sends "relay" frames carrying
+// json-rpc requests (e.g. eth_subscribe) and "credit" frames carrying the proofs that pay for subscription messages
 func RelayWebsocket(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	var upgrader = websocket.Upgrader{
 		ReadBufferSize:  1024,
@@ -486,24 +547,5 @@ func RelayWebsocket(w http.ResponseWriter, r *http.Request, ps httprouter.Params
 	}
 	defer conn.Close()
 
-	var relay = types.Relay{}
-	if err := PopModel(w, r, ps, &relay); err != nil {
-		response := RelayWebsocketErrorResponse{
-			Error: err,
-		}
-		j, _ := json.Marshal(response)
-		conn.WriteMessage(websocket.TextMessage, j)
-		return
-	}
-
-	dispatch, err := app.VCA.HandleWebsocketRelay(relay, conn)
-	if err != nil {
-		response := RelayWebsocketErrorResponse{
-			Error:    err,
-			Dispatch: dispatch,
-		}
-		j, _ := json.Marshal(response)
-		conn.WriteMessage(websocket.TextMessage, j)
-		return
-	}
+	app.VCA.HandleWebsocketSession(conn)
 }
@@ -0,0 +1,193 @@
+package rpc
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/vipernet-xyz/viper-network/app"
+
+	"github.com/gorilla/websocket"
+	"github.com/julienschmidt/httprouter"
+)
+
+const (
+	eventsPathPrefix   = "/v1/events/"
+	eventsKeepAlive    = 15 * time.Second
+	eventsWriteTimeout = 10 * time.Second
+)
+
+// EventRoutes returns the routes of the event streams, they are served without the timeout of the other routes
+func EventRoutes() Routes {
+	return Routes{
+		Route{Name: "EventsWebsocket", Method: "GET", Path: eventsPathPrefix + "ws", HandlerFunc: EventsWebsocket},
+		Route{Name: "EventsSSE", Method: "GET", Path: eventsPathPrefix + "sse", HandlerFunc: EventsSSE},
+	}
+}
+
+// withEventStreams serves the event streams with their router and the other requests with the handler
+func withEventStreams(handler http.Handler, streams http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, eventsPathPrefix) {
+			streams.ServeHTTP(w, r)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// EventsWebsocket streams the events matching the filter of the query as json text messages
+func EventsWebsocket(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	sub, err := app.VCA.SubscribeEvents(eventFilter(r))
+	if err != nil {
+		writeSubscriptionError(w, err)
+		return
+	}
+	defer sub.Close()
+	upgrader := websocket.Upgrader{
+		ReadBufferSize:  1024,
+		WriteBufferSize: 1024,
+		CheckOrigin: func(r *http.Request) bool {
+			return true
+		},
+	}
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	defer conn.Close()
+	// the messages of the client are discarded, reading them handles the control frames
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			if _, _, err := conn.NextReader(); err != nil {
+				return
+			}
+		}
+	}()
+	keepAlive := time.NewTicker(eventsKeepAlive)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case e, ok := <-sub.Events():
+			if !ok {
+				msg := websocket.FormatCloseMessage(websocket.CloseTryAgainLater, "the subscription fell behind the events")
+				_ = conn.WriteControl(websocket.CloseMessage, msg, time.Now().Add(eventsWriteTimeout))
+				return
+			}
+			_ = conn.SetWriteDeadline(time.Now().Add(eventsWriteTimeout))
+			if err := conn.WriteJSON(e); err != nil {
+				return
+			}
+		case <-keepAlive.C:
+			if err := conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(eventsWriteTimeout)); err != nil {
+				return
+			}
+		case <-done:
+			return
+		}
+	}
+}
+
+// EventsSSE streams the events matching the filter of the query as server-sent events named after their type.
+// The connection is hijacked so the write timeout of the server doesn't end the stream
+func EventsSSE(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		WriteErrorResponse(w, http.StatusInternalServerError, "the connection does not support streaming")
+		return
+	}
+	sub, err := app.VCA.SubscribeEvents(eventFilter(r))
+	if err != nil {
+		writeSubscriptionError(w, err)
+		return
+	}
+	defer sub.Close()
+	conn, rw, err := hijacker.Hijack()
+	if err != nil {
+		log.Println(err)
+		return
+	}
+	defer conn.Close()
+	write := func(s string) error {
+		_ = conn.SetWriteDeadline(time.Now().Add(eventsWriteTimeout))
+		if _, err := rw.WriteString(s); err != nil {
+			return err
+		}
+		return rw.Flush()
+	}
+	if err := write("HTTP/1.1 200 OK\r\nContent-Type: text/event-stream\r\nCache-Control: no-cache\r\n" +
+		"Connection: close\r\nAccess-Control-Allow-Origin: *\r\n\r\n"); err != nil {
+		return
+	}
+	// the client doesn't send anything after the request, the read ends when it disconnects
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		_, _ = io.Copy(io.Discard, rw)
+	}()
+	keepAlive := time.NewTicker(eventsKeepAlive)
+	defer keepAlive.Stop()
+	for {
+		select {
+		case e, ok := <-sub.Events():
+			if !ok {
+				_ = write("event: error\ndata: the subscription fell behind the events\n\n")
+				return
+			}
+			j, err := json.Marshal(e)
+			if err != nil {
+				log.Println(err)
+				continue
+			}
+			if err := write(fmt.Sprintf("event: %s\ndata: %s\n\n", e.Type, j)); err != nil {
+				return
+			}
+		case <-keepAlive.C:
+			if err := write(": keep-alive\n\n"); err != nil {
+				return
+			}
+		case <-done:
+			return
+		}
+	}
+}
+
+// eventFilter returns the filter of the query, the values are repeated or comma separated
+// e.g. /v1/events/sse?type=jail,slash&chain=0001
+func eventFilter(r *http.Request) app.EventFilter {
+	query := r.URL.Query()
+	values := func(key string) (res []string) {
+		for _, v := range query[key] {
+			for _, s := range strings.Split(v, ",") {
+				if s = strings.TrimSpace(s); s != "" {
+					res = append(res, s)
+				}
+			}
+		}
+		return
+	}
+	return app.EventFilter{
+		Types:     values("type"),
+		Addresses: values("address"),
+		Chains:    values("chain"),
+		GeoZones:  values("geozone"),
+	}
+}
+
+func writeSubscriptionError(w http.ResponseWriter, err error) {
+	switch err {
+	case app.MaxEventSubscriptionsError:
+		WriteErrorResponse(w, http.StatusServiceUnavailable, err.Error())
+	case app.EventSubscriptionsDisabledError:
+		WriteErrorResponse(w, http.StatusNotFound, err.Error())
+	default:
+		WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+	}
+}
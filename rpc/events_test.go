@@ -0,0 +1,34 @@
+package rpc
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/vipernet-xyz/viper-network/app"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRPC_EventFilter(t *testing.T) {
+	r := httptest.NewRequest("GET", "/v1/events/sse?type=jail,%20slash&type=pause&address=abcd&chain=0001&geozone=", nil)
+	assert.Equal(t, app.EventFilter{
+		Types:     []string{"jail", "slash", "pause"},
+		Addresses: []string{"abcd"},
+		Chains:    []string{"0001"},
+	}, eventFilter(r))
+}
+
+func TestRPC_WithEventStreams(t *testing.T) {
+	named := func(name string) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(name))
+		})
+	}
+	handler := withEventStreams(named("rpc"), named("events"))
+	for path, want := range map[string]string{"/v1/events/ws": "events", "/v1/events/sse": "events", "/v1/query/height": "rpc"} {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequest("GET", path, nil))
+		assert.Equal(t, want, rec.Body.String(), path)
+	}
+}
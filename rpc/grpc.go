@@ -0,0 +1,55 @@
+package rpc
+
+import (
+	"context"
+	"log"
+	"net"
+
+	"github.com/vipernet-xyz/viper-network/app"
+	authTypes "github.com/vipernet-xyz/viper-network/x/authentication/types"
+	governanceTypes "github.com/vipernet-xyz/viper-network/x/governance/types"
+	requestorsTypes "github.com/vipernet-xyz/viper-network/x/requestors/types"
+	servicersTypes "github.com/vipernet-xyz/viper-network/x/servicers/types"
+	viperTypes "github.com/vipernet-xyz/viper-network/x/viper-main/types"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// StartGRPC serves the grpc query and tx services of the app on the port
+func StartGRPC(port string) {
+	listener, err := net.Listen("tcp", ":"+port)
+	if err != nil {
+		log.Fatal(err)
+	}
+	log.Fatal(app.VCA.NewGRPCServer().Serve(listener))
+}
+
+// GatewayRoutes returns the routes of the grpc-gateway, mapping the REST requests under /viper to the grpc services
+// served on the port. The responses are encoded with the json tags of the messages, like the ones of the rpc
+func GatewayRoutes(grpcPort string) Routes {
+	conn, err := grpc.Dial("127.0.0.1:"+grpcPort,
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(app.GRPCCodec())))
+	if err != nil {
+		log.Fatal(err)
+	}
+	mux := runtime.NewServeMux(runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONBuiltin{}))
+	for _, register := range []func(context.Context, *runtime.ServeMux, *grpc.ClientConn) error{
+		servicersTypes.RegisterQueryHandler,
+		requestorsTypes.RegisterQueryHandler,
+		viperTypes.RegisterQueryHandler,
+		governanceTypes.RegisterQueryHandler,
+		authTypes.RegisterQueryHandler,
+		authTypes.RegisterTxHandler,
+	} {
+		if err := register(context.Background(), mux, conn); err != nil {
+			log.Fatal(err)
+		}
+	}
+	return Routes{
+		Route{Name: "GatewayQuery", Method: "GET", Path: "/viper/*grpc", HandlerFunc: wrapperHandler(mux)},
+		Route{Name: "GatewayTx", Method: "POST", Path: "/viper/*grpc", HandlerFunc: wrapperHandler(mux)},
+	}
+}
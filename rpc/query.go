@@ -9,6 +9,7 @@ import (
 	"strconv"
 
 	types4 "github.com/vipernet-xyz/viper-network/rpc/types"
+	snapshottypes "github.com/vipernet-xyz/viper-network/store/snapshots/types"
 	sdk "github.com/vipernet-xyz/viper-network/types"
 	types2 "github.com/vipernet-xyz/viper-network/x/authentication/types"
 	types3 "github.com/vipernet-xyz/viper-network/x/viper-main/types"
@@ -100,6 +101,16 @@ type PaginatedHeightParams struct {
 	Sort    string `json:"order,omitempty"`
 }
 
+type HeightAndProposalParams struct {
+	Height     int64  `json:"height"`
+	ProposalID uint64 `json:"proposal_id"`
+}
+
+type HeightAndProposalStatusParams struct {
+	Height int64  `json:"height"`
+	Status string `json:"status,omitempty"`
+}
+
 type PaginatedHeightAndAddrParams struct {
 	Height  int64  `json:"height"`
 	Addr    string `json:"address"`
@@ -182,6 +193,8 @@ type rPCStdTx struct {
 	Signature RPCStdSignature `json:"signature" yaml:"signature"`
 	Memo      string          `json:"memo" yaml:"memo"`
 	Entropy   int64           `json:"entropy" yaml:"entropy"`
+	// the messages following msg in a multi message transaction
+	AdditionalMsgs []json.RawMessage `json:"additional_msgs,omitempty" yaml:"additional_msgs"`
 }
 
 type RPCStdSignature struct {
@@ -194,16 +207,21 @@ func (r RPCStdTx) MarshalJSON() ([]byte, error) {
 		return json.Marshal(rPCStdTx{})
 	}
 	msgBz := (types2.StdTx)(r).Msg.GetSignBytes()
+	var additionalMsgs []json.RawMessage
+	for _, msg := range r.AdditionalMsgs {
+		additionalMsgs = append(additionalMsgs, msg.GetSignBytes())
+	}
 	sig := RPCStdSignature{
 		PublicKey: r.Signature.RawString(),
 		Signature: hex.EncodeToString(r.Signature.Signature),
 	}
 	return json.Marshal(rPCStdTx{
-		Msg:       msgBz,
-		Fee:       r.Fee,
-		Signature: sig,
-		Memo:      r.Memo,
-		Entropy:   r.Entropy,
+		Msg:            msgBz,
+		Fee:            r.Fee,
+		Signature:      sig,
+		Memo:           r.Memo,
+		Entropy:        r.Entropy,
+		AdditionalMsgs: additionalMsgs,
 	})
 }
 
@@ -392,6 +410,122 @@ func Account(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 		WriteErrorResponse(w, 400, err.Error())
 		return
 	}
+	balances, err := app.VCA.QueryVestingBalances(params.Address, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if balances != nil {
+		s, err = withVestingBalances(s, balances)
+		if err != nil {
+			WriteErrorResponse(w, 400, err.Error())
+			return
+		}
+	}
+	WriteJSONResponse(w, string(s), r.URL.Path, r.Host)
+}
+
+// withVestingBalances adds the vested, vesting and spendable coins to the json of a vesting account
+func withVestingBalances(accountJSON []byte, balances *app.VestingBalances) ([]byte, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(accountJSON, &fields); err != nil {
+		return nil, err
+	}
+	balancesJSON, err := json.Marshal(balances)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(balancesJSON, &fields); err != nil {
+		return nil, err
+	}
+	return json.Marshal(fields)
+}
+
+type queryAccountSequenceResponse struct {
+	Sequence uint64 `json:"sequence"`
+}
+
+// AccountSequence returns the sequence the next transaction signed by the account must carry
+func AccountSequence(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightAndAddrParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.VCA.BaseApp.LastBlockHeight()
+	}
+	sequence, err := app.VCA.QueryAccountSequence(params.Address, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	s, err := json.Marshal(&queryAccountSequenceResponse{Sequence: sequence})
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(s), r.URL.Path, r.Host)
+}
+
+type QueryFeeAllowancesParams struct {
+	Height  int64  `json:"height"`
+	Grantee string `json:"grantee"`
+	Granter string `json:"granter,omitempty"` // only the allowance of the granter if not empty
+}
+
+// FeeAllowances returns the fee allowances granted to an account
+func FeeAllowances(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = QueryFeeAllowancesParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.VCA.BaseApp.LastBlockHeight()
+	}
+	allowances, err := app.VCA.QueryFeeAllowances(params.Grantee, params.Granter, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if allowances == nil {
+		allowances = []types2.FeeAllowance{}
+	}
+	s, err := json.Marshal(allowances)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(s), r.URL.Path, r.Host)
+}
+
+type QueryAuthorizationsParams struct {
+	Height  int64  `json:"height"`
+	Grantee string `json:"grantee"`
+	Granter string `json:"granter,omitempty"` // only the authorizations of the granter if not empty
+}
+
+// Authorizations returns the authorizations granted to an account
+func Authorizations(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = QueryAuthorizationsParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.VCA.BaseApp.LastBlockHeight()
+	}
+	authorizations, err := app.VCA.QueryAuthorizations(params.Grantee, params.Granter, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	s, err := json.Marshal(authorizations)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
 	WriteJSONResponse(w, string(s), r.URL.Path, r.Host)
 }
 
@@ -471,6 +605,72 @@ func Node(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
 }
 
+func NodeUnbondingStakes(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightAndAddrParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.VCA.BaseApp.LastBlockHeight()
+	}
+	res, err := app.VCA.QueryServicerUnbondingStakes(params.Address, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteRaw(w, string(j), r.URL.Path, r.Host)
+}
+
+func NodeDelegations(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightAndAddrParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.VCA.BaseApp.LastBlockHeight()
+	}
+	res, err := app.VCA.QueryServicerDelegations(params.Address, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteRaw(w, string(j), r.URL.Path, r.Host)
+}
+
+func DelegatorDelegations(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightAndAddrParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.VCA.BaseApp.LastBlockHeight()
+	}
+	res, err := app.VCA.QueryDelegatorDelegations(params.Address, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteRaw(w, string(j), r.URL.Path, r.Host)
+}
+
 func SigningInfo(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	var params = PaginatedHeightAndAddrParams{Height: 0}
 	if err := PopModel(w, r, ps, &params); err != nil {
@@ -512,6 +712,75 @@ func Chains(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
 	}
 }
 
+// Snapshots lists the state-sync snapshots of the node, newest first
+func Snapshots(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	res := app.VCA.ListSnapshots(snapshottypes.RequestListSnapshots{})
+	j, err := json.Marshal(res.Snapshots)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+// SnapshotChunk serves a chunk of a state-sync snapshot of the node
+func SnapshotChunk(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params snapshottypes.RequestLoadSnapshotChunk
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	res := app.VCA.LoadSnapshotChunk(params)
+	if len(res.Chunk) == 0 {
+		WriteErrorResponse(w, 404, fmt.Sprintf("chunk %d of the snapshot at height %d in format %d not found", params.Chunk, params.Height, params.Format))
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func Sampling(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	value := r.URL.Query().Get("authtoken")
+	if value == app.AuthToken.Value {
+		res, err := app.VCA.QuerySamplingSessions()
+		if err != nil {
+			WriteErrorResponse(w, 400, err.Error())
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			WriteErrorResponse(w, 400, err.Error())
+			return
+		}
+		WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+	} else {
+		WriteErrorResponse(w, 401, "wrong authtoken "+value)
+	}
+}
+
+func Submissions(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	value := r.URL.Query().Get("authtoken")
+	if value == app.AuthToken.Value {
+		res, err := app.VCA.QuerySubmissions()
+		if err != nil {
+			WriteErrorResponse(w, 400, err.Error())
+			return
+		}
+		j, err := json.Marshal(res)
+		if err != nil {
+			WriteErrorResponse(w, 400, err.Error())
+			return
+		}
+		WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
+	} else {
+		WriteErrorResponse(w, 401, "wrong authtoken "+value)
+	}
+}
+
 func GeoZone(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
 	value := r.URL.Query().Get("authtoken")
 	if value == app.AuthToken.Value {
@@ -709,6 +978,28 @@ func App(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	WriteJSONResponse(w, string(j), r.URL.Path, r.Host)
 }
 
+func AppUnbondingStakes(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightAndAddrParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.VCA.BaseApp.LastBlockHeight()
+	}
+	res, err := app.VCA.QueryRequestorUnbondingStakes(params.Address, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := json.Marshal(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteRaw(w, string(j), r.URL.Path, r.Host)
+}
+
 func RequestorParams(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	var params = HeightParams{Height: 0}
 	if err := PopModel(w, r, ps, &params); err != nil {
@@ -891,6 +1182,94 @@ func ACL(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	WriteResponse(w, string(j), r.URL.Path, r.Host)
 }
 
+func Proposal(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightAndProposalParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.VCA.BaseApp.LastBlockHeight()
+	}
+	res, err := app.VCA.QueryProposal(params.ProposalID, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := app.Codec().MarshalJSON(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func Proposals(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightAndProposalStatusParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.VCA.BaseApp.LastBlockHeight()
+	}
+	res, err := app.VCA.QueryProposals(params.Status, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := app.Codec().MarshalJSON(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func Votes(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightAndProposalParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.VCA.BaseApp.LastBlockHeight()
+	}
+	res, err := app.VCA.QueryVotes(params.ProposalID, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := app.Codec().MarshalJSON(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteResponse(w, string(j), r.URL.Path, r.Host)
+}
+
+func Tally(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
+	var params = HeightAndProposalParams{Height: 0}
+	if err := PopModel(w, r, ps, &params); err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	if params.Height == 0 {
+		params.Height = app.VCA.BaseApp.LastBlockHeight()
+	}
+	res, err := app.VCA.QueryTally(params.ProposalID, params.Height)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	j, err := app.Codec().MarshalJSON(res)
+	if err != nil {
+		WriteErrorResponse(w, 400, err.Error())
+		return
+	}
+	WriteResponse(w, string(j), r.URL.Path, r.Host)
+}
+
 func AllParams(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
 	var params = HeightParams{Height: 0}
 	if err := PopModel(w, r, ps, &params); err != nil {
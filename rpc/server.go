@@ -55,12 +55,21 @@ func StartRPC(port string, timeout int64, simulation, debug, allBlockTxs, hotRel
 		routes = append(routes, Route{Name: "UpdateGeoZones", Method: "POST", Path: "/v1/private/updategeozones", HandlerFunc: UpdateGeoZones})
 	}
 
+	if grpcPort := app.GlobalConfig.ViperConfig.GRPCPort; grpcPort != "" {
+		routes = append(routes, GatewayRoutes(grpcPort)...)
+	}
+
+	var handler http.Handler = http.TimeoutHandler(Router(routes), time.Duration(timeout)*time.Millisecond, "Server Timeout Handling Request")
+	if app.GlobalConfig.ViperConfig.RPCMaxEventSubscriptions > 0 {
+		handler = withEventStreams(handler, Router(EventRoutes()))
+	}
+
 	srv := &http.Server{
 		ReadTimeout:       30 * time.Second,
 		ReadHeaderTimeout: 20 * time.Second,
 		WriteTimeout:      60 * time.Second,
 		Addr:              ":" + port,
-		Handler:           http.TimeoutHandler(Router(routes), time.Duration(timeout)*time.Millisecond, "Server Timeout Handling Request"),
+		Handler:           handler,
 	}
 	log.Fatal(srv.ListenAndServe())
 }
@@ -102,16 +111,20 @@ func GetRoutes() Routes {
 		Route{Name: "ServiceCORS", Method: "OPTIONS", Path: "/v1/client/relay", HandlerFunc: Relay},
 		Route{Name: "Trigger", Method: "POST", Path: "/v1/client/trigger", HandlerFunc: FishermanTrigger},
 		Route{Name: "TriggerSCORS", Method: "OPTIONS", Path: "/v1/client/trigger", HandlerFunc: FishermanTrigger},
-		Route{Name: "WebSocket", Method: "POST", Path: "/v1/client/websocket", HandlerFunc: RelayWebsocket},
+		Route{Name: "WebSocket", Method: "GET", Path: "/v1/client/websocket", HandlerFunc: RelayWebsocket},
 		Route{Name: "WebSocketSCORS", Method: "OPTIONS", Path: "/v1/client/websocket", HandlerFunc: RelayWebsocket},
 		Route{Name: "QueryAccount", Method: "POST", Path: "/v1/query/account", HandlerFunc: Account},
 		Route{Name: "QueryAccounts", Method: "POST", Path: "/v1/query/accounts", HandlerFunc: Accounts},
 		Route{Name: "QueryAccountTxs", Method: "POST", Path: "/v1/query/accounttxs", HandlerFunc: AccountTxs},
+		Route{Name: "QueryAccountSequence", Method: "POST", Path: "/v1/query/accountsequence", HandlerFunc: AccountSequence},
+		Route{Name: "QueryFeeAllowances", Method: "POST", Path: "/v1/query/feeallowances", HandlerFunc: FeeAllowances},
+		Route{Name: "QueryAuthorizations", Method: "POST", Path: "/v1/query/authorizations", HandlerFunc: Authorizations},
 		Route{Name: "QueryACL", Method: "POST", Path: "/v1/query/acl", HandlerFunc: ACL},
 		Route{Name: "QueryAllParams", Method: "POST", Path: "/v1/query/allparams", HandlerFunc: AllParams},
 		Route{Name: "QueryRequestor", Method: "POST", Path: "/v1/query/app", HandlerFunc: App},
 		Route{Name: "QueryRequestorParams", Method: "POST", Path: "/v1/query/requestorparams", HandlerFunc: RequestorParams},
 		Route{Name: "QueryRequestors", Method: "POST", Path: "/v1/query/requestors", HandlerFunc: Requestors},
+		Route{Name: "QueryRequestorUnbondingStakes", Method: "POST", Path: "/v1/query/requestorunbonding", HandlerFunc: AppUnbondingStakes},
 		Route{Name: "QueryBalance", Method: "POST", Path: "/v1/query/balance", HandlerFunc: Balance},
 		Route{Name: "QueryBlock", Method: "POST", Path: "/v1/query/block", HandlerFunc: Block},
 		Route{Name: "QueryBlockTxs", Method: "POST", Path: "/v1/query/blocktxs", HandlerFunc: BlockTxs},
@@ -122,7 +135,14 @@ func GetRoutes() Routes {
 		Route{Name: "QueryNodeClaims", Method: "POST", Path: "/v1/query/servicerclaims", HandlerFunc: NodeClaims},
 		Route{Name: "QueryNodeParams", Method: "POST", Path: "/v1/query/servicerparams", HandlerFunc: NodeParams},
 		Route{Name: "QueryServicers", Method: "POST", Path: "/v1/query/servicers", HandlerFunc: Servicers},
+		Route{Name: "QueryNodeUnbondingStakes", Method: "POST", Path: "/v1/query/servicerunbonding", HandlerFunc: NodeUnbondingStakes},
+		Route{Name: "QueryNodeDelegations", Method: "POST", Path: "/v1/query/servicerdelegations", HandlerFunc: NodeDelegations},
+		Route{Name: "QueryDelegatorDelegations", Method: "POST", Path: "/v1/query/delegatordelegations", HandlerFunc: DelegatorDelegations},
 		Route{Name: "QueryParam", Method: "POST", Path: "/v1/query/param", HandlerFunc: Param},
+		Route{Name: "QueryProposal", Method: "POST", Path: "/v1/query/proposal", HandlerFunc: Proposal},
+		Route{Name: "QueryProposals", Method: "POST", Path: "/v1/query/proposals", HandlerFunc: Proposals},
+		Route{Name: "QueryProposalVotes", Method: "POST", Path: "/v1/query/votes", HandlerFunc: Votes},
+		Route{Name: "QueryProposalTally", Method: "POST", Path: "/v1/query/tally", HandlerFunc: Tally},
 		Route{Name: "QueryViperParams", Method: "POST", Path: "/v1/query/viperparams", HandlerFunc: ViperParams},
 		Route{Name: "QueryState", Method: "POST", Path: "/v1/query/state", HandlerFunc: State},
 		Route{Name: "QuerySupply", Method: "POST", Path: "/v1/query/supply", HandlerFunc: Supply},
@@ -130,8 +150,12 @@ func GetRoutes() Routes {
 		Route{Name: "QueryTX", Method: "POST", Path: "/v1/query/tx", HandlerFunc: Tx},
 		Route{Name: "QueryUpgrade", Method: "POST", Path: "/v1/query/upgrade", HandlerFunc: Upgrade},
 		Route{Name: "QuerySigningInfo", Method: "POST", Path: "/v1/query/signinginfo", HandlerFunc: SigningInfo},
+		Route{Name: "QuerySnapshots", Method: "POST", Path: "/v1/query/snapshots", HandlerFunc: Snapshots},
+		Route{Name: "QuerySnapshotChunk", Method: "POST", Path: "/v1/query/snapshotchunk", HandlerFunc: SnapshotChunk},
 		Route{Name: "QueryChains", Method: "POST", Path: "/v1/private/chains", HandlerFunc: Chains},
 		Route{Name: "QueryGeoZone", Method: "POST", Path: "/v1/private/geozones", HandlerFunc: GeoZone},
+		Route{Name: "QuerySampling", Method: "POST", Path: "/v1/private/sampling", HandlerFunc: Sampling},
+		Route{Name: "QuerySubmissions", Method: "POST", Path: "/v1/private/submissions", HandlerFunc: Submissions},
 	}
 	return routes
 }
@@ -0,0 +1,45 @@
+package iavl
+
+import (
+	"github.com/pkg/errors"
+)
+
+// ExportNode contains exported node data. Nodes are exported in post-order (children before
+// their parent), which allows an importer to rebuild the tree bottom-up with the same hashes.
+type ExportNode struct {
+	Key     []byte
+	Value   []byte
+	Version int64
+	Height  int8
+}
+
+// Export walks the tree in post-order, passing every node to fn. It stops at the first error
+// returned by fn.
+func (t *ImmutableTree) Export(fn func(node *ExportNode) error) error {
+	if t.root == nil {
+		return nil
+	}
+	var err error
+	t.root.traversePost(t, true, func(node *Node) bool {
+		err = fn(&ExportNode{
+			Key:     node.key,
+			Value:   node.value,
+			Version: node.version,
+			Height:  node.height,
+		})
+		return err != nil
+	})
+	return err
+}
+
+// Export exports the tree at the given version. The root is read from the database, so the
+// export can run while new versions are saved; the version is protected from deletion meanwhile.
+func (tree *MutableTree) Export(version int64, fn func(node *ExportNode) error) error {
+	iTree, err := tree.GetImmutable(version)
+	if err != nil {
+		return errors.Wrapf(err, "version %d", version)
+	}
+	tree.ndb.incrVersionReaders(version)
+	defer tree.ndb.decrVersionReaders(version)
+	return iTree.Export(fn)
+}
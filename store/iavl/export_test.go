@@ -0,0 +1,87 @@
+package iavl
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestExportImport(t *testing.T) {
+	tree, err := NewMutableTree(dbm.NewMemDB(), cacheSize)
+	require.NoError(t, err)
+	for v := 1; v <= 3; v++ {
+		for i := 0; i < 20; i++ {
+			tree.Set([]byte(fmt.Sprintf("k%02d", i*v%20)), []byte(fmt.Sprintf("v%d", v)))
+		}
+		tree.Remove([]byte(fmt.Sprintf("k%02d", v)))
+		_, _, err = tree.SaveVersion()
+		require.NoError(t, err)
+	}
+	source, err := tree.GetImmutable(2)
+	require.NoError(t, err)
+
+	var nodes []*ExportNode
+	require.NoError(t, tree.Export(2, func(node *ExportNode) error {
+		nodes = append(nodes, node)
+		return nil
+	}))
+	imported, err := NewMutableTree(dbm.NewMemDB(), cacheSize)
+	require.NoError(t, err)
+	importer, err := imported.Import(2)
+	require.NoError(t, err)
+	for _, node := range nodes {
+		require.NoError(t, importer.Add(node))
+	}
+	require.NoError(t, importer.Commit())
+	require.Equal(t, int64(2), imported.Version())
+	require.Equal(t, source.Hash(), imported.Hash())
+	source.Iterate(func(key, value []byte) bool {
+		_, v := imported.Get(key)
+		require.Equal(t, value, v)
+		return false
+	})
+	// a tree with versions can't be imported into
+	_, err = imported.Import(3)
+	require.Error(t, err)
+	// missing version
+	require.Error(t, tree.Export(4, func(*ExportNode) error { return nil }))
+}
+
+func TestImportInvalidNodes(t *testing.T) {
+	leaf := func(key string, version int64) *ExportNode {
+		return &ExportNode{Key: []byte(key), Value: []byte("v"), Version: version}
+	}
+	tests := []struct {
+		name  string
+		nodes []*ExportNode
+	}{
+		{"orphan inner node", []*ExportNode{leaf("a", 1), {Key: []byte("b"), Version: 1, Height: 1}}},
+		{"future version", []*ExportNode{leaf("a", 3)}},
+		{"unordered children", []*ExportNode{leaf("b", 1), leaf("a", 1), {Key: []byte("a"), Version: 1, Height: 1}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tree, err := NewMutableTree(dbm.NewMemDB(), cacheSize)
+			require.NoError(t, err)
+			importer, err := tree.Import(2)
+			require.NoError(t, err)
+			defer importer.Close()
+			for _, node := range tt.nodes {
+				if err = importer.Add(node); err != nil {
+					break
+				}
+			}
+			require.Error(t, err)
+		})
+	}
+	// dangling nodes are rejected on commit
+	tree, err := NewMutableTree(dbm.NewMemDB(), cacheSize)
+	require.NoError(t, err)
+	importer, err := tree.Import(1)
+	require.NoError(t, err)
+	require.NoError(t, importer.Add(leaf("a", 1)))
+	require.NoError(t, importer.Add(leaf("b", 1)))
+	require.Error(t, importer.Commit())
+}
@@ -0,0 +1,122 @@
+package iavl
+
+import (
+	"bytes"
+
+	"github.com/pkg/errors"
+)
+
+// importBatchSize is the number of nodes buffered before they are flushed to the database.
+const importBatchSize = 10000
+
+// Importer rebuilds a tree version from the nodes of an export. Nodes must be added in the
+// order they were exported, and the import only takes effect once Commit is called.
+type Importer struct {
+	tree    *MutableTree
+	version int64
+	stack   []*Node
+	pending int
+}
+
+// Import returns an importer for the given version. The tree must be empty, i.e. it must not
+// have any saved versions.
+func (tree *MutableTree) Import(version int64) (*Importer, error) {
+	if version <= 0 {
+		return nil, errors.New("imported version must be greater than 0")
+	}
+	if tree.ndb.getLatestVersion() > 0 {
+		return nil, errors.Errorf("found database at version %d, must be 0", tree.ndb.getLatestVersion())
+	}
+	return &Importer{tree: tree, version: version}, nil
+}
+
+// Add adds an exported node to the import. Leaf nodes are pushed on a stack until their parent
+// arrives, which then pops its two children and computes its hash from theirs.
+func (i *Importer) Add(exportNode *ExportNode) error {
+	if i.tree == nil {
+		return errors.New("import has been closed")
+	}
+	if exportNode == nil {
+		return errors.New("node cannot be nil")
+	}
+	if exportNode.Version > i.version {
+		return errors.Errorf("node version %d can't be greater than import version %d", exportNode.Version, i.version)
+	}
+	node := &Node{
+		key:     exportNode.Key,
+		value:   exportNode.Value,
+		version: exportNode.Version,
+		height:  exportNode.Height,
+		size:    1,
+	}
+	if node.height > 0 {
+		if len(i.stack) < 2 {
+			return errors.Errorf("inner node at height %d is missing its children", node.height)
+		}
+		left, right := i.stack[len(i.stack)-2], i.stack[len(i.stack)-1]
+		if left.height >= node.height || right.height >= node.height {
+			return errors.Errorf("inner node at height %d can't have children at height %d and %d", node.height, left.height, right.height)
+		}
+		if bytes.Compare(left.key, node.key) >= 0 || bytes.Compare(node.key, right.key) > 0 {
+			return errors.New("inner node key is out of the range of its children")
+		}
+		node.leftHash = left.hash
+		node.rightHash = right.hash
+		node.size = left.size + right.size
+		i.stack = i.stack[:len(i.stack)-2]
+	}
+	if err := node.validate(); err != nil {
+		return err
+	}
+	node._hash()
+	var buf bytes.Buffer
+	buf.Grow(node.aminoSize())
+	if err := node.writeBytes(&buf); err != nil {
+		return err
+	}
+	i.tree.ndb.batch.Set(i.tree.ndb.nodeKey(node.hash), buf.Bytes())
+	i.pending++
+	if i.pending >= importBatchSize {
+		if err := i.tree.ndb.Commit(); err != nil {
+			return err
+		}
+		i.pending = 0
+	}
+	// only the fields needed by the parent are kept on the stack
+	i.stack = append(i.stack, &Node{key: node.key, hash: node.hash, height: node.height, size: node.size})
+	return nil
+}
+
+// Commit saves the root of the imported version and loads it in the tree.
+func (i *Importer) Commit() error {
+	if i.tree == nil {
+		return errors.New("import has been closed")
+	}
+	ndb := i.tree.ndb
+	switch len(i.stack) {
+	case 0:
+		ndb.batch.Set(ndb.rootKey(i.version), []byte{})
+	case 1:
+		ndb.batch.Set(ndb.rootKey(i.version), i.stack[0].hash)
+	default:
+		return errors.Errorf("invalid node structure, found %d dangling nodes", len(i.stack))
+	}
+	if err := ndb.Commit(); err != nil {
+		return err
+	}
+	ndb.updateLatestVersion(i.version)
+	if _, err := i.tree.LoadVersion(i.version); err != nil {
+		return err
+	}
+	i.Close()
+	return nil
+}
+
+// Close discards the nodes of the import that were not flushed yet.
+func (i *Importer) Close() {
+	if i.tree != nil {
+		i.tree.ndb.batch.Close()
+		i.tree.ndb.batch = i.tree.ndb.db.NewBatch()
+	}
+	i.tree = nil
+}
@@ -129,6 +129,24 @@ func (st *Store) Rollback(version int64) error {
 	return nil
 }
 
+// Export exports the nodes of the tree at the given version, see MutableTree.Export
+func (st *Store) Export(version int64, fn func(node *ExportNode) error) error {
+	tree, ok := st.tree.(*MutableTree)
+	if !ok {
+		return fmt.Errorf("cant export an immutable tree")
+	}
+	return tree.Export(version, fn)
+}
+
+// Import returns an importer for the given version, the store must be empty
+func (st *Store) Import(version int64) (*Importer, error) {
+	tree, ok := st.tree.(*MutableTree)
+	if !ok {
+		return nil, fmt.Errorf("cant import into an immutable tree")
+	}
+	return tree.Import(version)
+}
+
 // Implements Committer.
 func (st *Store) Commit() types.CommitID {
 	// Save a new version.
@@ -0,0 +1,244 @@
+package rootmulti
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/vipernet-xyz/viper-network/store/iavl"
+	"github.com/vipernet-xyz/viper-network/store/types"
+)
+
+// SnapshotFormat is the format of the state-sync snapshots of the multistore: a stream of
+// store items, each followed by the IAVL nodes of that store in post-order
+const SnapshotFormat uint32 = 1
+
+const (
+	snapshotItemStore byte = iota + 1
+	snapshotItemIAVL
+)
+
+// maximum size of a key or value in a snapshot stream, bounds the allocations of a restore
+const maxSnapshotItemSize = 64 << 20
+
+// SnapshotFormat implements snapshottypes.Snapshotter
+func (rs *Store) SnapshotFormat() uint32 {
+	return SnapshotFormat
+}
+
+// Snapshot writes the IAVL stores at the given height to w. It only reads committed versions,
+// so it can run concurrently with new commits.
+func (rs *Store) Snapshot(height uint64, format uint32, w io.Writer) error {
+	if format != SnapshotFormat {
+		return fmt.Errorf("unsupported snapshot format %d", format)
+	}
+	if height == 0 || height > uint64(rs.LastCommitID().Version) {
+		return fmt.Errorf("cannot snapshot height %d, the latest height is %d", height, rs.LastCommitID().Version)
+	}
+	stores, err := rs.snapshotStores()
+	if err != nil {
+		return err
+	}
+	bw := bufio.NewWriter(w)
+	for _, s := range stores {
+		if err := writeSnapshotItem(bw, snapshotItemStore, []byte(s.name)); err != nil {
+			return err
+		}
+		err := s.store.Export(int64(height), func(node *iavl.ExportNode) error {
+			return writeSnapshotNode(bw, node)
+		})
+		if err != nil {
+			return fmt.Errorf("failed to export store %s: %v", s.name, err)
+		}
+	}
+	return bw.Flush()
+}
+
+// Restore imports the IAVL stores of a snapshot at the given height and loads that version. The
+// stores must be empty.
+func (rs *Store) Restore(height uint64, format uint32, r io.Reader) error {
+	if format != SnapshotFormat {
+		return fmt.Errorf("unsupported snapshot format %d", format)
+	}
+	if height == 0 {
+		return fmt.Errorf("cannot restore snapshot at height 0")
+	}
+	br := bufio.NewReader(r)
+	var importer *iavl.Importer
+	defer func() {
+		if importer != nil {
+			importer.Close()
+		}
+	}()
+	for {
+		item, err := br.ReadByte()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		switch item {
+		case snapshotItemStore:
+			name, err := readSnapshotBytes(br)
+			if err != nil {
+				return err
+			}
+			if importer != nil {
+				if err := importer.Commit(); err != nil {
+					return err
+				}
+			}
+			store, ok := rs.getStoreByName(string(name)).(*iavl.Store)
+			if !ok || store == nil {
+				return fmt.Errorf("cannot restore unknown store %s", name)
+			}
+			importer, err = store.Import(int64(height))
+			if err != nil {
+				return fmt.Errorf("failed to import store %s: %v", name, err)
+			}
+		case snapshotItemIAVL:
+			if importer == nil {
+				return fmt.Errorf("received IAVL node before any store")
+			}
+			node, err := readSnapshotNode(br)
+			if err != nil {
+				return err
+			}
+			if err := importer.Add(node); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("unknown snapshot item %d", item)
+		}
+	}
+	if importer != nil {
+		if err := importer.Commit(); err != nil {
+			return err
+		}
+		importer = nil
+	}
+	return rs.flushRestoredVersion(int64(height))
+}
+
+// flushRestoredVersion writes the commit info of the restored stores and reloads them
+func (rs *Store) flushRestoredVersion(version int64) error {
+	storeInfos := make([]StoreInfo, 0, len(rs.stores))
+	for key, store := range rs.stores {
+		if store.GetStoreType() == types.StoreTypeTransient || store.GetStoreType() == types.StoreTypeMemory {
+			continue
+		}
+		si := StoreInfo{}
+		si.Name = key.Name()
+		si.Core.CommitID = store.LastCommitID()
+		storeInfos = append(storeInfos, si)
+	}
+	batch := rs.DB.NewBatch()
+	defer batch.Close()
+	setCommitInfo(batch, version, CommitInfo{Version: version, StoreInfos: storeInfos})
+	setLatestVersion(batch, version)
+	if err := batch.WriteSync(); err != nil {
+		return err
+	}
+	return rs.LoadVersion(version)
+}
+
+type namedIAVLStore struct {
+	name  string
+	store *iavl.Store
+}
+
+// snapshotStores returns the IAVL stores sorted by name, the other committed store types can't
+// be snapshotted
+func (rs *Store) snapshotStores() ([]namedIAVLStore, error) {
+	stores := make([]namedIAVLStore, 0, len(rs.stores))
+	for key, store := range rs.stores {
+		switch store.GetStoreType() {
+		case types.StoreTypeTransient, types.StoreTypeMemory:
+			continue
+		case types.StoreTypeIAVL:
+			stores = append(stores, namedIAVLStore{name: key.Name(), store: store.(*iavl.Store)})
+		default:
+			return nil, fmt.Errorf("cannot snapshot store %s of type %v", key.Name(), store.GetStoreType())
+		}
+	}
+	sort.Slice(stores, func(i, j int) bool { return stores[i].name < stores[j].name })
+	return stores, nil
+}
+
+func writeSnapshotItem(w *bufio.Writer, item byte, bz []byte) error {
+	if err := w.WriteByte(item); err != nil {
+		return err
+	}
+	return writeSnapshotBytes(w, bz)
+}
+
+// leaves always carry a value, which may be empty, inner nodes never do
+func writeSnapshotNode(w *bufio.Writer, node *iavl.ExportNode) error {
+	if err := writeSnapshotItem(w, snapshotItemIAVL, node.Key); err != nil {
+		return err
+	}
+	if err := writeSnapshotUvarint(w, uint64(node.Version)); err != nil {
+		return err
+	}
+	if err := w.WriteByte(byte(node.Height)); err != nil {
+		return err
+	}
+	if node.Height == 0 {
+		return writeSnapshotBytes(w, node.Value)
+	}
+	return nil
+}
+
+func writeSnapshotUvarint(w *bufio.Writer, n uint64) error {
+	buf := make([]byte, binary.MaxVarintLen64)
+	_, err := w.Write(buf[:binary.PutUvarint(buf, n)])
+	return err
+}
+
+func writeSnapshotBytes(w *bufio.Writer, bz []byte) error {
+	if err := writeSnapshotUvarint(w, uint64(len(bz))); err != nil {
+		return err
+	}
+	_, err := w.Write(bz)
+	return err
+}
+
+func readSnapshotNode(r *bufio.Reader) (*iavl.ExportNode, error) {
+	key, err := readSnapshotBytes(r)
+	if err != nil {
+		return nil, err
+	}
+	version, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	height, err := r.ReadByte()
+	if err != nil {
+		return nil, err
+	}
+	node := &iavl.ExportNode{Key: key, Version: int64(version), Height: int8(height)}
+	if node.Height == 0 {
+		if node.Value, err = readSnapshotBytes(r); err != nil {
+			return nil, err
+		}
+	}
+	return node, nil
+}
+
+func readSnapshotBytes(r *bufio.Reader) ([]byte, error) {
+	n, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if n > maxSnapshotItemSize {
+		return nil, fmt.Errorf("snapshot item of %d bytes exceeds the limit of %d", n, maxSnapshotItemSize)
+	}
+	bz := make([]byte, n)
+	if _, err := io.ReadFull(r, bz); err != nil {
+		return nil, err
+	}
+	return bz, nil
+}
@@ -0,0 +1,74 @@
+package rootmulti
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/vipernet-xyz/viper-network/store/types"
+)
+
+func newSnapshotTestStore(t *testing.T) *Store {
+	ms := newMultiStoreWithMounts(dbm.NewMemDB())
+	require.Nil(t, ms.LoadLatestVersion())
+	return ms
+}
+
+func writeSnapshotTestVersion(ms *Store, version int) types.CommitID {
+	store1 := ms.getStoreByName("store1").(types.KVStore)
+	store2 := ms.getStoreByName("store2").(types.KVStore)
+	for i := 0; i < 50; i++ {
+		_ = store1.Set([]byte(fmt.Sprintf("key%03d", i)), []byte(fmt.Sprintf("value%d-%d", version, i)))
+	}
+	_ = store1.Delete([]byte(fmt.Sprintf("key%03d", version)))
+	_ = store2.Set([]byte(fmt.Sprintf("version%d", version)), []byte{})
+	return ms.Commit()
+}
+
+func TestMultistoreSnapshotRestore(t *testing.T) {
+	source := newSnapshotTestStore(t)
+	writeSnapshotTestVersion(source, 1)
+	commitID := writeSnapshotTestVersion(source, 2)
+	writeSnapshotTestVersion(source, 3)
+
+	// snapshot a past version
+	var buf bytes.Buffer
+	require.Nil(t, source.Snapshot(uint64(commitID.Version), SnapshotFormat, &buf))
+
+	target := newSnapshotTestStore(t)
+	require.Nil(t, target.Restore(uint64(commitID.Version), SnapshotFormat, bytes.NewReader(buf.Bytes())))
+	require.Equal(t, commitID, target.LastCommitID())
+	// the restored version is persisted
+	reloaded := newMultiStoreWithMounts(target.DB)
+	require.Nil(t, reloaded.LoadLatestVersion())
+	require.Equal(t, commitID, reloaded.LastCommitID())
+	value, _ := reloaded.getStoreByName("store1").(types.KVStore).Get([]byte("key010"))
+	require.Equal(t, []byte("value2-10"), value)
+	value, _ = reloaded.getStoreByName("store2").(types.KVStore).Get([]byte("version1"))
+	require.Equal(t, []byte{}, value)
+
+	// the restored store continues the chain with the same hashes
+	sourceNext, err := getCommitInfo(source.DB, 3)
+	require.Nil(t, err)
+	require.Equal(t, sourceNext.CommitID(), writeSnapshotTestVersion(reloaded, 3))
+}
+
+func TestMultistoreSnapshotErrors(t *testing.T) {
+	source := newSnapshotTestStore(t)
+	commitID := writeSnapshotTestVersion(source, 1)
+	var buf bytes.Buffer
+	require.NotNil(t, source.Snapshot(uint64(commitID.Version), SnapshotFormat+1, &buf))
+	require.NotNil(t, source.Snapshot(uint64(commitID.Version+1), SnapshotFormat, &buf))
+	require.NotNil(t, source.Snapshot(0, SnapshotFormat, &buf))
+	require.Nil(t, source.Snapshot(uint64(commitID.Version), SnapshotFormat, &buf))
+
+	// the target must be empty
+	require.NotNil(t, source.Restore(uint64(commitID.Version), SnapshotFormat, bytes.NewReader(buf.Bytes())))
+	// truncated streams are rejected
+	target := newSnapshotTestStore(t)
+	require.NotNil(t, target.Restore(uint64(commitID.Version), SnapshotFormat, bytes.NewReader(buf.Bytes()[:buf.Len()/2])))
+	require.NotNil(t, newSnapshotTestStore(t).Restore(uint64(commitID.Version), SnapshotFormat, bytes.NewReader([]byte{0xff})))
+}
@@ -0,0 +1,240 @@
+package snapshots
+
+import (
+	"bytes"
+	"compress/zlib"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"sync"
+
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/vipernet-xyz/viper-network/store/snapshots/types"
+)
+
+// operation represents a Manager operation. Only one operation can be in progress at a time.
+type operation string
+
+const (
+	opNone     operation = ""
+	opSnapshot operation = "snapshot"
+	opPrune    operation = "prune"
+	opRestore  operation = "restore"
+)
+
+// ErrOperationInProgress is returned when an operation is started while another one runs.
+var ErrOperationInProgress = errors.New("another snapshot operation is in progress")
+
+// Manager manages the snapshot and restore operations of a snapshotter (i.e. the multistore)
+// and the snapshot store. Creating and pruning snapshots happen in the background once the
+// snapshot interval is reached; restoring is driven chunk by chunk through RestoreChunk.
+type Manager struct {
+	store  *Store
+	target types.Snapshotter
+	opts   types.SnapshotOptions
+	logger log.Logger
+
+	mtx       sync.Mutex
+	operation operation
+
+	// the state of the restore in progress
+	restoreSnapshot *types.Snapshot
+	restoreChunk    uint32
+	restoreHash     hash.Hash
+	restoreWriter   *io.PipeWriter
+	restoreDone     chan error
+}
+
+// NewManager creates a new snapshot manager.
+func NewManager(store *Store, target types.Snapshotter, opts types.SnapshotOptions, logger log.Logger) *Manager {
+	return &Manager{
+		store:  store,
+		target: target,
+		opts:   opts,
+		logger: logger,
+	}
+}
+
+// Options returns the snapshot options of the manager.
+func (m *Manager) Options() types.SnapshotOptions {
+	return m.opts
+}
+
+// begin starts an operation, or returns ErrOperationInProgress
+func (m *Manager) begin(op operation) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if m.operation != opNone {
+		return fmt.Errorf("%w: %s", ErrOperationInProgress, m.operation)
+	}
+	m.operation = op
+	return nil
+}
+
+// end ends the current operation
+func (m *Manager) end() {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.endLocked()
+}
+
+func (m *Manager) endLocked() {
+	m.operation = opNone
+	m.restoreSnapshot = nil
+	m.restoreChunk = 0
+	m.restoreHash = nil
+	m.restoreWriter = nil
+	m.restoreDone = nil
+}
+
+// Create creates a snapshot of the given height and returns its metadata.
+func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
+	if err := m.begin(opSnapshot); err != nil {
+		return nil, err
+	}
+	defer m.end()
+	format := m.target.SnapshotFormat()
+	return m.store.Save(height, format, func(w io.Writer) error {
+		return m.target.Snapshot(height, format, w)
+	})
+}
+
+// SnapshotIfApplicable creates a snapshot of the committed height in the background when it is a
+// snapshot height, then prunes the old snapshots.
+func (m *Manager) SnapshotIfApplicable(height int64) {
+	if !m.opts.IsSnapshotHeight(height) {
+		return
+	}
+	go m.snapshot(uint64(height))
+}
+
+func (m *Manager) snapshot(height uint64) {
+	m.logger.Info("creating state snapshot", "height", height)
+	snapshot, err := m.Create(height)
+	if err != nil {
+		m.logger.Error("failed to create state snapshot", "height", height, "err", err)
+		return
+	}
+	m.logger.Info("completed state snapshot", "height", height, "format", snapshot.Format, "chunks", snapshot.Chunks)
+	if m.opts.KeepRecent > 0 {
+		pruned, err := m.Prune(m.opts.KeepRecent)
+		if err != nil {
+			m.logger.Error("failed to prune state snapshots", "err", err)
+			return
+		}
+		m.logger.Debug("pruned state snapshots", "pruned", pruned)
+	}
+}
+
+// Prune prunes the old snapshots, keeping the given number of most recent ones.
+func (m *Manager) Prune(retain uint32) (uint64, error) {
+	if err := m.begin(opPrune); err != nil {
+		return 0, err
+	}
+	defer m.end()
+	return m.store.Prune(retain)
+}
+
+// List lists the snapshots, newest first.
+func (m *Manager) List() ([]*types.Snapshot, error) {
+	return m.store.List()
+}
+
+// LoadChunk loads a chunk of a snapshot, nil if it doesn't exist.
+func (m *Manager) LoadChunk(height uint64, format uint32, chunk uint32) ([]byte, error) {
+	return m.store.LoadChunk(height, format, chunk)
+}
+
+// Restore starts restoring the given snapshot. The chunks are then applied in order with
+// RestoreChunk, while the snapshotter reads them in the background.
+func (m *Manager) Restore(snapshot types.Snapshot) error {
+	if err := snapshot.Validate(); err != nil {
+		return err
+	}
+	if snapshot.Format != m.target.SnapshotFormat() {
+		return fmt.Errorf("%w: %d", types.ErrUnknownFormat, snapshot.Format)
+	}
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if m.operation != opNone {
+		return fmt.Errorf("%w: %s", ErrOperationInProgress, m.operation)
+	}
+	r, w := io.Pipe()
+	done := make(chan error, 1)
+	go func() {
+		zr, err := zlib.NewReader(r)
+		if err == nil {
+			err = m.target.Restore(snapshot.Height, snapshot.Format, zr)
+			_ = zr.Close()
+		}
+		// unblock the writer if the restore stops before the last chunk
+		_ = r.CloseWithError(err)
+		done <- err
+	}()
+	m.operation = opRestore
+	m.restoreSnapshot = &snapshot
+	m.restoreHash = sha256.New()
+	m.restoreWriter = w
+	m.restoreDone = done
+	return nil
+}
+
+// RestoreChunk applies the next chunk of the snapshot being restored. It returns true once the
+// last chunk is applied and the restore completed. A chunk that fails the hash verification is
+// rejected without aborting the restore, so it can be fetched again.
+func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if m.operation != opRestore {
+		return false, fmt.Errorf("no restore operation in progress")
+	}
+	snapshot := m.restoreSnapshot
+	if m.restoreChunk >= snapshot.Chunks {
+		return false, fmt.Errorf("received unexpected chunk %d", m.restoreChunk)
+	}
+	chunkHash := sha256.Sum256(chunk)
+	if !bytes.Equal(chunkHash[:], snapshot.Metadata.ChunkHashes[m.restoreChunk]) {
+		return false, fmt.Errorf("%w: chunk %d", types.ErrChunkHashMismatch, m.restoreChunk)
+	}
+	if _, err := m.restoreWriter.Write(chunk); err != nil {
+		return false, m.abortLocked(err)
+	}
+	_, _ = m.restoreHash.Write(chunk)
+	m.restoreChunk++
+	if m.restoreChunk < snapshot.Chunks {
+		return false, nil
+	}
+	// all the chunks are verified, so the snapshot hash only mismatches on a corrupt metadata
+	if !bytes.Equal(m.restoreHash.Sum(nil), snapshot.Hash) {
+		return false, m.abortLocked(fmt.Errorf("%w: the snapshot hash does not match its chunks", types.ErrInvalidMetadata))
+	}
+	_ = m.restoreWriter.Close()
+	err := <-m.restoreDone
+	m.endLocked()
+	if err != nil {
+		return false, fmt.Errorf("failed to restore snapshot at height %d: %v", snapshot.Height, err)
+	}
+	return true, nil
+}
+
+// Abort aborts the restore in progress, if any.
+func (m *Manager) Abort() {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if m.operation == opRestore {
+		_ = m.abortLocked(errors.New("restore aborted"))
+	}
+}
+
+// abortLocked stops the snapshotter and returns the cause of the failed restore
+func (m *Manager) abortLocked(cause error) error {
+	_ = m.restoreWriter.CloseWithError(cause)
+	if err := <-m.restoreDone; err != nil {
+		cause = err
+	}
+	m.endLocked()
+	return cause
+}
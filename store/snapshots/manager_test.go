@@ -0,0 +1,128 @@
+package snapshots
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/vipernet-xyz/viper-network/store/snapshots/types"
+)
+
+// mockSnapshotter snapshots a fixed payload per height, and records the restored ones
+type mockSnapshotter struct {
+	restored map[uint64][]byte
+}
+
+func (m *mockSnapshotter) SnapshotFormat() uint32 { return 1 }
+
+func (m *mockSnapshotter) Snapshot(height uint64, format uint32, w io.Writer) error {
+	_, err := w.Write(snapshotPayload(height))
+	return err
+}
+
+func (m *mockSnapshotter) Restore(height uint64, format uint32, r io.Reader) error {
+	bz, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	if m.restored == nil {
+		m.restored = make(map[uint64][]byte)
+	}
+	m.restored[height] = bz
+	return nil
+}
+
+// the payload is random enough to span several compressed chunks
+func snapshotPayload(height uint64) []byte {
+	var buf bytes.Buffer
+	x := height
+	for i := 0; i < 20000; i++ {
+		x = x*6364136223846793005 + 1442695040888963407
+		_, _ = fmt.Fprintf(&buf, "%x", x)
+	}
+	return buf.Bytes()
+}
+
+func newTestManager(t *testing.T, opts types.SnapshotOptions) (*Manager, *mockSnapshotter) {
+	store, err := NewStore(t.TempDir())
+	require.NoError(t, err)
+	store.chunkSize = 64 << 10
+	target := &mockSnapshotter{}
+	return NewManager(store, target, opts, log.NewNopLogger()), target
+}
+
+func TestManager_CreateListPrune(t *testing.T) {
+	m, _ := newTestManager(t, types.NewSnapshotOptions(5, 2))
+	for _, height := range []uint64{5, 10, 15} {
+		snapshot, err := m.Create(height)
+		require.NoError(t, err)
+		require.NoError(t, snapshot.Validate())
+		require.True(t, snapshot.Chunks > 1)
+	}
+	_, err := m.Create(10)
+	require.Error(t, err)
+
+	list, err := m.List()
+	require.NoError(t, err)
+	require.Len(t, list, 3)
+	require.Equal(t, uint64(15), list[0].Height)
+	chunk, err := m.LoadChunk(15, 1, 0)
+	require.NoError(t, err)
+	require.NotEmpty(t, chunk)
+	chunk, err = m.LoadChunk(15, 1, list[0].Chunks)
+	require.NoError(t, err)
+	require.Nil(t, chunk)
+
+	pruned, err := m.Prune(2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), pruned)
+	list, err = m.List()
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	require.Equal(t, uint64(10), list[1].Height)
+
+	require.True(t, m.Options().IsSnapshotHeight(20))
+	require.False(t, m.Options().IsSnapshotHeight(21))
+}
+
+func TestManager_Restore(t *testing.T) {
+	source, _ := newTestManager(t, types.SnapshotOptions{})
+	snapshot, err := source.Create(7)
+	require.NoError(t, err)
+
+	m, target := newTestManager(t, types.SnapshotOptions{})
+	invalid := *snapshot
+	invalid.Format = 2
+	require.True(t, errors.Is(m.Restore(invalid), types.ErrUnknownFormat))
+	invalid = *snapshot
+	invalid.Chunks++
+	require.True(t, errors.Is(m.Restore(invalid), types.ErrInvalidMetadata))
+
+	require.NoError(t, m.Restore(*snapshot))
+	require.True(t, errors.Is(m.Restore(*snapshot), ErrOperationInProgress))
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := source.LoadChunk(snapshot.Height, snapshot.Format, i)
+		require.NoError(t, err)
+		// a corrupted chunk is rejected without aborting the restore
+		_, err = m.RestoreChunk(append([]byte{0}, chunk...))
+		require.True(t, errors.Is(err, types.ErrChunkHashMismatch))
+		done, err := m.RestoreChunk(chunk)
+		require.NoError(t, err)
+		require.Equal(t, i == snapshot.Chunks-1, done)
+	}
+	require.Equal(t, snapshotPayload(7), target.restored[7])
+	_, err = m.RestoreChunk([]byte{})
+	require.Error(t, err)
+
+	// an aborted restore frees the manager
+	require.NoError(t, m.Restore(*snapshot))
+	m.Abort()
+	_, err = m.Create(8)
+	require.NoError(t, err)
+}
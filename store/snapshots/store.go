@@ -0,0 +1,292 @@
+package snapshots
+
+import (
+	"compress/zlib"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/vipernet-xyz/viper-network/store/snapshots/types"
+)
+
+const (
+	// DefaultChunkSize is the size of the snapshot chunks.
+	DefaultChunkSize = 10e6
+	metadataFile     = "metadata.json"
+	tmpPrefix        = "tmp-"
+)
+
+// Store is a snapshot store, containing the snapshot chunks and their metadata on disk. The
+// snapshots are laid out as <dir>/<height>/<format>/<chunk>, and a snapshot is only listed
+// once all of its chunks and its metadata are written.
+type Store struct {
+	dir       string
+	chunkSize int
+
+	mtx    sync.Mutex
+	saving map[uint64]bool // heights being saved
+}
+
+// NewStore creates a new snapshot store in the given directory.
+func NewStore(dir string) (*Store, error) {
+	if dir == "" {
+		return nil, fmt.Errorf("snapshot directory not given")
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create snapshot directory %s: %v", dir, err)
+	}
+	return &Store{dir: dir, chunkSize: DefaultChunkSize, saving: make(map[uint64]bool)}, nil
+}
+
+// Save creates a snapshot at the given height. The stream written by fn is compressed, split in
+// chunks and hashed.
+func (s *Store) Save(height uint64, format uint32, fn func(w io.Writer) error) (*types.Snapshot, error) {
+	if height == 0 {
+		return nil, types.ErrInvalidSnapshotVersion
+	}
+	s.mtx.Lock()
+	if s.saving[height] {
+		s.mtx.Unlock()
+		return nil, fmt.Errorf("a snapshot is already being saved at height %d", height)
+	}
+	s.saving[height] = true
+	s.mtx.Unlock()
+	defer func() {
+		s.mtx.Lock()
+		delete(s.saving, height)
+		s.mtx.Unlock()
+	}()
+	if existing, err := s.Get(height, format); err != nil {
+		return nil, err
+	} else if existing != nil {
+		return nil, fmt.Errorf("snapshot already exists at height %d in format %d", height, format)
+	}
+	tmpDir := filepath.Join(s.dir, fmt.Sprintf("%s%d-%d", tmpPrefix, height, format))
+	if err := os.RemoveAll(tmpDir); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(tmpDir, 0755); err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(tmpDir)
+	cw := &chunkWriter{dir: tmpDir, size: s.chunkSize, hash: sha256.New()}
+	zw := zlib.NewWriter(cw)
+	if err := fn(zw); err != nil {
+		_ = cw.Close()
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		_ = cw.Close()
+		return nil, err
+	}
+	if err := cw.Close(); err != nil {
+		return nil, err
+	}
+	snapshot := &types.Snapshot{
+		Height:   height,
+		Format:   format,
+		Chunks:   uint32(len(cw.chunkHashes)),
+		Hash:     cw.hash.Sum(nil),
+		Metadata: types.Metadata{ChunkHashes: cw.chunkHashes},
+	}
+	bz, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(filepath.Join(tmpDir, metadataFile), bz, 0644); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(s.pathHeight(height), 0755); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmpDir, s.pathSnapshot(height, format)); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
+
+// Get fetches the snapshot metadata, nil if the snapshot doesn't exist.
+func (s *Store) Get(height uint64, format uint32) (*types.Snapshot, error) {
+	bz, err := ioutil.ReadFile(filepath.Join(s.pathSnapshot(height, format), metadataFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	snapshot := &types.Snapshot{}
+	if err := json.Unmarshal(bz, snapshot); err != nil {
+		return nil, fmt.Errorf("failed to decode snapshot metadata at height %d: %v", height, err)
+	}
+	return snapshot, nil
+}
+
+// List lists the snapshots in the store, newest first.
+func (s *Store) List() ([]*types.Snapshot, error) {
+	heights, err := s.heights()
+	if err != nil {
+		return nil, err
+	}
+	snapshots := make([]*types.Snapshot, 0, len(heights))
+	for _, height := range heights {
+		formats, err := ioutil.ReadDir(s.pathHeight(height))
+		if err != nil {
+			return nil, err
+		}
+		for i := len(formats) - 1; i >= 0; i-- {
+			format, err := strconv.ParseUint(formats[i].Name(), 10, 32)
+			if err != nil || !formats[i].IsDir() {
+				continue
+			}
+			snapshot, err := s.Get(height, uint32(format))
+			if err != nil {
+				return nil, err
+			}
+			if snapshot != nil {
+				snapshots = append(snapshots, snapshot)
+			}
+		}
+	}
+	return snapshots, nil
+}
+
+// LoadChunk loads a chunk of a snapshot, nil if it doesn't exist.
+func (s *Store) LoadChunk(height uint64, format uint32, chunk uint32) ([]byte, error) {
+	snapshot, err := s.Get(height, format)
+	if err != nil || snapshot == nil || chunk >= snapshot.Chunks {
+		return nil, err
+	}
+	return ioutil.ReadFile(s.pathChunk(height, format, chunk))
+}
+
+// Delete deletes a snapshot.
+func (s *Store) Delete(height uint64, format uint32) error {
+	s.mtx.Lock()
+	saving := s.saving[height]
+	s.mtx.Unlock()
+	if saving {
+		return fmt.Errorf("snapshot at height %d is being saved", height)
+	}
+	if err := os.RemoveAll(s.pathSnapshot(height, format)); err != nil {
+		return err
+	}
+	// the height directory is removed with its last format
+	_ = os.Remove(s.pathHeight(height))
+	return nil
+}
+
+// Prune removes old snapshots, keeping the ones of the given number of most recent heights. It
+// returns the number of pruned snapshots.
+func (s *Store) Prune(retain uint32) (uint64, error) {
+	snapshots, err := s.List()
+	if err != nil {
+		return 0, err
+	}
+	var pruned uint64
+	var skip = make(map[uint64]bool)
+	for _, snapshot := range snapshots {
+		if skip[snapshot.Height] || uint32(len(skip)) < retain {
+			skip[snapshot.Height] = true
+			continue
+		}
+		if err := s.Delete(snapshot.Height, snapshot.Format); err != nil {
+			return pruned, err
+		}
+		pruned++
+	}
+	return pruned, nil
+}
+
+// heights returns the snapshotted heights, newest first
+func (s *Store) heights() ([]uint64, error) {
+	entries, err := ioutil.ReadDir(s.dir)
+	if err != nil {
+		return nil, err
+	}
+	heights := make([]uint64, 0, len(entries))
+	for _, entry := range entries {
+		height, err := strconv.ParseUint(entry.Name(), 10, 64)
+		if err != nil || !entry.IsDir() {
+			continue
+		}
+		heights = append(heights, height)
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] > heights[j] })
+	return heights, nil
+}
+
+func (s *Store) pathHeight(height uint64) string {
+	return filepath.Join(s.dir, strconv.FormatUint(height, 10))
+}
+
+func (s *Store) pathSnapshot(height uint64, format uint32) string {
+	return filepath.Join(s.pathHeight(height), strconv.FormatUint(uint64(format), 10))
+}
+
+func (s *Store) pathChunk(height uint64, format uint32, chunk uint32) string {
+	return filepath.Join(s.pathSnapshot(height, format), strconv.FormatUint(uint64(chunk), 10))
+}
+
+// chunkWriter splits the written stream in chunk files of a fixed size, hashing each of them
+// and the whole stream
+type chunkWriter struct {
+	dir         string
+	size        int
+	hash        hash.Hash
+	file        *os.File
+	written     int
+	chunkHash   hash.Hash
+	chunkHashes [][]byte
+}
+
+func (w *chunkWriter) Write(p []byte) (int, error) {
+	n := 0
+	for len(p) > 0 {
+		if w.file == nil || w.written >= w.size {
+			if err := w.closeChunk(); err != nil {
+				return n, err
+			}
+			f, err := os.Create(filepath.Join(w.dir, strconv.Itoa(len(w.chunkHashes))))
+			if err != nil {
+				return n, err
+			}
+			w.file, w.written, w.chunkHash = f, 0, sha256.New()
+		}
+		part := p
+		if len(part) > w.size-w.written {
+			part = part[:w.size-w.written]
+		}
+		written, err := w.file.Write(part)
+		_, _ = w.hash.Write(part[:written])
+		_, _ = w.chunkHash.Write(part[:written])
+		w.written += written
+		n += written
+		if err != nil {
+			return n, err
+		}
+		p = p[written:]
+	}
+	return n, nil
+}
+
+func (w *chunkWriter) closeChunk() error {
+	if w.file == nil {
+		return nil
+	}
+	err := w.file.Close()
+	w.file = nil
+	w.chunkHashes = append(w.chunkHashes, w.chunkHash.Sum(nil))
+	return err
+}
+
+func (w *chunkWriter) Close() error {
+	return w.closeChunk()
+}
@@ -0,0 +1,25 @@
+package types
+
+// The snapshot serving messages of ABCI 0.34. The vendored Tendermint predates state sync, so they are
+// mirrored here to keep the BaseApp calls identical to the ABCI ones. Restoring a node needs the state-sync
+// reactor of Tendermint to bootstrap its block store, so the offer and apply calls are left out until it exists.
+
+// RequestListSnapshots lists the available snapshots.
+type RequestListSnapshots struct{}
+
+// ResponseListSnapshots contains the available snapshots, newest first.
+type ResponseListSnapshots struct {
+	Snapshots []*Snapshot `json:"snapshots"`
+}
+
+// RequestLoadSnapshotChunk loads a chunk of a local snapshot.
+type RequestLoadSnapshotChunk struct {
+	Height uint64 `json:"height"`
+	Format uint32 `json:"format"`
+	Chunk  uint32 `json:"chunk"`
+}
+
+// ResponseLoadSnapshotChunk contains the chunk, empty if it doesn't exist.
+type ResponseLoadSnapshotChunk struct {
+	Chunk []byte `json:"chunk"`
+}
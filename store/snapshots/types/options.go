@@ -0,0 +1,29 @@
+package types
+
+const (
+	// DefaultSnapshotKeepRecent is the number of snapshots kept on disk by default.
+	DefaultSnapshotKeepRecent = 2
+)
+
+// SnapshotOptions defines the snapshot strategy used when determining which
+// heights are snapshotted for state sync.
+type SnapshotOptions struct {
+	// Interval defines at which heights the snapshot is taken, 0 disables the snapshots.
+	Interval uint64
+
+	// KeepRecent defines how many snapshots to keep on disk, 0 keeps all of them.
+	KeepRecent uint32
+}
+
+// NewSnapshotOptions creates and returns a new SnapshotOptions instance.
+func NewSnapshotOptions(interval uint64, keepRecent uint32) SnapshotOptions {
+	return SnapshotOptions{
+		Interval:   interval,
+		KeepRecent: keepRecent,
+	}
+}
+
+// IsSnapshotHeight returns true if a snapshot is taken at the given height.
+func (o SnapshotOptions) IsSnapshotHeight(height int64) bool {
+	return o.Interval > 0 && height > 0 && uint64(height)%o.Interval == 0
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+var (
+	// ErrUnknownFormat is returned when a snapshot format is not supported by the snapshotter.
+	ErrUnknownFormat = errors.New("unknown snapshot format")
+	// ErrChunkHashMismatch is returned when a chunk does not match the hash of the snapshot metadata.
+	ErrChunkHashMismatch = errors.New("chunk hash verification failed")
+	// ErrInvalidMetadata is returned when the snapshot metadata is invalid.
+	ErrInvalidMetadata = errors.New("invalid snapshot metadata")
+	// ErrInvalidSnapshotVersion is returned when the snapshot height is invalid.
+	ErrInvalidSnapshotVersion = errors.New("invalid snapshot version")
+	// ErrSnapshotNotFound is returned when the snapshot is not in the store.
+	ErrSnapshotNotFound = errors.New("snapshot not found")
+)
+
+// Snapshot contains the metadata of a state-sync snapshot. The snapshot itself is stored as a
+// number of chunks, each of them verified by the hashes of the metadata.
+type Snapshot struct {
+	Height   uint64   `json:"height"`
+	Format   uint32   `json:"format"`
+	Chunks   uint32   `json:"chunks"`
+	Hash     []byte   `json:"hash"` // hash of all the chunks
+	Metadata Metadata `json:"metadata"`
+}
+
+// Metadata contains the hashes of the snapshot chunks, in order.
+type Metadata struct {
+	ChunkHashes [][]byte `json:"chunk_hashes"`
+}
+
+// Validate checks that the snapshot metadata is consistent.
+func (s Snapshot) Validate() error {
+	if s.Height == 0 {
+		return fmt.Errorf("%w: height can't be 0", ErrInvalidSnapshotVersion)
+	}
+	if s.Chunks == 0 {
+		return fmt.Errorf("%w: no chunks", ErrInvalidMetadata)
+	}
+	if len(s.Metadata.ChunkHashes) != int(s.Chunks) {
+		return fmt.Errorf("%w: snapshot has %d chunk hashes, but %d chunks", ErrInvalidMetadata, len(s.Metadata.ChunkHashes), s.Chunks)
+	}
+	if len(s.Hash) == 0 {
+		return fmt.Errorf("%w: no snapshot hash", ErrInvalidMetadata)
+	}
+	return nil
+}
+
+// Snapshotter is something that can create and restore snapshots, e.g. the multistore.
+type Snapshotter interface {
+	// SnapshotFormat returns the format written by Snapshot.
+	SnapshotFormat() uint32
+	// Snapshot writes the state at the given height to w.
+	Snapshot(height uint64, format uint32, w io.Writer) error
+	// Restore restores the state at the given height from r, which is read until EOF.
+	Restore(height uint64, format uint32, r io.Reader) error
+}
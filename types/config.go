@@ -25,9 +25,11 @@ type ViperConfig struct {
 	ChainsName                 string `json:"chains_name"`
 	EvidenceDBName             string `json:"evidence_db_name"`
 	ResultDBName               string `json:"result_db_name"`
+	SubmissionDBName           string `json:"submission_db_name"`
 	TendermintURI              string `json:"tendermint_uri"`
 	KeybaseName                string `json:"keybase_name"`
 	RPCPort                    string `json:"rpc_port"`
+	GRPCPort                   string `json:"grpc_port"` // the grpc services are disabled when it is empty
 	ClientBlockSyncAllowance   int    `json:"client_block_sync_allowance"`
 	ClientSessionSyncAllowance int64  `json:"client_session_sync_allowance"`
 	MaxEvidenceCacheEntires    int    `json:"max_evidence_cache_entries"`
@@ -57,6 +59,26 @@ type ViperConfig struct {
 	GeoZonesHotReload          bool   `json:"geo_zones_hot_reload"`
 	SamplePoolName             string `json:"sample_pool_name"`
 	SamplePoolHotReload        bool   `json:"sample_pool_hot_reload"`
+	UpstreamHealthInterval     int64  `json:"upstream_health_interval"`
+	UpstreamMaxFailures        int    `json:"upstream_max_failures"`
+	UpstreamCooldown           int64  `json:"upstream_cooldown"`
+	ChainSyncInterval          int64  `json:"chain_sync_interval"`
+	RelayCacheSize             int    `json:"relay_cache_size"`
+	RelayCacheTTL              int64  `json:"relay_cache_ttl"`
+	SamplingWorkers            int    `json:"sampling_workers"`
+	MaxSamplingSessions        int    `json:"max_sampling_sessions"`
+	SnapshotInterval           uint64 `json:"snapshot_interval"`
+	SnapshotKeepRecent         uint32 `json:"snapshot_keep_recent"`
+	RPCClientRateLimit         int    `json:"rpc_client_rate_limit"`
+	RPCClientBurst             int    `json:"rpc_client_burst"`
+	RPCRequestorRateLimit      int    `json:"rpc_requestor_rate_limit"`
+	RPCRequestorBurst          int    `json:"rpc_requestor_burst"`
+	RPCIPRateLimit             int    `json:"rpc_ip_rate_limit"`
+	RPCIPBurst                 int    `json:"rpc_ip_burst"`
+	RPCRateLimitMaxKeys        int    `json:"rpc_rate_limit_max_keys"`
+	RPCRateLimitTrustProxy     bool   `json:"rpc_rate_limit_trust_proxy"`
+	RPCMaxConcurrentRelays     int    `json:"rpc_max_concurrent_relays"`
+	RPCMaxEventSubscriptions   int    `json:"rpc_max_event_subscriptions"`
 }
 
 func (c ViperConfig) GetLeanViperUserKeyFilePath() string {
@@ -86,8 +108,10 @@ const (
 	DefaultGeoZoneName                 = "geozone.json"
 	DefaultGenesisName                 = "genesis.json"
 	DefaultRPCPort                     = "8081"
+	DefaultGRPCPort                    = "9090"
 	DefaultEvidenceDBName              = "viper_evidence"
 	DefaultResultDBName                = "viper_result"
+	DefaultSubmissionDBName            = "viper_submission"
 	DefaultTMURI                       = "tcp://localhost:26657"
 	DefaultMaxSessionCacheEntries      = 500
 	DefaultMaxEvidenceCacheEntries     = 500
@@ -127,6 +151,26 @@ const (
 	DefaultLeanViperUserKeyFileName    = "lean_nodes_keys.json"
 	DefaultSamplePoolName              = "samplepool.json"
 	DefaultSamplePoolHotReload         = false
+	DefaultUpstreamHealthInterval      = 30 // seconds, 0 disables active health checks
+	DefaultUpstreamMaxFailures         = 3
+	DefaultUpstreamCooldown            = 30 // seconds
+	DefaultChainSyncInterval           = 30 // seconds, 0 disables the sync checks
+	DefaultRelayCacheSize              = 10000
+	DefaultRelayCacheTTL               = 60 // seconds
+	DefaultSamplingWorkers             = 10
+	DefaultMaxSamplingSessions         = 100
+	DefaultSnapshotInterval            = 0 // blocks, 0 disables the state-sync snapshots
+	DefaultSnapshotKeepRecent          = 2
+	DefaultRPCClientRateLimit          = 100 // requests per second, 0 disables the limit
+	DefaultRPCClientBurst              = 200
+	DefaultRPCRequestorRateLimit       = 0 // requests per second, 0 disables the limit
+	DefaultRPCRequestorBurst           = 0
+	DefaultRPCIPRateLimit              = 0 // requests per second, 0 disables the limit
+	DefaultRPCIPBurst                  = 0
+	DefaultRPCRateLimitMaxKeys         = 100000
+	DefaultRPCRateLimitTrustProxy      = false // use the last X-Forwarded-For address as the source ip
+	DefaultRPCMaxConcurrentRelays      = 0     // per chain, 0 disables the cap
+	DefaultRPCMaxEventSubscriptions    = 100   // 0 disables the event streams
 )
 
 func DefaultConfig(dataDir string) Config {
@@ -139,9 +183,11 @@ func DefaultConfig(dataDir string) Config {
 			GeoZoneName:              DefaultGeoZoneName,
 			EvidenceDBName:           DefaultEvidenceDBName,
 			ResultDBName:             DefaultResultDBName,
+			SubmissionDBName:         DefaultSubmissionDBName,
 			TendermintURI:            DefaultTMURI,
 			KeybaseName:              DefaultKeybaseName,
 			RPCPort:                  DefaultRPCPort,
+			GRPCPort:                 DefaultGRPCPort,
 			ClientBlockSyncAllowance: DefaultClientBlockSyncAllowance,
 			MaxEvidenceCacheEntires:  DefaultMaxEvidenceCacheEntries,
 			MaxSessionCacheEntries:   DefaultMaxSessionCacheEntries,
@@ -168,6 +214,26 @@ func DefaultConfig(dataDir string) Config {
 			GeoZonesHotReload:        DefaultGeoZoneHotReload,
 			SamplePoolName:           DefaultSamplePoolName,
 			SamplePoolHotReload:      DefaultSamplePoolHotReload,
+			UpstreamHealthInterval:   DefaultUpstreamHealthInterval,
+			UpstreamMaxFailures:      DefaultUpstreamMaxFailures,
+			UpstreamCooldown:         DefaultUpstreamCooldown,
+			ChainSyncInterval:        DefaultChainSyncInterval,
+			RelayCacheSize:           DefaultRelayCacheSize,
+			RelayCacheTTL:            DefaultRelayCacheTTL,
+			SamplingWorkers:          DefaultSamplingWorkers,
+			MaxSamplingSessions:      DefaultMaxSamplingSessions,
+			SnapshotInterval:         DefaultSnapshotInterval,
+			SnapshotKeepRecent:       DefaultSnapshotKeepRecent,
+			RPCClientRateLimit:       DefaultRPCClientRateLimit,
+			RPCClientBurst:           DefaultRPCClientBurst,
+			RPCRequestorRateLimit:    DefaultRPCRequestorRateLimit,
+			RPCRequestorBurst:        DefaultRPCRequestorBurst,
+			RPCIPRateLimit:           DefaultRPCIPRateLimit,
+			RPCIPBurst:               DefaultRPCIPBurst,
+			RPCRateLimitMaxKeys:      DefaultRPCRateLimitMaxKeys,
+			RPCRateLimitTrustProxy:   DefaultRPCRateLimitTrustProxy,
+			RPCMaxConcurrentRelays:   DefaultRPCMaxConcurrentRelays,
+			RPCMaxEventSubscriptions: DefaultRPCMaxEventSubscriptions,
 		},
 	}
 	c.TendermintConfig.LevelDBOptions = config.DefaultLevelDBOpts()
@@ -13,6 +13,21 @@ type Handler func(ctx Ctx, msg Msg, signer crypto.PublicKey) Result
 // If newCtx.IsZero(), ctx is used instead.
 type AnteHandler func(ctx Ctx, tx Tx, txBz []byte, txIndexer txindex.TxIndexer, simulate bool) (newCtx Ctx, result Result, signer crypto.PublicKey, abort bool)
 
+// deliverTxKey is the context key flagging the ante handler runs in DeliverTx
+type deliverTxKey struct{}
+
+// WithDeliverTx flags whether the ante handler runs in DeliverTx, the check flag of the context is set
+// for the check and deliver states alike
+func WithDeliverTx(ctx Ctx, deliver bool) Ctx {
+	return ctx.WithValue(deliverTxKey{}, deliver)
+}
+
+// IsDeliverTx returns whether the ante handler runs in DeliverTx
+func IsDeliverTx(ctx Ctx) bool {
+	deliver, _ := ctx.Value(deliverTxKey{}).(bool)
+	return deliver
+}
+
 // AnteHandler authenticates transactions, before their internal messages are handled.
 // If newCtx.IsZero(), ctx is used instead.
 type AnteHandler1 func(ctx Context, tx Tx1, simulate bool) (newCtx Context, err error)
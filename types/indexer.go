@@ -9,6 +9,7 @@ import (
 
 	"github.com/jordanorelli/lexnum"
 	"github.com/pkg/errors"
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/libs/pubsub/query"
 	"github.com/tendermint/tendermint/state/txindex"
 	"github.com/tendermint/tendermint/types"
@@ -29,6 +30,7 @@ const (
 	TxHeightKey         = "tx.height"
 	TxSignerKey         = "tx.signer"
 	TxRecipientKey      = "tx.recipient"
+	TxMessageTypeKey    = "tx.message_type"
 	TxHashKey           = "tx.hash"
 	SortAscending       = "asc"
 	SortDescending      = "desc"
@@ -36,6 +38,10 @@ const (
 	sep                 = "/"
 	maxPerPage          = 10000
 	AnteHandlerMaxError = 10
+	// the events carrying the recipient and the type of each message of a transaction
+	EventTypeIndex          = "index"
+	AttributeKeyRecipient   = "recipient"
+	AttributeKeyMessageType = "message_type"
 )
 
 type TransactionIndexer struct {
@@ -61,10 +67,8 @@ func (t *TransactionIndexer) AddBatch(b *txindex.Batch) error {
 			storeBatch.Set(keyForSigner(result), hash)
 		}
 
-		// index tx by recipient
-		if result.Result.Recipient != nil {
-			storeBatch.Set(keyForRecipient(result), hash)
-		}
+		// index tx by the recipients and the types of its messages
+		indexMessages(storeBatch, result, hash)
 
 		// index tx by height
 		storeBatch.Set(keyForHeight(result), hash)
@@ -92,10 +96,8 @@ func (t *TransactionIndexer) Index(result *types.TxResult) error {
 		storeBatch.Set(keyForSigner(result), hash)
 	}
 
-	// index tx by recipient
-	if result.Result.Recipient != nil {
-		storeBatch.Set(keyForRecipient(result), hash)
-	}
+	// index tx by the recipients and the types of its messages
+	indexMessages(storeBatch, result, hash)
 
 	// index tx by height
 	storeBatch.Set(keyForHeight(result), hash)
@@ -110,6 +112,51 @@ func (t *TransactionIndexer) Index(result *types.TxResult) error {
 	return storeBatch.WriteSync()
 }
 
+// NewIndexEvent - Returns the event carrying the index tags of a message of a transaction
+func NewIndexEvent(messageType string, recipient Address) abci.Event {
+	attrs := []Attribute{NewAttribute(AttributeKeyMessageType, messageType)}
+	if recipient != nil {
+		attrs = append(attrs, NewAttribute(AttributeKeyRecipient, recipient.String()))
+	}
+	return NewEvent(EventTypeIndex, attrs...)
+}
+
+// indexMessages - Indexes the tx by the recipient and the type of every message, the result only holds the
+// ones of the first message so the others are read from the index events
+func indexMessages(storeBatch dbm.Batch, result *types.TxResult, hash []byte) {
+	recipients := make(map[string]struct{})
+	messageTypes := make(map[string]struct{})
+	if result.Result.Recipient != nil {
+		recipients[Address(result.Result.Recipient).String()] = struct{}{}
+	}
+	if result.Result.MessageType != "" {
+		messageTypes[result.Result.MessageType] = struct{}{}
+	}
+	for _, event := range result.Result.Events {
+		if event.Type != EventTypeIndex {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			switch string(attr.Key) {
+			case AttributeKeyRecipient:
+				recipients[string(attr.Value)] = struct{}{}
+			case AttributeKeyMessageType:
+				messageTypes[string(attr.Value)] = struct{}{}
+			}
+		}
+	}
+	for recipient := range recipients {
+		addr, err := AddressFromHex(recipient)
+		if err != nil || addr.Empty() {
+			continue
+		}
+		storeBatch.Set(keyForRecipient(addr, result), hash)
+	}
+	for messageType := range messageTypes {
+		storeBatch.Set(keyForMessageType(messageType, result), hash)
+	}
+}
+
 func (t *TransactionIndexer) Get(hash []byte) (*types.TxResult, error) {
 	if len(hash) == 0 {
 		return nil, txindex.ErrorEmptyHash
@@ -152,6 +199,8 @@ func (t *TransactionIndexer) Search(ctx context.Context, q *query.Query) (res []
 		return t.signerQuery(condition, q.Pagination)
 	case TxRecipientKey:
 		return t.recipientQuery(condition, q.Pagination)
+	case TxMessageTypeKey:
+		return t.messageTypeQuery(condition, q.Pagination)
 	case TxHashKey:
 		return t.hashQuery(condition)
 	default:
@@ -217,6 +266,14 @@ func (t *TransactionIndexer) recipientQuery(condition query.Condition, paginatio
 	return t.getByPrefix(prefixKeyForRecipient(recipient), pagination)
 }
 
+func (t *TransactionIndexer) messageTypeQuery(condition query.Condition, pagination *query.Page) (res []*types.TxResult, total int, err error) {
+	messageType, ok := condition.Operand.(string)
+	if !ok {
+		return nil, 0, errors.New("error during searching for a message type in the query, c.Operand not type string")
+	}
+	return t.getByPrefix(prefixKeyForMessageType(messageType), pagination)
+}
+
 func (t *TransactionIndexer) getByPrefix(prefix []byte, pagination *query.Page) (res []*types.TxResult, total int, err error) {
 	it, err := PrefixIterator(t.store, prefix, pagination.Sort)
 	if err != nil {
@@ -274,10 +331,10 @@ func prefixKeyForSigner(signer Address) []byte {
 	))
 }
 
-func keyForRecipient(result *types.TxResult) []byte {
+func keyForRecipient(recipient Address, result *types.TxResult) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%s/%s",
 		TxRecipientKey,
-		Address(result.Result.Recipient),
+		recipient,
 		elenEncoder.EncodeInt(int(result.Height)),
 		elenEncoder.EncodeInt(int(result.Index)),
 	))
@@ -291,6 +348,23 @@ func prefixKeyForRecipient(recipient Address) []byte {
 	))
 }
 
+func keyForMessageType(messageType string, result *types.TxResult) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%s",
+		TxMessageTypeKey,
+		messageType,
+		elenEncoder.EncodeInt(int(result.Height)),
+		elenEncoder.EncodeInt(int(result.Index)),
+	))
+}
+
+func prefixKeyForMessageType(messageType string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s",
+		TxMessageTypeKey,
+		messageType,
+		elenEncoder.EncodeInt(0),
+	))
+}
+
 // contract: caller must close iterator
 func PrefixIterator(db dbm.DB, prefix []byte, order string) (dbm.Iterator, error) {
 	switch order {
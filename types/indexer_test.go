@@ -0,0 +1,41 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/pubsub/query"
+	"github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestTransactionIndexer_IndexEveryMessage(t *testing.T) {
+	first, second := Address([]byte("first_recipient_addr")), Address([]byte("other_recipient_addr"))
+	indexer := NewTransactionIndexer(dbm.NewMemDB())
+	result := &types.TxResult{
+		Height: 1,
+		Tx:     types.Tx("multi message tx"),
+		Result: abci.ResponseDeliverTx{
+			Recipient:   first,
+			MessageType: "send",
+			Events:      []abci.Event{NewIndexEvent("send", first), NewIndexEvent("stake_validator", second)},
+		},
+	}
+	require.Nil(t, indexer.Index(result))
+	for _, q := range []string{
+		fmt.Sprintf("%s='%s'", TxRecipientKey, first),
+		fmt.Sprintf("%s='%s'", TxRecipientKey, second),
+		fmt.Sprintf("%s='send'", TxMessageTypeKey),
+		fmt.Sprintf("%s='stake_validator'", TxMessageTypeKey),
+	} {
+		search := query.MustParse(q)
+		search.AddPage(10, 0, SortDescending)
+		res, total, err := indexer.Search(context.Background(), search)
+		require.Nil(t, err)
+		require.Equal(t, 1, total, q)
+		require.Equal(t, result.Tx.Hash(), res[0].Tx.Hash())
+	}
+}
@@ -83,9 +83,12 @@ type ProtoMsg interface {
 
 // Transactions objects must fulfill the Tx
 type Tx interface {
-	// Gets the all the transaction's messages.
+	// Gets the first of the transaction's messages.
 	GetMsg() Msg
 
+	// Gets the all the transaction's messages, executed in order and atomically.
+	GetMsgs() []Msg
+
 	// ValidateBasic does a simple and lightweight validation check that doesn't
 	// require access to any other information.
 	ValidateBasic() Error
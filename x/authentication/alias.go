@@ -12,15 +12,20 @@ import (
 
 // Const Constants
 const (
-	ModuleName        = types.ModuleName
-	StoreKey          = types.StoreKey
-	FeeCollectorName  = types.FeeCollectorName
-	QuerierRoute      = types.QuerierRoute
-	DefaultParamspace = types.DefaultCodespace
-	QueryAccount      = types.QueryAccount
-	Burner            = types.Burner
-	Staking           = types.Staking
-	Minter            = types.Minter
+	ModuleName          = types.ModuleName
+	StoreKey            = types.StoreKey
+	FeeCollectorName    = types.FeeCollectorName
+	QuerierRoute        = types.QuerierRoute
+	DefaultParamspace   = types.DefaultCodespace
+	QueryAccount        = types.QueryAccount
+	QuerySequence       = types.QuerySequence
+	QueryFeeAllowance   = types.QueryFeeAllowance
+	QueryFeeAllowances  = types.QueryFeeAllowances
+	QueryAuthorizations = types.QueryAuthorizations
+	RouterKey           = types.RouterKey
+	Burner              = types.Burner
+	Staking             = types.Staking
+	Minter              = types.Minter
 )
 
 var (
@@ -30,23 +35,35 @@ var (
 	RegisterCodec             = types.RegisterCodec
 	CountSubKeys              = types.CountSubKeys
 	StdSignBytes              = types.StdSignBytes
+	StdMultiSignBytes         = types.StdMultiSignBytes
 	DefaultTxDecoder          = types.DefaultTxDecoder
 	DefaultTxEncoder          = types.DefaultTxEncoder
 	NewTxBuilder              = types.NewTxBuilder
+	NewMultiMsgTx             = types.NewMultiMsgTx
+	NewFeeAllowance           = types.NewFeeAllowance
+	NewAuthorization          = types.NewAuthorization
+	NewMsgExec                = types.NewMsgExec
 	ModuleCdc                 = types.ModuleCdc
 )
 
 // Type exported types
 type (
-	GenesisState       = types.GenesisState
-	Keeper             = keeper.Keeper
-	Account            = exported.Account
-	BaseAccount        = types.BaseAccount
-	Params             = types.Params
-	QueryAccountParams = types.QueryAccountParams
-	ProtoStdTx         = types.ProtoStdTx
-	StdTx              = types.StdTx
-	StdSignDoc         = types.StdSignDoc
-	StdSignature       = types.ProtoStdSignature
-	TxBuilder          = types.TxBuilder
+	GenesisState            = types.GenesisState
+	Keeper                  = keeper.Keeper
+	Account                 = exported.Account
+	BaseAccount             = types.BaseAccount
+	Params                  = types.Params
+	QueryAccountParams      = types.QueryAccountParams
+	FeeAllowance            = types.FeeAllowance
+	QueryFeeAllowanceParams = types.QueryFeeAllowanceParams
+	MsgGrantFeeAllowance    = types.MsgGrantFeeAllowance
+	MsgRevokeFeeAllowance   = types.MsgRevokeFeeAllowance
+	MsgGrantAuthorization   = types.MsgGrantAuthorization
+	MsgRevokeAuthorization  = types.MsgRevokeAuthorization
+	MsgExec                 = types.MsgExec
+	ProtoStdTx              = types.ProtoStdTx
+	StdTx                   = types.StdTx
+	StdSignDoc              = types.StdSignDoc
+	StdSignature            = types.ProtoStdSignature
+	TxBuilder               = types.TxBuilder
 )
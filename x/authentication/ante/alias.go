@@ -29,6 +29,7 @@ var (
 	RegisterCodec             = types.RegisterCodec
 	CountSubKeys              = types.CountSubKeys
 	StdSignBytes              = types.StdSignBytes
+	StdMultiSignBytes         = types.StdMultiSignBytes
 	DefaultTxDecoder          = types.DefaultTxDecoder
 	DefaultTxEncoder          = types.DefaultTxEncoder
 	NewTxBuilder              = types.NewTxBuilder
@@ -5,7 +5,9 @@ import (
 	"encoding/hex"
 	"fmt"
 	"os"
+	"sync"
 
+	"github.com/vipernet-xyz/viper-network/codec"
 	posCrypto "github.com/vipernet-xyz/viper-network/crypto/codec"
 	sdk "github.com/vipernet-xyz/viper-network/types"
 	"github.com/vipernet-xyz/viper-network/x/authentication/keeper"
@@ -15,8 +17,10 @@ import (
 	tmTypes "github.com/tendermint/tendermint/types"
 )
 
-// NewAnteHandler returns an AnteHandler that checks signatures and deducts fees from the first signer.
+// NewAnteHandler returns an AnteHandler that checks signatures and deducts fees from the first signer,
+// or from the fee granter named by the transaction.
 func NewAnteHandler(ak keeper.Keeper) sdk.AnteHandler {
+	sequences := newCheckSequences()
 	return func(ctx sdk.Ctx, tx sdk.Tx, txBz []byte, txIndexer txindex.TxIndexer, simulate bool) (newCtx sdk.Ctx, res sdk.Result, signer posCrypto.PublicKey, abort bool) {
 		if addr := ak.GetModuleAddress(types.FeeCollectorName); addr == nil {
 			ctx.Logger().Error(fmt.Sprintf("%s module account has not been set", types.FeeCollectorName))
@@ -30,6 +34,25 @@ func NewAnteHandler(ak keeper.Keeper) sdk.AnteHandler {
 		if !ok {
 			return newCtx, sdk.ErrInternal("all transactions must be convertible to inteface: ProtoStdTx").Result(), nil, true
 		}
+		// multi message transactions are accepted once the feature is activated by an upgrade
+		if len(stdTx.GetMsgs()) > 1 && !types.ModuleCdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.MultiMsgTxKey) {
+			return newCtx, types.ErrMultiMsgTxInactive(ModuleName).Result(), nil, true
+		}
+		// the account sequences replace the tx indexer replay protection once activated by an upgrade
+		sequenceMode := types.ModuleCdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.AccountSequenceKey)
+		if !sequenceMode && stdTx.GetSequence() != 0 {
+			return newCtx, types.ErrSequenceInactive(ModuleName).Result(), nil, true
+		}
+		// the fee granters pay the fees once activated by an upgrade
+		if stdTx.GetFeeGranter() != nil && !types.ModuleCdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.FeeGrantKey) {
+			return newCtx, types.ErrFeeGrantInactive(ModuleName).Result(), nil, true
+		}
+		// the authorizations are granted, revoked and executed once activated by an upgrade
+		for _, msg := range stdTx.GetMsgs() {
+			if types.IsAuthorizationMsg(msg) && !types.ModuleCdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.AuthorizationKey) {
+				return newCtx, types.ErrAuthzInactive(ModuleName).Result(), nil, true
+			}
+		}
 		signer, err := ValidateTransaction(ctx, ak, stdTx, ak.GetParams(ctx), txIndexer, txBz, simulate)
 		if err != nil {
 			return newCtx, err.Result(), signer, true
@@ -38,6 +61,16 @@ func NewAnteHandler(ak keeper.Keeper) sdk.AnteHandler {
 		if err != nil {
 			return newCtx, err.Result(), signer, true
 		}
+		if sequenceMode && !simulate {
+			if sdk.IsDeliverTx(ctx) {
+				err = IncrementSequence(ctx, ak, stdTx, signer)
+			} else {
+				err = sequences.increment(ctx, ak, stdTx, signer)
+			}
+			if err != nil {
+				return newCtx, err.Result(), signer, true
+			}
+		}
 		return ctx, sdk.Result{}, signer, false // continue...
 	}
 }
@@ -47,25 +80,13 @@ func ValidateTransaction(ctx sdk.Ctx, k Keeper, stdTx types.StdTx, params Params
 	if err := ValidateMemo(stdTx, params); err != nil {
 		return nil, types.ErrInvalidMemo(ModuleName, err)
 	}
-	// check for duplicate transaction to prevent replay attacks
-	txHash := tmTypes.Tx(txBz).Hash()
-	// make http call to tendermint to check txIndexer
-	if txIndexer == nil {
-		ctx.Logger().Error(types.ErrNilTxIndexer(ModuleName).Error())
-		return nil, types.ErrNilTxIndexer(ModuleName)
-	}
-	res, err := (txIndexer).Get(txHash)
-	if err != nil {
-		ctx.Logger().Error(err.Error())
-		return nil, sdk.ErrInternal(err.Error())
-	}
-	if res != nil {
-		return nil, types.ErrDuplicateTx(ModuleName, hex.EncodeToString(txHash))
+	// check for duplicate transaction to prevent replay attacks, the account sequences
+	// take over once activated
+	if !types.ModuleCdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.AccountSequenceKey) {
+		if err := ValidateNotDuplicate(ctx, txIndexer, txBz); err != nil {
+			return nil, err
+		}
 	}
-	validSigners := stdTx.GetSigners()
-
-	validSigners = append(validSigners, k.POSKeeper.GetMsgStakeOutputSigner(ctx, stdTx.Msg))
-
 	var pk posCrypto.PublicKey
 	for _, signer := range stdTx.GetSigners() {
 		// attempt to get the public key from the signature
@@ -88,13 +109,17 @@ func ValidateTransaction(ctx sdk.Ctx, k Keeper, stdTx types.StdTx, params Params
 		if !bytes.Equal(pk.Address(), signer) {
 			continue
 		}
+		// the single signature of the transaction must be valid for each of its messages
+		if err := ValidateMsgsSigner(ctx, k, stdTx.GetMsgs(), signer); err != nil {
+			return nil, err
+		}
 		// get the sign bytes from the tx
 		signBytes, err := GetSignBytes(ctx.ChainID(), stdTx)
 		if err != nil {
 			return nil, sdk.ErrInternal(err.Error())
 		}
 		// get the fees from the tx
-		expectedFee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, k.GetParams(ctx).FeeMultiplier.GetFee(stdTx.GetMsg())))
+		expectedFee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, k.GetParams(ctx).FeeMultiplier.GetTotalFee(stdTx.GetMsgs())))
 		// test for public key type
 		p, ok := pk.(posCrypto.PublicKeyMultiSig)
 		// if standard public key
@@ -123,6 +148,128 @@ func ValidateTransaction(ctx sdk.Ctx, k Keeper, stdTx types.StdTx, params Params
 	return nil, sdk.ErrUnauthorized("signature verification failed for the transaction")
 }
 
+// ValidateNotDuplicate asks the tx indexer whether the transaction was already included
+func ValidateNotDuplicate(ctx sdk.Ctx, txIndexer txindex.TxIndexer, txBz []byte) sdk.Error {
+	txHash := tmTypes.Tx(txBz).Hash()
+	// make http call to tendermint to check txIndexer
+	if txIndexer == nil {
+		ctx.Logger().Error(types.ErrNilTxIndexer(ModuleName).Error())
+		return types.ErrNilTxIndexer(ModuleName)
+	}
+	res, err := (txIndexer).Get(txHash)
+	if err != nil {
+		ctx.Logger().Error(err.Error())
+		return sdk.ErrInternal(err.Error())
+	}
+	if res != nil {
+		return types.ErrDuplicateTx(ModuleName, hex.EncodeToString(txHash))
+	}
+	return nil
+}
+
+// IncrementSequence verifies the transaction sequence against the signer account and stores it
+// as the account sequence
+func IncrementSequence(ctx sdk.Ctx, k keeper.Keeper, stdTx types.StdTx, signer posCrypto.PublicKey) sdk.Error {
+	acc, err := GetSignerAcc(ctx, k, sdk.Address(signer.Address()))
+	if err != nil {
+		return err
+	}
+	if err := ValidateSequence(acc.GetSequence(), stdTx.GetSequence()); err != nil {
+		return err
+	}
+	if err := acc.SetSequence(stdTx.GetSequence()); err != nil {
+		return sdk.ErrInternal(err.Error())
+	}
+	k.SetAccount(ctx, acc)
+	return nil
+}
+
+// ValidateSequence checks the transaction sequence follows the account one
+func ValidateSequence(accSequence, txSequence uint64) sdk.Error {
+	expected := accSequence + 1
+	if txSequence != expected {
+		return types.ErrInvalidSequence(ModuleName, expected, txSequence)
+	}
+	return nil
+}
+
+// checkSequences tracks the sequences of the transactions accepted by CheckTx. The ante writes of CheckTx
+// are discarded, so the sequences are kept here for the height of the check state and reset on commit
+type checkSequences struct {
+	mu        sync.Mutex
+	height    int64
+	sequences map[string]uint64
+}
+
+func newCheckSequences() *checkSequences {
+	return &checkSequences{sequences: make(map[string]uint64)}
+}
+
+// increment verifies the transaction sequence follows the last one accepted for the signer, or the account
+// one, and tracks it for the next transaction of the signer in the mempool
+func (c *checkSequences) increment(ctx sdk.Ctx, k keeper.Keeper, stdTx types.StdTx, signer posCrypto.PublicKey) sdk.Error {
+	acc, err := GetSignerAcc(ctx, k, sdk.Address(signer.Address()))
+	if err != nil {
+		return err
+	}
+	return c.validate(ctx.BlockHeight(), acc.GetAddress(), acc.GetSequence(), stdTx.GetSequence())
+}
+
+func (c *checkSequences) validate(height int64, addr sdk.Address, accSequence, txSequence uint64) sdk.Error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	// the check state is reset on commit, and the mempool transactions are checked again in order
+	if height != c.height {
+		c.height = height
+		c.sequences = make(map[string]uint64)
+	}
+	sequence, found := c.sequences[addr.String()]
+	if !found || sequence < accSequence {
+		sequence = accSequence
+	}
+	if err := ValidateSequence(sequence, txSequence); err != nil {
+		return err
+	}
+	c.sequences[addr.String()] = txSequence
+	return nil
+}
+
+// ValidateMsgsSigner ensures the signer is a valid signer of each message, and is authorized to execute
+// the messages wrapped in its exec messages
+func ValidateMsgsSigner(ctx sdk.Ctx, k Keeper, msgs []sdk.Msg, signer sdk.Address) sdk.Error {
+	for i, msg := range msgs {
+		validSigners := append(msg.GetSigners(), k.POSKeeper.GetMsgStakeOutputSigner(ctx, msg))
+		found := false
+		for _, s := range validSigners {
+			if s != nil && bytes.Equal(s, signer) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return sdk.ErrUnauthorized(fmt.Sprintf("%s is not a signer of the message %d (%s) of the transaction", signer, i, msg.Type()))
+		}
+		if exec, ok := execMsg(msg); ok {
+			for _, m := range exec.Msgs {
+				if _, err := k.GetExecSigner(ctx, exec.Grantee, m); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func execMsg(msg sdk.Msg) (types.MsgExec, bool) {
+	switch m := msg.(type) {
+	case types.MsgExec:
+		return m, true
+	case *types.MsgExec:
+		return *m, true
+	}
+	return types.MsgExec{}, false
+}
+
 func ValidateSignatureDepth(limit uint64, publicKey posCrypto.PublicKeyMultiSig) (ok bool) {
 	_, ok = recSignDepth(1, limit, publicKey)
 	return
@@ -168,12 +315,15 @@ func ValidateMemo(stdTx types.StdTx, params Params) sdk.Error {
 	return nil
 }
 
-// DeductFees deducts fees from the given account.
+// DeductFees deducts fees from the given account, or from the fee granter allowance to the account.
 func DeductFees(keeper keeper.Keeper, ctx sdk.Ctx, tx types.StdTx, signer posCrypto.PublicKey) sdk.Error {
 	fees := tx.GetFee()
 	if !fees.IsValid() {
 		return sdk.ErrInsufficientFee(fmt.Sprintf("invalid fee amount: %s", fees))
 	}
+	if granter := tx.GetFeeGranter(); granter != nil {
+		return keeper.UseGrantedFees(ctx, granter, sdk.Address(signer.Address()), fees, tx.GetMsgs())
+	}
 	var acc Account
 	var err sdk.Error
 
@@ -198,7 +348,7 @@ func DeductFees(keeper keeper.Keeper, ctx sdk.Ctx, tx types.StdTx, signer posCry
 // GetSignBytes returns a slice of bytes to sign over for a given transaction
 // and an account.
 func GetSignBytes(chainID string, stdTx types.StdTx) ([]byte, error) {
-	return StdSignBytes(
-		chainID, stdTx.GetEntropy(), stdTx.GetFee(), stdTx.GetMsg(), stdTx.GetMemo(),
+	return StdMultiSignBytes(
+		chainID, stdTx.GetEntropy(), stdTx.GetFee(), stdTx.GetMsgs(), stdTx.GetMemo(), stdTx.GetSequence(), stdTx.GetFeeGranter(),
 	)
 }
@@ -4,6 +4,9 @@ import (
 	"testing"
 
 	crypto "github.com/vipernet-xyz/viper-network/crypto/codec"
+	sdk "github.com/vipernet-xyz/viper-network/types"
+	"github.com/vipernet-xyz/viper-network/x/authentication/keeper"
+	servicersTypes "github.com/vipernet-xyz/viper-network/x/servicers/types"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -30,3 +33,55 @@ func TestSignatureDepth(t *testing.T) {
 	assert.True(t, ValidateSignatureDepth(5, mspk))
 	assert.False(t, ValidateSignatureDepth(4, mspk))
 }
+
+// outputSignerKeeper allows the output address to sign the stake messages of its operator
+type outputSignerKeeper struct {
+	output sdk.Address
+}
+
+func (k outputSignerKeeper) GetMsgStakeOutputSigner(_ sdk.Ctx, msg sdk.Msg) sdk.Address {
+	if _, ok := msg.(*servicersTypes.MsgStake); ok {
+		return k.output
+	}
+	return nil
+}
+
+func TestValidateMsgsSigner(t *testing.T) {
+	signer := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	other := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	operator := crypto.GenerateEd25519PrivKey().PublicKey()
+	k := keeper.Keeper{POSKeeper: outputSignerKeeper{output: signer}}
+	send := func(from sdk.Address) sdk.Msg {
+		return &servicersTypes.MsgSend{FromAddress: from, ToAddress: from, Amount: sdk.OneInt()}
+	}
+	stake := &servicersTypes.MsgStake{PublicKey: operator, Value: sdk.OneInt()}
+	assert.Nil(t, ValidateMsgsSigner(nil, k, []sdk.Msg{send(signer), send(signer)}, signer))
+	// the output address signs for the stake message of its operator
+	assert.Nil(t, ValidateMsgsSigner(nil, k, []sdk.Msg{send(signer), stake}, signer))
+	// a signer must sign for every message
+	assert.NotNil(t, ValidateMsgsSigner(nil, k, []sdk.Msg{send(signer), send(other)}, signer))
+	assert.NotNil(t, ValidateMsgsSigner(nil, keeper.Keeper{POSKeeper: outputSignerKeeper{}}, []sdk.Msg{stake}, signer))
+}
+
+func TestValidateSequence(t *testing.T) {
+	// the first transaction of an account carries 1
+	assert.Nil(t, ValidateSequence(0, 1))
+	assert.NotNil(t, ValidateSequence(0, 0))
+	assert.Nil(t, ValidateSequence(4, 5))
+	// replays and gaps are rejected
+	assert.NotNil(t, ValidateSequence(5, 5))
+	assert.NotNil(t, ValidateSequence(4, 6))
+}
+
+func TestCheckSequences(t *testing.T) {
+	addr := crypto.GenerateEd25519PrivKey().PublicKey().Address()
+	sequences := newCheckSequences()
+	// the mempool accepts the transactions queued after the pending ones, one sequence at a time
+	assert.Nil(t, sequences.validate(1, sdk.Address(addr), 4, 5))
+	assert.Nil(t, sequences.validate(1, sdk.Address(addr), 4, 6))
+	assert.NotNil(t, sequences.validate(1, sdk.Address(addr), 4, 6))
+	assert.NotNil(t, sequences.validate(1, sdk.Address(addr), 4, 8))
+	// the pending transactions are checked again from the account sequence once committed
+	assert.Nil(t, sequences.validate(2, sdk.Address(addr), 5, 6))
+	assert.Nil(t, sequences.validate(2, sdk.Address(addr), 5, 7))
+}
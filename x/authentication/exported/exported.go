@@ -20,6 +20,11 @@ type Account interface {
 	GetCoins() sdk.Coins
 	SetCoins(sdk.Coins) error
 
+	// The sequence of the last transaction signed by the account,
+	// used for replay protection once the sequence mode is active.
+	GetSequence() uint64
+	SetSequence(uint64) error
+
 	// Calculates the amount of coins that can be sent to other accounts given
 	// the current time.
 	SpendableCoins(blockTime time.Time) sdk.Coins
@@ -37,6 +42,32 @@ type ModuleAccountI interface {
 	HasPermission(string) bool
 }
 
+// VestingAccount defines an account whose coins vest over time, the vesting coins
+// can be delegated (staked) but not transferred until they are vested.
+type VestingAccount interface {
+	Account
+
+	// The vesting coins that are not delegated at the block time
+	LockedCoins(blockTime time.Time) sdk.Coins
+
+	// Track the delegation of coins, the vesting coins are delegated first.
+	// TrackUndelegation undelegates the free coins first.
+	TrackDelegation(blockTime time.Time, amount sdk.Coins)
+	TrackUndelegation(amount sdk.Coins)
+
+	GetVestedCoins(blockTime time.Time) sdk.Coins
+	GetVestingCoins(blockTime time.Time) sdk.Coins
+
+	GetStartTime() int64
+	GetEndTime() int64
+
+	GetOriginalVesting() sdk.Coins
+	GetDelegatedFree() sdk.Coins
+	GetDelegatedVesting() sdk.Coins
+
+	Validate() error
+}
+
 // SupplyI defines an inflationary supply interface for modules that handle
 // token supply.
 type SupplyI interface {
@@ -14,7 +14,10 @@ func ExportGenesis(ctx sdk.Ctx, k keeper.Keeper) types.GenesisState {
 	params := k.GetParams(ctx)
 	accounts := k.GetAllAccountsExport(ctx)
 	supply := k.GetSupply(ctx)
-	return types.NewGenesisState(params, accounts, supply.GetTotal())
+	genesis := types.NewGenesisState(params, accounts, supply.GetTotal())
+	genesis.FeeAllowances = k.GetAllFeeAllowances(ctx)
+	genesis.Authorizations = k.GetAllAuthorizations(ctx)
+	return genesis
 }
 
 // InitGenesis sets supply information for genesis.
@@ -46,4 +49,10 @@ func InitGenesis(ctx sdk.Ctx, k keeper.Keeper, data types.GenesisState) {
 		data.Supply = totalSupply
 	}
 	k.SetSupply(ctx, types.NewSupply(data.Supply))
+	for _, allowance := range data.FeeAllowances {
+		k.SetFeeAllowance(ctx, allowance)
+	}
+	for _, authorization := range data.Authorizations {
+		k.SetAuthorization(ctx, authorization)
+	}
 }
@@ -0,0 +1,68 @@
+package authentication
+
+import (
+	"fmt"
+	"reflect"
+
+	crypto "github.com/vipernet-xyz/viper-network/crypto/codec"
+	sdk "github.com/vipernet-xyz/viper-network/types"
+	"github.com/vipernet-xyz/viper-network/x/authentication/keeper"
+	"github.com/vipernet-xyz/viper-network/x/authentication/types"
+)
+
+func NewHandler(k keeper.Keeper) sdk.Handler {
+	return func(ctx sdk.Ctx, msg sdk.Msg, signer crypto.PublicKey) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+		// convert to value for switch consistency
+		if reflect.ValueOf(msg).Kind() == reflect.Ptr {
+			msg = reflect.Indirect(reflect.ValueOf(msg)).Interface().(sdk.Msg)
+		}
+		switch msg := msg.(type) {
+		case types.MsgGrantFeeAllowance:
+			return handleMsgGrantFeeAllowance(ctx, msg, k)
+		case types.MsgRevokeFeeAllowance:
+			return handleMsgRevokeFeeAllowance(ctx, msg, k)
+		case types.MsgGrantAuthorization:
+			return handleMsgGrantAuthorization(ctx, msg, k)
+		case types.MsgRevokeAuthorization:
+			return handleMsgRevokeAuthorization(ctx, msg, k)
+		case types.MsgExec:
+			return handleMsgExec(ctx, msg, k, signer)
+		default:
+			errMsg := fmt.Sprintf("unrecognized authentication message type: %T", msg)
+			return sdk.ErrUnknownRequest(errMsg).Result()
+		}
+	}
+}
+
+func handleMsgGrantFeeAllowance(ctx sdk.Ctx, msg types.MsgGrantFeeAllowance, k keeper.Keeper) sdk.Result {
+	if err := k.GrantFeeAllowance(ctx, msg.FeeAllowance()); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgRevokeFeeAllowance(ctx sdk.Ctx, msg types.MsgRevokeFeeAllowance, k keeper.Keeper) sdk.Result {
+	if err := k.RevokeFeeAllowance(ctx, msg.Granter, msg.Grantee); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgGrantAuthorization(ctx sdk.Ctx, msg types.MsgGrantAuthorization, k keeper.Keeper) sdk.Result {
+	if err := k.GrantAuthorization(ctx, msg.Authorization()); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgRevokeAuthorization(ctx sdk.Ctx, msg types.MsgRevokeAuthorization, k keeper.Keeper) sdk.Result {
+	if err := k.RevokeAuthorization(ctx, msg.Granter, msg.Grantee, msg.MsgType); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgExec(ctx sdk.Ctx, msg types.MsgExec, k keeper.Keeper, signer crypto.PublicKey) sdk.Result {
+	return k.Exec(ctx, msg, signer)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/vipernet-xyz/viper-network/codec"
 	sdk "github.com/vipernet-xyz/viper-network/types"
 	"github.com/vipernet-xyz/viper-network/x/authentication/exported"
 	"github.com/vipernet-xyz/viper-network/x/authentication/types"
@@ -18,6 +19,16 @@ func (k Keeper) GetModuleAddress(moduleName string) sdk.Address {
 	return permAddr.GetAddress()
 }
 
+// BlockedAddr returns whether or not the address belongs to a module account, which may not receive funds from external sources
+func (k Keeper) BlockedAddr(addr sdk.Address) bool {
+	for _, permAddr := range k.permAddrs {
+		if permAddr.GetAddress().Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
 // GetModuleAddressAndPermissions returns an address and permissions based on the module name
 func (k Keeper) GetModuleAddressAndPermissions(moduleName string) (addr sdk.Address, permissions []string) {
 	permAddr, ok := k.permAddrs[moduleName]
@@ -94,6 +105,19 @@ func (k Keeper) GetAccount(ctx sdk.Ctx, addr sdk.Address) exported.Account {
 	return acc
 }
 
+// GetNextSequence returns the sequence the next transaction signed by the address must carry,
+// zero while the account sequences are not activated for the next block
+func (k Keeper) GetNextSequence(ctx sdk.Ctx, addr sdk.Address) uint64 {
+	if !types.ModuleCdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight()+1, codec.AccountSequenceKey) {
+		return 0
+	}
+	acc := k.GetAccount(ctx, addr)
+	if acc == nil {
+		return 1
+	}
+	return acc.GetSequence() + 1
+}
+
 // GetAllAccounts returns all accounts in the accountKeeper.
 func (k Keeper) GetAllAccounts(ctx sdk.Ctx) []exported.Account {
 	var accounts []exported.Account
@@ -109,8 +133,9 @@ func (k Keeper) GetAllAccounts(ctx sdk.Ctx) []exported.Account {
 func (k Keeper) GetAllAccountsExport(ctx sdk.Ctx) []exported.Account {
 	var accounts []exported.Account
 	appendAccount := func(acc exported.Account) (stop bool) {
-		//not get empty coins accounts
-		if !acc.GetCoins().Empty() {
+		//not get empty coins accounts, the vesting accounts are kept for their fully staked vesting coins
+		_, isVesting := acc.(exported.VestingAccount)
+		if !acc.GetCoins().Empty() || isVesting {
 			//sanity check here
 			if acc.GetAddress() != nil {
 				accounts = append(accounts, acc)
@@ -187,6 +212,8 @@ func (k Keeper) EncodeAccount(acc exported.Account, ctx sdk.Ctx) ([]byte, error)
 		return k.EncodeBaseAccount(a, ctx)
 	case *types.ModuleAccount:
 		return k.EncodeModuleAccount(a, ctx)
+	case exported.VestingAccount:
+		return k.EncodeVestingAccount(a, ctx)
 	}
 	return nil, fmt.Errorf("could not encode account: unrecognized account type")
 }
@@ -200,8 +227,63 @@ func (k Keeper) EncodeModuleAccount(macc *types.ModuleAccount, ctx sdk.Ctx) ([]b
 	return k.Cdc.MarshalBinaryBare(macc)
 }
 
+// vesting accounts are stored with a zero prefix byte, which can't start a proto nor an amino
+// encoding, followed by the byte of their type
+const (
+	vestingAccountPrefix     byte = 0x00
+	continuousVestingAccount byte = 0x01
+	delayedVestingAccount    byte = 0x02
+	periodicVestingAccount   byte = 0x03
+)
+
+// "EncodeVestingAccount" - encodes the vesting account with its prefix and type bytes
+func (k Keeper) EncodeVestingAccount(acc exported.VestingAccount, ctx sdk.Ctx) ([]byte, error) {
+	var accType byte
+	switch acc.(type) {
+	case *types.ContinuousVestingAccount:
+		accType = continuousVestingAccount
+	case *types.DelayedVestingAccount:
+		accType = delayedVestingAccount
+	case *types.PeriodicVestingAccount:
+		accType = periodicVestingAccount
+	default:
+		return nil, fmt.Errorf("could not encode account: unrecognized vesting account type")
+	}
+	bz, err := k.Cdc.ProtoMarshalBinaryBare(acc.(codec.ProtoMarshaler))
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{vestingAccountPrefix, accType}, bz...), nil
+}
+
+// "DecodeVestingAccount" - decodes a vesting account encoded by EncodeVestingAccount
+func (k Keeper) DecodeVestingAccount(bz []byte, ctx sdk.Ctx) (exported.VestingAccount, error) {
+	if len(bz) < 2 || bz[0] != vestingAccountPrefix {
+		return nil, fmt.Errorf("could not decode account: not a vesting account")
+	}
+	var acc interface {
+		exported.VestingAccount
+		codec.ProtoMarshaler
+	}
+	switch bz[1] {
+	case continuousVestingAccount:
+		acc = &types.ContinuousVestingAccount{}
+	case delayedVestingAccount:
+		acc = &types.DelayedVestingAccount{}
+	case periodicVestingAccount:
+		acc = &types.PeriodicVestingAccount{}
+	default:
+		return nil, fmt.Errorf("could not decode account: unrecognized vesting account type %d", bz[1])
+	}
+	err := k.Cdc.ProtoUnmarshalBinaryBare(bz[2:], acc)
+	return acc, err
+}
+
 // "DecodeAccount" - decodes into account interface
 func (k Keeper) DecodeAccount(bz []byte, ctx sdk.Ctx) (exported.Account, error) {
+	if len(bz) != 0 && bz[0] == vestingAccountPrefix {
+		return k.DecodeVestingAccount(bz, ctx)
+	}
 	acc, err := k.DecodeBaseAccount(bz, ctx)
 	if err == nil {
 		return acc, err
@@ -35,3 +35,10 @@ func TestSetAndGetAccounts(t *testing.T) {
 	gotAcc := keeper.GetAccount(ctx, baseAcc.GetAddress())
 	assert.Equal(t, baseAcc, gotAcc)
 }
+
+func TestBlockedAddr(t *testing.T) {
+	_, keeper := createTestInput(t, false, initialPower, 0)
+	assert.True(t, keeper.BlockedAddr(keeper.GetModuleAddress(holder)))
+	assert.True(t, keeper.BlockedAddr(types.NewModuleAddress(multiPerm)))
+	assert.False(t, keeper.BlockedAddr(types.NewModuleAddress("baseAcc")))
+}
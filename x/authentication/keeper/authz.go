@@ -0,0 +1,194 @@
+package keeper
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+
+	crypto "github.com/vipernet-xyz/viper-network/crypto/codec"
+	sdk "github.com/vipernet-xyz/viper-network/types"
+	"github.com/vipernet-xyz/viper-network/x/authentication/types"
+)
+
+// SetAuthorization stores the authorization, replacing any authorization from its granter to its grantee for the message type
+func (k Keeper) SetAuthorization(ctx sdk.Ctx, authorization types.Authorization) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.Cdc.ProtoMarshalBinaryBare(&authorization)
+	if err != nil {
+		ctx.Logger().Error(fmt.Errorf("error marshalling authorization %v at height: %d, err: %s", authorization, ctx.BlockHeight(), err.Error()).Error())
+		os.Exit(1)
+	}
+	_ = store.Set(types.AuthorizationKey(authorization.Grantee, authorization.Granter, authorization.MsgType), bz)
+}
+
+// GetAuthorization returns the authorization from the granter to the grantee for the message type
+func (k Keeper) GetAuthorization(ctx sdk.Ctx, granter, grantee sdk.Address, msgType string) (authorization types.Authorization, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz, _ := store.Get(types.AuthorizationKey(grantee, granter, msgType))
+	if bz == nil {
+		return authorization, false
+	}
+	if err := k.Cdc.ProtoUnmarshalBinaryBare(bz, &authorization); err != nil {
+		ctx.Logger().Error(fmt.Errorf("error unmarshalling authorization from %s to %s at height: %d, err: %s", granter, grantee, ctx.BlockHeight(), err.Error()).Error())
+		return authorization, false
+	}
+	return authorization, true
+}
+
+// DeleteAuthorization removes the authorization from the granter to the grantee for the message type
+func (k Keeper) DeleteAuthorization(ctx sdk.Ctx, granter, grantee sdk.Address, msgType string) {
+	store := ctx.KVStore(k.storeKey)
+	_ = store.Delete(types.AuthorizationKey(grantee, granter, msgType))
+}
+
+// GetAuthorizations returns the authorizations granted to the grantee
+func (k Keeper) GetAuthorizations(ctx sdk.Ctx, grantee sdk.Address) (authorizations []types.Authorization) {
+	k.iterateAuthorizations(ctx, types.AuthorizationByGranteeKey(grantee), func(authorization types.Authorization) (stop bool) {
+		authorizations = append(authorizations, authorization)
+		return false
+	})
+	return
+}
+
+// GetAllAuthorizations returns all the authorizations of the store
+func (k Keeper) GetAllAuthorizations(ctx sdk.Ctx) (authorizations []types.Authorization) {
+	k.iterateAuthorizations(ctx, types.AuthorizationKeyPrefix, func(authorization types.Authorization) (stop bool) {
+		authorizations = append(authorizations, authorization)
+		return false
+	})
+	return
+}
+
+func (k Keeper) iterateAuthorizations(ctx sdk.Ctx, prefix []byte, process func(types.Authorization) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter, _ := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var authorization types.Authorization
+		if err := k.Cdc.ProtoUnmarshalBinaryBare(iter.Value(), &authorization); err != nil {
+			ctx.Logger().Error(fmt.Errorf("error while iterating authorizations: unmarshalling %v at height: %d, err: %s", iter.Value(), ctx.BlockHeight(), err.Error()).Error())
+			continue
+		}
+		if process(authorization) {
+			return
+		}
+	}
+}
+
+// GrantAuthorization lets the grantee execute the message type on behalf of the granter
+func (k Keeper) GrantAuthorization(ctx sdk.Ctx, authorization types.Authorization) sdk.Error {
+	if err := authorization.ValidateBasic(); err != nil {
+		return types.ErrInvalidAuthorization(types.DefaultCodespace, err)
+	}
+	if authorization.IsExpired(ctx.BlockTime()) {
+		return types.ErrInvalidAuthorization(types.DefaultCodespace, fmt.Errorf("the authorization expired at %d", authorization.Expiration))
+	}
+	if k.BlockedAddr(authorization.Granter) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("the module account %s can't grant an authorization", authorization.Granter))
+	}
+	k.SetAuthorization(ctx, authorization)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeGrantAuthorization,
+			sdk.NewAttribute(types.AttributeKeyGranter, authorization.Granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, authorization.Grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyMsgType, authorization.MsgType),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(types.AttributeKeySender, authorization.Granter.String()),
+		),
+	})
+	return nil
+}
+
+// RevokeAuthorization removes the authorization from the granter to the grantee for the message type
+func (k Keeper) RevokeAuthorization(ctx sdk.Ctx, granter, grantee sdk.Address, msgType string) sdk.Error {
+	if _, found := k.GetAuthorization(ctx, granter, grantee, msgType); !found {
+		return types.ErrNoAuthorization(types.DefaultCodespace, granter, grantee, msgType)
+	}
+	k.DeleteAuthorization(ctx, granter, grantee, msgType)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevokeAuthorization,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+			sdk.NewAttribute(types.AttributeKeyMsgType, msgType),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(types.AttributeKeySender, granter.String()),
+		),
+	})
+	return nil
+}
+
+// GetExecSigner returns the account the grantee executes the message on behalf of: the grantee itself if
+// it is a valid signer of the message, else the first valid signer that authorized it for the message type.
+func (k Keeper) GetExecSigner(ctx sdk.Ctx, grantee sdk.Address, msg sdk.Msg) (sdk.Address, sdk.Error) {
+	validSigners := msg.GetSigners()
+	if k.POSKeeper != nil {
+		validSigners = append(validSigners, k.POSKeeper.GetMsgStakeOutputSigner(ctx, msg))
+	}
+	for _, s := range validSigners {
+		if s != nil && bytes.Equal(s, grantee) {
+			return grantee, nil
+		}
+	}
+	for _, s := range validSigners {
+		if s == nil {
+			continue
+		}
+		authorization, found := k.GetAuthorization(ctx, s, grantee, msg.Type())
+		if found && !authorization.IsExpired(ctx.BlockTime()) {
+			return s, nil
+		}
+	}
+	var granter sdk.Address
+	if len(validSigners) != 0 {
+		granter = validSigners[0]
+	}
+	return nil, types.ErrNoAuthorization(types.DefaultCodespace, granter, grantee, msg.Type())
+}
+
+// Exec executes the messages of the grantee on behalf of the accounts that authorized it, through the
+// message router. The handlers are passed the public key of the account the message executes for, when known.
+func (k Keeper) Exec(ctx sdk.Ctx, msg types.MsgExec, signer crypto.PublicKey) sdk.Result {
+	if k.Router == nil {
+		return sdk.ErrInternal("the authentication keeper has no message router").Result()
+	}
+	var data []byte
+	events := sdk.EmptyEvents()
+	for _, m := range msg.Msgs {
+		granter, err := k.GetExecSigner(ctx, msg.Grantee, m)
+		if err != nil {
+			return err.Result()
+		}
+		handler := k.Router.Route(m.Route())
+		if handler == nil {
+			return sdk.ErrUnknownRequest("unrecognized ProtoMsg type: " + m.Route()).Result()
+		}
+		pk := signer
+		if !granter.Equals(msg.Grantee) {
+			pk = nil
+			if acc := k.GetAccount(ctx, granter); acc != nil {
+				pk = acc.GetPubKey()
+			}
+		}
+		res := handler(ctx, m, pk)
+		events = events.AppendEvents(sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeExec,
+				sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+				sdk.NewAttribute(types.AttributeKeyGrantee, msg.Grantee.String()),
+				sdk.NewAttribute(types.AttributeKeyMsgType, m.Type()),
+			),
+		}).AppendEvents(res.Events)
+		if !res.IsOK() {
+			res.Events = events
+			return res
+		}
+		data = append(data, res.Data...)
+	}
+	return sdk.Result{Data: data, Events: events}
+}
@@ -0,0 +1,84 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	crypto "github.com/vipernet-xyz/viper-network/crypto/codec"
+	sdk "github.com/vipernet-xyz/viper-network/types"
+	"github.com/vipernet-xyz/viper-network/x/authentication/types"
+	servicersTypes "github.com/vipernet-xyz/viper-network/x/servicers/types"
+)
+
+type testRouter map[string]sdk.Handler
+
+func (r testRouter) AddRoute(path string, h sdk.Handler) sdk.Router {
+	r[path] = h
+	return r
+}
+
+func (r testRouter) Route(path string) sdk.Handler {
+	return r[path]
+}
+
+func TestAuthorization(t *testing.T) {
+	ctx, keeper := createTestInput(t, false, initialPower, 1)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+	granter := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	granteePK := crypto.GenerateEd25519PrivKey().PublicKey()
+	grantee := sdk.Address(granteePK.Address())
+	unjail := &servicersTypes.MsgUnjail{ValidatorAddr: granter, Signer: granter}
+	pause := &servicersTypes.MsgPause{ValidatorAddr: granter, Signer: granter}
+
+	// no authorization
+	_, err := keeper.GetExecSigner(ctx, grantee, unjail)
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeNoAuthorization, err.Code())
+	// the grantee signs its own messages
+	signer, err := keeper.GetExecSigner(ctx, grantee, &servicersTypes.MsgUnjail{ValidatorAddr: grantee, Signer: grantee})
+	require.Nil(t, err)
+	require.Equal(t, grantee, signer)
+	// an expired authorization can't be granted
+	require.NotNil(t, keeper.GrantAuthorization(ctx, types.NewAuthorization(granter, grantee, unjail.Type(), 1000)))
+
+	authorization := types.NewAuthorization(granter, grantee, unjail.Type(), 2000)
+	require.Nil(t, keeper.GrantAuthorization(ctx, authorization))
+	stored, found := keeper.GetAuthorization(ctx, granter, grantee, unjail.Type())
+	require.True(t, found)
+	require.Equal(t, authorization, stored)
+	require.Equal(t, []types.Authorization{authorization}, keeper.GetAuthorizations(ctx, grantee))
+	require.Empty(t, keeper.GetAuthorizations(ctx, granter))
+	signer, err = keeper.GetExecSigner(ctx, grantee, unjail)
+	require.Nil(t, err)
+	require.Equal(t, granter, signer)
+	// the authorization is scoped to the message type
+	_, err = keeper.GetExecSigner(ctx, grantee, pause)
+	require.NotNil(t, err)
+	// and expires
+	_, err = keeper.GetExecSigner(ctx.WithBlockTime(time.Unix(2000, 0)), grantee, unjail)
+	require.NotNil(t, err)
+
+	// the executed messages are routed to their handler
+	var executed []sdk.Msg
+	router := testRouter{}
+	router.AddRoute(servicersTypes.RouterKey, func(ctx sdk.Ctx, msg sdk.Msg, _ crypto.PublicKey) sdk.Result {
+		executed = append(executed, msg)
+		return sdk.Result{}
+	})
+	require.False(t, keeper.Exec(ctx, types.NewMsgExec(grantee, []sdk.Msg{unjail}), granteePK).IsOK())
+	keeper.Router = router
+	require.True(t, keeper.Exec(ctx, types.NewMsgExec(grantee, []sdk.Msg{unjail}), granteePK).IsOK())
+	require.Equal(t, []sdk.Msg{unjail}, executed)
+	// every message must be authorized
+	res := keeper.Exec(ctx, types.NewMsgExec(grantee, []sdk.Msg{unjail, pause}), granteePK)
+	require.False(t, res.IsOK())
+	require.Equal(t, types.CodeNoAuthorization, res.Code)
+
+	// the authorization is revoked
+	require.Nil(t, keeper.RevokeAuthorization(ctx, granter, grantee, unjail.Type()))
+	require.NotNil(t, keeper.RevokeAuthorization(ctx, granter, grantee, unjail.Type()))
+	require.Empty(t, keeper.GetAllAuthorizations(ctx))
+	require.False(t, keeper.Exec(ctx, types.NewMsgExec(grantee, []sdk.Msg{unjail}), granteePK).IsOK())
+}
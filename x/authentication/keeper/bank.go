@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	"github.com/vipernet-xyz/viper-network/x/authentication/exported"
 	"github.com/vipernet-xyz/viper-network/x/authentication/types"
 
 	gogotypes "github.com/cosmos/gogoproto/types"
@@ -51,6 +52,110 @@ func (k Keeper) SendCoinsFromAccountToModule(ctx sdk.Ctx, senderAddr sdk.Address
 	return k.SendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
 }
 
+// DelegateCoinsFromAccountToModule transfers coins from an Address to a staking ModuleAccount,
+// unlike SendCoinsFromAccountToModule the vesting coins of a vesting account can be delegated
+func (k Keeper) DelegateCoinsFromAccountToModule(ctx sdk.Ctx, senderAddr sdk.Address,
+	recipientModule string, amt sdk.Coins) sdk.Error {
+
+	// create the account if it doesn't yet exist
+	recipientAcc := k.GetModuleAccount(ctx, recipientModule)
+	if recipientAcc == nil {
+		return sdk.ErrModuleAccountCreate(fmt.Sprintf("module account %s isn't able to be created", recipientModule))
+	}
+	if !recipientAcc.HasPermission(types.Staking) {
+		return sdk.ErrForbidden(fmt.Sprintf("module account %s does not have permissions to receive delegated coins", recipientModule))
+	}
+	acc := k.GetAccount(ctx, senderAddr)
+	vacc, ok := acc.(exported.VestingAccount)
+	if !ok {
+		return k.SendCoins(ctx, senderAddr, recipientAcc.GetAddress(), amt)
+	}
+	if !amt.IsValid() {
+		return sdk.ErrInvalidCoins(amt.String())
+	}
+	newCoins, hasNeg := vacc.GetCoins().SafeSub(amt)
+	if hasNeg {
+		return sdk.ErrInsufficientCoins(
+			fmt.Sprintf("insufficient account funds; %s < %s", vacc.GetCoins(), amt),
+		)
+	}
+	vacc.TrackDelegation(ctx.BlockHeader().Time, amt)
+	if err := vacc.SetCoins(newCoins); err != nil {
+		return sdk.ErrInternal(err.Error())
+	}
+	k.SetAccount(ctx, vacc)
+	if _, err := k.AddCoins(ctx, recipientAcc.GetAddress(), amt); err != nil {
+		return err
+	}
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransfer,
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipientAcc.GetAddress().String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amt.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(types.AttributeKeySender, senderAddr.String()),
+		),
+	})
+	return nil
+}
+
+// UndelegateCoinsFromModuleToAccount transfers coins from a staking ModuleAccount to an Address,
+// the undelegation is tracked if the recipient is a vesting account
+func (k Keeper) UndelegateCoinsFromModuleToAccount(ctx sdk.Ctx, senderModule string,
+	recipientAddr sdk.Address, amt sdk.Coins) sdk.Error {
+
+	senderAcc := k.GetModuleAccount(ctx, senderModule)
+	if senderAcc == nil {
+		return sdk.ErrUnknownAddress(fmt.Sprintf("module account %s does not exist", senderModule))
+	}
+	if !senderAcc.HasPermission(types.Staking) {
+		return sdk.ErrForbidden(fmt.Sprintf("module account %s does not have permissions to undelegate coins", senderModule))
+	}
+	if err := k.SendCoins(ctx, senderAcc.GetAddress(), recipientAddr, amt); err != nil {
+		return err
+	}
+	if vacc, ok := k.GetAccount(ctx, recipientAddr).(exported.VestingAccount); ok {
+		vacc.TrackUndelegation(amt)
+		k.SetAccount(ctx, vacc)
+	}
+	return nil
+}
+
+// CreateVestingAccountFromModule funds a new vesting account with its coins from a ModuleAccount
+func (k Keeper) CreateVestingAccountFromModule(ctx sdk.Ctx, senderModule string, acc exported.VestingAccount) sdk.Error {
+	senderAddr := k.GetModuleAddress(senderModule)
+	if senderAddr == nil {
+		return sdk.ErrUnknownAddress(fmt.Sprintf("module account %s does not exist", senderModule))
+	}
+	if k.GetAccount(ctx, acc.GetAddress()) != nil {
+		return types.ErrAccountExists(k.Codespace(), acc.GetAddress())
+	}
+	if k.BlockedAddr(acc.GetAddress()) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("%s is not allowed to receive funds", acc.GetAddress()))
+	}
+	if err := acc.Validate(); err != nil {
+		return types.ErrInvalidVesting(k.Codespace(), err)
+	}
+	if _, err := k.SubtractCoins(ctx, senderAddr, acc.GetCoins()); err != nil {
+		return err
+	}
+	k.SetAccount(ctx, acc)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransfer,
+			sdk.NewAttribute(types.AttributeKeyRecipient, acc.GetAddress().String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, acc.GetCoins().String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(types.AttributeKeySender, senderAddr.String()),
+		),
+	})
+	return nil
+}
+
 // MintCoins creates new coins from thin air and adds it to the module account.
 // Panics if the name maps to a non-minter module account or if the amount is invalid.
 func (k Keeper) MintCoins(ctx sdk.Ctx, moduleName string, amt sdk.Coins) sdk.Error {
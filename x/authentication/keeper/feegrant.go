@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/vipernet-xyz/viper-network/codec"
+	sdk "github.com/vipernet-xyz/viper-network/types"
+	"github.com/vipernet-xyz/viper-network/x/authentication/types"
+)
+
+// SetFeeAllowance stores the fee allowance, replacing any allowance from its granter to its grantee
+func (k Keeper) SetFeeAllowance(ctx sdk.Ctx, allowance types.FeeAllowance) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.Cdc.ProtoMarshalBinaryBare(&allowance)
+	if err != nil {
+		ctx.Logger().Error(fmt.Errorf("error marshalling fee allowance %v at height: %d, err: %s", allowance, ctx.BlockHeight(), err.Error()).Error())
+		os.Exit(1)
+	}
+	_ = store.Set(types.FeeAllowanceKey(allowance.Grantee, allowance.Granter), bz)
+}
+
+// GetFeeAllowance returns the fee allowance from the granter to the grantee
+func (k Keeper) GetFeeAllowance(ctx sdk.Ctx, granter, grantee sdk.Address) (allowance types.FeeAllowance, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz, _ := store.Get(types.FeeAllowanceKey(grantee, granter))
+	if bz == nil {
+		return allowance, false
+	}
+	if err := k.Cdc.ProtoUnmarshalBinaryBare(bz, &allowance); err != nil {
+		ctx.Logger().Error(fmt.Errorf("error unmarshalling fee allowance from %s to %s at height: %d, err: %s", granter, grantee, ctx.BlockHeight(), err.Error()).Error())
+		return allowance, false
+	}
+	return allowance, true
+}
+
+// DeleteFeeAllowance removes the fee allowance from the granter to the grantee
+func (k Keeper) DeleteFeeAllowance(ctx sdk.Ctx, granter, grantee sdk.Address) {
+	store := ctx.KVStore(k.storeKey)
+	_ = store.Delete(types.FeeAllowanceKey(grantee, granter))
+}
+
+// GetFeeAllowances returns the fee allowances granted to the grantee
+func (k Keeper) GetFeeAllowances(ctx sdk.Ctx, grantee sdk.Address) (allowances []types.FeeAllowance) {
+	k.iterateFeeAllowances(ctx, types.FeeAllowanceByGranteeKey(grantee), func(allowance types.FeeAllowance) (stop bool) {
+		allowances = append(allowances, allowance)
+		return false
+	})
+	return
+}
+
+// GetAllFeeAllowances returns all the fee allowances of the store
+func (k Keeper) GetAllFeeAllowances(ctx sdk.Ctx) (allowances []types.FeeAllowance) {
+	k.iterateFeeAllowances(ctx, types.FeeAllowanceKeyPrefix, func(allowance types.FeeAllowance) (stop bool) {
+		allowances = append(allowances, allowance)
+		return false
+	})
+	return
+}
+
+func (k Keeper) iterateFeeAllowances(ctx sdk.Ctx, prefix []byte, process func(types.FeeAllowance) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter, _ := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var allowance types.FeeAllowance
+		if err := k.Cdc.ProtoUnmarshalBinaryBare(iter.Value(), &allowance); err != nil {
+			ctx.Logger().Error(fmt.Errorf("error while iterating fee allowances: unmarshalling %v at height: %d, err: %s", iter.Value(), ctx.BlockHeight(), err.Error()).Error())
+			continue
+		}
+		if process(allowance) {
+			return
+		}
+	}
+}
+
+// GrantFeeAllowance lets the grantee pay its fees from the granter account, within the allowance
+func (k Keeper) GrantFeeAllowance(ctx sdk.Ctx, allowance types.FeeAllowance) sdk.Error {
+	if !types.ModuleCdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.FeeGrantKey) {
+		return types.ErrFeeAllowanceInactive(types.DefaultCodespace)
+	}
+	if err := allowance.ValidateBasic(); err != nil {
+		return types.ErrInvalidFeeAllowance(types.DefaultCodespace, err)
+	}
+	if allowance.IsExpired(ctx.BlockTime()) {
+		return types.ErrInvalidFeeAllowance(types.DefaultCodespace, fmt.Errorf("the allowance expired at %d", allowance.Expiration))
+	}
+	if k.BlockedAddr(allowance.Granter) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("the module account %s can't grant a fee allowance", allowance.Granter))
+	}
+	k.SetFeeAllowance(ctx, allowance)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeGrantFeeAllowance,
+			sdk.NewAttribute(types.AttributeKeyGranter, allowance.Granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, allowance.Grantee.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(types.AttributeKeySender, allowance.Granter.String()),
+		),
+	})
+	return nil
+}
+
+// RevokeFeeAllowance removes the fee allowance from the granter to the grantee
+func (k Keeper) RevokeFeeAllowance(ctx sdk.Ctx, granter, grantee sdk.Address) sdk.Error {
+	if !types.ModuleCdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.FeeGrantKey) {
+		return types.ErrFeeAllowanceInactive(types.DefaultCodespace)
+	}
+	if _, found := k.GetFeeAllowance(ctx, granter, grantee); !found {
+		return types.ErrNoFeeAllowance(types.DefaultCodespace, granter, grantee)
+	}
+	k.DeleteFeeAllowance(ctx, granter, grantee)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevokeFeeAllowance,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(types.AttributeKeySender, granter.String()),
+		),
+	})
+	return nil
+}
+
+// UseGrantedFees pays the fee of the grantee transaction from the granter account, deducting it
+// from their allowance. The allowance is removed once used up.
+func (k Keeper) UseGrantedFees(ctx sdk.Ctx, granter, grantee sdk.Address, fee sdk.Coins, msgs []sdk.Msg) sdk.Error {
+	allowance, found := k.GetFeeAllowance(ctx, granter, grantee)
+	if !found {
+		return types.ErrNoFeeAllowance(types.DefaultCodespace, granter, grantee)
+	}
+	left, remove, err := allowance.Accept(ctx.BlockTime(), fee, msgs)
+	if err != nil {
+		return types.ErrFeeAllowanceDenied(types.DefaultCodespace, err)
+	}
+	if remove {
+		k.DeleteFeeAllowance(ctx, granter, grantee)
+	} else {
+		k.SetFeeAllowance(ctx, left)
+	}
+	if sdkErr := k.SendCoinsFromAccountToModule(ctx, granter, types.FeeCollectorName, fee); sdkErr != nil {
+		return sdkErr
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUseFeeAllowance,
+			sdk.NewAttribute(types.AttributeKeyGranter, granter.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantee, grantee.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, fee.String()),
+		),
+	)
+	return nil
+}
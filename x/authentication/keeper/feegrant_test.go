@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/vipernet-xyz/viper-network/codec"
+	sdk "github.com/vipernet-xyz/viper-network/types"
+	"github.com/vipernet-xyz/viper-network/x/authentication/types"
+	servicersTypes "github.com/vipernet-xyz/viper-network/x/servicers/types"
+)
+
+func TestFeeAllowance(t *testing.T) {
+	ctx, keeper := createTestInput(t, false, initialPower, 1)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+	granter := newTestBaseAccount(initCoins)
+	keeper.SetAccount(ctx, granter)
+	grantee := newTestBaseAccount(nil).GetAddress()
+	fee := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultStakeDenom, 10000))
+	send := []sdk.Msg{&servicersTypes.MsgSend{FromAddress: grantee, ToAddress: grantee, Amount: sdk.OneInt()}}
+
+	// the allowances can't be granted or revoked before the activation
+	inactive := keeper.GrantFeeAllowance(ctx, types.NewFeeAllowance(granter.GetAddress(), grantee, fee, 2000, nil))
+	require.NotNil(t, inactive)
+	require.Equal(t, types.CodeFeeAllowanceInactive, inactive.Code())
+	inactive = keeper.RevokeFeeAllowance(ctx, granter.GetAddress(), grantee)
+	require.NotNil(t, inactive)
+	require.Equal(t, types.CodeFeeAllowanceInactive, inactive.Code())
+	_, found := keeper.GetFeeAllowance(ctx, granter.GetAddress(), grantee)
+	require.False(t, found)
+	codec.UpgradeFeatureMap[codec.FeeGrantKey] = -1
+	defer func() { codec.UpgradeFeatureMap[codec.FeeGrantKey] = 0 }()
+
+	// no allowance
+	err := keeper.UseGrantedFees(ctx, granter.GetAddress(), grantee, fee, send)
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeNoFeeAllowance, err.Code())
+	// an expired allowance can't be granted
+	require.Error(t, keeper.GrantFeeAllowance(ctx, types.NewFeeAllowance(granter.GetAddress(), grantee, nil, 1000, nil)))
+
+	allowance := types.NewFeeAllowance(granter.GetAddress(), grantee, fee.Add(fee), 2000, []string{servicersTypes.MsgSendName})
+	require.Nil(t, keeper.GrantFeeAllowance(ctx, allowance))
+	stored, found := keeper.GetFeeAllowance(ctx, granter.GetAddress(), grantee)
+	require.True(t, found)
+	require.Equal(t, allowance, stored)
+	require.Equal(t, []types.FeeAllowance{allowance}, keeper.GetFeeAllowances(ctx, grantee))
+	require.Empty(t, keeper.GetFeeAllowances(ctx, granter.GetAddress()))
+
+	// the messages must be allowed
+	stake := []sdk.Msg{&servicersTypes.MsgUnjail{ValidatorAddr: grantee, Signer: grantee}}
+	err = keeper.UseGrantedFees(ctx, granter.GetAddress(), grantee, fee, stake)
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeFeeAllowanceDenied, err.Code())
+	// the fee is paid by the granter and deducted from the spend limit
+	require.Nil(t, keeper.UseGrantedFees(ctx, granter.GetAddress(), grantee, fee, send))
+	require.Equal(t, initCoins.Sub(fee), keeper.GetCoins(ctx, granter.GetAddress()))
+	require.Equal(t, fee, getCoinsByName(ctx, keeper, types.FeeCollectorName))
+	stored, _ = keeper.GetFeeAllowance(ctx, granter.GetAddress(), grantee)
+	require.Equal(t, fee, stored.SpendLimit)
+	// the fee must not exceed the spend limit
+	require.NotNil(t, keeper.UseGrantedFees(ctx, granter.GetAddress(), grantee, fee.Add(fee), send))
+	// the used up allowance is removed
+	require.Nil(t, keeper.UseGrantedFees(ctx, granter.GetAddress(), grantee, fee, send))
+	_, found = keeper.GetFeeAllowance(ctx, granter.GetAddress(), grantee)
+	require.False(t, found)
+
+	// an allowance expires
+	require.Nil(t, keeper.GrantFeeAllowance(ctx, types.NewFeeAllowance(granter.GetAddress(), grantee, nil, 2000, nil)))
+	require.Nil(t, keeper.UseGrantedFees(ctx, granter.GetAddress(), grantee, fee, send))
+	require.NotNil(t, keeper.UseGrantedFees(ctx.WithBlockTime(time.Unix(2000, 0)), granter.GetAddress(), grantee, fee, send))
+	require.Len(t, keeper.GetAllFeeAllowances(ctx), 1)
+
+	// and is revoked
+	require.Nil(t, keeper.RevokeFeeAllowance(ctx, granter.GetAddress(), grantee))
+	require.NotNil(t, keeper.RevokeFeeAllowance(ctx, granter.GetAddress(), grantee))
+	require.Empty(t, keeper.GetAllFeeAllowances(ctx))
+}
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/vipernet-xyz/viper-network/types"
+	"github.com/vipernet-xyz/viper-network/x/authentication/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ types.QueryServer = queryServer{}
+
+type queryServer struct {
+	k Keeper
+}
+
+// NewQueryServer returns the implementation of the grpc query service of the module
+func NewQueryServer(k Keeper) types.QueryServer {
+	return queryServer{k: k}
+}
+
+// Supply implements the Query/Supply gRPC method
+func (q queryServer) Supply(c context.Context, req *types.QuerySupplyRequest) (*types.QuerySupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	supply := q.k.GetSupply(sdk.UnwrapSDKContext(c))
+	if supply == nil {
+		return nil, status.Error(codes.NotFound, "supply not found")
+	}
+	return &types.QuerySupplyResponse{Total: supply.GetTotal()}, nil
+}
+
+// Balance implements the Query/Balance gRPC method
+func (q queryServer) Balance(c context.Context, req *types.QueryBalanceRequest) (*types.QueryBalanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	addr, err := sdk.AddressFromHex(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryBalanceResponse{Balance: q.k.GetCoins(sdk.UnwrapSDKContext(c), addr)}, nil
+}
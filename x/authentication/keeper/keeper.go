@@ -14,6 +14,7 @@ import (
 type Keeper struct {
 	Cdc       *codec.Codec
 	POSKeeper types.PosKeeper
+	Router    sdk.Router
 	storeKey  sdk.StoreKey
 	subspace  sdk.Subspace
 	permAddrs map[string]types.PermissionsForAddress
@@ -62,11 +62,12 @@ func createTestInput(t *testing.T, isCheckTx bool, initPower int64, nAccs int64)
 	)
 	cdc := makeTestCodec()
 	maccPerms := map[string][]string{
-		holder:       nil,
-		types.Minter: {types.Minter},
-		types.Burner: {types.Burner},
-		multiPerm:    {types.Minter, types.Burner, types.Staking},
-		randomPerm:   {"random"},
+		holder:                 nil,
+		types.FeeCollectorName: nil,
+		types.Minter:           {types.Minter},
+		types.Burner:           {types.Burner},
+		multiPerm:              {types.Minter, types.Burner, types.Staking},
+		randomPerm:             {"random"},
 	}
 	keeper := NewKeeper(cdc, keyAcc, sdk.NewSubspace(types.StoreKey), maccPerms)
 	valTokens := sdk.TokensFromConsensusPower(initPower)
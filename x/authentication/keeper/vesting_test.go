@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	crypto "github.com/vipernet-xyz/viper-network/crypto/codec"
+	sdk "github.com/vipernet-xyz/viper-network/types"
+	"github.com/vipernet-xyz/viper-network/x/authentication/exported"
+	"github.com/vipernet-xyz/viper-network/x/authentication/types"
+)
+
+func newTestBaseAccount(coins sdk.Coins) *types.BaseAccount {
+	pk := crypto.GenerateEd25519PrivKey().PublicKey()
+	return &types.BaseAccount{Address: sdk.Address(pk.Address()), PubKey: pk, Coins: coins}
+}
+
+func TestVestingAccountEncoding(t *testing.T) {
+	ctx, keeper := createTestInput(t, false, initialPower, 1)
+	half := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, initTokens.QuoRaw(2)))
+	accs := []exported.VestingAccount{
+		types.NewContinuousVestingAccount(newTestBaseAccount(initCoins), 1000, 2000),
+		types.NewDelayedVestingAccount(newTestBaseAccount(initCoins), 2000),
+		types.NewPeriodicVestingAccount(newTestBaseAccount(initCoins), 1000, types.Periods{{Length: 100, Amount: half}, {Length: 200, Amount: half}}),
+	}
+	for _, acc := range accs {
+		acc.TrackDelegation(time.Unix(1500, 0), half)
+		keeper.SetAccount(ctx, acc)
+		require.Equal(t, acc, keeper.GetAccount(ctx, acc.GetAddress()))
+	}
+	// the base and module accounts aren't decoded as vesting accounts
+	require.NoError(t, holderAcc.SetCoins(initCoins))
+	keeper.SetModuleAccount(ctx, holderAcc)
+	_, isVesting := keeper.GetAccount(ctx, holderAcc.GetAddress()).(exported.VestingAccount)
+	require.False(t, isVesting)
+	// the fully staked vesting accounts are exported
+	require.NoError(t, accs[0].SetCoins(nil))
+	keeper.SetAccount(ctx, accs[0])
+	exportedAccs := keeper.GetAllAccountsExport(ctx)
+	require.Contains(t, exportedAccs, exported.Account(accs[0]))
+	require.Len(t, exportedAccs, len(accs)+2)
+}
+
+func TestDelegateVestingCoins(t *testing.T) {
+	ctx, keeper := createTestInput(t, false, initialPower, 1)
+	ctx = ctx.WithBlockTime(time.Unix(1000, 0))
+	half := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, initTokens.QuoRaw(2)))
+	acc := types.NewContinuousVestingAccount(newTestBaseAccount(initCoins), 1000, 2000)
+	addr := acc.GetAddress()
+	keeper.SetAccount(ctx, acc)
+	keeper.SetModuleAccount(ctx, holderAcc)
+	require.NoError(t, multiPermAcc.SetCoins(nil))
+	keeper.SetModuleAccount(ctx, multiPermAcc)
+	other := newTestBaseAccount(nil).GetAddress()
+
+	// the vesting coins can't be sent
+	require.Error(t, keeper.SendCoins(ctx, addr, other, half))
+	require.Error(t, keeper.SendCoinsFromAccountToModule(ctx, addr, multiPerm, half))
+	// but can be delegated to a staking module
+	require.Error(t, keeper.DelegateCoinsFromAccountToModule(ctx, addr, holder, half))
+	require.NoError(t, keeper.DelegateCoinsFromAccountToModule(ctx, addr, multiPerm, half))
+	require.Error(t, keeper.DelegateCoinsFromAccountToModule(ctx, addr, multiPerm, initCoins))
+	vacc := keeper.GetAccount(ctx, addr).(exported.VestingAccount)
+	require.Equal(t, half, vacc.GetCoins())
+	require.Equal(t, half, vacc.GetDelegatedVesting())
+	require.True(t, vacc.GetDelegatedFree().Empty())
+	require.Equal(t, half, getCoinsByName(ctx, keeper, multiPerm))
+
+	// the undelegated coins are still vesting
+	require.NoError(t, keeper.UndelegateCoinsFromModuleToAccount(ctx, multiPerm, addr, half))
+	vacc = keeper.GetAccount(ctx, addr).(exported.VestingAccount)
+	require.Equal(t, initCoins, vacc.GetCoins())
+	require.True(t, vacc.GetDelegatedVesting().Empty())
+	require.Error(t, keeper.SendCoins(ctx, addr, other, half))
+
+	// once vested the coins can be sent
+	ctx = ctx.WithBlockTime(time.Unix(1500, 0))
+	require.Equal(t, half, vacc.SpendableCoins(ctx.BlockHeader().Time))
+	require.NoError(t, keeper.SendCoins(ctx, addr, other, half))
+	require.Error(t, keeper.SendCoins(ctx, addr, other, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultStakeDenom, 1))))
+
+	// non vesting accounts delegate their coins as a send
+	require.NoError(t, keeper.DelegateCoinsFromAccountToModule(ctx, other, multiPerm, half))
+	require.True(t, keeper.GetCoins(ctx, other).Empty())
+}
+
+func TestCreateVestingAccountFromModule(t *testing.T) {
+	ctx, keeper := createTestInput(t, false, initialPower, 1)
+	require.NoError(t, holderAcc.SetCoins(initCoins))
+	keeper.SetModuleAccount(ctx, holderAcc)
+	half := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, initTokens.QuoRaw(2)))
+
+	acc := types.NewDelayedVestingAccount(newTestBaseAccount(half), 2000)
+	require.Error(t, keeper.CreateVestingAccountFromModule(ctx, "", acc))
+	require.NoError(t, keeper.CreateVestingAccountFromModule(ctx, holder, acc))
+	require.Equal(t, acc, keeper.GetAccount(ctx, acc.GetAddress()))
+	require.Equal(t, half, getCoinsByName(ctx, keeper, holder))
+	// the account must not exist
+	err := keeper.CreateVestingAccountFromModule(ctx, holder, acc)
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeAccountExists, err.Code())
+	// the module must hold the coins
+	require.Error(t, keeper.CreateVestingAccountFromModule(ctx, holder, types.NewDelayedVestingAccount(newTestBaseAccount(initCoins), 2000)))
+	// and the vesting schedule must be valid
+	err = keeper.CreateVestingAccountFromModule(ctx, holder, types.NewContinuousVestingAccount(newTestBaseAccount(half), 2000, 1000))
+	require.NotNil(t, err)
+	require.Equal(t, types.CodeInvalidVesting, err.Code())
+}
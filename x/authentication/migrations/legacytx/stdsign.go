@@ -22,7 +22,7 @@ import (
 // containing Amino signing method.
 // Deprecated: Please use `Msg` instead.
 type LegacyMsg interface {
-	sdk.Msg
+	sdk.Msg1
 
 	// Get the canonical byte representation of the Msg.
 	GetSignBytes() []byte
@@ -18,6 +18,14 @@ func NewQuerier(keeper keeper.Keeper) sdk.Querier {
 		switch path[0] {
 		case types.QueryAccount:
 			return queryAccount(ctx, req, keeper)
+		case types.QuerySequence:
+			return querySequence(ctx, req, keeper)
+		case types.QueryFeeAllowance:
+			return queryFeeAllowance(ctx, req, keeper)
+		case types.QueryFeeAllowances:
+			return queryFeeAllowances(ctx, req, keeper)
+		case types.QueryAuthorizations:
+			return queryAuthorizations(ctx, req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown authentication query endpoint")
 		}
@@ -44,3 +52,63 @@ func queryAccount(ctx sdk.Ctx, req abci.RequestQuery, keeper keeper.Keeper) ([]b
 
 	return bz, nil
 }
+
+func querySequence(ctx sdk.Ctx, req abci.RequestQuery, keeper keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAccountParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, keeper.GetNextSequence(ctx, params.Address))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryFeeAllowance(ctx sdk.Ctx, req abci.RequestQuery, keeper keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QueryFeeAllowanceParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	allowance, found := keeper.GetFeeAllowance(ctx, params.Granter, params.Grantee)
+	if !found {
+		return nil, types.ErrNoFeeAllowance(types.DefaultCodespace, params.Granter, params.Grantee)
+	}
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, allowance)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryFeeAllowances(ctx sdk.Ctx, req abci.RequestQuery, keeper keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAccountParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	allowances := keeper.GetFeeAllowances(ctx, params.Address)
+	if allowances == nil {
+		allowances = []types.FeeAllowance{}
+	}
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, allowances)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func queryAuthorizations(ctx sdk.Ctx, req abci.RequestQuery, keeper keeper.Keeper) ([]byte, sdk.Error) {
+	var params types.QueryAccountParams
+	if err := types.ModuleCdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	authorizations := keeper.GetAuthorizations(ctx, params.Address)
+	if authorizations == nil {
+		authorizations = []types.Authorization{}
+	}
+	bz, err := codec.MarshalJSONIndent(types.ModuleCdc, authorizations)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
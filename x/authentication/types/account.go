@@ -23,9 +23,10 @@ var _ codec.ProtoMarshaler = &BaseAccount{}
 
 // BaseAccount - a base account structure.
 type BaseAccount struct {
-	Address sdk.Address      `json:"address" yaml:"address"`
-	Coins   sdk.Coins        `json:"coins" yaml:"coins"`
-	PubKey  crypto.PublicKey `json:"public_key" yaml:"public_key"`
+	Address  sdk.Address      `json:"address" yaml:"address"`
+	Coins    sdk.Coins        `json:"coins" yaml:"coins"`
+	PubKey   crypto.PublicKey `json:"public_key" yaml:"public_key"`
+	Sequence uint64           `json:"sequence,omitempty" yaml:"sequence"` // sequence of the last tx signed by the account
 }
 
 func (acc *BaseAccount) Reset() {
@@ -89,8 +90,9 @@ func (acc *BaseAccount) String() string {
 	return fmt.Sprintf(`Account:
   Address:       %s
   Pubkey:        %s
-  Coins:         %s`,
-		acc.Address, pubkey, acc.Coins,
+  Coins:         %s
+  Sequence:      %d`,
+		acc.Address, pubkey, acc.Coins, acc.Sequence,
 	)
 }
 
@@ -130,6 +132,17 @@ func (acc *BaseAccount) SetCoins(coins sdk.Coins) error {
 	return nil
 }
 
+// GetSequence - Implements sdk.Account.
+func (acc *BaseAccount) GetSequence() uint64 {
+	return acc.Sequence
+}
+
+// SetSequence - Implements sdk.Account.
+func (acc *BaseAccount) SetSequence(seq uint64) error {
+	acc.Sequence = seq
+	return nil
+}
+
 // SpendableCoins returns the total set of spendable coins. For a base account,
 // this is simply the base coins.
 func (acc *BaseAccount) SpendableCoins(_ time.Time) sdk.Coins {
@@ -147,9 +160,10 @@ func (acc BaseAccount) MarshalYAML() (interface{}, error) {
 	}
 
 	bs, err = yaml.Marshal(marshalBaseAccount{
-		Address: acc.Address,
-		Coins:   acc.Coins,
-		PubKey:  pubkey,
+		Address:  acc.Address,
+		Coins:    acc.Coins,
+		PubKey:   pubkey,
+		Sequence: acc.Sequence,
 	})
 	if err != nil {
 		return nil, err
@@ -164,16 +178,18 @@ func (acc BaseAccount) ToProto() ProtoBaseAccount {
 		pk = acc.PubKey.RawBytes()
 	}
 	return ProtoBaseAccount{
-		Address: acc.Address,
-		Coins:   acc.Coins,
-		PubKey:  pk,
+		Address:  acc.Address,
+		Coins:    acc.Coins,
+		PubKey:   pk,
+		Sequence: acc.Sequence,
 	}
 }
 
 type marshalBaseAccount struct {
-	Address sdk.Address
-	Coins   sdk.Coins
-	PubKey  string
+	Address  sdk.Address
+	Coins    sdk.Coins
+	PubKey   string
+	Sequence uint64
 }
 
 // multisig account
@@ -185,6 +201,7 @@ type MultiSigAccount struct {
 	Address   sdk.Address              `json:"address"`
 	PublicKey crypto.PublicKeyMultiSig `json:"public_key_multi_sig"`
 	Coins     sdk.Coins                `json:"coins"`
+	Sequence  uint64                   `json:"sequence,omitempty"`
 }
 
 func (m MultiSigAccount) GetAddress() sdk.Address {
@@ -228,6 +245,15 @@ func (m *MultiSigAccount) SetCoins(c sdk.Coins) error {
 	return nil
 }
 
+func (m MultiSigAccount) GetSequence() uint64 {
+	return m.Sequence
+}
+
+func (m *MultiSigAccount) SetSequence(seq uint64) error {
+	m.Sequence = seq
+	return nil
+}
+
 func (m MultiSigAccount) SpendableCoins(blockTime time.Time) sdk.Coins {
 	return m.GetCoins()
 }
@@ -236,8 +262,9 @@ func (m MultiSigAccount) String() string {
 	return fmt.Sprintf(`
   Address:       %s
   Pubkey:        %s
-  Coins:         %s`,
-		m.Address, m.PublicKey, m.Coins,
+  Coins:         %s
+  Sequence:      %d`,
+		m.Address, m.PublicKey, m.Coins, m.Sequence,
 	)
 }
 
@@ -286,9 +313,10 @@ func (m *MultiSigAccount) Unmarshal(data []byte) error {
 
 func (m MultiSigAccount) ToProto() ProtoMultiSigAccount {
 	return ProtoMultiSigAccount{
-		Address: m.Address,
-		PubKey:  m.PublicKey.RawBytes(),
-		Coins:   m.Coins,
+		Address:  m.Address,
+		PubKey:   m.PublicKey.RawBytes(),
+		Coins:    m.Coins,
+		Sequence: m.Sequence,
 	}
 }
 
@@ -305,6 +333,7 @@ func (pms ProtoMultiSigAccount) FromProto() (MultiSigAccount, error) {
 		Address:   pms.Address,
 		PublicKey: pkms,
 		Coins:     pms.Coins,
+		Sequence:  pms.Sequence,
 	}, nil
 }
 
@@ -462,9 +491,10 @@ func (m *ProtoBaseAccount) FromProto() (ba BaseAccount, err error) {
 		}
 	}
 	return BaseAccount{
-		Address: m.Address,
-		Coins:   m.Coins,
-		PubKey:  pk,
+		Address:  m.Address,
+		Coins:    m.Coins,
+		PubKey:   pk,
+		Sequence: m.Sequence,
 	}, nil
 }
 
@@ -56,3 +56,21 @@ func TestHasPermissions(t *testing.T) {
 		}
 	}
 }
+
+func TestBaseAccountSequenceProto(t *testing.T) {
+	acc := NewBaseAccountWithAddress(sdk.Address(crypto.AddressHash([]byte("test"))))
+	require.NoError(t, acc.SetSequence(7))
+	bz, err := acc.Marshal()
+	require.NoError(t, err)
+	var decoded BaseAccount
+	require.NoError(t, decoded.Unmarshal(bz))
+	require.Equal(t, uint64(7), decoded.GetSequence())
+	// accounts without sequence keep their encoding
+	require.NoError(t, acc.SetSequence(0))
+	bz, err = acc.Marshal()
+	require.NoError(t, err)
+	legacy := ProtoBaseAccount{Address: acc.Address}
+	legacyBz, err := legacy.Marshal()
+	require.NoError(t, err)
+	require.Equal(t, legacyBz, bz)
+}
@@ -7,10 +7,10 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/regen-network/cosmos-proto"
 	types1 "github.com/vipernet-xyz/viper-network/codec/types"
 	github_com_viper_network_viper_core_types "github.com/vipernet-xyz/viper-network/types"
 	types "github.com/vipernet-xyz/viper-network/types"
-	_ "github.com/regen-network/cosmos-proto"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -31,9 +31,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // for basic account functionality. Any custom account type should extend this
 // type for additional functionality (e.g. vesting).
 type ProtoBaseAccount struct {
-	Address github_com_viper_network_viper_core_types.Address `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/vipernet-xyz/viper-network/types.Address" json:"address"`
-	PubKey  []byte                                            `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"public_key" yaml:"public_key"`
-	Coins   github_com_viper_network_viper_core_types.Coins   `protobuf:"bytes,3,rep,name=coins,proto3,castrepeated=github.com/vipernet-xyz/viper-network/types.Coins" json:"coins"`
+	Address  github_com_viper_network_viper_core_types.Address `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/vipernet-xyz/viper-network/types.Address" json:"address"`
+	PubKey   []byte                                            `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"public_key" yaml:"public_key"`
+	Coins    github_com_viper_network_viper_core_types.Coins   `protobuf:"bytes,3,rep,name=coins,proto3,castrepeated=github.com/vipernet-xyz/viper-network/types.Coins" json:"coins"`
+	Sequence uint64                                            `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty" yaml:"sequence"`
 }
 
 func (m *ProtoBaseAccount) Reset()         { *m = ProtoBaseAccount{} }
@@ -118,9 +119,10 @@ func (*ProtoModuleAccount) XXX_MessageName() string {
 }
 
 type ProtoMultiSigAccount struct {
-	Address github_com_viper_network_viper_core_types.Address `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/vipernet-xyz/viper-network/types.Address" json:"address"`
-	PubKey  []byte                                            `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"public_key_multi_sig" yaml:"public_key_multi_sig"`
-	Coins   github_com_viper_network_viper_core_types.Coins   `protobuf:"bytes,3,rep,name=coins,proto3,castrepeated=github.com/vipernet-xyz/viper-network/types.Coins" json:"coins"`
+	Address  github_com_viper_network_viper_core_types.Address `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/vipernet-xyz/viper-network/types.Address" json:"address"`
+	PubKey   []byte                                            `protobuf:"bytes,2,opt,name=pub_key,json=pubKey,proto3" json:"public_key_multi_sig" yaml:"public_key_multi_sig"`
+	Coins    github_com_viper_network_viper_core_types.Coins   `protobuf:"bytes,3,rep,name=coins,proto3,castrepeated=github.com/vipernet-xyz/viper-network/types.Coins" json:"coins"`
+	Sequence uint64                                            `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty" yaml:"sequence"`
 }
 
 func (m *ProtoMultiSigAccount) Reset()         { *m = ProtoMultiSigAccount{} }
@@ -160,6 +162,234 @@ func (*ProtoMultiSigAccount) XXX_MessageName() string {
 	return "x.authentication.ProtoMultiSigAccount"
 }
 
+// ProtoBaseVestingAccount implements the common fields of the vesting accounts
+type ProtoBaseVestingAccount struct {
+	ProtoBaseAccount `protobuf:"bytes,1,opt,name=base_account,json=baseAccount,proto3,embedded=base_account" json:"base_account" yaml:"base_account"`
+	OriginalVesting  github_com_viper_network_viper_core_types.Coins `protobuf:"bytes,2,rep,name=original_vesting,json=originalVesting,proto3,castrepeated=github.com/vipernet-xyz/viper-network/types.Coins" json:"original_vesting" yaml:"original_vesting"`
+	DelegatedFree    github_com_viper_network_viper_core_types.Coins `protobuf:"bytes,3,rep,name=delegated_free,json=delegatedFree,proto3,castrepeated=github.com/vipernet-xyz/viper-network/types.Coins" json:"delegated_free" yaml:"delegated_free"`
+	DelegatedVesting github_com_viper_network_viper_core_types.Coins `protobuf:"bytes,4,rep,name=delegated_vesting,json=delegatedVesting,proto3,castrepeated=github.com/vipernet-xyz/viper-network/types.Coins" json:"delegated_vesting" yaml:"delegated_vesting"`
+	EndTime          int64                                           `protobuf:"varint,5,opt,name=end_time,json=endTime,proto3" json:"end_time" yaml:"end_time"`
+}
+
+func (m *ProtoBaseVestingAccount) Reset()         { *m = ProtoBaseVestingAccount{} }
+func (m *ProtoBaseVestingAccount) String() string { return proto.CompactTextString(m) }
+func (*ProtoBaseVestingAccount) ProtoMessage()    {}
+func (*ProtoBaseVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_840f82faebe7fabc, []int{3}
+}
+func (m *ProtoBaseVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProtoBaseVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProtoBaseVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProtoBaseVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProtoBaseVestingAccount.Merge(m, src)
+}
+func (m *ProtoBaseVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProtoBaseVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProtoBaseVestingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProtoBaseVestingAccount proto.InternalMessageInfo
+
+func (*ProtoBaseVestingAccount) XXX_MessageName() string {
+	return "x.authentication.ProtoBaseVestingAccount"
+}
+
+// ProtoContinuousVestingAccount vests its coins linearly between the start and the end time
+type ProtoContinuousVestingAccount struct {
+	ProtoBaseVestingAccount `protobuf:"bytes,1,opt,name=base_vesting_account,json=baseVestingAccount,proto3,embedded=base_vesting_account" json:"base_vesting_account" yaml:"base_vesting_account"`
+	StartTime               int64 `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time" yaml:"start_time"`
+}
+
+func (m *ProtoContinuousVestingAccount) Reset()         { *m = ProtoContinuousVestingAccount{} }
+func (m *ProtoContinuousVestingAccount) String() string { return proto.CompactTextString(m) }
+func (*ProtoContinuousVestingAccount) ProtoMessage()    {}
+func (*ProtoContinuousVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_840f82faebe7fabc, []int{4}
+}
+func (m *ProtoContinuousVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProtoContinuousVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProtoContinuousVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProtoContinuousVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProtoContinuousVestingAccount.Merge(m, src)
+}
+func (m *ProtoContinuousVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProtoContinuousVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProtoContinuousVestingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProtoContinuousVestingAccount proto.InternalMessageInfo
+
+func (*ProtoContinuousVestingAccount) XXX_MessageName() string {
+	return "x.authentication.ProtoContinuousVestingAccount"
+}
+
+// ProtoDelayedVestingAccount vests all of its coins at the end time
+type ProtoDelayedVestingAccount struct {
+	ProtoBaseVestingAccount `protobuf:"bytes,1,opt,name=base_vesting_account,json=baseVestingAccount,proto3,embedded=base_vesting_account" json:"base_vesting_account" yaml:"base_vesting_account"`
+}
+
+func (m *ProtoDelayedVestingAccount) Reset()         { *m = ProtoDelayedVestingAccount{} }
+func (m *ProtoDelayedVestingAccount) String() string { return proto.CompactTextString(m) }
+func (*ProtoDelayedVestingAccount) ProtoMessage()    {}
+func (*ProtoDelayedVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_840f82faebe7fabc, []int{5}
+}
+func (m *ProtoDelayedVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProtoDelayedVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProtoDelayedVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProtoDelayedVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProtoDelayedVestingAccount.Merge(m, src)
+}
+func (m *ProtoDelayedVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProtoDelayedVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProtoDelayedVestingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProtoDelayedVestingAccount proto.InternalMessageInfo
+
+func (*ProtoDelayedVestingAccount) XXX_MessageName() string {
+	return "x.authentication.ProtoDelayedVestingAccount"
+}
+
+// Period defines the length in seconds of a vesting period and the amount vested at its end
+type Period struct {
+	Length int64                                           `protobuf:"varint,1,opt,name=length,proto3" json:"length" yaml:"length"`
+	Amount github_com_viper_network_viper_core_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/vipernet-xyz/viper-network/types.Coins" json:"amount" yaml:"amount"`
+}
+
+func (m *Period) Reset()         { *m = Period{} }
+func (m *Period) String() string { return proto.CompactTextString(m) }
+func (*Period) ProtoMessage()    {}
+func (*Period) Descriptor() ([]byte, []int) {
+	return fileDescriptor_840f82faebe7fabc, []int{6}
+}
+func (m *Period) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Period) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Period.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Period) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Period.Merge(m, src)
+}
+func (m *Period) XXX_Size() int {
+	return m.Size()
+}
+func (m *Period) XXX_DiscardUnknown() {
+	xxx_messageInfo_Period.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Period proto.InternalMessageInfo
+
+func (m *Period) GetLength() int64 {
+	if m != nil {
+		return m.Length
+	}
+	return 0
+}
+
+func (m *Period) GetAmount() github_com_viper_network_viper_core_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// ProtoPeriodicVestingAccount vests its coins at the end of each consecutive period
+type ProtoPeriodicVestingAccount struct {
+	ProtoBaseVestingAccount `protobuf:"bytes,1,opt,name=base_vesting_account,json=baseVestingAccount,proto3,embedded=base_vesting_account" json:"base_vesting_account" yaml:"base_vesting_account"`
+	StartTime               int64   `protobuf:"varint,2,opt,name=start_time,json=startTime,proto3" json:"start_time" yaml:"start_time"`
+	VestingPeriods          Periods `protobuf:"bytes,3,rep,name=vesting_periods,json=vestingPeriods,proto3,castrepeated=Periods" json:"vesting_periods" yaml:"vesting_periods"`
+}
+
+func (m *ProtoPeriodicVestingAccount) Reset()         { *m = ProtoPeriodicVestingAccount{} }
+func (m *ProtoPeriodicVestingAccount) String() string { return proto.CompactTextString(m) }
+func (*ProtoPeriodicVestingAccount) ProtoMessage()    {}
+func (*ProtoPeriodicVestingAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_840f82faebe7fabc, []int{7}
+}
+func (m *ProtoPeriodicVestingAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProtoPeriodicVestingAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProtoPeriodicVestingAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProtoPeriodicVestingAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProtoPeriodicVestingAccount.Merge(m, src)
+}
+func (m *ProtoPeriodicVestingAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProtoPeriodicVestingAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProtoPeriodicVestingAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProtoPeriodicVestingAccount proto.InternalMessageInfo
+
+func (*ProtoPeriodicVestingAccount) XXX_MessageName() string {
+	return "x.authentication.ProtoPeriodicVestingAccount"
+}
+
 // Fee Multiplier derfines a key value multiplier for the fee of the
 type FeeMultiplier struct {
 	Key        string `protobuf:"bytes,1,opt,name=key,proto3" json:"key"`
@@ -170,7 +400,7 @@ func (m *FeeMultiplier) Reset()         { *m = FeeMultiplier{} }
 func (m *FeeMultiplier) String() string { return proto.CompactTextString(m) }
 func (*FeeMultiplier) ProtoMessage()    {}
 func (*FeeMultiplier) Descriptor() ([]byte, []int) {
-	return fileDescriptor_840f82faebe7fabc, []int{3}
+	return fileDescriptor_840f82faebe7fabc, []int{8}
 }
 func (m *FeeMultiplier) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -222,7 +452,7 @@ func (m *FeeMultipliers) Reset()         { *m = FeeMultipliers{} }
 func (m *FeeMultipliers) String() string { return proto.CompactTextString(m) }
 func (*FeeMultipliers) ProtoMessage()    {}
 func (*FeeMultipliers) Descriptor() ([]byte, []int) {
-	return fileDescriptor_840f82faebe7fabc, []int{4}
+	return fileDescriptor_840f82faebe7fabc, []int{9}
 }
 func (m *FeeMultipliers) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -272,7 +502,7 @@ type Supply struct {
 func (m *Supply) Reset()      { *m = Supply{} }
 func (*Supply) ProtoMessage() {}
 func (*Supply) Descriptor() ([]byte, []int) {
-	return fileDescriptor_840f82faebe7fabc, []int{5}
+	return fileDescriptor_840f82faebe7fabc, []int{10}
 }
 func (m *Supply) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -302,18 +532,21 @@ func (m *Supply) XXX_DiscardUnknown() {
 var xxx_messageInfo_Supply proto.InternalMessageInfo
 
 type ProtoStdTx struct {
-	Msg       types1.Any                                      `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg" yaml:"msg"`
-	Fee       github_com_viper_network_viper_core_types.Coins `protobuf:"bytes,2,rep,name=fee,proto3,castrepeated=github.com/vipernet-xyz/viper-network/types.Coins" json:"fee" yaml:"fee"`
-	Signature ProtoStdSignature                               `protobuf:"bytes,3,opt,name=signature,proto3,casttype=ProtoStdSignature" json:"signature" yaml:"signature"`
-	Memo      string                                          `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo" yaml:"memo"`
-	Entropy   int64                                           `protobuf:"varint,5,opt,name=entropy,proto3" json:"entropy" yaml:"entropy"`
+	Msg            types1.Any                                        `protobuf:"bytes,1,opt,name=msg,proto3" json:"msg" yaml:"msg"`
+	Fee            github_com_viper_network_viper_core_types.Coins   `protobuf:"bytes,2,rep,name=fee,proto3,castrepeated=github.com/vipernet-xyz/viper-network/types.Coins" json:"fee" yaml:"fee"`
+	Signature      ProtoStdSignature                                 `protobuf:"bytes,3,opt,name=signature,proto3,casttype=ProtoStdSignature" json:"signature" yaml:"signature"`
+	Memo           string                                            `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo" yaml:"memo"`
+	Entropy        int64                                             `protobuf:"varint,5,opt,name=entropy,proto3" json:"entropy" yaml:"entropy"`
+	AdditionalMsgs []types1.Any                                      `protobuf:"bytes,6,rep,name=additional_msgs,json=additionalMsgs,proto3" json:"additional_msgs,omitempty" yaml:"additional_msgs"`
+	Sequence       uint64                                            `protobuf:"varint,7,opt,name=sequence,proto3" json:"sequence,omitempty" yaml:"sequence"`
+	FeeGranter     github_com_viper_network_viper_core_types.Address `protobuf:"bytes,8,opt,name=fee_granter,json=feeGranter,proto3,casttype=github.com/vipernet-xyz/viper-network/types.Address" json:"fee_granter,omitempty" yaml:"fee_granter"`
 }
 
 func (m *ProtoStdTx) Reset()         { *m = ProtoStdTx{} }
 func (m *ProtoStdTx) String() string { return proto.CompactTextString(m) }
 func (*ProtoStdTx) ProtoMessage()    {}
 func (*ProtoStdTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_840f82faebe7fabc, []int{6}
+	return fileDescriptor_840f82faebe7fabc, []int{11}
 }
 func (m *ProtoStdTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -355,7 +588,7 @@ func (m *ProtoStdSignature) Reset()         { *m = ProtoStdSignature{} }
 func (m *ProtoStdSignature) String() string { return proto.CompactTextString(m) }
 func (*ProtoStdSignature) ProtoMessage()    {}
 func (*ProtoStdSignature) Descriptor() ([]byte, []int) {
-	return fileDescriptor_840f82faebe7fabc, []int{7}
+	return fileDescriptor_840f82faebe7fabc, []int{12}
 }
 func (m *ProtoStdSignature) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -403,18 +636,20 @@ func (*ProtoStdSignature) XXX_MessageName() string {
 }
 
 type StdSignDoc struct {
-	ChainID string                                        `protobuf:"bytes,1,opt,name=ChainID,proto3" json:"chain_id" yaml:"chain_id"`
-	Fee     github_com_viper_network_viper_core_types.Raw `protobuf:"bytes,2,opt,name=fee,proto3,casttype=github.com/vipernet-xyz/viper-network/types.Raw" json:"fee" yaml:"fee"`
-	Memo    string                                        `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo" yaml:"memo"`
-	Msg     github_com_viper_network_viper_core_types.Raw `protobuf:"bytes,4,opt,name=msg,proto3,casttype=github.com/vipernet-xyz/viper-network/types.Raw" json:"msg" yaml:"msg"`
-	Entropy int64                                         `protobuf:"varint,5,opt,name=entropy,proto3" json:"entropy" yaml:"entropy"`
+	ChainID    string                                            `protobuf:"bytes,1,opt,name=ChainID,proto3" json:"chain_id" yaml:"chain_id"`
+	Fee        github_com_viper_network_viper_core_types.Raw     `protobuf:"bytes,2,opt,name=fee,proto3,casttype=github.com/vipernet-xyz/viper-network/types.Raw" json:"fee" yaml:"fee"`
+	Memo       string                                            `protobuf:"bytes,3,opt,name=memo,proto3" json:"memo" yaml:"memo"`
+	Msg        github_com_viper_network_viper_core_types.Raw     `protobuf:"bytes,4,opt,name=msg,proto3,casttype=github.com/vipernet-xyz/viper-network/types.Raw" json:"msg" yaml:"msg"`
+	Entropy    int64                                             `protobuf:"varint,5,opt,name=entropy,proto3" json:"entropy" yaml:"entropy"`
+	Sequence   uint64                                            `protobuf:"varint,6,opt,name=sequence,proto3" json:"sequence,omitempty" yaml:"sequence"`
+	FeeGranter github_com_viper_network_viper_core_types.Address `protobuf:"bytes,7,opt,name=fee_granter,json=feeGranter,proto3,casttype=github.com/vipernet-xyz/viper-network/types.Address" json:"fee_granter,omitempty" yaml:"fee_granter"`
 }
 
 func (m *StdSignDoc) Reset()         { *m = StdSignDoc{} }
 func (m *StdSignDoc) String() string { return proto.CompactTextString(m) }
 func (*StdSignDoc) ProtoMessage()    {}
 func (*StdSignDoc) Descriptor() ([]byte, []int) {
-	return fileDescriptor_840f82faebe7fabc, []int{8}
+	return fileDescriptor_840f82faebe7fabc, []int{13}
 }
 func (m *StdSignDoc) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -450,6 +685,11 @@ func init() {
 	proto.RegisterType((*ProtoBaseAccount)(nil), "x.authentication.ProtoBaseAccount")
 	proto.RegisterType((*ProtoModuleAccount)(nil), "x.authentication.ProtoModuleAccount")
 	proto.RegisterType((*ProtoMultiSigAccount)(nil), "x.authentication.ProtoMultiSigAccount")
+	proto.RegisterType((*ProtoBaseVestingAccount)(nil), "x.authentication.ProtoBaseVestingAccount")
+	proto.RegisterType((*ProtoContinuousVestingAccount)(nil), "x.authentication.ProtoContinuousVestingAccount")
+	proto.RegisterType((*ProtoDelayedVestingAccount)(nil), "x.authentication.ProtoDelayedVestingAccount")
+	proto.RegisterType((*Period)(nil), "x.authentication.Period")
+	proto.RegisterType((*ProtoPeriodicVestingAccount)(nil), "x.authentication.ProtoPeriodicVestingAccount")
 	proto.RegisterType((*FeeMultiplier)(nil), "x.authentication.FeeMultiplier")
 	proto.RegisterType((*FeeMultipliers)(nil), "x.authentication.FeeMultipliers")
 	proto.RegisterType((*Supply)(nil), "x.authentication.Supply")
@@ -458,73 +698,139 @@ func init() {
 	proto.RegisterType((*StdSignDoc)(nil), "x.authentication.StdSignDoc")
 }
 
-func init() { proto.RegisterFile("x/authentication/authentication.proto", fileDescriptor_840f82faebe7fabc) }
+func init() {
+	proto.RegisterFile("x/authentication/authentication.proto", fileDescriptor_840f82faebe7fabc)
+}
 
 var fileDescriptor_840f82faebe7fabc = []byte{
-	// 967 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xc6, 0x49, 0x1c, 0x8f, 0xdb, 0x90, 0x0c, 0xa6, 0xb5, 0x8b, 0xea, 0x29, 0x83, 0x2a,
-	0x55, 0x42, 0xd9, 0x85, 0x72, 0x88, 0x30, 0x48, 0x34, 0xdb, 0x52, 0x04, 0x01, 0xa9, 0x5a, 0x73,
-	0xa8, 0x72, 0xb1, 0xf6, 0xc7, 0x78, 0xb3, 0xf2, 0xee, 0xce, 0xca, 0xbb, 0xab, 0xc4, 0xff, 0x41,
-	0x4f, 0x88, 0x1b, 0x88, 0x53, 0xca, 0x91, 0x33, 0x17, 0xfe, 0x83, 0x1e, 0x23, 0x4e, 0x9c, 0x06,
-	0x94, 0x5c, 0x90, 0xb9, 0xed, 0x31, 0x27, 0x34, 0x3f, 0x36, 0x6b, 0x27, 0x48, 0x44, 0x41, 0x82,
-	0x8b, 0x35, 0xef, 0xdb, 0xf7, 0xde, 0x7c, 0xf3, 0xcd, 0xf7, 0x46, 0x06, 0x9b, 0x87, 0x86, 0x9d,
-	0x67, 0xfb, 0xe2, 0x47, 0x4f, 0x26, 0x34, 0xa3, 0x70, 0xf5, 0x50, 0xe7, 0xd1, 0x9d, 0xae, 0x4b,
-	0xd3, 0x88, 0xa6, 0x43, 0x81, 0x1a, 0x32, 0x90, 0x29, 0x77, 0xda, 0x3e, 0xf5, 0xa9, 0xc4, 0xf9,
-	0x4a, 0xa1, 0x1b, 0xd9, 0x34, 0x21, 0xa9, 0xe1, 0xd2, 0x20, 0x56, 0x48, 0xd7, 0xa7, 0xd4, 0x0f,
-	0x89, 0x21, 0x22, 0x27, 0x1f, 0x19, 0x76, 0x3c, 0x95, 0x9f, 0xf0, 0xcb, 0x25, 0xb0, 0xf1, 0x8c,
-	0xaf, 0x4c, 0x3b, 0x25, 0x3b, 0xae, 0x4b, 0xf3, 0x38, 0x83, 0x7b, 0xa0, 0x61, 0x7b, 0xde, 0x84,
-	0xa4, 0x69, 0x47, 0xbb, 0xa7, 0x3d, 0xb8, 0x61, 0x3e, 0x9a, 0x31, 0x54, 0x42, 0x67, 0x0c, 0xbd,
-	0xe7, 0x07, 0xd9, 0x7e, 0xee, 0xe8, 0x2e, 0x8d, 0x8c, 0x84, 0x8e, 0xb3, 0xad, 0x98, 0x64, 0x07,
-	0x74, 0x32, 0x36, 0x12, 0xea, 0x8e, 0x49, 0xb6, 0xe5, 0xd2, 0x09, 0x31, 0x04, 0x0b, 0x7d, 0x47,
-	0x16, 0x59, 0x65, 0x35, 0xfc, 0x08, 0x34, 0x92, 0xdc, 0x19, 0x8e, 0xc9, 0xb4, 0xb3, 0x24, 0x7a,
-	0xbf, 0x3d, 0x63, 0x08, 0x24, 0xb9, 0x13, 0x06, 0x2e, 0x47, 0x0b, 0x86, 0x36, 0xa7, 0x76, 0x14,
-	0xf6, 0x71, 0x85, 0x61, 0x6b, 0x35, 0xc9, 0x9d, 0x5d, 0x32, 0x85, 0x7b, 0x60, 0x85, 0x9f, 0x2b,
-	0xed, 0xd4, 0xef, 0xd5, 0x1f, 0xb4, 0x1e, 0xb6, 0x74, 0xb9, 0xcb, 0x63, 0x1a, 0xc4, 0xe6, 0xf6,
-	0x2b, 0x86, 0x6a, 0x3f, 0xfe, 0x86, 0x8c, 0xab, 0xb3, 0xe3, 0x75, 0xa9, 0x25, 0x5b, 0xf6, 0x6f,
-	0xbf, 0x38, 0x42, 0xb5, 0xef, 0x8e, 0x90, 0xf6, 0xe2, 0x07, 0xa4, 0xfd, 0xf2, 0xd3, 0x56, 0x43,
-	0xc9, 0x81, 0xbf, 0x5f, 0x02, 0x50, 0x68, 0xf4, 0x25, 0xf5, 0xf2, 0xf0, 0x5c, 0x25, 0x0a, 0x6e,
-	0x39, 0x76, 0x4a, 0x86, 0xb6, 0x8c, 0x87, 0x24, 0x76, 0xa9, 0x67, 0x3b, 0x21, 0x11, 0xa2, 0xb5,
-	0x1e, 0x76, 0x74, 0x79, 0x83, 0xfa, 0x45, 0x7d, 0x4d, 0xc4, 0x99, 0x1e, 0x33, 0xa4, 0x15, 0x0c,
-	0xbd, 0x2e, 0x0f, 0x3b, 0xdf, 0x09, 0x5b, 0x6d, 0xa7, 0xca, 0xfe, 0xa4, 0x6c, 0x0b, 0xdf, 0x01,
-	0xcb, 0xb1, 0x1d, 0x11, 0xa1, 0x5b, 0xd3, 0xbc, 0x3d, 0x63, 0x48, 0xc4, 0x05, 0x43, 0x2d, 0xd9,
-	0x84, 0x47, 0xd8, 0x12, 0x20, 0xfc, 0x14, 0xb4, 0x12, 0x32, 0x89, 0x82, 0x34, 0x0d, 0xa8, 0xd2,
-	0xab, 0x69, 0xde, 0x9f, 0x31, 0x34, 0x0f, 0x17, 0x0c, 0x41, 0x25, 0x76, 0x05, 0x62, 0x6b, 0x3e,
-	0xa5, 0x7f, 0xf7, 0x82, 0x2c, 0x37, 0x17, 0x54, 0xc0, 0x3f, 0x2f, 0x81, 0xb6, 0x14, 0x27, 0x0f,
-	0xb3, 0x60, 0x10, 0xf8, 0xff, 0x85, 0x89, 0x9e, 0x5d, 0x34, 0xd1, 0xf6, 0x8c, 0xa1, 0x76, 0x65,
-	0x98, 0x61, 0xc4, 0xc9, 0x0c, 0xd3, 0xc0, 0x2f, 0x18, 0x7a, 0xf3, 0xa2, 0x9d, 0xaa, 0xaf, 0xff,
-	0xb3, 0xb1, 0x3c, 0x70, 0xf3, 0x29, 0x21, 0x42, 0xb8, 0x24, 0x0c, 0xc8, 0x04, 0x76, 0x41, 0x9d,
-	0x9f, 0x49, 0x13, 0x17, 0xdc, 0x98, 0x31, 0xc4, 0x43, 0x8b, 0xff, 0x40, 0x1d, 0x80, 0xe8, 0x3c,
-	0x51, 0x9c, 0xba, 0x6e, 0xae, 0xf3, 0xd1, 0xa9, 0x50, 0x6b, 0x6e, 0xdd, 0x5f, 0xe3, 0x1b, 0xfe,
-	0x71, 0x84, 0x34, 0xfc, 0xb5, 0x06, 0xd6, 0x17, 0xb6, 0x49, 0xe1, 0x2e, 0x68, 0x8e, 0x14, 0xc2,
-	0x6f, 0x87, 0x9f, 0xf8, 0x8d, 0xd2, 0xad, 0x0b, 0xa9, 0xe6, 0x2d, 0x7e, 0xf6, 0x19, 0x43, 0xeb,
-	0x23, 0x42, 0x86, 0x73, 0x5b, 0x55, 0xf5, 0xf0, 0x3e, 0x68, 0x78, 0x64, 0x64, 0xe7, 0x61, 0xa6,
-	0x68, 0xb5, 0xf8, 0x45, 0x2b, 0xc8, 0x2a, 0x17, 0x73, 0x84, 0x0e, 0xc0, 0xea, 0x20, 0x4f, 0x92,
-	0x70, 0x0a, 0x5d, 0xb0, 0x92, 0xd1, 0xcc, 0x0e, 0x15, 0x87, 0x05, 0xd5, 0x1f, 0xa9, 0x9d, 0x65,
-	0xc6, 0xb5, 0xe4, 0x17, 0x95, 0xfd, 0x35, 0x25, 0x7f, 0x0d, 0xbf, 0xac, 0x03, 0x20, 0xbc, 0x3a,
-	0xc8, 0xbc, 0xaf, 0x0e, 0xe1, 0x0e, 0xa8, 0x47, 0xa9, 0xaf, 0xa6, 0xb5, 0xad, 0xcb, 0x47, 0x52,
-	0x2f, 0x1f, 0x49, 0x7d, 0x27, 0x9e, 0x9a, 0x5d, 0x45, 0x82, 0x27, 0x16, 0x0c, 0x01, 0x69, 0xa5,
-	0x28, 0xf5, 0xb1, 0xc5, 0x21, 0x38, 0x06, 0xf5, 0x11, 0xe1, 0x13, 0x79, 0x89, 0xfe, 0x17, 0x65,
-	0xe5, 0x88, 0x90, 0xaa, 0x72, 0x44, 0x08, 0xbe, 0xce, 0x51, 0x78, 0x17, 0x98, 0x82, 0x66, 0x1a,
-	0xf8, 0xb1, 0x9d, 0xe5, 0x13, 0xd2, 0xa9, 0x0b, 0xd6, 0xdd, 0x85, 0x37, 0x66, 0x90, 0x79, 0x83,
-	0x32, 0xc1, 0xec, 0x2b, 0x02, 0x55, 0x4d, 0xc1, 0xd0, 0x86, 0xa4, 0x71, 0x0e, 0xe1, 0x33, 0x86,
-	0x36, 0x2f, 0xd5, 0x5a, 0x55, 0x0d, 0x7f, 0x74, 0x22, 0x12, 0xd1, 0xce, 0x72, 0xf5, 0xe8, 0xf0,
-	0xb8, 0x7a, 0x74, 0x78, 0x84, 0x2d, 0x01, 0xc2, 0x6d, 0xd0, 0x20, 0x71, 0x36, 0xa1, 0xc9, 0xb4,
-	0xb3, 0x22, 0xac, 0x70, 0x97, 0x5b, 0x41, 0x41, 0x05, 0x43, 0xeb, 0xb2, 0x44, 0x01, 0xd8, 0x2a,
-	0x3f, 0xc9, 0x3b, 0xe2, 0xe3, 0x81, 0xbf, 0xd5, 0xc0, 0x65, 0x42, 0xf0, 0x43, 0xd0, 0x94, 0xf3,
-	0xbb, 0xab, 0xc6, 0xe3, 0x86, 0x6c, 0xad, 0x5e, 0x81, 0xaa, 0xb5, 0x02, 0xb0, 0x55, 0xe5, 0xc3,
-	0x8f, 0x41, 0xf3, 0xbc, 0x93, 0x7a, 0x2f, 0xde, 0xfa, 0x47, 0x61, 0xac, 0xaa, 0xa6, 0xbf, 0x2c,
-	0x98, 0xfd, 0xb9, 0x04, 0x80, 0x22, 0xf5, 0x84, 0xba, 0xf0, 0x03, 0xd0, 0x78, 0xbc, 0x6f, 0x07,
-	0xf1, 0x67, 0x4f, 0xd4, 0xbc, 0xa2, 0x19, 0x43, 0x6b, 0x2e, 0x87, 0x86, 0x81, 0x57, 0x30, 0xf4,
-	0x9a, 0x6c, 0x59, 0x22, 0xd8, 0x2a, 0xf3, 0xe1, 0xf3, 0xd2, 0x35, 0x9c, 0xca, 0xd3, 0xbf, 0x35,
-	0xc9, 0x19, 0x43, 0x5b, 0x57, 0x37, 0x89, 0x65, 0x1f, 0x48, 0x8b, 0x94, 0xb7, 0x55, 0xbf, 0xca,
-	0x6d, 0x3d, 0x97, 0xfe, 0x5f, 0xae, 0x68, 0x5c, 0x72, 0xf9, 0x35, 0x68, 0xf0, 0xb1, 0xf8, 0xf7,
-	0x3e, 0x30, 0x3f, 0x7f, 0x75, 0xd2, 0xd3, 0x8e, 0x4f, 0x7a, 0xda, 0xef, 0x27, 0x3d, 0xed, 0x9b,
-	0xd3, 0x5e, 0xed, 0xf8, 0xb4, 0x57, 0xfb, 0xf5, 0xb4, 0x57, 0xdb, 0x7b, 0xf7, 0x2a, 0x84, 0xd4,
-	0xff, 0x29, 0xc1, 0xcb, 0x59, 0x15, 0x33, 0xfd, 0xfe, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xdf,
-	0x08, 0xe4, 0xc3, 0x66, 0x09, 0x00, 0x00,
-}
+	// 1483 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xcd, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xc6, 0x8e, 0x1d, 0x8f, 0xf3, 0xd5, 0x21, 0x6d, 0x9c, 0x44, 0xcd, 0x84, 0xad, 0x2a,
+	0x52, 0x41, 0x6d, 0xd1, 0x1e, 0xaa, 0xba, 0x95, 0x50, 0xb6, 0x5f, 0xa0, 0xaa, 0xa8, 0xda, 0x94,
+	0x1e, 0xaa, 0x4a, 0xd6, 0xda, 0x3b, 0xde, 0x2c, 0xdd, 0x0f, 0xb3, 0x3b, 0xdb, 0xc6, 0x95, 0x10,
+	0x97, 0x4a, 0xf4, 0xc8, 0x8d, 0x1e, 0x23, 0xd4, 0x13, 0x27, 0x90, 0xf8, 0x03, 0x10, 0xa7, 0x1e,
+	0x2b, 0x4e, 0x9c, 0x06, 0xd4, 0x5c, 0x90, 0xc5, 0xc9, 0x17, 0x50, 0x4e, 0x68, 0x3e, 0xd6, 0xbb,
+	0x5e, 0xa7, 0xd0, 0x9a, 0x0a, 0x50, 0x2f, 0x91, 0xdf, 0x6f, 0xde, 0x7b, 0xf3, 0x3e, 0x7e, 0x33,
+	0xf3, 0x36, 0xe0, 0xf8, 0x4e, 0xcd, 0x88, 0xc8, 0x36, 0xf6, 0x88, 0xdd, 0x32, 0x88, 0xed, 0x7b,
+	0x19, 0xb1, 0xda, 0x09, 0x7c, 0xe2, 0xc3, 0x85, 0x9d, 0xea, 0x30, 0xbe, 0xb2, 0xdc, 0xf2, 0x43,
+	0xd7, 0x0f, 0x1b, 0x7c, 0xbd, 0x26, 0x04, 0xa1, 0xbc, 0xb2, 0x68, 0xf9, 0x96, 0x2f, 0x70, 0xf6,
+	0x4b, 0xa2, 0x0b, 0xa4, 0xdb, 0xc1, 0x61, 0xad, 0xe5, 0xdb, 0xd2, 0xe9, 0xca, 0xb2, 0xe5, 0xfb,
+	0x96, 0x83, 0x6b, 0x5c, 0x6a, 0x46, 0xed, 0x9a, 0xe1, 0x75, 0xc5, 0x92, 0xfa, 0xdb, 0x24, 0x58,
+	0xb8, 0xce, 0x7e, 0x69, 0x46, 0x88, 0x37, 0x5b, 0x2d, 0x3f, 0xf2, 0x08, 0xbc, 0x0d, 0x8a, 0x86,
+	0x69, 0x06, 0x38, 0x0c, 0x2b, 0xca, 0xba, 0xb2, 0x31, 0xa3, 0x69, 0x3d, 0x8a, 0x62, 0x68, 0x9f,
+	0xa2, 0xd3, 0x96, 0x4d, 0xb6, 0xa3, 0x66, 0xb5, 0xe5, 0xbb, 0xb5, 0xbb, 0x76, 0x07, 0x07, 0x1e,
+	0x26, 0x27, 0x77, 0xba, 0xf7, 0x85, 0x70, 0xd2, 0xc3, 0xe4, 0x9e, 0x1f, 0xdc, 0xa9, 0xf1, 0x38,
+	0xaa, 0x9b, 0xc2, 0x4c, 0x8f, 0xed, 0xe1, 0x79, 0x50, 0xec, 0x44, 0xcd, 0xc6, 0x1d, 0xdc, 0xad,
+	0x4c, 0x72, 0xef, 0xc7, 0x7a, 0x14, 0x81, 0x4e, 0xd4, 0x74, 0xec, 0x16, 0x43, 0xfb, 0x14, 0x1d,
+	0xea, 0x1a, 0xae, 0x53, 0x57, 0x13, 0x4c, 0xd5, 0x0b, 0x9d, 0xa8, 0x79, 0x15, 0x77, 0xe1, 0x6d,
+	0x30, 0xc5, 0x32, 0x0b, 0x2b, 0xb9, 0xf5, 0xdc, 0x46, 0xf9, 0x54, 0xb9, 0x2a, 0x76, 0xb9, 0xe0,
+	0xdb, 0x9e, 0x76, 0xf6, 0x09, 0x45, 0x13, 0x5f, 0xff, 0x8c, 0xde, 0x7d, 0x99, 0xf8, 0x98, 0x65,
+	0xa8, 0x0b, 0xa7, 0xf0, 0x12, 0x98, 0x0e, 0xf1, 0x27, 0x11, 0xf6, 0x5a, 0xb8, 0x92, 0x5f, 0x57,
+	0x36, 0xf2, 0xda, 0x89, 0x1e, 0x45, 0x30, 0xc6, 0xde, 0xf1, 0x5d, 0x9b, 0x60, 0xb7, 0x43, 0x58,
+	0x90, 0xf3, 0x22, 0xc8, 0x78, 0x4d, 0xd5, 0x07, 0xa6, 0xf5, 0xa5, 0x87, 0xbb, 0x68, 0xe2, 0xd1,
+	0x2e, 0x52, 0x1e, 0x7e, 0x85, 0x94, 0x1f, 0xbf, 0x3b, 0x59, 0x94, 0x95, 0x55, 0x1f, 0x4f, 0x02,
+	0xc8, 0xcb, 0x7d, 0xcd, 0x37, 0x23, 0x67, 0x50, 0xf0, 0x2e, 0x38, 0xd2, 0x34, 0x42, 0xdc, 0x30,
+	0x84, 0xdc, 0xc0, 0x5e, 0xcb, 0x37, 0x8d, 0xa6, 0x83, 0x79, 0xfd, 0xcb, 0xa7, 0xd4, 0x6a, 0x96,
+	0x16, 0xd5, 0x6c, 0xd3, 0x34, 0xc4, 0x92, 0x7f, 0x4a, 0x91, 0xd2, 0xa7, 0xe8, 0x0d, 0x11, 0x5a,
+	0xda, 0xa7, 0xaa, 0x2f, 0x36, 0x13, 0xed, 0x4b, 0xf1, 0x06, 0xf0, 0x6d, 0x90, 0xf7, 0x0c, 0x17,
+	0xf3, 0x56, 0x94, 0xb4, 0xa5, 0x1e, 0x45, 0x5c, 0xee, 0x53, 0x54, 0x16, 0x4e, 0x98, 0xa4, 0xea,
+	0x1c, 0x84, 0x57, 0x40, 0xb9, 0x83, 0x03, 0xd7, 0x0e, 0x43, 0xdb, 0x97, 0x2d, 0x28, 0x69, 0xc7,
+	0x7b, 0x14, 0xa5, 0xe1, 0x3e, 0x45, 0x50, 0xf6, 0x2f, 0x01, 0x55, 0x3d, 0xad, 0x52, 0x3f, 0x9a,
+	0x29, 0xd0, 0xec, 0x50, 0x3d, 0xd4, 0x07, 0x39, 0xb0, 0x28, 0xca, 0x14, 0x39, 0xc4, 0xde, 0xb2,
+	0xad, 0x7f, 0x87, 0x99, 0xd7, 0xb3, 0xcc, 0x3c, 0xd3, 0xa3, 0x68, 0x31, 0x61, 0x61, 0xc3, 0x65,
+	0xe1, 0x34, 0x42, 0xdb, 0xea, 0x53, 0xb4, 0x9a, 0xe5, 0x68, 0xb2, 0xfa, 0xba, 0xb0, 0xf5, 0x9b,
+	0x29, 0xb0, 0x34, 0xe0, 0xd9, 0x4d, 0x1c, 0x12, 0xdb, 0x1b, 0x74, 0xa2, 0x0d, 0x66, 0xd2, 0xf4,
+	0x7a, 0x95, 0x44, 0x2d, 0xa7, 0x88, 0x0a, 0x77, 0x15, 0xb0, 0xe0, 0x07, 0xb6, 0x65, 0x7b, 0x86,
+	0xd3, 0xb8, 0x2b, 0x62, 0xa8, 0x4c, 0x8e, 0x56, 0x73, 0x9b, 0x79, 0xed, 0x51, 0x34, 0xa2, 0xdc,
+	0xa7, 0x68, 0x49, 0xec, 0x92, 0x5d, 0x51, 0xc7, 0x2b, 0xfe, 0x7c, 0xec, 0x47, 0x56, 0x04, 0x3e,
+	0x52, 0xc0, 0x9c, 0x89, 0x1d, 0x6c, 0x19, 0x04, 0x9b, 0x8d, 0x76, 0x80, 0xf1, 0x41, 0xed, 0x36,
+	0x65, 0x80, 0x19, 0xd5, 0x3e, 0x45, 0x87, 0x45, 0x78, 0xc3, 0xf8, 0x98, 0xc1, 0xcd, 0x0e, 0xbc,
+	0x5c, 0x0e, 0x30, 0x86, 0x8f, 0x15, 0x70, 0x28, 0xf1, 0x1b, 0x97, 0x2f, 0x3f, 0x1a, 0xdd, 0xc7,
+	0x32, 0xba, 0x51, 0xed, 0x3e, 0x45, 0x95, 0x6c, 0x80, 0xff, 0xac, 0x80, 0x0b, 0x03, 0x47, 0x71,
+	0x05, 0xeb, 0x60, 0x1a, 0x7b, 0x66, 0x83, 0xd8, 0x2e, 0xae, 0x4c, 0xad, 0x2b, 0x1b, 0x39, 0x0d,
+	0xf5, 0x28, 0x1a, 0x60, 0x09, 0x7d, 0x63, 0x44, 0xd5, 0x8b, 0xd8, 0x33, 0x6f, 0xd8, 0x2e, 0xae,
+	0xcf, 0xa4, 0xd9, 0xab, 0xfe, 0xa1, 0x80, 0xa3, 0x9c, 0x71, 0x17, 0x7c, 0x8f, 0xd8, 0x5e, 0xe4,
+	0x47, 0x61, 0x86, 0xb8, 0x0f, 0x14, 0xc0, 0x6f, 0xc2, 0x38, 0x89, 0x0c, 0x83, 0x4f, 0xfc, 0x05,
+	0x83, 0x87, 0x3d, 0x69, 0x6f, 0xa5, 0x88, 0xbc, 0x9a, 0x22, 0x72, 0xc6, 0xb1, 0xaa, 0xc3, 0xe6,
+	0xe8, 0xf9, 0xd1, 0x00, 0x08, 0x89, 0x11, 0x10, 0x91, 0xf4, 0x24, 0x4f, 0x9a, 0x3f, 0x84, 0x09,
+	0x9a, 0x3c, 0x84, 0x09, 0xa6, 0xea, 0x25, 0x2e, 0x1c, 0x90, 0xfa, 0xb7, 0x0a, 0x58, 0xe1, 0xa1,
+	0x5e, 0xc4, 0x8e, 0xd1, 0xc5, 0x66, 0x66, 0xc3, 0xff, 0x47, 0xde, 0x99, 0x98, 0x7f, 0x50, 0x40,
+	0xe1, 0x3a, 0x0e, 0x6c, 0xdf, 0x84, 0xa7, 0x41, 0xc1, 0xc1, 0x9e, 0x45, 0xb6, 0x79, 0x40, 0x39,
+	0x6d, 0xb5, 0x47, 0x91, 0x44, 0xfa, 0x14, 0xcd, 0x8a, 0x7d, 0x84, 0xac, 0xea, 0x72, 0x01, 0x76,
+	0x41, 0xc1, 0x70, 0x79, 0x16, 0x07, 0x5c, 0x09, 0x37, 0x24, 0xa7, 0xa5, 0x4a, 0xe2, 0x45, 0xc8,
+	0x63, 0xb2, 0x57, 0x7a, 0xab, 0x4f, 0xb3, 0x24, 0x7e, 0xdd, 0x45, 0x8a, 0xfa, 0xfb, 0x24, 0x58,
+	0xe5, 0xb5, 0x12, 0x99, 0xd8, 0xad, 0xd7, 0x96, 0x71, 0xf0, 0x33, 0x30, 0x1f, 0xef, 0xd5, 0xe1,
+	0xc9, 0xc6, 0x2f, 0x5b, 0xe5, 0x80, 0x24, 0xb8, 0x82, 0x76, 0x5e, 0x76, 0x21, 0x6b, 0xd8, 0xa7,
+	0xe8, 0x88, 0xd8, 0x2b, 0xb3, 0xc0, 0xfa, 0x52, 0x14, 0xc6, 0xa1, 0x3e, 0x27, 0x17, 0xa5, 0x9c,
+	0xa1, 0x8f, 0x09, 0x66, 0x2f, 0x63, 0xcc, 0x87, 0x84, 0x8e, 0x63, 0xe3, 0x00, 0x2e, 0x83, 0x1c,
+	0x7b, 0xbd, 0x15, 0x3e, 0xcc, 0x14, 0x7b, 0x14, 0x31, 0x51, 0x67, 0x7f, 0x60, 0x15, 0x00, 0x77,
+	0xa0, 0x28, 0xd3, 0x9f, 0x63, 0xe9, 0x27, 0xa8, 0x9e, 0xfa, 0x9d, 0xea, 0x2f, 0xbb, 0xdf, 0x87,
+	0xb6, 0x09, 0xe1, 0x47, 0xa0, 0xd4, 0x96, 0x08, 0x9b, 0x44, 0x58, 0x05, 0xd0, 0x68, 0x05, 0x86,
+	0x8c, 0xb4, 0x23, 0xf1, 0x03, 0xd0, 0xc6, 0xb8, 0x91, 0xda, 0x34, 0xf1, 0x04, 0x8f, 0x83, 0xa2,
+	0x89, 0xdb, 0x46, 0xe4, 0x10, 0x19, 0x60, 0x99, 0x8d, 0x37, 0x12, 0xd2, 0xe3, 0x1f, 0xa9, 0xd0,
+	0xba, 0xa0, 0xb0, 0x15, 0x75, 0x3a, 0x4e, 0x17, 0x62, 0x30, 0x45, 0x7c, 0x62, 0x38, 0x15, 0x65,
+	0xf4, 0x20, 0x68, 0x72, 0x67, 0xa1, 0x31, 0xe6, 0xc8, 0xc1, 0x6d, 0xeb, 0xd3, 0xb2, 0xfe, 0x13,
+	0xea, 0xfe, 0x14, 0x00, 0x9c, 0xa7, 0x5b, 0xc4, 0xbc, 0xb1, 0x03, 0x37, 0x41, 0xce, 0x0d, 0x2d,
+	0x49, 0xe9, 0xc5, 0xaa, 0xf8, 0xe2, 0xa8, 0xc6, 0x5f, 0x1c, 0xd5, 0x4d, 0xaf, 0xab, 0x2d, 0xcb,
+	0x30, 0x98, 0x62, 0x9f, 0x22, 0x20, 0xba, 0xef, 0x86, 0x96, 0xaa, 0x33, 0x08, 0xba, 0x20, 0xd7,
+	0xc6, 0xf8, 0xa0, 0x93, 0xfc, 0x61, 0x6c, 0xd9, 0xc6, 0x38, 0xb1, 0x6c, 0x8f, 0xfd, 0x4a, 0x32,
+	0x3f, 0xf0, 0x53, 0x50, 0x0a, 0x6d, 0xcb, 0x33, 0x48, 0x14, 0xb0, 0x07, 0x9b, 0xc5, 0x7d, 0xec,
+	0x39, 0x47, 0x71, 0x8b, 0x98, 0x5b, 0xb1, 0xaa, 0x56, 0x97, 0xc1, 0x24, 0xd6, 0x7d, 0x8a, 0x16,
+	0xe4, 0xb1, 0x89, 0x21, 0x75, 0x9f, 0xa2, 0x43, 0x23, 0xb6, 0x7a, 0x62, 0xc3, 0x06, 0x6f, 0x17,
+	0xbb, 0x7e, 0x25, 0x9f, 0x0c, 0xde, 0x4c, 0x4e, 0x06, 0x6f, 0x26, 0xa9, 0x3a, 0x07, 0xe1, 0x19,
+	0x50, 0xc4, 0x1e, 0x09, 0xfc, 0x4e, 0x57, 0xbe, 0x8f, 0x47, 0x19, 0x31, 0x24, 0xd4, 0xa7, 0x68,
+	0x2e, 0x7e, 0x1e, 0x39, 0xc0, 0x5f, 0x47, 0xfe, 0x0b, 0xde, 0x07, 0xf3, 0x86, 0x69, 0xda, 0x2c,
+	0x15, 0xc3, 0x69, 0xb8, 0xa1, 0x15, 0x56, 0x0a, 0xeb, 0xb9, 0xe7, 0xb6, 0xe8, 0x9c, 0xcc, 0x6d,
+	0x39, 0x63, 0x34, 0x34, 0x4a, 0xca, 0x63, 0x9b, 0x51, 0x51, 0xf5, 0xb9, 0x04, 0xb9, 0x16, 0x5a,
+	0xc3, 0xe3, 0x69, 0x71, 0xec, 0xf1, 0x14, 0x7e, 0xae, 0x80, 0x32, 0x3b, 0x32, 0x56, 0x60, 0x78,
+	0x04, 0x07, 0x95, 0x69, 0x3e, 0x9a, 0xb7, 0x7b, 0x14, 0x1d, 0x4e, 0xc1, 0x43, 0xde, 0xe0, 0x80,
+	0x20, 0xf1, 0xb2, 0x3a, 0xee, 0xc7, 0x01, 0x68, 0x63, 0x7c, 0x45, 0xf8, 0x10, 0xe4, 0xe7, 0x17,
+	0xcf, 0x97, 0x0a, 0x18, 0xed, 0x2e, 0x3c, 0x07, 0x4a, 0xe2, 0x73, 0xe0, 0xaa, 0xbc, 0x83, 0x66,
+	0x44, 0x9f, 0xe4, 0x47, 0x45, 0xd2, 0x27, 0x09, 0xa8, 0x7a, 0xa2, 0x0f, 0xdf, 0x03, 0xa5, 0x81,
+	0x27, 0xf9, 0xf9, 0xf1, 0xe6, 0xdf, 0xb2, 0x4c, 0x4f, 0x6c, 0xea, 0x79, 0x1e, 0xd9, 0xf7, 0x79,
+	0x00, 0x64, 0x50, 0x17, 0xfd, 0x16, 0x3c, 0x0b, 0x8a, 0x17, 0xb6, 0x0d, 0xdb, 0xfb, 0xe0, 0xa2,
+	0xbc, 0x14, 0xf9, 0x60, 0xd5, 0x62, 0x50, 0xc3, 0x36, 0x93, 0xc2, 0xc7, 0x88, 0xaa, 0xc7, 0xfa,
+	0xf0, 0x56, 0x7c, 0x1c, 0x59, 0x28, 0xef, 0x1f, 0x78, 0xfa, 0xf6, 0x29, 0xaa, 0xbd, 0x4c, 0x51,
+	0x75, 0xe3, 0x9e, 0x38, 0x7b, 0x31, 0xf9, 0x73, 0x2f, 0x42, 0xfe, 0x5b, 0xe2, 0x6a, 0xc9, 0x27,
+	0x81, 0x8c, 0x5c, 0x20, 0x63, 0x05, 0xc2, 0xee, 0x9c, 0xb1, 0x0f, 0x56, 0x9a, 0xdc, 0x85, 0x57,
+	0x47, 0xee, 0xe2, 0x7f, 0x4f, 0x6e, 0xed, 0xe6, 0x93, 0x67, 0x6b, 0xca, 0xd3, 0x67, 0x6b, 0xca,
+	0x2f, 0xcf, 0xd6, 0x94, 0x2f, 0xf6, 0xd6, 0x26, 0x9e, 0xee, 0xad, 0x4d, 0xfc, 0xb4, 0xb7, 0x36,
+	0x71, 0xeb, 0xfc, 0x8b, 0x6d, 0x32, 0xf2, 0xaf, 0x2e, 0xbe, 0x6b, 0xb3, 0xc0, 0xaf, 0x9a, 0xd3,
+	0x7f, 0x0e, 0x00, 0x5e, 0xde, 0x97, 0x2c, 0x0b, 0x13, 0x00, 0x00,
+}
+
+func (this *Period) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
+	that1, ok := that.(*Period)
+	if !ok {
+		that2, ok := that.(Period)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Length != that1.Length {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
 func (this *FeeMultiplier) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -604,6 +910,11 @@ func (m *ProtoBaseAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Coins) > 0 {
 		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -704,6 +1015,11 @@ func (m *ProtoMultiSigAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.Coins) > 0 {
 		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -735,7 +1051,7 @@ func (m *ProtoMultiSigAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *FeeMultiplier) Marshal() (dAtA []byte, err error) {
+func (m *ProtoBaseVestingAccount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -745,32 +1061,77 @@ func (m *FeeMultiplier) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FeeMultiplier) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProtoBaseVestingAccount) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FeeMultiplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProtoBaseVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Multiplier != 0 {
-		i = encodeVarintAuth(dAtA, i, uint64(m.Multiplier))
+	if m.EndTime != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.EndTime))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x28
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintAuth(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
+	if len(m.DelegatedVesting) > 0 {
+		for iNdEx := len(m.DelegatedVesting) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatedVesting[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DelegatedFree) > 0 {
+		for iNdEx := len(m.DelegatedFree) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DelegatedFree[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.OriginalVesting) > 0 {
+		for iNdEx := len(m.OriginalVesting) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OriginalVesting[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.ProtoBaseAccount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuth(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *FeeMultipliers) Marshal() (dAtA []byte, err error) {
+func (m *ProtoContinuousVestingAccount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -780,39 +1141,35 @@ func (m *FeeMultipliers) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FeeMultipliers) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProtoContinuousVestingAccount) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FeeMultipliers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProtoContinuousVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Default != 0 {
-		i = encodeVarintAuth(dAtA, i, uint64(m.Default))
+	if m.StartTime != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.StartTime))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.FeeMultis) > 0 {
-		for iNdEx := len(m.FeeMultis) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.FeeMultis[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAuth(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.ProtoBaseVestingAccount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintAuth(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Supply) Marshal() (dAtA []byte, err error) {
+func (m *ProtoDelayedVestingAccount) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -822,23 +1179,227 @@ func (m *Supply) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Supply) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProtoDelayedVestingAccount) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Supply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProtoDelayedVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Total) > 0 {
-		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
+	{
+		size, err := m.ProtoBaseVestingAccount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuth(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Period) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Period) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Period) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Length != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.Length))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProtoPeriodicVestingAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProtoPeriodicVestingAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProtoPeriodicVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.VestingPeriods) > 0 {
+		for iNdEx := len(m.VestingPeriods) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VestingPeriods[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.StartTime != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.StartTime))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.ProtoBaseVestingAccount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAuth(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeMultiplier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeMultiplier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeMultiplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Multiplier != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.Multiplier))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeMultipliers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeMultipliers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeMultipliers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Default != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.Default))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FeeMultis) > 0 {
+		for iNdEx := len(m.FeeMultis) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeMultis[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Supply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Supply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Supply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
 				i -= size
 				i = encodeVarintAuth(dAtA, i, uint64(size))
 			}
@@ -869,6 +1430,32 @@ func (m *ProtoStdTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeGranter) > 0 {
+		i -= len(m.FeeGranter)
+		copy(dAtA[i:], m.FeeGranter)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.FeeGranter)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.AdditionalMsgs) > 0 {
+		for iNdEx := len(m.AdditionalMsgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AdditionalMsgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.Entropy != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.Entropy))
 		i--
@@ -975,6 +1562,18 @@ func (m *StdSignDoc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeeGranter) > 0 {
+		i -= len(m.FeeGranter)
+		copy(dAtA[i:], m.FeeGranter)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.FeeGranter)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintAuth(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x30
+	}
 	if m.Entropy != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.Entropy))
 		i--
@@ -1042,6 +1641,9 @@ func (m *ProtoBaseAccount) Size() (n int) {
 			n += 1 + l + sovAuth(uint64(l))
 		}
 	}
+	if m.Sequence != 0 {
+		n += 1 + sovAuth(uint64(m.Sequence))
+	}
 	return n
 }
 
@@ -1086,51 +1688,80 @@ func (m *ProtoMultiSigAccount) Size() (n int) {
 			n += 1 + l + sovAuth(uint64(l))
 		}
 	}
+	if m.Sequence != 0 {
+		n += 1 + sovAuth(uint64(m.Sequence))
+	}
 	return n
 }
 
-func (m *FeeMultiplier) Size() (n int) {
+func (m *ProtoBaseVestingAccount) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovAuth(uint64(l))
+	l = m.ProtoBaseAccount.Size()
+	n += 1 + l + sovAuth(uint64(l))
+	if len(m.OriginalVesting) > 0 {
+		for _, e := range m.OriginalVesting {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
 	}
-	if m.Multiplier != 0 {
-		n += 1 + sovAuth(uint64(m.Multiplier))
+	if len(m.DelegatedFree) > 0 {
+		for _, e := range m.DelegatedFree {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if len(m.DelegatedVesting) > 0 {
+		for _, e := range m.DelegatedVesting {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if m.EndTime != 0 {
+		n += 1 + sovAuth(uint64(m.EndTime))
 	}
 	return n
 }
 
-func (m *FeeMultipliers) Size() (n int) {
+func (m *ProtoContinuousVestingAccount) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.FeeMultis) > 0 {
-		for _, e := range m.FeeMultis {
-			l = e.Size()
-			n += 1 + l + sovAuth(uint64(l))
-		}
+	l = m.ProtoBaseVestingAccount.Size()
+	n += 1 + l + sovAuth(uint64(l))
+	if m.StartTime != 0 {
+		n += 1 + sovAuth(uint64(m.StartTime))
 	}
-	if m.Default != 0 {
-		n += 1 + sovAuth(uint64(m.Default))
+	return n
+}
+
+func (m *ProtoDelayedVestingAccount) Size() (n int) {
+	if m == nil {
+		return 0
 	}
+	var l int
+	_ = l
+	l = m.ProtoBaseVestingAccount.Size()
+	n += 1 + l + sovAuth(uint64(l))
 	return n
 }
 
-func (m *Supply) Size() (n int) {
+func (m *Period) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Total) > 0 {
-		for _, e := range m.Total {
+	if m.Length != 0 {
+		n += 1 + sovAuth(uint64(m.Length))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
 			l = e.Size()
 			n += 1 + l + sovAuth(uint64(l))
 		}
@@ -1138,16 +1769,85 @@ func (m *Supply) Size() (n int) {
 	return n
 }
 
-func (m *ProtoStdTx) Size() (n int) {
+func (m *ProtoPeriodicVestingAccount) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.Msg.Size()
+	l = m.ProtoBaseVestingAccount.Size()
 	n += 1 + l + sovAuth(uint64(l))
-	if len(m.Fee) > 0 {
-		for _, e := range m.Fee {
+	if m.StartTime != 0 {
+		n += 1 + sovAuth(uint64(m.StartTime))
+	}
+	if len(m.VestingPeriods) > 0 {
+		for _, e := range m.VestingPeriods {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeMultiplier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	if m.Multiplier != 0 {
+		n += 1 + sovAuth(uint64(m.Multiplier))
+	}
+	return n
+}
+
+func (m *FeeMultipliers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeeMultis) > 0 {
+		for _, e := range m.FeeMultis {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if m.Default != 0 {
+		n += 1 + sovAuth(uint64(m.Default))
+	}
+	return n
+}
+
+func (m *Supply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProtoStdTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Msg.Size()
+	n += 1 + l + sovAuth(uint64(l))
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
 			l = e.Size()
 			n += 1 + l + sovAuth(uint64(l))
 		}
@@ -1161,6 +1861,19 @@ func (m *ProtoStdTx) Size() (n int) {
 	if m.Entropy != 0 {
 		n += 1 + sovAuth(uint64(m.Entropy))
 	}
+	if len(m.AdditionalMsgs) > 0 {
+		for _, e := range m.AdditionalMsgs {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovAuth(uint64(m.Sequence))
+	}
+	l = len(m.FeeGranter)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
 	return n
 }
 
@@ -1206,6 +1919,13 @@ func (m *StdSignDoc) Size() (n int) {
 	if m.Entropy != 0 {
 		n += 1 + sovAuth(uint64(m.Entropy))
 	}
+	if m.Sequence != 0 {
+		n += 1 + sovAuth(uint64(m.Sequence))
+	}
+	l = len(m.FeeGranter)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
 	return n
 }
 
@@ -1311,10 +2031,737 @@ func (m *ProtoBaseAccount) Unmarshal(dAtA []byte) error {
 			if m.PubKey == nil {
 				m.PubKey = []byte{}
 			}
-			iNdEx = postIndex
-		case 3:
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProtoModuleAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProtoModuleAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProtoModuleAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtoBaseAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtoBaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProtoMultiSigAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProtoMultiSigAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProtoMultiSigAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProtoBaseVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProtoBaseVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProtoBaseVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtoBaseAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtoBaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalVesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalVesting = append(m.OriginalVesting, types.Coin{})
+			if err := m.OriginalVesting[len(m.OriginalVesting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedFree", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatedFree = append(m.DelegatedFree, types.Coin{})
+			if err := m.DelegatedFree[len(m.DelegatedFree)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DelegatedVesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DelegatedVesting = append(m.DelegatedVesting, types.Coin{})
+			if err := m.DelegatedVesting[len(m.DelegatedVesting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			m.EndTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProtoContinuousVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProtoContinuousVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProtoContinuousVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtoBaseVestingAccount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ProtoBaseVestingAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			m.StartTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProtoDelayedVestingAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProtoDelayedVestingAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProtoDelayedVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtoBaseVestingAccount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1341,8 +2788,7 @@ func (m *ProtoBaseAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProtoBaseVestingAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1352,10 +2798,7 @@ func (m *ProtoBaseAccount) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if (iNdEx + skippy) > l {
@@ -1370,7 +2813,7 @@ func (m *ProtoBaseAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProtoModuleAccount) Unmarshal(dAtA []byte) error {
+func (m *Period) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1393,17 +2836,17 @@ func (m *ProtoModuleAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProtoModuleAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: Period: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProtoModuleAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Period: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProtoBaseAccount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Length", wireType)
 			}
-			var msglen int
+			m.Length = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -1413,30 +2856,16 @@ func (m *ProtoModuleAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Length |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthAuth
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ProtoBaseAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -1446,55 +2875,25 @@ func (m *ProtoModuleAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuth
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuth
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Permissions = append(m.Permissions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1502,10 +2901,7 @@ func (m *ProtoModuleAccount) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if (iNdEx + skippy) > l {
@@ -1520,7 +2916,7 @@ func (m *ProtoModuleAccount) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProtoMultiSigAccount) Unmarshal(dAtA []byte) error {
+func (m *ProtoPeriodicVestingAccount) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1543,17 +2939,17 @@ func (m *ProtoMultiSigAccount) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProtoMultiSigAccount: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProtoPeriodicVestingAccount: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProtoMultiSigAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProtoPeriodicVestingAccount: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtoBaseVestingAccount", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -1563,31 +2959,30 @@ func (m *ProtoMultiSigAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuth
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
-			if m.Address == nil {
-				m.Address = []byte{}
+			if err := m.ProtoBaseVestingAccount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
 			}
-			var byteLen int
+			m.StartTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuth
@@ -1597,29 +2992,14 @@ func (m *ProtoMultiSigAccount) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.StartTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthAuth
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
-			if m.PubKey == nil {
-				m.PubKey = []byte{}
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VestingPeriods", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1646,8 +3026,8 @@ func (m *ProtoMultiSigAccount) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Coins = append(m.Coins, types.Coin{})
-			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.VestingPeriods = append(m.VestingPeriods, Period{})
+			if err := m.VestingPeriods[len(m.VestingPeriods)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1657,10 +3037,7 @@ func (m *ProtoMultiSigAccount) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if (iNdEx + skippy) > l {
@@ -1761,10 +3138,7 @@ func (m *FeeMultiplier) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if (iNdEx + skippy) > l {
@@ -1867,10 +3241,7 @@ func (m *FeeMultipliers) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if (iNdEx + skippy) > l {
@@ -1954,10 +3325,7 @@ func (m *Supply) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if (iNdEx + skippy) > l {
@@ -2152,16 +3520,100 @@ func (m *ProtoStdTx) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdditionalMsgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdditionalMsgs = append(m.AdditionalMsgs, types1.Any{})
+			if err := m.AdditionalMsgs[len(m.AdditionalMsgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGranter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeGranter = append(m.FeeGranter[:0], dAtA[iNdEx:postIndex]...)
+			if m.FeeGranter == nil {
+				m.FeeGranter = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if (iNdEx + skippy) > l {
@@ -2279,10 +3731,7 @@ func (m *ProtoStdSignature) Unmarshal(dAtA []byte) error {
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if (iNdEx + skippy) > l {
@@ -2477,16 +3926,66 @@ func (m *StdSignDoc) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGranter", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeGranter = append(m.FeeGranter[:0], dAtA[iNdEx:postIndex]...)
+			if m.FeeGranter == nil {
+				m.FeeGranter = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
 			if err != nil {
 				return err
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthAuth
-			}
-			if (iNdEx + skippy) < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthAuth
 			}
 			if (iNdEx + skippy) > l {
@@ -0,0 +1,334 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/vipernet-xyz/viper-network/codec/types"
+	sdk "github.com/vipernet-xyz/viper-network/types"
+)
+
+const (
+	MsgGrantAuthorizationName  = "grant_authorization"
+	MsgRevokeAuthorizationName = "revoke_authorization"
+	MsgExecName                = "exec"
+	MsgAuthorizationFee        = 10000
+	// MaxExecMsgs is the maximum number of messages a single exec message may carry
+	MaxExecMsgs = MaxMsgsPerTx
+)
+
+// NewAuthorization creates an authorization from the granter to the grantee to execute the message type.
+// A zero expiration never expires.
+func NewAuthorization(granter, grantee sdk.Address, msgType string, expiration int64) Authorization {
+	return Authorization{
+		Granter:    granter,
+		Grantee:    grantee,
+		MsgType:    msgType,
+		Expiration: expiration,
+	}
+}
+
+// ValidateBasic does a stateless check of the authorization
+func (a Authorization) ValidateBasic() error {
+	if a.Granter.Empty() {
+		return errors.New("empty granter address")
+	}
+	if a.Grantee.Empty() {
+		return errors.New("empty grantee address")
+	}
+	if a.Granter.Equals(a.Grantee) {
+		return errors.New("an account can't authorize itself")
+	}
+	if a.MsgType == "" {
+		return errors.New("empty message type")
+	}
+	if a.MsgType == MsgExecName {
+		return errors.New("the exec messages can't be authorized")
+	}
+	if a.Expiration < 0 {
+		return fmt.Errorf("invalid expiration: %d", a.Expiration)
+	}
+	return nil
+}
+
+// IsExpired returns true if the authorization expired at the block time
+func (a Authorization) IsExpired(blockTime time.Time) bool {
+	return a.Expiration != 0 && blockTime.Unix() >= a.Expiration
+}
+
+// IsAuthorizationMsg returns true if the message grants, revokes or executes an authorization
+func IsAuthorizationMsg(msg sdk.Msg) bool {
+	switch msg.(type) {
+	case MsgGrantAuthorization, *MsgGrantAuthorization, MsgRevokeAuthorization, *MsgRevokeAuthorization, MsgExec, *MsgExec:
+		return true
+	}
+	return false
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+var _ sdk.ProtoMsg = &MsgGrantAuthorization{}
+
+// Authorization returns the authorization granted by the message
+func (msg MsgGrantAuthorization) Authorization() Authorization {
+	return NewAuthorization(msg.Granter, msg.Grantee, msg.MsgType, msg.Expiration)
+}
+
+// Route provides router key for msg
+func (msg MsgGrantAuthorization) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgGrantAuthorization) Type() string { return MsgGrantAuthorizationName }
+
+// GetFee get fee for msg
+func (msg MsgGrantAuthorization) GetFee() sdk.BigInt {
+	return sdk.NewInt(MsgAuthorizationFee)
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgGrantAuthorization) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.Granter}
+}
+
+// GetRecipient return the grantee of the authorization
+func (msg MsgGrantAuthorization) GetRecipient() sdk.Address {
+	return msg.Grantee
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgGrantAuthorization) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check, stateless
+func (msg MsgGrantAuthorization) ValidateBasic() sdk.Error {
+	if err := msg.Authorization().ValidateBasic(); err != nil {
+		return ErrInvalidAuthorization(DefaultCodespace, err)
+	}
+	return nil
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+var _ sdk.ProtoMsg = &MsgRevokeAuthorization{}
+
+// Route provides router key for msg
+func (msg MsgRevokeAuthorization) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgRevokeAuthorization) Type() string { return MsgRevokeAuthorizationName }
+
+// GetFee get fee for msg
+func (msg MsgRevokeAuthorization) GetFee() sdk.BigInt {
+	return sdk.NewInt(MsgAuthorizationFee)
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgRevokeAuthorization) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.Granter}
+}
+
+// GetRecipient return the grantee of the authorization
+func (msg MsgRevokeAuthorization) GetRecipient() sdk.Address {
+	return msg.Grantee
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgRevokeAuthorization) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check, stateless
+func (msg MsgRevokeAuthorization) ValidateBasic() sdk.Error {
+	if msg.Granter.Empty() {
+		return sdk.ErrInvalidAddress("empty granter address")
+	}
+	if msg.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("empty grantee address")
+	}
+	if msg.MsgType == "" {
+		return ErrInvalidAuthorization(DefaultCodespace, errors.New("empty message type"))
+	}
+	return nil
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+var _ sdk.ProtoMsg = &MsgExec{}
+
+// MsgExec lets the grantee execute messages on behalf of the accounts that authorized it
+type MsgExec struct {
+	Grantee sdk.Address `json:"grantee" yaml:"grantee"`
+	Msgs    []sdk.Msg   `json:"msgs" yaml:"msgs"`
+}
+
+// NewMsgExec creates an exec message of the grantee
+func NewMsgExec(grantee sdk.Address, msgs []sdk.Msg) MsgExec {
+	return MsgExec{Grantee: grantee, Msgs: msgs}
+}
+
+// Route provides router key for msg
+func (msg MsgExec) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgExec) Type() string { return MsgExecName }
+
+// GetFee get fee for msg, the fees of the executed messages
+func (msg MsgExec) GetFee() sdk.BigInt {
+	total := sdk.ZeroInt()
+	for _, m := range msg.Msgs {
+		total = total.Add(m.GetFee())
+	}
+	return total
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgExec) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.Grantee}
+}
+
+// GetRecipient return address(es) that receive the msg
+func (msg MsgExec) GetRecipient() sdk.Address {
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over, made of the sign bytes of the executed messages
+func (msg MsgExec) GetSignBytes() []byte {
+	msgs := make([]json.RawMessage, 0, len(msg.Msgs))
+	for _, m := range msg.Msgs {
+		msgs = append(msgs, json.RawMessage(m.GetSignBytes()))
+	}
+	bz, err := json.Marshal(struct {
+		Grantee sdk.Address       `json:"grantee"`
+		Msgs    []json.RawMessage `json:"msgs"`
+	}{msg.Grantee, msgs})
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check, stateless
+func (msg MsgExec) ValidateBasic() sdk.Error {
+	if msg.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("empty grantee address")
+	}
+	if len(msg.Msgs) == 0 {
+		return ErrInvalidExec(DefaultCodespace, errors.New("no messages to execute"))
+	}
+	if len(msg.Msgs) > MaxExecMsgs {
+		return ErrInvalidExec(DefaultCodespace, fmt.Errorf("too many messages: %d, max %d", len(msg.Msgs), MaxExecMsgs))
+	}
+	for _, m := range msg.Msgs {
+		if m == nil {
+			return ErrInvalidExec(DefaultCodespace, errors.New("nil message"))
+		}
+		if _, ok := m.(sdk.ProtoMsg); !ok {
+			return ErrInvalidExec(DefaultCodespace, fmt.Errorf("the message %s is not a proto message", m.Type()))
+		}
+		if m.Type() == MsgExecName {
+			return ErrInvalidExec(DefaultCodespace, errors.New("nested exec messages are not allowed"))
+		}
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (msg MsgExec) ToProto() (ProtoMsgExec, error) {
+	msgs := make([]types.Any, 0, len(msg.Msgs))
+	for _, m := range msg.Msgs {
+		a, err := msgToAny(m)
+		if err != nil {
+			return ProtoMsgExec{}, err
+		}
+		msgs = append(msgs, *a)
+	}
+	return ProtoMsgExec{
+		Grantee: msg.Grantee,
+		Msgs:    msgs,
+	}, nil
+}
+
+func (pm ProtoMsgExec) FromProto() (MsgExec, error) {
+	msgs := make([]sdk.Msg, 0, len(pm.Msgs))
+	for i := range pm.Msgs {
+		var m sdk.ProtoMsg
+		if err := ModuleCdc.ProtoCodec().UnpackAny(&pm.Msgs[i], &m); err != nil {
+			return MsgExec{}, err
+		}
+		msgs = append(msgs, m)
+	}
+	return MsgExec{
+		Grantee: pm.Grantee,
+		Msgs:    msgs,
+	}, nil
+}
+
+func (msg *MsgExec) Reset() {
+	*msg = MsgExec{}
+}
+
+func (msg MsgExec) String() string {
+	return fmt.Sprintf("Grantee: %s\nMsgs: %v\n", msg.Grantee, msg.Msgs)
+}
+
+func (msg *MsgExec) ProtoMessage() {
+	p := ProtoMsgExec{}
+	p.ProtoMessage()
+}
+
+func (msg *MsgExec) XXX_MessageName() string {
+	p := ProtoMsgExec{}
+	return p.XXX_MessageName()
+}
+
+func (msg MsgExec) Marshal() ([]byte, error) {
+	p, err := msg.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	return p.Marshal()
+}
+
+func (msg MsgExec) MarshalTo(data []byte) (n int, err error) {
+	p, err := msg.ToProto()
+	if err != nil {
+		return 0, err
+	}
+	return p.MarshalTo(data)
+}
+
+func (msg MsgExec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	p, err := msg.ToProto()
+	if err != nil {
+		return 0, err
+	}
+	return p.MarshalToSizedBuffer(dAtA)
+}
+
+func (msg MsgExec) Size() int {
+	p, err := msg.ToProto()
+	if err != nil {
+		return 0
+	}
+	return p.Size()
+}
+
+func (msg *MsgExec) Unmarshal(data []byte) error {
+	var p ProtoMsgExec
+	if err := p.Unmarshal(data); err != nil {
+		return err
+	}
+	m, err := p.FromProto()
+	if err != nil {
+		return err
+	}
+	*msg = m
+	return nil
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	crypto "github.com/vipernet-xyz/viper-network/crypto/codec"
+	sdk "github.com/vipernet-xyz/viper-network/types"
+	servicersTypes "github.com/vipernet-xyz/viper-network/x/servicers/types"
+)
+
+func TestAuthorization_ValidateBasic(t *testing.T) {
+	granter := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	grantee := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	assert.Nil(t, NewAuthorization(granter, grantee, "unjail_validator", 0).ValidateBasic())
+	assert.NotNil(t, NewAuthorization(nil, grantee, "unjail_validator", 0).ValidateBasic())
+	assert.NotNil(t, NewAuthorization(granter, granter, "unjail_validator", 0).ValidateBasic())
+	assert.NotNil(t, NewAuthorization(granter, grantee, "", 0).ValidateBasic())
+	assert.NotNil(t, NewAuthorization(granter, grantee, MsgExecName, 0).ValidateBasic())
+	assert.NotNil(t, NewAuthorization(granter, grantee, "unjail_validator", -1).ValidateBasic())
+	assert.False(t, NewAuthorization(granter, grantee, "unjail_validator", 0).IsExpired(time.Unix(1<<40, 0)))
+	assert.True(t, NewAuthorization(granter, grantee, "unjail_validator", 1000).IsExpired(time.Unix(1000, 0)))
+	msg := MsgGrantAuthorization{Granter: granter, Grantee: grantee, MsgType: "pause_node"}
+	assert.Nil(t, msg.ValidateBasic())
+	assert.Equal(t, []sdk.Address{granter}, msg.GetSigners())
+	assert.NotNil(t, MsgRevokeAuthorization{Granter: granter, Grantee: grantee}.ValidateBasic())
+}
+
+func TestMsgExec(t *testing.T) {
+	granter := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	grantee := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	inner := &MsgRevokeFeeAllowance{Granter: granter, Grantee: grantee}
+	msg := NewMsgExec(grantee, []sdk.Msg{inner})
+	assert.Nil(t, msg.ValidateBasic())
+	assert.Equal(t, []sdk.Address{grantee}, msg.GetSigners())
+	assert.Equal(t, inner.GetFee(), msg.GetFee())
+	assert.True(t, IsAuthorizationMsg(&msg))
+	assert.False(t, IsAuthorizationMsg(inner))
+	// the fee multipliers apply to the executed messages
+	fm := FeeMultipliers{FeeMultis: []FeeMultiplier{{Key: MsgRevokeFeeAllowanceName, Multiplier: 3}}, Default: 1}
+	assert.Equal(t, inner.GetFee().MulRaw(3), fm.GetFee(&msg))
+	// proto round trip
+	bz, err := msg.Marshal()
+	require.NoError(t, err)
+	var decoded MsgExec
+	require.NoError(t, decoded.Unmarshal(bz))
+	assert.Equal(t, msg, decoded)
+	assert.Equal(t, msg.GetSignBytes(), decoded.GetSignBytes())
+	// invalid exec messages
+	assert.NotNil(t, NewMsgExec(nil, []sdk.Msg{inner}).ValidateBasic())
+	assert.NotNil(t, NewMsgExec(grantee, nil).ValidateBasic())
+	assert.NotNil(t, NewMsgExec(grantee, []sdk.Msg{&msg}).ValidateBasic())
+	assert.NotNil(t, NewMsgExec(grantee, []sdk.Msg{&servicersTypes.MsgUnjail{}}).ValidateBasic())
+}
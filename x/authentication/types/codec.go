@@ -17,7 +17,17 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterStructure(StdTx{}, "posmint/StdTx")
 	cdc.RegisterStructure(&Supply{}, "posmint/Supply")
 	cdc.RegisterStructure(&ModuleAccount{}, "posmint/ModuleAccount")
+	cdc.RegisterStructure(&ContinuousVestingAccount{}, "posmint/ContinuousVestingAccount")
+	cdc.RegisterStructure(&DelayedVestingAccount{}, "posmint/DelayedVestingAccount")
+	cdc.RegisterStructure(&PeriodicVestingAccount{}, "posmint/PeriodicVestingAccount")
+	cdc.RegisterStructure(MsgGrantFeeAllowance{}, "posmint/MsgGrantFeeAllowance")
+	cdc.RegisterStructure(MsgRevokeFeeAllowance{}, "posmint/MsgRevokeFeeAllowance")
+	cdc.RegisterStructure(MsgGrantAuthorization{}, "posmint/MsgGrantAuthorization")
+	cdc.RegisterStructure(MsgRevokeAuthorization{}, "posmint/MsgRevokeAuthorization")
+	cdc.RegisterStructure(MsgExec{}, "posmint/MsgExec")
 	cdc.RegisterImplementation((*sdk.Tx)(nil), &StdTx{})
+	cdc.RegisterImplementation((*sdk.ProtoMsg)(nil), &MsgGrantFeeAllowance{}, &MsgRevokeFeeAllowance{}, &MsgGrantAuthorization{}, &MsgRevokeAuthorization{}, &MsgExec{})
+	cdc.RegisterImplementation((*sdk.Msg)(nil), &MsgGrantFeeAllowance{}, &MsgRevokeFeeAllowance{}, &MsgGrantAuthorization{}, &MsgRevokeAuthorization{}, &MsgExec{})
 	ModuleCdc = cdc
 }
 
@@ -8,14 +8,29 @@ import (
 
 // Param module codespace constants
 const (
-	CodeInvalidMemo         sdk.CodeType = 1
-	CodeEmptyPublicKey      sdk.CodeType = 2
-	CodeAccNotFound         sdk.CodeType = 3
-	CodeInsufficientFee     sdk.CodeType = 4
-	CodeSignatureLimit      sdk.CodeType = 5
-	CodeDupTx               sdk.CodeType = 6
-	CodeInsufficientBalance sdk.CodeType = 7
-	CodeTxIndexerNil        sdk.CodeType = 8
+	CodeInvalidMemo          sdk.CodeType = 1
+	CodeEmptyPublicKey       sdk.CodeType = 2
+	CodeAccNotFound          sdk.CodeType = 3
+	CodeInsufficientFee      sdk.CodeType = 4
+	CodeSignatureLimit       sdk.CodeType = 5
+	CodeDupTx                sdk.CodeType = 6
+	CodeInsufficientBalance  sdk.CodeType = 7
+	CodeTxIndexerNil         sdk.CodeType = 8
+	CodeTooManyMsgs          sdk.CodeType = 9
+	CodeMultiMsgTxInactive   sdk.CodeType = 10
+	CodeInvalidSequence      sdk.CodeType = 11
+	CodeSequenceInactive     sdk.CodeType = 12
+	CodeAccountExists        sdk.CodeType = 13
+	CodeInvalidVesting       sdk.CodeType = 14
+	CodeNoFeeAllowance       sdk.CodeType = 15
+	CodeFeeAllowanceDenied   sdk.CodeType = 16
+	CodeInvalidFeeAllowance  sdk.CodeType = 17
+	CodeFeeGrantInactive     sdk.CodeType = 18
+	CodeNoAuthorization      sdk.CodeType = 19
+	CodeInvalidAuthorization sdk.CodeType = 20
+	CodeInvalidExec          sdk.CodeType = 21
+	CodeAuthzInactive        sdk.CodeType = 22
+	CodeFeeAllowanceInactive sdk.CodeType = 23
 )
 
 // ErrUnknownSubspace returns an unknown subspace error.
@@ -50,3 +65,63 @@ func ErrDuplicateTx(codespace sdk.CodespaceType, txHash string) sdk.Error {
 func ErrInsufficientBalance(codespace sdk.CodespaceType, signer sdk.Address, neededFee sdk.Coins) sdk.Error {
 	return sdk.NewError(codespace, CodeDupTx, fmt.Sprintf("the signer account : %s, does not have enough coins for the tx. Need %s", signer, neededFee.String()))
 }
+
+func ErrTooManyMsgs(codespace sdk.CodespaceType, msgs int) sdk.Error {
+	return sdk.NewError(codespace, CodeTooManyMsgs, fmt.Sprintf("the transaction carries %d messages, the limit is %d", msgs, MaxMsgsPerTx))
+}
+
+func ErrMultiMsgTxInactive(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeMultiMsgTxInactive, "multi message transactions are not activated yet")
+}
+
+func ErrInvalidSequence(codespace sdk.CodespaceType, expected, actual uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidSequence, fmt.Sprintf("invalid transaction sequence, expected %d, got %d", expected, actual))
+}
+
+func ErrSequenceInactive(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeSequenceInactive, "the transaction carries a sequence but the account sequences are not activated yet")
+}
+
+func ErrAccountExists(codespace sdk.CodespaceType, addr sdk.Address) sdk.Error {
+	return sdk.NewError(codespace, CodeAccountExists, fmt.Sprintf("the account %s already exists", addr))
+}
+
+func ErrInvalidVesting(codespace sdk.CodespaceType, err error) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidVesting, fmt.Sprintf("the vesting account is invalid: %s", err.Error()))
+}
+
+func ErrNoFeeAllowance(codespace sdk.CodespaceType, granter, grantee sdk.Address) sdk.Error {
+	return sdk.NewError(codespace, CodeNoFeeAllowance, fmt.Sprintf("no fee allowance from %s to %s", granter, grantee))
+}
+
+func ErrFeeAllowanceDenied(codespace sdk.CodespaceType, err error) sdk.Error {
+	return sdk.NewError(codespace, CodeFeeAllowanceDenied, fmt.Sprintf("the fee allowance doesn't cover the transaction: %s", err.Error()))
+}
+
+func ErrInvalidFeeAllowance(codespace sdk.CodespaceType, err error) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidFeeAllowance, fmt.Sprintf("the fee allowance is invalid: %s", err.Error()))
+}
+
+func ErrFeeGrantInactive(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeFeeGrantInactive, "the transaction names a fee granter but the fee grants are not activated yet")
+}
+
+func ErrFeeAllowanceInactive(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeFeeAllowanceInactive, "the fee allowances are not activated yet")
+}
+
+func ErrNoAuthorization(codespace sdk.CodespaceType, granter, grantee sdk.Address, msgType string) sdk.Error {
+	return sdk.NewError(codespace, CodeNoAuthorization, fmt.Sprintf("no authorization from %s to %s for the message type %s", granter, grantee, msgType))
+}
+
+func ErrInvalidAuthorization(codespace sdk.CodespaceType, err error) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidAuthorization, fmt.Sprintf("the authorization is invalid: %s", err.Error()))
+}
+
+func ErrInvalidExec(codespace sdk.CodespaceType, err error) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidExec, fmt.Sprintf("the exec message is invalid: %s", err.Error()))
+}
+
+func ErrAuthzInactive(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeAuthzInactive, "the transaction carries an authorization message but the authorizations are not activated yet")
+}
@@ -5,4 +5,14 @@ var (
 	EventTypeTransfer     = "transfer"
 	AttributeKeyRecipient = "recipient"
 	AttributeKeySender    = "sender"
+
+	EventTypeGrantFeeAllowance   = "grant_fee_allowance"
+	EventTypeRevokeFeeAllowance  = "revoke_fee_allowance"
+	EventTypeUseFeeAllowance     = "use_fee_allowance"
+	EventTypeGrantAuthorization  = "grant_authorization"
+	EventTypeRevokeAuthorization = "revoke_authorization"
+	EventTypeExec                = "exec"
+	AttributeKeyMsgType          = "msg_type"
+	AttributeKeyGranter          = "granter"
+	AttributeKeyGrantee          = "grantee"
 )
@@ -2,7 +2,14 @@ package types
 
 import "github.com/vipernet-xyz/viper-network/types"
 
+// GetFee returns the fee of the message, the exec messages pay the fees of the messages they execute
 func (fm FeeMultipliers) GetFee(msg types.Msg) types.BigInt {
+	switch exec := msg.(type) {
+	case MsgExec:
+		return fm.GetTotalFee(exec.Msgs)
+	case *MsgExec:
+		return fm.GetTotalFee(exec.Msgs)
+	}
 	for _, feeMultiplier := range fm.FeeMultis {
 		if feeMultiplier.Key == msg.Type() {
 			return msg.GetFee().Mul(types.NewInt(feeMultiplier.Multiplier))
@@ -10,3 +17,12 @@ func (fm FeeMultipliers) GetFee(msg types.Msg) types.BigInt {
 	}
 	return msg.GetFee().Mul(types.NewInt(fm.Default))
 }
+
+// GetTotalFee returns the sum of the fees of the messages
+func (fm FeeMultipliers) GetTotalFee(msgs []types.Msg) types.BigInt {
+	total := types.ZeroInt()
+	for _, msg := range msgs {
+		total = total.Add(fm.GetFee(msg))
+	}
+	return total
+}
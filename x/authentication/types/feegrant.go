@@ -0,0 +1,181 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	sdk "github.com/vipernet-xyz/viper-network/types"
+)
+
+const (
+	MsgGrantFeeAllowanceName  = "grant_fee_allowance"
+	MsgRevokeFeeAllowanceName = "revoke_fee_allowance"
+	MsgFeeAllowanceFee        = 10000
+)
+
+// NewFeeAllowance creates a fee allowance from the granter to the grantee.
+// An empty spend limit, a zero expiration or empty allowed messages don't restrict the allowance.
+func NewFeeAllowance(granter, grantee sdk.Address, spendLimit sdk.Coins, expiration int64, allowedMsgs []string) FeeAllowance {
+	return FeeAllowance{
+		Granter:     granter,
+		Grantee:     grantee,
+		SpendLimit:  spendLimit,
+		Expiration:  expiration,
+		AllowedMsgs: allowedMsgs,
+	}
+}
+
+// ValidateBasic does a stateless check of the allowance
+func (a FeeAllowance) ValidateBasic() error {
+	if a.Granter.Empty() {
+		return errors.New("empty granter address")
+	}
+	if a.Grantee.Empty() {
+		return errors.New("empty grantee address")
+	}
+	if a.Granter.Equals(a.Grantee) {
+		return errors.New("an account can't grant a fee allowance to itself")
+	}
+	if !a.SpendLimit.Empty() && !a.SpendLimit.IsValid() {
+		return fmt.Errorf("invalid spend limit: %s", a.SpendLimit)
+	}
+	if a.Expiration < 0 {
+		return fmt.Errorf("invalid expiration: %d", a.Expiration)
+	}
+	seen := make(map[string]struct{}, len(a.AllowedMsgs))
+	for _, msgType := range a.AllowedMsgs {
+		if msgType == "" {
+			return errors.New("empty allowed message type")
+		}
+		if _, ok := seen[msgType]; ok {
+			return fmt.Errorf("duplicate allowed message type: %s", msgType)
+		}
+		seen[msgType] = struct{}{}
+	}
+	return nil
+}
+
+// IsExpired returns true if the allowance expired at the block time
+func (a FeeAllowance) IsExpired(blockTime time.Time) bool {
+	return a.Expiration != 0 && blockTime.Unix() >= a.Expiration
+}
+
+// Accept checks the allowance pays the fee of the messages at the block time. It returns the
+// allowance left after paying the fee, and whether it is used up and must be removed.
+func (a FeeAllowance) Accept(blockTime time.Time, fee sdk.Coins, msgs []sdk.Msg) (FeeAllowance, bool, error) {
+	if a.IsExpired(blockTime) {
+		return a, true, fmt.Errorf("the allowance expired at %d", a.Expiration)
+	}
+	if len(a.AllowedMsgs) != 0 {
+		for _, msg := range msgs {
+			if !a.allows(msg.Type()) {
+				return a, false, fmt.Errorf("the message type %s is not allowed", msg.Type())
+			}
+		}
+	}
+	if a.SpendLimit.Empty() {
+		return a, false, nil
+	}
+	left, hasNeg := a.SpendLimit.SafeSub(fee)
+	if hasNeg {
+		return a, false, fmt.Errorf("the fee %s exceeds the spend limit %s", fee, a.SpendLimit)
+	}
+	a.SpendLimit = left
+	return a, left.IsZero(), nil
+}
+
+func (a FeeAllowance) allows(msgType string) bool {
+	for _, allowed := range a.AllowedMsgs {
+		if allowed == msgType {
+			return true
+		}
+	}
+	return false
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+var _ sdk.ProtoMsg = &MsgGrantFeeAllowance{}
+
+// FeeAllowance returns the fee allowance granted by the message
+func (msg MsgGrantFeeAllowance) FeeAllowance() FeeAllowance {
+	return NewFeeAllowance(msg.Granter, msg.Grantee, msg.SpendLimit, msg.Expiration, msg.AllowedMsgs)
+}
+
+// Route provides router key for msg
+func (msg MsgGrantFeeAllowance) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgGrantFeeAllowance) Type() string { return MsgGrantFeeAllowanceName }
+
+// GetFee get fee for msg
+func (msg MsgGrantFeeAllowance) GetFee() sdk.BigInt {
+	return sdk.NewInt(MsgFeeAllowanceFee)
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgGrantFeeAllowance) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.Granter}
+}
+
+// GetRecipient return the grantee of the allowance
+func (msg MsgGrantFeeAllowance) GetRecipient() sdk.Address {
+	return msg.Grantee
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgGrantFeeAllowance) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check, stateless
+func (msg MsgGrantFeeAllowance) ValidateBasic() sdk.Error {
+	if err := msg.FeeAllowance().ValidateBasic(); err != nil {
+		return ErrInvalidFeeAllowance(DefaultCodespace, err)
+	}
+	return nil
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+var _ sdk.ProtoMsg = &MsgRevokeFeeAllowance{}
+
+// Route provides router key for msg
+func (msg MsgRevokeFeeAllowance) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgRevokeFeeAllowance) Type() string { return MsgRevokeFeeAllowanceName }
+
+// GetFee get fee for msg
+func (msg MsgRevokeFeeAllowance) GetFee() sdk.BigInt {
+	return sdk.NewInt(MsgFeeAllowanceFee)
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgRevokeFeeAllowance) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.Granter}
+}
+
+// GetRecipient return the grantee of the allowance
+func (msg MsgRevokeFeeAllowance) GetRecipient() sdk.Address {
+	return msg.Grantee
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgRevokeFeeAllowance) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check, stateless
+func (msg MsgRevokeFeeAllowance) ValidateBasic() sdk.Error {
+	if msg.Granter.Empty() {
+		return sdk.ErrInvalidAddress("empty granter address")
+	}
+	if msg.Grantee.Empty() {
+		return sdk.ErrInvalidAddress("empty grantee address")
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	crypto "github.com/vipernet-xyz/viper-network/crypto/codec"
+	sdk "github.com/vipernet-xyz/viper-network/types"
+	servicersTypes "github.com/vipernet-xyz/viper-network/x/servicers/types"
+)
+
+func TestFeeAllowance_ValidateBasic(t *testing.T) {
+	granter := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	grantee := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	assert.Nil(t, NewFeeAllowance(granter, grantee, nil, 0, nil).ValidateBasic())
+	assert.Nil(t, NewFeeAllowance(granter, grantee, testVestingCoins(10), 100, []string{"send"}).ValidateBasic())
+	assert.NotNil(t, NewFeeAllowance(nil, grantee, nil, 0, nil).ValidateBasic())
+	assert.NotNil(t, NewFeeAllowance(granter, granter, nil, 0, nil).ValidateBasic())
+	assert.NotNil(t, NewFeeAllowance(granter, grantee, nil, -1, nil).ValidateBasic())
+	assert.NotNil(t, NewFeeAllowance(granter, grantee, nil, 0, []string{"send", "send"}).ValidateBasic())
+	assert.NotNil(t, NewFeeAllowance(granter, grantee, sdk.Coins{sdk.Coin{Denom: sdk.DefaultStakeDenom, Amount: sdk.NewInt(-1)}}, 0, nil).ValidateBasic())
+	msg := MsgGrantFeeAllowance{Granter: granter, Grantee: grantee}
+	assert.Nil(t, msg.ValidateBasic())
+	assert.Equal(t, []sdk.Address{granter}, msg.GetSigners())
+	assert.NotNil(t, MsgRevokeFeeAllowance{Granter: granter}.ValidateBasic())
+}
+
+func TestFeeAllowance_Accept(t *testing.T) {
+	granter := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	grantee := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	send := []sdk.Msg{&servicersTypes.MsgSend{FromAddress: grantee, ToAddress: grantee, Amount: sdk.OneInt()}}
+	blockTime := time.Unix(1000, 0)
+	// unlimited
+	allowance := NewFeeAllowance(granter, grantee, nil, 0, nil)
+	left, remove, err := allowance.Accept(blockTime, testVestingCoins(100), send)
+	require.NoError(t, err)
+	assert.False(t, remove)
+	assert.Equal(t, allowance, left)
+	// limited
+	allowance = NewFeeAllowance(granter, grantee, testVestingCoins(150), 1001, []string{servicersTypes.MsgSendName})
+	left, remove, err = allowance.Accept(blockTime, testVestingCoins(100), send)
+	require.NoError(t, err)
+	assert.False(t, remove)
+	assert.Equal(t, testVestingCoins(50), left.SpendLimit)
+	_, _, err = left.Accept(blockTime, testVestingCoins(100), send)
+	assert.Error(t, err)
+	_, remove, err = left.Accept(blockTime, testVestingCoins(50), send)
+	require.NoError(t, err)
+	assert.True(t, remove)
+	_, _, err = allowance.Accept(blockTime, testVestingCoins(100), []sdk.Msg{&servicersTypes.MsgUnjail{ValidatorAddr: grantee, Signer: grantee}})
+	assert.Error(t, err)
+	_, remove, err = allowance.Accept(time.Unix(1001, 0), testVestingCoins(100), send)
+	assert.Error(t, err)
+	assert.True(t, remove)
+}
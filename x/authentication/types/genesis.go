@@ -4,13 +4,16 @@ import (
 	"fmt"
 
 	sdk "github.com/vipernet-xyz/viper-network/types"
+	"github.com/vipernet-xyz/viper-network/x/authentication/exported"
 )
 
 // GenesisState - all authentication state that must be provided at genesis
 type GenesisState struct {
-	Params   Params    `json:"params" yaml:"params"`
-	Accounts Accounts  `json:"accounts" yaml:"accounts"`
-	Supply   sdk.Coins `json:"supply" yaml:"supply"`
+	Params         Params          `json:"params" yaml:"params"`
+	Accounts       Accounts        `json:"accounts" yaml:"accounts"`
+	Supply         sdk.Coins       `json:"supply" yaml:"supply"`
+	FeeAllowances  []FeeAllowance  `json:"fee_allowances,omitempty" yaml:"fee_allowances"`
+	Authorizations []Authorization `json:"authorizations,omitempty" yaml:"authorizations"`
 }
 
 // NewGenesisState - Create a new genesis state
@@ -34,6 +37,21 @@ func ValidateGenesis(data GenesisState) error {
 		if account.GetPubKey().PubKey() == nil {
 			return fmt.Errorf("PubKey should never be nil")
 		}
+		if vacc, ok := account.(exported.VestingAccount); ok {
+			if err := vacc.Validate(); err != nil {
+				return fmt.Errorf("invalid vesting account %s: %s", vacc.GetAddress(), err.Error())
+			}
+		}
+	}
+	for _, allowance := range data.FeeAllowances {
+		if err := allowance.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid fee allowance from %s to %s: %s", allowance.Granter, allowance.Grantee, err.Error())
+		}
+	}
+	for _, authorization := range data.Authorizations {
+		if err := authorization.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid authorization from %s to %s: %s", authorization.Granter, authorization.Grantee, err.Error())
+		}
 	}
 	if data.Params.MaxMemoCharacters == 0 {
 		return fmt.Errorf("invalid max memo characters: %d", data.Params.MaxMemoCharacters)
@@ -13,6 +13,8 @@ const (
 	FeeCollectorName = "fee_collector"
 	// QuerierRoute is the querier route for authentication
 	QuerierRoute = StoreKey
+	// RouterKey is the msg router key for authentication
+	RouterKey = ModuleName
 	// default codespace
 	DefaultCodespace = ModuleName
 )
@@ -23,6 +25,10 @@ var (
 	AddressStoreKeyPrefix = []byte{0x01}
 	// SendEnabledPrefix is the prefix for the SendDisabled flags for a Denom.
 	SendEnabledPrefix = []byte{0x04}
+	// FeeAllowanceKeyPrefix prefix for the fee allowances, by grantee then granter
+	FeeAllowanceKeyPrefix = []byte{0x05}
+	// AuthorizationKeyPrefix prefix for the authorizations, by grantee then granter then message type
+	AuthorizationKeyPrefix = []byte{0x06}
 )
 
 // AddressStoreKey turn an address to key used to get it from the account store
@@ -37,3 +43,23 @@ func CreateSendEnabledKey(denom string) []byte {
 	copy(key[len(SendEnabledPrefix):], denom)
 	return key
 }
+
+// FeeAllowanceKey turns a grantee and granter to the key used to get their fee allowance from the store
+func FeeAllowanceKey(grantee, granter sdk.Address) []byte {
+	return append(FeeAllowanceByGranteeKey(grantee), granter.Bytes()...)
+}
+
+// FeeAllowanceByGranteeKey turns a grantee to the prefix of its fee allowances in the store
+func FeeAllowanceByGranteeKey(grantee sdk.Address) []byte {
+	return append(append([]byte{}, FeeAllowanceKeyPrefix...), grantee.Bytes()...)
+}
+
+// AuthorizationKey turns a grantee, granter and message type to the key used to get their authorization from the store
+func AuthorizationKey(grantee, granter sdk.Address, msgType string) []byte {
+	return append(append(AuthorizationByGranteeKey(grantee), granter.Bytes()...), []byte(msgType)...)
+}
+
+// AuthorizationByGranteeKey turns a grantee to the prefix of its authorizations in the store
+func AuthorizationByGranteeKey(grantee sdk.Address) []byte {
+	return append(append([]byte{}, AuthorizationKeyPrefix...), grantee.Bytes()...)
+}
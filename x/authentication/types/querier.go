@@ -6,7 +6,11 @@ import (
 
 // query endpoints supported by the authentication Querier
 const (
-	QueryAccount = "account"
+	QueryAccount        = "account"
+	QuerySequence       = "sequence"
+	QueryFeeAllowance   = "fee_allowance"
+	QueryFeeAllowances  = "fee_allowances"
+	QueryAuthorizations = "authorizations"
 )
 
 // QueryAccountParams defines the params for querying accounts.
@@ -18,3 +22,14 @@ type QueryAccountParams struct {
 func NewQueryAccountParams(addr sdk.Address) QueryAccountParams {
 	return QueryAccountParams{Address: addr}
 }
+
+// QueryFeeAllowanceParams defines the params for querying the fee allowance of a granter to a grantee.
+type QueryFeeAllowanceParams struct {
+	Granter sdk.Address `json:"granter"`
+	Grantee sdk.Address `json:"grantee"`
+}
+
+// NewQueryFeeAllowanceParams creates a new instance of QueryFeeAllowanceParams.
+func NewQueryFeeAllowanceParams(granter, grantee sdk.Address) QueryFeeAllowanceParams {
+	return QueryFeeAllowanceParams{Granter: granter, Grantee: grantee}
+}
@@ -15,6 +15,9 @@ import (
 	"gopkg.in/yaml.v2"
 )
 
+// MaxMsgsPerTx is the maximum number of messages a single transaction may carry
+const MaxMsgsPerTx = 100
+
 // ProtoStdTx is a standard way to wrap a ProtoMsg with Fee and Sigs.
 // NOTE: the first signature is the fee payer (Sigs must not be nil).
 func NewTx(msgs sdk.ProtoMsg, fee sdk.Coins, sig StdSignature, memo string, entropy int64) sdk.Tx {
@@ -27,6 +30,25 @@ func NewTx(msgs sdk.ProtoMsg, fee sdk.Coins, sig StdSignature, memo string, entr
 	}
 }
 
+// NewMultiMsgTx wraps an ordered list of messages, executed atomically, with Fee and Sigs.
+// A single message results in the same transaction as NewTx.
+func NewMultiMsgTx(msgs []sdk.ProtoMsg, fee sdk.Coins, sig StdSignature, memo string, entropy int64) sdk.Tx {
+	tx := StdTx{
+		Fee:       fee,
+		Signature: sig,
+		Memo:      memo,
+		Entropy:   entropy,
+	}
+	for i, msg := range msgs {
+		if i == 0 {
+			tx.Msg = msg
+			continue
+		}
+		tx.AdditionalMsgs = append(tx.AdditionalMsgs, msg)
+	}
+	return tx
+}
+
 // CountSubKeys counts the total number of keys for a multi-sig public key.
 func CountSubKeys(pub crypto.PubKey) int {
 	v, ok := pub.(multisig.PubKeyMultisigThreshold)
@@ -63,17 +85,61 @@ func StdSignBytes(chainID string, entropy int64, fee sdk.Coins, msg sdk.Msg, mem
 	return sdk.MustSortJSON(bz), nil
 }
 
+// StdMultiSignBytes returns the bytes to sign for a transaction carrying the given messages.
+// The sign bytes of a single message transaction without sequence nor fee granter are the ones of
+// StdSignBytes, the messages of a multi message transaction are signed as an ordered json array.
+func StdMultiSignBytes(chainID string, entropy int64, fee sdk.Coins, msgs []sdk.Msg, memo string, sequence uint64, feeGranter sdk.Address) ([]byte, error) {
+	var msgBz sdk.Raw
+	if len(msgs) == 1 {
+		msgBz = msgs[0].GetSignBytes()
+	} else {
+		msgsBytes := make([]json.RawMessage, 0, len(msgs))
+		for _, msg := range msgs {
+			msgsBytes = append(msgsBytes, msg.GetSignBytes())
+		}
+		var err error
+		msgBz, err = json.Marshal(msgsBytes)
+		if err != nil {
+			return nil, fmt.Errorf("could not marshal msgs to json for StdMultiSignBytes function: %v", err.Error())
+		}
+	}
+	var feeBytes sdk.Raw
+	feeBytes, err := fee.MarshalJSON()
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal fee to json for StdMultiSignBytes function: %v", err.Error())
+	}
+	bz, err := ModuleCdc.MarshalJSON(StdSignDoc{
+		ChainID:    chainID,
+		Fee:        feeBytes,
+		Memo:       memo,
+		Msg:        msgBz,
+		Entropy:    entropy,
+		Sequence:   sequence,
+		FeeGranter: feeGranter,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal bytes to json for StdSignDoc function: %v", err.Error())
+	}
+	return sdk.MustSortJSON(bz), nil
+}
+
 // Legacy Amino Code Below
 // ---------------------------------------------------------------------------------------------------------------------
 
 var _ codec.ProtoMarshaler = &StdTx{}
 
+// StdTx carries one or more messages: Msg is the first one, and AdditionalMsgs the following
+// ones. AdditionalMsgs, Sequence and FeeGranter are appended last, so single message transactions
+// without sequence nor fee granter keep their encoding.
 type StdTx struct {
-	Msg       sdk.Msg      `json:"msg" yaml:"msg"`
-	Fee       sdk.Coins    `json:"fee" yaml:"fee"`
-	Signature StdSignature `json:"signature" yaml:"signature"`
-	Memo      string       `json:"memo" yaml:"memo"`
-	Entropy   int64        `json:"entropy" yaml:"entropy"`
+	Msg            sdk.Msg      `json:"msg" yaml:"msg"`
+	Fee            sdk.Coins    `json:"fee" yaml:"fee"`
+	Signature      StdSignature `json:"signature" yaml:"signature"`
+	Memo           string       `json:"memo" yaml:"memo"`
+	Entropy        int64        `json:"entropy" yaml:"entropy"`
+	AdditionalMsgs []sdk.Msg    `json:"additional_msgs,omitempty" yaml:"additional_msgs"`
+	Sequence       uint64       `json:"sequence,omitempty" yaml:"sequence"`       // signer account sequence, once activated
+	FeeGranter     sdk.Address  `json:"fee_granter,omitempty" yaml:"fee_granter"` // account paying the fee through an allowance, once activated
 }
 
 func (tx *StdTx) Reset() {
@@ -134,21 +200,40 @@ func (tx *StdTx) Unmarshal(data []byte) error {
 }
 
 func (tx StdTx) ToProto() (ProtoStdTx, error) {
-	pMsg, ok := tx.Msg.(sdk.ProtoMsg)
+	any, err := msgToAny(tx.Msg)
+	if err != nil {
+		return ProtoStdTx{}, err
+	}
+	var additionalMsgs []types.Any
+	for _, msg := range tx.AdditionalMsgs {
+		a, err := msgToAny(msg)
+		if err != nil {
+			return ProtoStdTx{}, err
+		}
+		additionalMsgs = append(additionalMsgs, *a)
+	}
+	return ProtoStdTx{
+		Msg:            *any,
+		Fee:            tx.Fee,
+		Signature:      tx.Signature.ToProto(),
+		Memo:           tx.Memo,
+		Entropy:        tx.Entropy,
+		AdditionalMsgs: additionalMsgs,
+		Sequence:       tx.Sequence,
+		FeeGranter:     tx.FeeGranter,
+	}, nil
+}
+
+func msgToAny(msg sdk.Msg) (*types.Any, error) {
+	pMsg, ok := msg.(sdk.ProtoMsg)
 	if !ok {
-		return ProtoStdTx{}, fmt.Errorf("unable to convert sdk.Msg to sdk.ProtoMsg: %v", tx.Msg)
+		return nil, fmt.Errorf("unable to convert sdk.Msg to sdk.ProtoMsg: %v", msg)
 	}
 	any, err := types.NewAnyWithValue(pMsg)
 	if err != nil {
-		return ProtoStdTx{}, fmt.Errorf("unable to convert sdk.ProtoMsg into any %v", pMsg)
+		return nil, fmt.Errorf("unable to convert sdk.ProtoMsg into any %v", pMsg)
 	}
-	return ProtoStdTx{
-		Msg:       *any,
-		Fee:       tx.Fee,
-		Signature: tx.Signature.ToProto(),
-		Memo:      tx.Memo,
-		Entropy:   tx.Entropy,
-	}, nil
+	return any, nil
 }
 
 func (tx StdTx) WithSignature(sig StdSignature) (StdTx, error) {
@@ -160,6 +245,16 @@ func (tx StdTx) GetEntropy() int64 {
 	return tx.Entropy
 }
 
+// GetSequence returns the signer account sequence the transaction is valid for, zero if none
+func (tx StdTx) GetSequence() uint64 {
+	return tx.Sequence
+}
+
+// GetFeeGranter returns the account paying the fee through an allowance, nil if the signer pays
+func (tx StdTx) GetFeeGranter() sdk.Address {
+	return tx.FeeGranter
+}
+
 func (tx StdTx) GetFee() sdk.Coins {
 	return tx.Fee
 }
@@ -172,13 +267,33 @@ func (tx StdTx) GetSignature() StdSignature {
 	return tx.Signature
 }
 
+// GetSigners returns the signers of all the transaction's messages, deduplicated and in order
 func (tx StdTx) GetSigners() []sdk.Address {
-	return tx.GetMsg().GetSigners()
+	var signers []sdk.Address
+	seen := make(map[string]struct{})
+	for _, msg := range tx.GetMsgs() {
+		for _, signer := range msg.GetSigners() {
+			if _, ok := seen[signer.String()]; ok {
+				continue
+			}
+			seen[signer.String()] = struct{}{}
+			signers = append(signers, signer)
+		}
+	}
+	return signers
 }
 
-// GetMsg returns the all the transaction's messages.
+// GetMsg returns the first of the transaction's messages.
 func (tx StdTx) GetMsg() sdk.Msg { return tx.Msg }
 
+// GetMsgs returns the all the transaction's messages, in order.
+func (tx StdTx) GetMsgs() []sdk.Msg {
+	if tx.Msg == nil {
+		return nil
+	}
+	return append([]sdk.Msg{tx.Msg}, tx.AdditionalMsgs...)
+}
+
 // ValidateBasic does a simple and lightweight validation check that doesn't
 // require access to any other information.
 func (tx StdTx) ValidateBasic() sdk.Error {
@@ -188,6 +303,14 @@ func (tx StdTx) ValidateBasic() sdk.Error {
 	if len(tx.Signature.Signature) == 0 {
 		return sdk.ErrUnauthorized("empty signature")
 	}
+	if len(tx.AdditionalMsgs)+1 > MaxMsgsPerTx {
+		return ErrTooManyMsgs(ModuleName, len(tx.AdditionalMsgs)+1)
+	}
+	for _, msg := range tx.AdditionalMsgs {
+		if msg == nil {
+			return sdk.ErrUnknownRequest("nil message in the transaction")
+		}
+	}
 	return nil
 }
 
@@ -197,16 +320,27 @@ func (ptx ProtoStdTx) FromProto() (StdTx, error) {
 	if err != nil {
 		return StdTx{}, err
 	}
+	var additionalMsgs []sdk.Msg
+	for i := range ptx.AdditionalMsgs {
+		var msg sdk.ProtoMsg
+		if err := ModuleCdc.ProtoCodec().UnpackAny(&ptx.AdditionalMsgs[i], &msg); err != nil {
+			return StdTx{}, err
+		}
+		additionalMsgs = append(additionalMsgs, msg)
+	}
 	ss, err := ptx.Signature.FromProto()
 	if err != nil {
 		return StdTx{}, err
 	}
 	return StdTx{
-		Msg:       res,
-		Fee:       ptx.Fee,
-		Signature: ss,
-		Memo:      ptx.Memo,
-		Entropy:   ptx.Entropy,
+		Msg:            res,
+		Fee:            ptx.Fee,
+		Signature:      ss,
+		Memo:           ptx.Memo,
+		Entropy:        ptx.Entropy,
+		AdditionalMsgs: additionalMsgs,
+		Sequence:       ptx.Sequence,
+		FeeGranter:     ptx.FeeGranter,
 	}, nil
 }
 
@@ -0,0 +1,191 @@
+package types
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vipernet-xyz/viper-network/codec"
+	codecTypes "github.com/vipernet-xyz/viper-network/codec/types"
+	crypto "github.com/vipernet-xyz/viper-network/crypto/codec"
+	sdk "github.com/vipernet-xyz/viper-network/types"
+	servicersTypes "github.com/vipernet-xyz/viper-network/x/servicers/types"
+)
+
+func newStdTxTestCodec() *codec.Codec {
+	cdc := codec.NewCodec(codecTypes.NewInterfaceRegistry())
+	sdk.RegisterCodec(cdc)
+	servicersTypes.RegisterCodec(cdc)
+	RegisterCodec(cdc)
+	crypto.RegisterAmino(cdc.AminoCodec().Amino)
+	return cdc
+}
+
+func newTestMsgSend(from sdk.Address, amount int64) *servicersTypes.MsgSend {
+	return &servicersTypes.MsgSend{
+		FromAddress: from,
+		ToAddress:   sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address()),
+		Amount:      sdk.NewInt(amount),
+	}
+}
+
+func newTestStdTx(t *testing.T, msgs []sdk.ProtoMsg, pk crypto.PrivateKey) StdTx {
+	fee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(10000)))
+	signBz, err := StdMultiSignBytes("test", 1, fee, toMsgs(msgs), "memo", 0, nil)
+	require.NoError(t, err)
+	sig, err := pk.Sign(signBz)
+	require.NoError(t, err)
+	return NewMultiMsgTx(msgs, fee, StdSignature{PublicKey: pk.PublicKey(), Signature: sig}, "memo", 1).(StdTx)
+}
+
+func TestStdTx_SingleMsgCompatibility(t *testing.T) {
+	cdc := newStdTxTestCodec()
+	pk := crypto.GenerateEd25519PrivKey()
+	msg := newTestMsgSend(sdk.Address(pk.PublicKey().Address()), 10)
+	tx := newTestStdTx(t, []sdk.ProtoMsg{msg}, pk)
+	assert.Equal(t, NewTx(msg, tx.Fee, tx.Signature, tx.Memo, tx.Entropy), tx)
+	// the sign bytes are the single message ones
+	multiSignBz, err := StdMultiSignBytes("test", 1, tx.Fee, tx.GetMsgs(), "memo", 0, nil)
+	require.NoError(t, err)
+	signBz, err := StdSignBytes("test", 1, tx.Fee, msg, "memo")
+	require.NoError(t, err)
+	assert.Equal(t, signBz, multiSignBz)
+	// the proto encoding doesn't carry the additional messages field
+	any, err := codecTypes.NewAnyWithValue(msg)
+	require.NoError(t, err)
+	legacyProto := ProtoStdTx{Msg: *any, Fee: tx.Fee, Signature: tx.Signature.ToProto(), Memo: tx.Memo, Entropy: tx.Entropy}
+	legacyBz, err := legacyProto.Marshal()
+	require.NoError(t, err)
+	bz, err := tx.Marshal()
+	require.NoError(t, err)
+	assert.Equal(t, legacyBz, bz)
+	// and decodes with both codecs
+	for _, height := range []int64{0, -1} {
+		bz, err = DefaultTxEncoder(cdc)(tx, height)
+		require.NoError(t, err)
+		decoded, sdkErr := DefaultTxDecoder(cdc)(bz, height)
+		require.Nil(t, sdkErr)
+		assert.Equal(t, []sdk.Msg{msg}, decoded.GetMsgs())
+	}
+	aminoBz, err := cdc.LegacyMarshalBinaryLengthPrefixed(tx)
+	require.NoError(t, err)
+	var aminoTx StdTx
+	require.NoError(t, cdc.LegacyUnmarshalBinaryLengthPrefixed(aminoBz, &aminoTx))
+	assert.Empty(t, aminoTx.AdditionalMsgs)
+}
+
+func TestStdTx_MultiMsg(t *testing.T) {
+	cdc := newStdTxTestCodec()
+	pk := crypto.GenerateEd25519PrivKey()
+	signer := sdk.Address(pk.PublicKey().Address())
+	msgs := []sdk.ProtoMsg{newTestMsgSend(signer, 1), newTestMsgSend(signer, 2), newTestMsgSend(signer, 3)}
+	tx := newTestStdTx(t, msgs, pk)
+	assert.Nil(t, tx.ValidateBasic())
+	assert.Equal(t, toMsgs(msgs), tx.GetMsgs())
+	assert.Equal(t, msgs[0], tx.GetMsg())
+	// the signers are aggregated across the messages
+	assert.Equal(t, []sdk.Address{signer}, tx.GetSigners())
+	// the messages are all signed, in order
+	signBz, err := StdMultiSignBytes("test", 1, tx.Fee, tx.GetMsgs(), "memo", 0, nil)
+	require.NoError(t, err)
+	assert.True(t, pk.PublicKey().VerifyBytes(signBz, tx.Signature.Signature))
+	firstSignBz, err := StdSignBytes("test", 1, tx.Fee, tx.GetMsg(), "memo")
+	require.NoError(t, err)
+	assert.False(t, bytes.Equal(signBz, firstSignBz))
+	reversedSignBz, err := StdMultiSignBytes("test", 1, tx.Fee, []sdk.Msg{msgs[2], msgs[1], msgs[0]}, "memo", 0, nil)
+	require.NoError(t, err)
+	assert.False(t, bytes.Equal(signBz, reversedSignBz))
+	// proto round trip
+	bz, err := DefaultTxEncoder(cdc)(tx, -1)
+	require.NoError(t, err)
+	decoded, sdkErr := DefaultTxDecoder(cdc)(bz, -1)
+	require.Nil(t, sdkErr)
+	assert.Equal(t, tx.GetMsgs(), decoded.GetMsgs())
+	// amino round trip
+	aminoBz, err := cdc.LegacyMarshalBinaryLengthPrefixed(tx)
+	require.NoError(t, err)
+	var aminoTx StdTx
+	require.NoError(t, cdc.LegacyUnmarshalBinaryLengthPrefixed(aminoBz, &aminoTx))
+	// amino decodes the messages by value
+	require.Len(t, aminoTx.GetMsgs(), 3)
+	for i, msg := range aminoTx.GetMsgs() {
+		assert.Equal(t, msgs[i].GetSignBytes(), msg.GetSignBytes())
+	}
+	// json
+	jsonTx := StdTx{}
+	require.NoError(t, cdc.UnmarshalJSON(cdc.MustMarshalJSON(tx), &jsonTx))
+	assert.Len(t, jsonTx.GetMsgs(), 3)
+}
+
+func TestStdTx_MultiMsgSigners(t *testing.T) {
+	pk1, pk2 := crypto.GenerateEd25519PrivKey(), crypto.GenerateEd25519PrivKey()
+	signer1, signer2 := sdk.Address(pk1.PublicKey().Address()), sdk.Address(pk2.PublicKey().Address())
+	tx := newTestStdTx(t, []sdk.ProtoMsg{newTestMsgSend(signer1, 1), newTestMsgSend(signer2, 2), newTestMsgSend(signer1, 3)}, pk1)
+	assert.Equal(t, []sdk.Address{signer1, signer2}, tx.GetSigners())
+}
+
+func TestStdTx_ValidateBasicTooManyMsgs(t *testing.T) {
+	pk := crypto.GenerateEd25519PrivKey()
+	signer := sdk.Address(pk.PublicKey().Address())
+	msgs := make([]sdk.ProtoMsg, 0, MaxMsgsPerTx+1)
+	for i := 0; i < MaxMsgsPerTx; i++ {
+		msgs = append(msgs, newTestMsgSend(signer, 1))
+	}
+	assert.Nil(t, newTestStdTx(t, msgs, pk).ValidateBasic())
+	msgs = append(msgs, newTestMsgSend(signer, 1))
+	err := newTestStdTx(t, msgs, pk).ValidateBasic()
+	require.NotNil(t, err)
+	assert.Equal(t, CodeTooManyMsgs, err.Code())
+}
+
+func TestFeeMultipliers_GetTotalFee(t *testing.T) {
+	signer := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	msg := newTestMsgSend(signer, 1)
+	fm := FeeMultipliers{FeeMultis: []FeeMultiplier{{Key: msg.Type(), Multiplier: 3}}, Default: 1}
+	assert.Equal(t, fm.GetFee(msg).MulRaw(2), fm.GetTotalFee([]sdk.Msg{msg, msg}))
+}
+
+func TestStdTx_Sequence(t *testing.T) {
+	cdc := newStdTxTestCodec()
+	pk := crypto.GenerateEd25519PrivKey()
+	msg := newTestMsgSend(sdk.Address(pk.PublicKey().Address()), 10)
+	tx := newTestStdTx(t, []sdk.ProtoMsg{msg}, pk)
+	tx.Sequence = 5
+	// the sequence is signed
+	signBz, err := StdMultiSignBytes("test", 1, tx.Fee, tx.GetMsgs(), "memo", tx.Sequence, nil)
+	require.NoError(t, err)
+	noSeqSignBz, err := StdMultiSignBytes("test", 1, tx.Fee, tx.GetMsgs(), "memo", 0, nil)
+	require.NoError(t, err)
+	assert.False(t, bytes.Equal(signBz, noSeqSignBz))
+	// and kept by both codecs
+	for _, height := range []int64{0, -1} {
+		bz, err := DefaultTxEncoder(cdc)(tx, height)
+		require.NoError(t, err)
+		decoded, sdkErr := DefaultTxDecoder(cdc)(bz, height)
+		require.Nil(t, sdkErr)
+		assert.Equal(t, uint64(5), decoded.(StdTx).GetSequence())
+	}
+}
+
+func TestStdTx_FeeGranter(t *testing.T) {
+	cdc := newStdTxTestCodec()
+	pk := crypto.GenerateEd25519PrivKey()
+	msg := newTestMsgSend(sdk.Address(pk.PublicKey().Address()), 10)
+	tx := newTestStdTx(t, []sdk.ProtoMsg{msg}, pk)
+	tx.FeeGranter = sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	// the fee granter is signed
+	signBz, err := StdMultiSignBytes("test", 1, tx.Fee, tx.GetMsgs(), "memo", 0, tx.FeeGranter)
+	require.NoError(t, err)
+	noGranterSignBz, err := StdMultiSignBytes("test", 1, tx.Fee, tx.GetMsgs(), "memo", 0, nil)
+	require.NoError(t, err)
+	assert.False(t, bytes.Equal(signBz, noGranterSignBz))
+	// and kept by both codecs
+	for _, height := range []int64{0, -1} {
+		bz, err := DefaultTxEncoder(cdc)(tx, height)
+		require.NoError(t, err)
+		decoded, sdkErr := DefaultTxDecoder(cdc)(bz, height)
+		require.Nil(t, sdkErr)
+		assert.Equal(t, tx.FeeGranter, decoded.(StdTx).GetFeeGranter())
+	}
+}
@@ -15,12 +15,14 @@ import (
 
 // TxBuilder implements a transaction context created in SDK modules.
 type TxBuilder struct {
-	txEncoder sdk.TxEncoder
-	txDecoder sdk.TxDecoder
-	keybase   crkeys.Keybase
-	chainID   string
-	memo      string
-	fees      sdk.Coins
+	txEncoder  sdk.TxEncoder
+	txDecoder  sdk.TxDecoder
+	keybase    crkeys.Keybase
+	chainID    string
+	memo       string
+	fees       sdk.Coins
+	sequence   uint64
+	feeGranter sdk.Address
 }
 
 // NewTxBuilder returns a new initialized TxBuilder.
@@ -53,6 +55,12 @@ func (bldr TxBuilder) Memo() string { return bldr.memo }
 // Fees returns the fees for the transaction
 func (bldr TxBuilder) Fees() sdk.Coins { return bldr.fees }
 
+// Sequence returns the signer account sequence for the transaction
+func (bldr TxBuilder) Sequence() uint64 { return bldr.sequence }
+
+// FeeGranter returns the account paying the fee of the transaction through an allowance
+func (bldr TxBuilder) FeeGranter() sdk.Address { return bldr.feeGranter }
+
 // WithTxEncoder returns a copy of the context with an updated codec.
 func (bldr TxBuilder) WithTxEncoder(txEncoder sdk.TxEncoder) TxBuilder {
 	bldr.txEncoder = txEncoder
@@ -83,6 +91,20 @@ func (bldr TxBuilder) WithKeybase(keybase crkeys.Keybase) TxBuilder {
 	return bldr
 }
 
+// WithSequence returns a copy of the context with an updated signer account sequence.
+// It must be left to zero until the account sequences are activated.
+func (bldr TxBuilder) WithSequence(sequence uint64) TxBuilder {
+	bldr.sequence = sequence
+	return bldr
+}
+
+// WithFeeGranter returns a copy of the context with an updated fee granter, the account paying
+// the fee through an allowance. It must be left to nil until the fee grants are activated.
+func (bldr TxBuilder) WithFeeGranter(feeGranter sdk.Address) TxBuilder {
+	bldr.feeGranter = feeGranter
+	return bldr
+}
+
 // WithMemo returns a copy of the context with an updated memo.
 func (bldr TxBuilder) WithMemo(memo string) TxBuilder {
 	bldr.memo = strings.TrimSpace(memo)
@@ -92,11 +114,20 @@ func (bldr TxBuilder) WithMemo(memo string) TxBuilder {
 // BuildAndSign builds a single message to be signed, and signs a transaction
 // with the built message given a address, private key, and a set of messages.
 func (bldr TxBuilder) BuildAndSign(address sdk.Address, privateKey crypto.PrivateKey, msg sdk.ProtoMsg, legacyCodec bool) ([]byte, error) {
+	return bldr.BuildAndSignMsgs(address, privateKey, []sdk.ProtoMsg{msg}, legacyCodec)
+}
+
+// BuildAndSignMsgs builds an ordered list of messages to be signed, and signs a transaction
+// with the built messages given a address and private key.
+func (bldr TxBuilder) BuildAndSignMsgs(address sdk.Address, privateKey crypto.PrivateKey, msgs []sdk.ProtoMsg, legacyCodec bool) ([]byte, error) {
 	if bldr.chainID == "" {
 		return nil, errors.New("cant build and sign transaciton: the chainID is empty")
 	}
+	if len(msgs) == 0 {
+		return nil, errors.New("cant build and sign transaciton: no messages")
+	}
 	entropy := rand.Int64()
-	bytesToSign, err := StdSignBytes(bldr.chainID, entropy, bldr.fees, msg, bldr.memo)
+	bytesToSign, err := StdMultiSignBytes(bldr.chainID, entropy, bldr.fees, toMsgs(msgs), bldr.memo, bldr.sequence, bldr.feeGranter)
 	if err != nil {
 		return nil, err
 	}
@@ -109,22 +140,31 @@ func (bldr TxBuilder) BuildAndSign(address sdk.Address, privateKey crypto.Privat
 		PublicKey: privateKey.PublicKey(),
 	}
 	if legacyCodec {
-		return bldr.txEncoder(NewTx(msg, bldr.fees, sig, bldr.memo, entropy), 0)
+		return bldr.txEncoder(bldr.newTx(msgs, bldr.fees, sig, bldr.memo, entropy), 0)
 	}
-	return bldr.txEncoder(NewTx(msg, bldr.fees, sig, bldr.memo, entropy), -1)
+	return bldr.txEncoder(bldr.newTx(msgs, bldr.fees, sig, bldr.memo, entropy), -1)
 }
 
 // BuildAndSignWithKeyBase builds a single message to be signed, and signs a transaction
 // with the built message given a address, passphrase, and a set of messages.
 func (bldr TxBuilder) BuildAndSignWithKeyBase(address sdk.Address, passphrase string, msg sdk.ProtoMsg, legacyCodec bool) ([]byte, error) {
+	return bldr.BuildAndSignMsgsWithKeyBase(address, passphrase, []sdk.ProtoMsg{msg}, legacyCodec)
+}
+
+// BuildAndSignMsgsWithKeyBase builds an ordered list of messages to be signed, and signs a
+// transaction with the built messages given a address and passphrase.
+func (bldr TxBuilder) BuildAndSignMsgsWithKeyBase(address sdk.Address, passphrase string, msgs []sdk.ProtoMsg, legacyCodec bool) ([]byte, error) {
 	if bldr.keybase == nil {
 		return nil, errors.New("cant build and sign transaciton: the keybase is nil")
 	}
 	if bldr.chainID == "" {
 		return nil, errors.New("cant build and sign transaciton: the chainID is empty")
 	}
+	if len(msgs) == 0 {
+		return nil, errors.New("cant build and sign transaciton: no messages")
+	}
 	entropy := rand.Int64()
-	bytesToSign, err := StdSignBytes(bldr.chainID, entropy, bldr.fees, msg, bldr.memo)
+	bytesToSign, err := StdMultiSignBytes(bldr.chainID, entropy, bldr.fees, toMsgs(msgs), bldr.memo, bldr.sequence, bldr.feeGranter)
 	if err != nil {
 		return nil, err
 	}
@@ -137,9 +177,9 @@ func (bldr TxBuilder) BuildAndSignWithKeyBase(address sdk.Address, passphrase st
 		PublicKey: pk,
 	}
 	if legacyCodec {
-		return bldr.txEncoder(NewTx(msg, bldr.fees, sig, bldr.memo, entropy), 0)
+		return bldr.txEncoder(bldr.newTx(msgs, bldr.fees, sig, bldr.memo, entropy), 0)
 	}
-	return bldr.txEncoder(NewTx(msg, bldr.fees, sig, bldr.memo, entropy), -1)
+	return bldr.txEncoder(bldr.newTx(msgs, bldr.fees, sig, bldr.memo, entropy), -1)
 }
 
 func (bldr TxBuilder) SignMultisigTransaction(address sdk.Address, keys []crypto.PublicKey, passphrase string, txBytes []byte, legacyCodec bool) (signedTx []byte, err error) {
@@ -164,7 +204,7 @@ func (bldr TxBuilder) SignMultisigTransaction(address sdk.Address, keys []crypto
 	}
 	tx := t.(StdTx)
 	// get the sign bytes from the transaction
-	bytesToSign, err := StdSignBytes(bldr.chainID, tx.GetEntropy(), tx.GetFee(), tx.GetMsg(), tx.GetMemo())
+	bytesToSign, err := StdMultiSignBytes(bldr.chainID, tx.GetEntropy(), tx.GetFee(), tx.GetMsgs(), tx.GetMemo(), tx.GetSequence(), tx.GetFeeGranter())
 	if err != nil {
 		return nil, err
 	}
@@ -204,6 +244,15 @@ func (bldr TxBuilder) SignMultisigTransaction(address sdk.Address, keys []crypto
 }
 
 func (bldr TxBuilder) BuildAndSignMultisigTransaction(address sdk.Address, publicKey crypto.PublicKeyMultiSig, m sdk.ProtoMsg, passphrase string, fees int64, legacyCodec bool) (signedTx []byte, err error) {
+	return bldr.BuildAndSignMultisigMsgsTransaction(address, publicKey, []sdk.ProtoMsg{m}, passphrase, fees, legacyCodec)
+}
+
+// BuildAndSignMultisigMsgsTransaction builds a multisignature transaction carrying an ordered
+// list of messages, and adds the first signature
+func (bldr TxBuilder) BuildAndSignMultisigMsgsTransaction(address sdk.Address, publicKey crypto.PublicKeyMultiSig, msgs []sdk.ProtoMsg, passphrase string, fees int64, legacyCodec bool) (signedTx []byte, err error) {
+	if len(msgs) == 0 {
+		return nil, errors.New("cant build and sign transaciton: no messages")
+	}
 	if bldr.keybase == nil {
 		return nil, errors.New("cant build and sign transaciton: the keybase is nil")
 	}
@@ -213,7 +262,7 @@ func (bldr TxBuilder) BuildAndSignMultisigTransaction(address sdk.Address, publi
 	// bulid the transaction from scratch
 	entropy := rand.Int64()
 	fee := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(fees)))
-	signBz, err := StdSignBytes(bldr.chainID, entropy, fee, m, bldr.memo)
+	signBz, err := StdMultiSignBytes(bldr.chainID, entropy, fee, toMsgs(msgs), bldr.memo, bldr.sequence, bldr.feeGranter)
 	if err != nil {
 		return nil, err
 	}
@@ -230,10 +279,26 @@ func (bldr TxBuilder) BuildAndSignMultisigTransaction(address sdk.Address, publi
 		Signature: ms.Marshal(),
 	}
 	// create a new standard transaction object
-	tx := NewTx(m, fee, sig, "", entropy)
+	tx := bldr.newTx(msgs, fee, sig, "", entropy)
 	// encode it using the default encoder
 	if legacyCodec {
 		return bldr.TxEncoder()(tx, 0)
 	}
 	return bldr.TxEncoder()(tx, -1)
 }
+
+// newTx creates the transaction with the builder sequence and fee granter
+func (bldr TxBuilder) newTx(msgs []sdk.ProtoMsg, fee sdk.Coins, sig StdSignature, memo string, entropy int64) StdTx {
+	tx := NewMultiMsgTx(msgs, fee, sig, memo, entropy).(StdTx)
+	tx.Sequence = bldr.sequence
+	tx.FeeGranter = bldr.feeGranter
+	return tx
+}
+
+func toMsgs(protoMsgs []sdk.ProtoMsg) []sdk.Msg {
+	msgs := make([]sdk.Msg, 0, len(protoMsgs))
+	for _, msg := range protoMsgs {
+		msgs = append(msgs, msg)
+	}
+	return msgs
+}
@@ -0,0 +1,646 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/vipernet-xyz/viper-network/codec"
+	sdk "github.com/vipernet-xyz/viper-network/types"
+	"github.com/vipernet-xyz/viper-network/x/authentication/exported"
+)
+
+// -----------------------------------------------------------------------------
+// BaseVestingAccount
+
+// BaseVestingAccount implements the common logic of the vesting accounts. The locked coins
+// can't be transferred but they can be delegated (staked), the delegations are tracked to
+// know which part of the staked coins was still vesting.
+type BaseVestingAccount struct {
+	*BaseAccount
+	OriginalVesting  sdk.Coins `json:"original_vesting" yaml:"original_vesting"`   // coins that were initially vesting
+	DelegatedFree    sdk.Coins `json:"delegated_free" yaml:"delegated_free"`       // delegated coins that were vested at the time of the delegation
+	DelegatedVesting sdk.Coins `json:"delegated_vesting" yaml:"delegated_vesting"` // delegated coins that were vesting at the time of the delegation
+	EndTime          int64     `json:"end_time" yaml:"end_time"`                   // unix time (seconds) at which all the coins are vested
+}
+
+// NewBaseVestingAccount returns a new BaseVestingAccount
+func NewBaseVestingAccount(baseAccount *BaseAccount, originalVesting sdk.Coins, endTime int64) *BaseVestingAccount {
+	return &BaseVestingAccount{
+		BaseAccount:     baseAccount,
+		OriginalVesting: originalVesting,
+		EndTime:         endTime,
+	}
+}
+
+// lockedCoins returns the vesting coins that are not delegated
+func (bva BaseVestingAccount) lockedCoins(vestingCoins sdk.Coins) sdk.Coins {
+	var locked sdk.Coins
+	for _, coin := range vestingCoins {
+		amt := coin.Amount.Sub(bva.DelegatedVesting.AmountOf(coin.Denom))
+		if amt.IsPositive() {
+			locked = locked.Add(sdk.NewCoins(sdk.NewCoin(coin.Denom, amt)))
+		}
+	}
+	return locked
+}
+
+// spendableCoins returns the coins of the account that are not locked
+func (bva BaseVestingAccount) spendableCoins(vestingCoins sdk.Coins) sdk.Coins {
+	locked := bva.lockedCoins(vestingCoins)
+	var spendable sdk.Coins
+	for _, coin := range bva.GetCoins() {
+		amt := coin.Amount.Sub(locked.AmountOf(coin.Denom))
+		if amt.IsPositive() {
+			spendable = spendable.Add(sdk.NewCoins(sdk.NewCoin(coin.Denom, amt)))
+		}
+	}
+	return spendable
+}
+
+// trackDelegation splits the delegated amount between the vesting and the free coins,
+// the vesting coins are delegated first
+func (bva *BaseVestingAccount) trackDelegation(vestingCoins, amount sdk.Coins) {
+	for _, coin := range amount {
+		vestingAmt := vestingCoins.AmountOf(coin.Denom)
+		delVestingAmt := bva.DelegatedVesting.AmountOf(coin.Denom)
+		// x = min(max(vesting - delegated vesting, 0), amount), y = amount - x
+		x := sdk.MinInt(sdk.MaxInt(vestingAmt.Sub(delVestingAmt), sdk.ZeroInt()), coin.Amount)
+		y := coin.Amount.Sub(x)
+		if x.IsPositive() {
+			bva.DelegatedVesting = bva.DelegatedVesting.Add(sdk.NewCoins(sdk.NewCoin(coin.Denom, x)))
+		}
+		if y.IsPositive() {
+			bva.DelegatedFree = bva.DelegatedFree.Add(sdk.NewCoins(sdk.NewCoin(coin.Denom, y)))
+		}
+	}
+}
+
+// TrackUndelegation tracks the undelegated amount, the free coins are undelegated first.
+// Slashed coins are never undelegated, so they stay tracked as delegated.
+func (bva *BaseVestingAccount) TrackUndelegation(amount sdk.Coins) {
+	for _, coin := range amount {
+		delFreeAmt := bva.DelegatedFree.AmountOf(coin.Denom)
+		delVestingAmt := bva.DelegatedVesting.AmountOf(coin.Denom)
+		x := sdk.MinInt(delFreeAmt, coin.Amount)
+		y := sdk.MinInt(delVestingAmt, coin.Amount.Sub(x))
+		if x.IsPositive() {
+			bva.DelegatedFree = bva.DelegatedFree.Sub(sdk.NewCoins(sdk.NewCoin(coin.Denom, x)))
+		}
+		if y.IsPositive() {
+			bva.DelegatedVesting = bva.DelegatedVesting.Sub(sdk.NewCoins(sdk.NewCoin(coin.Denom, y)))
+		}
+	}
+}
+
+// GetOriginalVesting returns the coins that were initially vesting
+func (bva BaseVestingAccount) GetOriginalVesting() sdk.Coins {
+	return bva.OriginalVesting
+}
+
+// GetDelegatedFree returns the delegated coins that were vested at the time of the delegation
+func (bva BaseVestingAccount) GetDelegatedFree() sdk.Coins {
+	return bva.DelegatedFree
+}
+
+// GetDelegatedVesting returns the delegated coins that were vesting at the time of the delegation
+func (bva BaseVestingAccount) GetDelegatedVesting() sdk.Coins {
+	return bva.DelegatedVesting
+}
+
+// GetEndTime returns the unix time at which all the coins are vested
+func (bva BaseVestingAccount) GetEndTime() int64 {
+	return bva.EndTime
+}
+
+// Validate checks the vesting amounts against the account coins
+func (bva BaseVestingAccount) Validate() error {
+	if bva.BaseAccount == nil {
+		return errors.New("vesting account has no base account")
+	}
+	if !bva.OriginalVesting.IsValid() || bva.OriginalVesting.Empty() {
+		return fmt.Errorf("invalid original vesting coins: %s", bva.OriginalVesting)
+	}
+	if bva.OriginalVesting.IsAnyGT(bva.GetCoins().Add(bva.DelegatedFree).Add(bva.DelegatedVesting)) {
+		return errors.New("vesting amount cannot be greater than the account amount")
+	}
+	return nil
+}
+
+func (bva BaseVestingAccount) ToProto() ProtoBaseVestingAccount {
+	return ProtoBaseVestingAccount{
+		ProtoBaseAccount: bva.BaseAccount.ToProto(),
+		OriginalVesting:  bva.OriginalVesting,
+		DelegatedFree:    bva.DelegatedFree,
+		DelegatedVesting: bva.DelegatedVesting,
+		EndTime:          bva.EndTime,
+	}
+}
+
+func (m *ProtoBaseVestingAccount) FromProto() (BaseVestingAccount, error) {
+	ba, err := m.ProtoBaseAccount.FromProto()
+	if err != nil {
+		return BaseVestingAccount{}, err
+	}
+	return BaseVestingAccount{
+		BaseAccount:      &ba,
+		OriginalVesting:  m.OriginalVesting,
+		DelegatedFree:    m.DelegatedFree,
+		DelegatedVesting: m.DelegatedVesting,
+		EndTime:          m.EndTime,
+	}, nil
+}
+
+type marshalVestingAccount struct {
+	Address          sdk.Address
+	Coins            sdk.Coins
+	PubKey           string
+	Sequence         uint64
+	OriginalVesting  sdk.Coins
+	DelegatedFree    sdk.Coins
+	DelegatedVesting sdk.Coins
+	StartTime        int64 `yaml:",omitempty"`
+	EndTime          int64
+	VestingPeriods   Periods `yaml:",omitempty"`
+}
+
+// vestingString returns the yaml representation of a vesting account
+func vestingString(bva *BaseVestingAccount, startTime int64, periods Periods) string {
+	var pubkey string
+	if bva.PubKey != nil {
+		pubkey = bva.PubKey.RawString()
+	}
+	b, err := yaml.Marshal(marshalVestingAccount{
+		Address:          bva.Address,
+		Coins:            bva.Coins,
+		PubKey:           pubkey,
+		Sequence:         bva.Sequence,
+		OriginalVesting:  bva.OriginalVesting,
+		DelegatedFree:    bva.DelegatedFree,
+		DelegatedVesting: bva.DelegatedVesting,
+		StartTime:        startTime,
+		EndTime:          bva.EndTime,
+		VestingPeriods:   periods,
+	})
+	if err != nil {
+		fmt.Println("couldn't convert vesting account to yaml string: " + err.Error())
+		return ""
+	}
+	return string(b)
+}
+
+// -----------------------------------------------------------------------------
+// ContinuousVestingAccount
+
+var _ exported.VestingAccount = (*ContinuousVestingAccount)(nil)
+var _ codec.ProtoMarshaler = &ContinuousVestingAccount{}
+
+// ContinuousVestingAccount vests its coins linearly between the start and the end time
+type ContinuousVestingAccount struct {
+	*BaseVestingAccount
+	StartTime int64 `json:"start_time" yaml:"start_time"` // unix time (seconds) at which the vesting starts
+}
+
+// NewContinuousVestingAccount returns a ContinuousVestingAccount vesting all of the coins of the base account
+func NewContinuousVestingAccount(baseAcc *BaseAccount, startTime, endTime int64) *ContinuousVestingAccount {
+	return &ContinuousVestingAccount{
+		BaseVestingAccount: NewBaseVestingAccount(baseAcc, baseAcc.Coins, endTime),
+		StartTime:          startTime,
+	}
+}
+
+// GetVestedCoins returns the coins vested at the block time
+func (cva ContinuousVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	t := blockTime.Unix()
+	if t <= cva.StartTime {
+		return nil
+	}
+	if t >= cva.EndTime {
+		return cva.OriginalVesting
+	}
+	var vested sdk.Coins
+	for _, coin := range cva.OriginalVesting {
+		amt := coin.Amount.MulRaw(t - cva.StartTime).QuoRaw(cva.EndTime - cva.StartTime)
+		if amt.IsPositive() {
+			vested = vested.Add(sdk.NewCoins(sdk.NewCoin(coin.Denom, amt)))
+		}
+	}
+	return vested
+}
+
+// GetVestingCoins returns the coins still vesting at the block time
+func (cva ContinuousVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return cva.OriginalVesting.Sub(cva.GetVestedCoins(blockTime))
+}
+
+// LockedCoins returns the vesting coins that are not delegated
+func (cva ContinuousVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return cva.lockedCoins(cva.GetVestingCoins(blockTime))
+}
+
+// SpendableCoins returns the coins that can be transferred at the block time
+func (cva ContinuousVestingAccount) SpendableCoins(blockTime time.Time) sdk.Coins {
+	return cva.spendableCoins(cva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks the delegation of amount at the block time
+func (cva *ContinuousVestingAccount) TrackDelegation(blockTime time.Time, amount sdk.Coins) {
+	cva.trackDelegation(cva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the unix time at which the vesting starts
+func (cva ContinuousVestingAccount) GetStartTime() int64 {
+	return cva.StartTime
+}
+
+// Validate checks the vesting times and amounts
+func (cva ContinuousVestingAccount) Validate() error {
+	if cva.BaseVestingAccount == nil {
+		return errors.New("vesting account has no base vesting account")
+	}
+	if cva.StartTime >= cva.EndTime {
+		return errors.New("vesting start time must be before the end time")
+	}
+	return cva.BaseVestingAccount.Validate()
+}
+
+func (cva ContinuousVestingAccount) String() string {
+	return vestingString(cva.BaseVestingAccount, cva.StartTime, nil)
+}
+
+// MarshalYAML returns the YAML representation of the vesting account
+func (cva ContinuousVestingAccount) MarshalYAML() (interface{}, error) {
+	return cva.String(), nil
+}
+
+func (cva *ContinuousVestingAccount) Reset() {
+	*cva = ContinuousVestingAccount{}
+}
+
+func (cva *ContinuousVestingAccount) ProtoMessage() {
+	p := cva.ToProto()
+	p.ProtoMessage()
+}
+
+func (cva *ContinuousVestingAccount) Marshal() ([]byte, error) {
+	p := cva.ToProto()
+	return p.Marshal()
+}
+
+func (cva *ContinuousVestingAccount) MarshalTo(data []byte) (n int, err error) {
+	p := cva.ToProto()
+	return p.MarshalTo(data)
+}
+
+func (cva *ContinuousVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	p := cva.ToProto()
+	return p.MarshalToSizedBuffer(dAtA)
+}
+
+func (cva *ContinuousVestingAccount) Size() int {
+	p := cva.ToProto()
+	return p.Size()
+}
+
+func (cva *ContinuousVestingAccount) Unmarshal(data []byte) error {
+	var p ProtoContinuousVestingAccount
+	err := p.Unmarshal(data)
+	if err != nil {
+		return err
+	}
+	acc, err := p.FromProto()
+	if err != nil {
+		return err
+	}
+	*cva = acc
+	return nil
+}
+
+func (cva ContinuousVestingAccount) ToProto() ProtoContinuousVestingAccount {
+	return ProtoContinuousVestingAccount{
+		ProtoBaseVestingAccount: cva.BaseVestingAccount.ToProto(),
+		StartTime:               cva.StartTime,
+	}
+}
+
+func (m *ProtoContinuousVestingAccount) FromProto() (ContinuousVestingAccount, error) {
+	bva, err := m.ProtoBaseVestingAccount.FromProto()
+	if err != nil {
+		return ContinuousVestingAccount{}, err
+	}
+	return ContinuousVestingAccount{
+		BaseVestingAccount: &bva,
+		StartTime:          m.StartTime,
+	}, nil
+}
+
+// -----------------------------------------------------------------------------
+// DelayedVestingAccount
+
+var _ exported.VestingAccount = (*DelayedVestingAccount)(nil)
+var _ codec.ProtoMarshaler = &DelayedVestingAccount{}
+
+// DelayedVestingAccount vests all of its coins at the end time
+type DelayedVestingAccount struct {
+	*BaseVestingAccount
+}
+
+// NewDelayedVestingAccount returns a DelayedVestingAccount vesting all of the coins of the base account
+func NewDelayedVestingAccount(baseAcc *BaseAccount, endTime int64) *DelayedVestingAccount {
+	return &DelayedVestingAccount{
+		BaseVestingAccount: NewBaseVestingAccount(baseAcc, baseAcc.Coins, endTime),
+	}
+}
+
+// GetVestedCoins returns the coins vested at the block time
+func (dva DelayedVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	if blockTime.Unix() >= dva.EndTime {
+		return dva.OriginalVesting
+	}
+	return nil
+}
+
+// GetVestingCoins returns the coins still vesting at the block time
+func (dva DelayedVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return dva.OriginalVesting.Sub(dva.GetVestedCoins(blockTime))
+}
+
+// LockedCoins returns the vesting coins that are not delegated
+func (dva DelayedVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return dva.lockedCoins(dva.GetVestingCoins(blockTime))
+}
+
+// SpendableCoins returns the coins that can be transferred at the block time
+func (dva DelayedVestingAccount) SpendableCoins(blockTime time.Time) sdk.Coins {
+	return dva.spendableCoins(dva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks the delegation of amount at the block time
+func (dva *DelayedVestingAccount) TrackDelegation(blockTime time.Time, amount sdk.Coins) {
+	dva.trackDelegation(dva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns zero, the coins of a delayed vesting account vest at once
+func (dva DelayedVestingAccount) GetStartTime() int64 {
+	return 0
+}
+
+// Validate checks the vesting amounts
+func (dva DelayedVestingAccount) Validate() error {
+	if dva.BaseVestingAccount == nil {
+		return errors.New("vesting account has no base vesting account")
+	}
+	return dva.BaseVestingAccount.Validate()
+}
+
+func (dva DelayedVestingAccount) String() string {
+	return vestingString(dva.BaseVestingAccount, 0, nil)
+}
+
+// MarshalYAML returns the YAML representation of the vesting account
+func (dva DelayedVestingAccount) MarshalYAML() (interface{}, error) {
+	return dva.String(), nil
+}
+
+func (dva *DelayedVestingAccount) Reset() {
+	*dva = DelayedVestingAccount{}
+}
+
+func (dva *DelayedVestingAccount) ProtoMessage() {
+	p := dva.ToProto()
+	p.ProtoMessage()
+}
+
+func (dva *DelayedVestingAccount) Marshal() ([]byte, error) {
+	p := dva.ToProto()
+	return p.Marshal()
+}
+
+func (dva *DelayedVestingAccount) MarshalTo(data []byte) (n int, err error) {
+	p := dva.ToProto()
+	return p.MarshalTo(data)
+}
+
+func (dva *DelayedVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	p := dva.ToProto()
+	return p.MarshalToSizedBuffer(dAtA)
+}
+
+func (dva *DelayedVestingAccount) Size() int {
+	p := dva.ToProto()
+	return p.Size()
+}
+
+func (dva *DelayedVestingAccount) Unmarshal(data []byte) error {
+	var p ProtoDelayedVestingAccount
+	err := p.Unmarshal(data)
+	if err != nil {
+		return err
+	}
+	acc, err := p.FromProto()
+	if err != nil {
+		return err
+	}
+	*dva = acc
+	return nil
+}
+
+func (dva DelayedVestingAccount) ToProto() ProtoDelayedVestingAccount {
+	return ProtoDelayedVestingAccount{
+		ProtoBaseVestingAccount: dva.BaseVestingAccount.ToProto(),
+	}
+}
+
+func (m *ProtoDelayedVestingAccount) FromProto() (DelayedVestingAccount, error) {
+	bva, err := m.ProtoBaseVestingAccount.FromProto()
+	if err != nil {
+		return DelayedVestingAccount{}, err
+	}
+	return DelayedVestingAccount{BaseVestingAccount: &bva}, nil
+}
+
+// -----------------------------------------------------------------------------
+// PeriodicVestingAccount
+
+var _ exported.VestingAccount = (*PeriodicVestingAccount)(nil)
+var _ codec.ProtoMarshaler = &PeriodicVestingAccount{}
+
+// Periods are the consecutive vesting periods of a PeriodicVestingAccount
+type Periods []Period
+
+// TotalLength returns the summed length of the periods, in seconds
+func (p Periods) TotalLength() int64 {
+	var total int64
+	for _, period := range p {
+		total += period.Length
+	}
+	return total
+}
+
+// TotalAmount returns the summed amount of the periods
+func (p Periods) TotalAmount() sdk.Coins {
+	var total sdk.Coins
+	for _, period := range p {
+		total = total.Add(period.Amount)
+	}
+	return total
+}
+
+// PeriodicVestingAccount vests its coins at the end of each consecutive period
+type PeriodicVestingAccount struct {
+	*BaseVestingAccount
+	StartTime      int64   `json:"start_time" yaml:"start_time"`           // unix time (seconds) at which the first period starts
+	VestingPeriods Periods `json:"vesting_periods" yaml:"vesting_periods"` // consecutive vesting periods
+}
+
+// NewPeriodicVestingAccount returns a PeriodicVestingAccount vesting the amounts of the periods,
+// the end time is the end of the last period
+func NewPeriodicVestingAccount(baseAcc *BaseAccount, startTime int64, periods Periods) *PeriodicVestingAccount {
+	return &PeriodicVestingAccount{
+		BaseVestingAccount: NewBaseVestingAccount(baseAcc, periods.TotalAmount(), startTime+periods.TotalLength()),
+		StartTime:          startTime,
+		VestingPeriods:     periods,
+	}
+}
+
+// GetVestedCoins returns the coins of the periods ended at the block time
+func (pva PeriodicVestingAccount) GetVestedCoins(blockTime time.Time) sdk.Coins {
+	t := blockTime.Unix()
+	if t <= pva.StartTime {
+		return nil
+	}
+	if t >= pva.EndTime {
+		return pva.OriginalVesting
+	}
+	var vested sdk.Coins
+	periodEnd := pva.StartTime
+	for _, period := range pva.VestingPeriods {
+		periodEnd += period.Length
+		if t < periodEnd {
+			break
+		}
+		vested = vested.Add(period.Amount)
+	}
+	return vested
+}
+
+// GetVestingCoins returns the coins still vesting at the block time
+func (pva PeriodicVestingAccount) GetVestingCoins(blockTime time.Time) sdk.Coins {
+	return pva.OriginalVesting.Sub(pva.GetVestedCoins(blockTime))
+}
+
+// LockedCoins returns the vesting coins that are not delegated
+func (pva PeriodicVestingAccount) LockedCoins(blockTime time.Time) sdk.Coins {
+	return pva.lockedCoins(pva.GetVestingCoins(blockTime))
+}
+
+// SpendableCoins returns the coins that can be transferred at the block time
+func (pva PeriodicVestingAccount) SpendableCoins(blockTime time.Time) sdk.Coins {
+	return pva.spendableCoins(pva.GetVestingCoins(blockTime))
+}
+
+// TrackDelegation tracks the delegation of amount at the block time
+func (pva *PeriodicVestingAccount) TrackDelegation(blockTime time.Time, amount sdk.Coins) {
+	pva.trackDelegation(pva.GetVestingCoins(blockTime), amount)
+}
+
+// GetStartTime returns the unix time at which the first period starts
+func (pva PeriodicVestingAccount) GetStartTime() int64 {
+	return pva.StartTime
+}
+
+// Validate checks the periods against the vesting times and amounts
+func (pva PeriodicVestingAccount) Validate() error {
+	if pva.BaseVestingAccount == nil {
+		return errors.New("vesting account has no base vesting account")
+	}
+	if len(pva.VestingPeriods) == 0 {
+		return errors.New("periodic vesting account has no vesting periods")
+	}
+	for _, period := range pva.VestingPeriods {
+		if period.Length <= 0 {
+			return fmt.Errorf("invalid vesting period length: %d", period.Length)
+		}
+	}
+	if pva.StartTime+pva.VestingPeriods.TotalLength() != pva.EndTime {
+		return errors.New("vesting end time does not match the length of the vesting periods")
+	}
+	if !pva.VestingPeriods.TotalAmount().IsEqual(pva.OriginalVesting) {
+		return errors.New("original vesting coins do not match the sum of the vesting periods")
+	}
+	return pva.BaseVestingAccount.Validate()
+}
+
+func (pva PeriodicVestingAccount) String() string {
+	return vestingString(pva.BaseVestingAccount, pva.StartTime, pva.VestingPeriods)
+}
+
+// MarshalYAML returns the YAML representation of the vesting account
+func (pva PeriodicVestingAccount) MarshalYAML() (interface{}, error) {
+	return pva.String(), nil
+}
+
+func (pva *PeriodicVestingAccount) Reset() {
+	*pva = PeriodicVestingAccount{}
+}
+
+func (pva *PeriodicVestingAccount) ProtoMessage() {
+	p := pva.ToProto()
+	p.ProtoMessage()
+}
+
+func (pva *PeriodicVestingAccount) Marshal() ([]byte, error) {
+	p := pva.ToProto()
+	return p.Marshal()
+}
+
+func (pva *PeriodicVestingAccount) MarshalTo(data []byte) (n int, err error) {
+	p := pva.ToProto()
+	return p.MarshalTo(data)
+}
+
+func (pva *PeriodicVestingAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	p := pva.ToProto()
+	return p.MarshalToSizedBuffer(dAtA)
+}
+
+func (pva *PeriodicVestingAccount) Size() int {
+	p := pva.ToProto()
+	return p.Size()
+}
+
+func (pva *PeriodicVestingAccount) Unmarshal(data []byte) error {
+	var p ProtoPeriodicVestingAccount
+	err := p.Unmarshal(data)
+	if err != nil {
+		return err
+	}
+	acc, err := p.FromProto()
+	if err != nil {
+		return err
+	}
+	*pva = acc
+	return nil
+}
+
+func (pva PeriodicVestingAccount) ToProto() ProtoPeriodicVestingAccount {
+	return ProtoPeriodicVestingAccount{
+		ProtoBaseVestingAccount: pva.BaseVestingAccount.ToProto(),
+		StartTime:               pva.StartTime,
+		VestingPeriods:          pva.VestingPeriods,
+	}
+}
+
+func (m *ProtoPeriodicVestingAccount) FromProto() (PeriodicVestingAccount, error) {
+	bva, err := m.ProtoBaseVestingAccount.FromProto()
+	if err != nil {
+		return PeriodicVestingAccount{}, err
+	}
+	return PeriodicVestingAccount{
+		BaseVestingAccount: &bva,
+		StartTime:          m.StartTime,
+		VestingPeriods:     m.VestingPeriods,
+	}, nil
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	crypto "github.com/vipernet-xyz/viper-network/crypto/codec"
+	sdk "github.com/vipernet-xyz/viper-network/types"
+)
+
+func testVestingCoins(amt int64) sdk.Coins {
+	return sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultStakeDenom, amt))
+}
+
+func newTestVestingBaseAccount(amt int64) *BaseAccount {
+	pk := crypto.GenerateEd25519PrivKey().PublicKey()
+	return &BaseAccount{Address: sdk.Address(pk.Address()), PubKey: pk, Coins: testVestingCoins(amt)}
+}
+
+func TestContinuousVestingAccount(t *testing.T) {
+	acc := NewContinuousVestingAccount(newTestVestingBaseAccount(1000), 1000, 2000)
+	assert.Nil(t, acc.Validate())
+	assert.True(t, acc.GetVestedCoins(time.Unix(1000, 0)).Empty())
+	assert.Equal(t, testVestingCoins(1000), acc.LockedCoins(time.Unix(1000, 0)))
+	assert.Equal(t, testVestingCoins(250), acc.GetVestedCoins(time.Unix(1250, 0)))
+	assert.Equal(t, testVestingCoins(750), acc.GetVestingCoins(time.Unix(1250, 0)))
+	assert.Equal(t, testVestingCoins(250), acc.SpendableCoins(time.Unix(1250, 0)))
+	assert.Equal(t, testVestingCoins(1000), acc.GetVestedCoins(time.Unix(3000, 0)))
+	assert.Equal(t, testVestingCoins(1000), acc.SpendableCoins(time.Unix(3000, 0)))
+	acc.StartTime = 2000
+	assert.NotNil(t, acc.Validate())
+}
+
+func TestDelayedVestingAccount(t *testing.T) {
+	acc := NewDelayedVestingAccount(newTestVestingBaseAccount(1000), 2000)
+	assert.Nil(t, acc.Validate())
+	assert.True(t, acc.GetVestedCoins(time.Unix(1999, 0)).Empty())
+	assert.True(t, acc.SpendableCoins(time.Unix(1999, 0)).Empty())
+	assert.Equal(t, testVestingCoins(1000), acc.GetVestedCoins(time.Unix(2000, 0)))
+	assert.True(t, acc.LockedCoins(time.Unix(2000, 0)).Empty())
+}
+
+func TestPeriodicVestingAccount(t *testing.T) {
+	periods := Periods{{Length: 100, Amount: testVestingCoins(300)}, {Length: 200, Amount: testVestingCoins(700)}}
+	acc := NewPeriodicVestingAccount(newTestVestingBaseAccount(1000), 1000, periods)
+	assert.Nil(t, acc.Validate())
+	assert.Equal(t, int64(1300), acc.GetEndTime())
+	assert.Equal(t, testVestingCoins(1000), acc.GetOriginalVesting())
+	assert.True(t, acc.GetVestedCoins(time.Unix(1099, 0)).Empty())
+	assert.Equal(t, testVestingCoins(300), acc.GetVestedCoins(time.Unix(1100, 0)))
+	assert.Equal(t, testVestingCoins(300), acc.GetVestedCoins(time.Unix(1299, 0)))
+	assert.Equal(t, testVestingCoins(1000), acc.GetVestedCoins(time.Unix(1300, 0)))
+	acc.VestingPeriods = acc.VestingPeriods[:1]
+	assert.NotNil(t, acc.Validate())
+}
+
+func TestVestingAccountDelegation(t *testing.T) {
+	acc := NewContinuousVestingAccount(newTestVestingBaseAccount(1000), 1000, 2000)
+	blockTime := time.Unix(1500, 0)
+	// the vesting coins are delegated first
+	acc.TrackDelegation(blockTime, testVestingCoins(600))
+	require.NoError(t, acc.SetCoins(testVestingCoins(400)))
+	assert.Equal(t, testVestingCoins(500), acc.GetDelegatedVesting())
+	assert.Equal(t, testVestingCoins(100), acc.GetDelegatedFree())
+	assert.True(t, acc.LockedCoins(blockTime).Empty())
+	assert.Equal(t, testVestingCoins(400), acc.SpendableCoins(blockTime))
+	// and the free coins are undelegated first
+	acc.TrackUndelegation(testVestingCoins(300))
+	require.NoError(t, acc.SetCoins(testVestingCoins(700)))
+	assert.True(t, acc.GetDelegatedFree().Empty())
+	assert.Equal(t, testVestingCoins(300), acc.GetDelegatedVesting())
+	assert.Equal(t, testVestingCoins(200), acc.LockedCoins(blockTime))
+	assert.Equal(t, testVestingCoins(500), acc.SpendableCoins(blockTime))
+	assert.Nil(t, acc.Validate())
+}
+
+func TestVestingAccountProto(t *testing.T) {
+	acc := NewPeriodicVestingAccount(newTestVestingBaseAccount(1000), 1000, Periods{{Length: 100, Amount: testVestingCoins(1000)}})
+	acc.TrackDelegation(time.Unix(1000, 0), testVestingCoins(400))
+	bz, err := acc.Marshal()
+	require.NoError(t, err)
+	var decoded PeriodicVestingAccount
+	require.NoError(t, decoded.Unmarshal(bz))
+	assert.Equal(t, *acc, decoded)
+	// json round trip through the account interface
+	var genesis GenesisState
+	require.NoError(t, ModuleCdc.UnmarshalJSON(ModuleCdc.MustMarshalJSON(GenesisState{Accounts: Accounts{acc}}), &genesis))
+	require.Len(t, genesis.Accounts, 1)
+	assert.Equal(t, acc.String(), genesis.Accounts[0].String())
+}
@@ -249,6 +249,24 @@ func (ctx CLIContext) GetAccountWithHeight(addr sdk.Address) (exported.Account,
 	return account, height, nil
 }
 
+// GetNextSequence queries the sequence the next transaction signed by the address must carry,
+// zero while the account sequences are not activated. Returns the height of the query.
+func (ctx CLIContext) GetNextSequence(addr sdk.Address) (uint64, int64, error) {
+	bs, err := authentication.ModuleCdc.MarshalJSON(types.NewQueryAccountParams(addr))
+	if err != nil {
+		return 0, 0, err
+	}
+	res, height, err := ctx.QueryWithData(fmt.Sprintf("custom/%s/%s", authentication.QuerierRoute, authentication.QuerySequence), bs)
+	if err != nil {
+		return 0, height, err
+	}
+	var sequence uint64
+	if err := authentication.ModuleCdc.UnmarshalJSON(res, &sequence); err != nil {
+		return 0, height, err
+	}
+	return sequence, height, nil
+}
+
 // EnsureExists returns an error if no account exists for the given address else nil.
 func (ctx CLIContext) EnsureExists(addr sdk.Address) error {
 	if _, err := ctx.GetAccount(addr); err != nil {
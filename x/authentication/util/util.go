@@ -1,6 +1,8 @@
 package util
 
 import (
+	"sync"
+
 	sdk "github.com/vipernet-xyz/viper-network/types"
 	"github.com/vipernet-xyz/viper-network/x/authentication"
 )
@@ -16,6 +18,11 @@ func CompleteAndBroadcastTxCLI(txBldr authentication.TxBuilder, cliCtx CLIContex
 	//	return nil, err
 	//} TODO removed safety check for auto transactions
 
+	sequence, err := NextSequence(cliCtx, cliCtx.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+	txBldr = txBldr.WithSequence(sequence)
 	// build and sign the transaction
 
 	if cliCtx.PrivateKey != nil {
@@ -25,6 +32,9 @@ func CompleteAndBroadcastTxCLI(txBldr authentication.TxBuilder, cliCtx CLIContex
 		}
 		// broadcast to a Tendermint servicer
 		tx, err := cliCtx.BroadcastTx(txBytes)
+		if err != nil || tx.Code != 0 {
+			ReleaseSequence(cliCtx.FromAddress)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -36,6 +46,9 @@ func CompleteAndBroadcastTxCLI(txBldr authentication.TxBuilder, cliCtx CLIContex
 		}
 		// broadcast to a Tendermint servicer
 		tx, err := cliCtx.BroadcastTx(txBytes)
+		if err != nil || tx.Code != 0 {
+			ReleaseSequence(cliCtx.FromAddress)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -46,6 +59,41 @@ func CompleteAndBroadcastTxCLI(txBldr authentication.TxBuilder, cliCtx CLIContex
 
 }
 
+// pendingSequences holds the last sequence used by each address, with the height it was queried
+// at, so the transactions sent within a block don't all carry the committed next sequence
+var pendingSequences = struct {
+	sync.Mutex
+	m map[string]pendingSequence
+}{m: make(map[string]pendingSequence)}
+
+type pendingSequence struct {
+	height   int64
+	sequence uint64
+}
+
+// NextSequence returns the sequence of the next transaction signed by the address, accounting
+// for the transactions already sent since the last block. Zero while the sequences are not activated.
+func NextSequence(cliCtx CLIContext, addr sdk.Address) (uint64, error) {
+	sequence, height, err := cliCtx.GetNextSequence(addr)
+	if err != nil || sequence == 0 {
+		return sequence, err
+	}
+	pendingSequences.Lock()
+	defer pendingSequences.Unlock()
+	if p, ok := pendingSequences.m[addr.String()]; ok && p.height == height && p.sequence >= sequence {
+		sequence = p.sequence + 1
+	}
+	pendingSequences.m[addr.String()] = pendingSequence{height: height, sequence: sequence}
+	return sequence, nil
+}
+
+// ReleaseSequence forgets the pending sequences of the address, the next one is queried again
+func ReleaseSequence(addr sdk.Address) {
+	pendingSequences.Lock()
+	defer pendingSequences.Unlock()
+	delete(pendingSequences.m, addr.String())
+}
+
 // PrepareTxBuilder populates a TxBuilder in preparation for the build of a Tx.
 func PrepareTxBuilder(txBldr authentication.TxBuilder, cliCtx CLIContext) (authentication.TxBuilder, error) {
 	from := cliCtx.GetFromAddress()
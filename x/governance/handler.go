@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"reflect"
 
+	"github.com/vipernet-xyz/viper-network/codec"
 	crypto "github.com/vipernet-xyz/viper-network/crypto/codec"
 
 	sdk "github.com/vipernet-xyz/viper-network/types"
@@ -27,6 +28,12 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgUpgrade(ctx, msg, k)
 		case types.MsgGenerateDiscountKey:
 			return handleMsgGenerateDiscountKey(ctx, k, msg)
+		case types.MsgSubmitProposal:
+			return handleMsgSubmitProposal(ctx, msg, k)
+		case types.MsgVote:
+			return handleMsgVote(ctx, msg, k)
+		case types.MsgCreateVestingAccount:
+			return handleMsgCreateVestingAccount(ctx, msg, k)
 		default:
 			errMsg := fmt.Sprintf("unrecognized governance message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -52,10 +59,35 @@ func handleMsgDaoTransfer(ctx sdk.Ctx, msg types.MsgDAOTransfer, k keeper.Keeper
 	return sdk.Result{}
 }
 
+func handleMsgCreateVestingAccount(ctx sdk.Ctx, msg types.MsgCreateVestingAccount, k keeper.Keeper) sdk.Result {
+	return k.DAOCreateVestingAccount(ctx, msg.FromAddress, msg.VestingAccount(sdk.DefaultStakeDenom))
+}
+
 func handleMsgUpgrade(ctx sdk.Ctx, msg types.MsgUpgrade, k keeper.Keeper) sdk.Result {
 	return k.HandleUpgrade(ctx, types.NewACLKey(ModuleName, string(types.UpgradeKey)), msg.Upgrade, msg.Address)
 }
 
+func handleMsgSubmitProposal(ctx sdk.Ctx, msg types.MsgSubmitProposal, k keeper.Keeper) sdk.Result {
+	if !types.ModuleCdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.GovernanceKey) {
+		return types.ErrProposalsInactive(types.ModuleName).Result()
+	}
+	proposal, err := k.SubmitProposal(ctx, msg.Proposer, msg.Title, msg.Description, msg.Content, msg.Deposit)
+	if err != nil {
+		return err.Result()
+	}
+	return sdk.Result{Data: types.ProposalIDToBytes(proposal.Id), Events: ctx.EventManager().Events()}
+}
+
+func handleMsgVote(ctx sdk.Ctx, msg types.MsgVote, k keeper.Keeper) sdk.Result {
+	if !types.ModuleCdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.GovernanceKey) {
+		return types.ErrProposalsInactive(types.ModuleName).Result()
+	}
+	if err := k.AddVote(ctx, msg.ProposalID, msg.Voter, msg.Option); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
 func handleMsgGenerateDiscountKey(ctx sdk.Ctx, k keeper.Keeper, msg types.MsgGenerateDiscountKey) sdk.Result {
 	// Check if a discount key already exists for the given address
 	if k.HasDiscountKey(ctx, msg.ToAddress) {
@@ -0,0 +1,33 @@
+package keeper
+
+import (
+	"github.com/vipernet-xyz/viper-network/codec"
+	sdk "github.com/vipernet-xyz/viper-network/types"
+	"github.com/vipernet-xyz/viper-network/x/governance/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+)
+
+// EndBlocker - Tallies and executes the proposals whose voting period ends at this height
+func EndBlocker(ctx sdk.Ctx, k Keeper) []abci.ValidatorUpdate {
+	// no proposal can be submitted before the activation
+	if !types.ModuleCdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.GovernanceKey) {
+		return []abci.ValidatorUpdate{}
+	}
+	store := ctx.KVStore(k.key)
+	iterator, _ := store.Iterator(types.ActiveProposalsQueueKey, types.KeyForActiveProposalsByHeight(ctx.BlockHeight()+1))
+	queueKeys := make([][]byte, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		queueKeys = append(queueKeys, iterator.Key())
+	}
+	iterator.Close()
+	for _, queueKey := range queueKeys {
+		_ = store.Delete(queueKey)
+		proposal, found := k.GetProposal(ctx, types.ProposalIDFromActiveQueueKey(queueKey))
+		if !found || !proposal.IsActive() {
+			continue
+		}
+		k.finalizeProposal(ctx, proposal)
+	}
+	return []abci.ValidatorUpdate{}
+}
@@ -75,6 +75,7 @@ func createTestKeeperAndContext(t *testing.T, isCheckTx bool) (sdk.Ctx, Keeper)
 	maccPerms := map[string][]string{
 		authentication.FeeCollectorName: nil,
 		governanceTypes.DAOAccountName:  {"burner", "staking", "minter"},
+		governanceTypes.ModuleName:      {"burner"},
 		"FAKE":                          {"burner", "staking", "minter"},
 	}
 	modAccAddrs := make(map[string]bool)
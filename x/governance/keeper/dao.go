@@ -3,6 +3,7 @@ package keeper
 import (
 	"fmt"
 
+	"github.com/vipernet-xyz/viper-network/codec"
 	sdk "github.com/vipernet-xyz/viper-network/types"
 	exported2 "github.com/vipernet-xyz/viper-network/x/authentication/exported"
 	"github.com/vipernet-xyz/viper-network/x/governance/types"
@@ -12,6 +13,11 @@ func (k Keeper) DAOTransferFrom(ctx sdk.Ctx, owner, to sdk.Address, amount sdk.B
 	if !k.GetDAOOwner(ctx).Equals(owner) {
 		return sdk.ErrUnauthorized(fmt.Sprintf("non dao owner is trying to transfer from the dao %s", owner.String())).Result()
 	}
+	return k.daoTransfer(ctx, owner, to, amount)
+}
+
+// daoTransfer sends tokens from the dao without checking the dao owner
+func (k Keeper) daoTransfer(ctx sdk.Ctx, owner, to sdk.Address, amount sdk.BigInt) sdk.Result {
 	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, amount))
 	err := k.AuthKeeper.SendCoinsFromModuleToAccount(ctx, types.DAOAccountName, to, coins)
 	if err != nil {
@@ -33,6 +39,11 @@ func (k Keeper) DAOBurn(ctx sdk.Ctx, owner sdk.Address, amount sdk.BigInt) sdk.R
 	if !k.GetDAOOwner(ctx).Equals(owner) {
 		return sdk.ErrUnauthorized(fmt.Sprintf("non dao owner is trying to burn from the dao %s", owner.String())).Result()
 	}
+	return k.daoBurn(ctx, owner, amount)
+}
+
+// daoBurn burns tokens from the dao without checking the dao owner
+func (k Keeper) daoBurn(ctx sdk.Ctx, owner sdk.Address, amount sdk.BigInt) sdk.Result {
 	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, amount))
 	err := k.AuthKeeper.BurnCoins(ctx, types.DAOAccountName, coins)
 	if err != nil {
@@ -55,6 +66,30 @@ func (k Keeper) DAOBurn(ctx sdk.Ctx, owner sdk.Address, amount sdk.BigInt) sdk.R
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
 
+// DAOCreateVestingAccount funds a new vesting account from the dao
+func (k Keeper) DAOCreateVestingAccount(ctx sdk.Ctx, owner sdk.Address, acc exported2.VestingAccount) sdk.Result {
+	if !types.ModuleCdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.VestingAccountKey) {
+		return types.ErrVestingAccountsInactive(k.codespace).Result()
+	}
+	if !k.GetDAOOwner(ctx).Equals(owner) {
+		return sdk.ErrUnauthorized(fmt.Sprintf("non dao owner is trying to create a vesting account from the dao %s", owner.String())).Result()
+	}
+	err := k.AuthKeeper.CreateVestingAccountFromModule(ctx, types.DAOAccountName, acc)
+	if err != nil {
+		return err.Result()
+	}
+	// create the event
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventCreateVesting,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, acc.GetOriginalVesting().String()),
+			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
 func (k Keeper) GetDAOTokens(ctx sdk.Ctx) sdk.BigInt {
 	return k.GetDAOAccount(ctx).GetCoins().AmountOf(sdk.DefaultStakeDenom)
 }
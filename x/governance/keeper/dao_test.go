@@ -4,7 +4,9 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/vipernet-xyz/viper-network/codec"
 	sdk "github.com/vipernet-xyz/viper-network/types"
+	authKeeper "github.com/vipernet-xyz/viper-network/x/authentication/keeper"
 	"github.com/vipernet-xyz/viper-network/x/governance/types"
 
 	"github.com/stretchr/testify/assert"
@@ -86,3 +88,32 @@ func TestKeeper_GetDAOTransfer(t *testing.T) {
 		),
 	)
 }
+
+func TestKeeper_DAOCreateVestingAccount(t *testing.T) {
+	ctx, k := createTestKeeperAndContext(t, false)
+	err := k.AuthKeeper.MintCoins(ctx, types.DAOAccountName, sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(1000))))
+	assert.Nil(t, err)
+	msg := types.MsgCreateVestingAccount{
+		FromAddress: k.GetDAOOwner(ctx),
+		ToAddress:   getRandomValidatorAddress(),
+		Amount:      sdk.NewInt(100),
+		VestingType: types.DelayedVestingString,
+		EndTime:     2000,
+	}
+	// the vesting accounts can't be created before the activation
+	res := k.DAOCreateVestingAccount(ctx, k.GetDAOOwner(ctx), msg.VestingAccount(sdk.DefaultStakeDenom))
+	assert.Equal(t, types.CodeVestingAccountsInactive, res.Code)
+	assert.Equal(t, int64(1000), k.GetDAOTokens(ctx).Int64())
+	codec.UpgradeFeatureMap[codec.VestingAccountKey] = -1
+	defer func() { codec.UpgradeFeatureMap[codec.VestingAccountKey] = 0 }()
+	res = k.DAOCreateVestingAccount(ctx, getRandomValidatorAddress(), msg.VestingAccount(sdk.DefaultStakeDenom))
+	assert.False(t, res.IsOK())
+	res = k.DAOCreateVestingAccount(ctx, k.GetDAOOwner(ctx), msg.VestingAccount(sdk.DefaultStakeDenom))
+	assert.True(t, res.IsOK())
+	assert.Equal(t, int64(900), k.GetDAOTokens(ctx).Int64())
+	acc := k.AuthKeeper.(authKeeper.Keeper).GetAccount(ctx, msg.ToAddress)
+	assert.Equal(t, int64(100), acc.GetCoins().AmountOf(sdk.DefaultStakeDenom).Int64())
+	// the account can't be created twice
+	res = k.DAOCreateVestingAccount(ctx, k.GetDAOOwner(ctx), msg.VestingAccount(sdk.DefaultStakeDenom))
+	assert.False(t, res.IsOK())
+}
@@ -27,10 +27,27 @@ func (k Keeper) InitGenesis(ctx sdk.Ctx, data types.GenesisState) []abci.Validat
 	if err != nil {
 		k.Logger(ctx).Error(fmt.Errorf("unable to set dao tokens: %s", err.Error()).Error())
 	}
+	nextProposalID := uint64(1)
+	for _, proposal := range data.Proposals {
+		k.SetProposal(ctx, proposal)
+		if proposal.IsActive() {
+			k.insertActiveProposal(ctx, proposal)
+		}
+		if proposal.Id >= nextProposalID {
+			nextProposalID = proposal.Id + 1
+		}
+	}
+	k.SetNextProposalID(ctx, nextProposalID)
+	for _, vote := range data.Votes {
+		k.SetVote(ctx, vote)
+	}
 	return []abci.ValidatorUpdate{}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper
 func (k Keeper) ExportGenesis(ctx sdk.Ctx) types.GenesisState {
-	return types.NewGenesisState(k.GetParams(ctx), k.GetDAOTokens(ctx))
+	gs := types.NewGenesisState(k.GetParams(ctx), k.GetDAOTokens(ctx))
+	gs.Proposals = k.GetAllProposals(ctx, types.StatusNil)
+	gs.Votes = k.GetAllVotes(ctx)
+	return gs
 }
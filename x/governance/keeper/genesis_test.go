@@ -31,3 +31,22 @@ func TestExportGenesis(t *testing.T) {
 	assert.Equal(t, k.ExportGenesis(ctx).Params.ACL.String(), d.Params.ACL.String())
 	assert.Equal(t, k.ExportGenesis(ctx).DAOTokens.Int64(), d.DAOTokens.Int64())
 }
+
+func TestExportGenesisProposals(t *testing.T) {
+	ctx, k, servicer, _ := createTestProposalKeeper(t)
+	fundAccount(t, ctx, k, servicer, sdk.NewInt(1000))
+	proposal, err := k.SubmitProposal(ctx, servicer, "title", "", types.NewUpgradeContent(types.NewUpgrade(100, "0.2.0")), sdk.NewInt(1000))
+	assert.Nil(t, err)
+	assert.Nil(t, k.AddVote(ctx, proposal.Id, servicer, types.OptionYes))
+	gs := k.ExportGenesis(ctx)
+	assert.Len(t, gs.Proposals, 1)
+	assert.Len(t, gs.Votes, 1)
+	assert.Nil(t, types.ValidateGenesis(gs))
+	ctx2, k2 := createTestKeeperAndContext(t, false)
+	k2.InitGenesis(ctx2, gs)
+	imported, found := k2.GetProposal(ctx2, proposal.Id)
+	assert.True(t, found)
+	assert.Equal(t, proposal.VotingEndHeight, imported.VotingEndHeight)
+	assert.Len(t, k2.GetVotes(ctx2, proposal.Id), 1)
+	assert.Equal(t, proposal.Id+1, k2.GetNextProposalID(ctx2))
+}
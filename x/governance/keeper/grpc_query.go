@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	sdk "github.com/vipernet-xyz/viper-network/types"
+	"github.com/vipernet-xyz/viper-network/x/governance/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ types.QueryServer = queryServer{}
+
+type queryServer struct {
+	k Keeper
+}
+
+// NewQueryServer returns the implementation of the grpc query service of the module
+func NewQueryServer(k Keeper) types.QueryServer {
+	return queryServer{k: k}
+}
+
+// Params implements the Query/Params gRPC method
+func (q queryServer) Params(c context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	params := make([]types.Param, 0)
+	for key, value := range q.k.GetAllParamNameValue(sdk.UnwrapSDKContext(c)) {
+		if req.Key != "" && key != req.Key {
+			continue
+		}
+		// the json strings are unquoted, the json objects are kept as is
+		if s, err := strconv.Unquote(value); err == nil {
+			value = s
+		}
+		params = append(params, types.Param{Key: key, Value: value})
+	}
+	if req.Key != "" && len(params) == 0 {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("param %s not found", req.Key))
+	}
+	sort.Slice(params, func(i, j int) bool {
+		return params[i].Key < params[j].Key
+	})
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// ACL implements the Query/ACL gRPC method
+func (q queryServer) ACL(c context.Context, req *types.QueryACLRequest) (*types.QueryACLResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	return &types.QueryACLResponse{Acl: q.k.GetACL(sdk.UnwrapSDKContext(c))}, nil
+}
+
+// Upgrade implements the Query/Upgrade gRPC method
+func (q queryServer) Upgrade(c context.Context, req *types.QueryUpgradeRequest) (*types.QueryUpgradeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	return &types.QueryUpgradeResponse{Upgrade: q.k.GetUpgrade(sdk.UnwrapSDKContext(c))}, nil
+}
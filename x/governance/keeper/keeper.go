@@ -19,6 +19,8 @@ type Keeper struct {
 	codespace        sdk.CodespaceType
 	paramstore       sdk.Subspace
 	AuthKeeper       types.AuthKeeper
+	ServicersKeeper  types.ServicersKeeper
+	RequestorsKeeper types.RequestorsKeeper
 	spaces           map[string]sdk.Subspace
 }
 
@@ -23,6 +23,12 @@ func (k Keeper) GetParams(ctx sdk.Ctx) types.Params {
 		ACL:      k.GetACL(ctx),
 		Upgrade:  k.GetUpgrade(ctx),
 		DAOOwner: k.GetDAOOwner(ctx),
+
+		MinProposalDeposit: k.MinProposalDeposit(ctx),
+		VotingPeriod:       k.VotingPeriod(ctx),
+		Quorum:             k.Quorum(ctx),
+		Threshold:          k.Threshold(ctx),
+		VetoThreshold:      k.VetoThreshold(ctx),
 	}
 }
 
@@ -41,6 +47,48 @@ func (k Keeper) GetUpgrade(ctx sdk.Ctx) (res types.Upgrade) {
 	return
 }
 
+// MinProposalDeposit - Retrieve the minimum deposit needed to submit a proposal
+func (k Keeper) MinProposalDeposit(ctx sdk.Ctx) (res sdk.BigInt) {
+	k.paramstore.Get(ctx, types.MinProposalDepositKey, &res)
+	if res.BigInt() == nil {
+		return sdk.ZeroInt()
+	}
+	return
+}
+
+// VotingPeriod - Retrieve the number of blocks a proposal is open for votes
+func (k Keeper) VotingPeriod(ctx sdk.Ctx) (res int64) {
+	k.paramstore.Get(ctx, types.VotingPeriodKey, &res)
+	return
+}
+
+// Quorum - Retrieve the fraction of the total stake that must vote for a tally to be valid
+func (k Keeper) Quorum(ctx sdk.Ctx) (res sdk.BigDec) {
+	k.paramstore.Get(ctx, types.QuorumKey, &res)
+	if res.IsNil() {
+		return types.DefaultQuorum
+	}
+	return
+}
+
+// Threshold - Retrieve the fraction of yes votes needed for a proposal to pass
+func (k Keeper) Threshold(ctx sdk.Ctx) (res sdk.BigDec) {
+	k.paramstore.Get(ctx, types.ThresholdKey, &res)
+	if res.IsNil() {
+		return types.DefaultThreshold
+	}
+	return
+}
+
+// VetoThreshold - Retrieve the fraction of veto votes needed to reject a proposal and burn its deposit
+func (k Keeper) VetoThreshold(ctx sdk.Ctx) (res sdk.BigDec) {
+	k.paramstore.Get(ctx, types.VetoThresholdKey, &res)
+	if res.IsNil() {
+		return types.DefaultVetoThreshold
+	}
+	return
+}
+
 func (k Keeper) GetCodec() *codec.Codec {
 	return k.cdc
 }
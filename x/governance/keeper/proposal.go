@@ -0,0 +1,373 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/vipernet-xyz/viper-network/types"
+	"github.com/vipernet-xyz/viper-network/x/governance/types"
+	requestorsExported "github.com/vipernet-xyz/viper-network/x/requestors/exported"
+	servicersExported "github.com/vipernet-xyz/viper-network/x/servicers/exported"
+)
+
+// SubmitProposal - Escrows the deposit of the proposer and opens the proposal for voting
+func (k Keeper) SubmitProposal(ctx sdk.Ctx, proposer sdk.Address, title, description string, content types.ProposalContent, deposit sdk.BigInt) (types.Proposal, sdk.Error) {
+	votingPeriod := k.VotingPeriod(ctx)
+	if votingPeriod <= 0 {
+		return types.Proposal{}, types.ErrProposalsDisabled(types.ModuleName)
+	}
+	minDeposit := k.MinProposalDeposit(ctx)
+	if deposit.LT(minDeposit) {
+		return types.Proposal{}, types.ErrInsufficientProposalDeposit(types.ModuleName, deposit, minDeposit)
+	}
+	if err := content.ValidateBasic(); err != nil {
+		return types.Proposal{}, err
+	}
+	if content.Type == types.ProposalTypeParamChange {
+		if _, ok := k.GetAllParamNames(ctx)[content.ParamKey]; !ok {
+			return types.Proposal{}, types.ErrUnrecognizedParamKey(types.ModuleName, content.ParamKey)
+		}
+	}
+	// escrow the deposit in the governance module account
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, deposit))
+	if err := k.AuthKeeper.SendCoinsFromAccountToModule(ctx, proposer, types.ModuleName, coins); err != nil {
+		return types.Proposal{}, err
+	}
+	id := k.GetNextProposalID(ctx)
+	k.SetNextProposalID(ctx, id+1)
+	proposal := types.NewProposal(id, title, description, content, proposer, deposit, ctx.BlockHeight(), ctx.BlockHeight()+votingPeriod)
+	k.SetProposal(ctx, proposal)
+	k.insertActiveProposal(ctx, proposal)
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventSubmitProposal,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeProposalID, fmt.Sprintf("%d", id)),
+			sdk.NewAttribute(types.AttributeProposalType, content.Type),
+			sdk.NewAttribute(sdk.AttributeKeySender, proposer.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, proposer.String()),
+		),
+	})
+	return proposal, nil
+}
+
+// AddVote - Records (or overwrites) the vote of a staked servicer or requestor on an active proposal
+func (k Keeper) AddVote(ctx sdk.Ctx, proposalID uint64, voter sdk.Address, option types.VoteOption) sdk.Error {
+	proposal, found := k.GetProposal(ctx, proposalID)
+	if !found {
+		return types.ErrProposalNotFound(types.ModuleName, proposalID)
+	}
+	if !proposal.IsActive() {
+		return types.ErrProposalNotActive(types.ModuleName, proposalID)
+	}
+	if !option.IsValid() {
+		return types.ErrInvalidVoteOption(types.ModuleName, option.String())
+	}
+	if !k.VotingPower(ctx, voter).IsPositive() {
+		return types.ErrNoVotingPower(types.ModuleName, voter)
+	}
+	k.SetVote(ctx, types.NewVote(proposalID, voter, option))
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventProposalVote,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(types.AttributeProposalID, fmt.Sprintf("%d", proposalID)),
+			sdk.NewAttribute(types.AttributeVoteOption, option.String()),
+			sdk.NewAttribute(sdk.AttributeKeySender, voter.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, voter.String()),
+		),
+	})
+	return nil
+}
+
+// VotingPower - The stake of an address as a servicer and as a requestor (jailed or unstaked actors have no power),
+// the stake delegated to a servicer is voted by its operator
+func (k Keeper) VotingPower(ctx sdk.Ctx, addr sdk.Address) sdk.BigInt {
+	power := sdk.ZeroInt()
+	if k.ServicersKeeper != nil {
+		if val := k.ServicersKeeper.Validator(ctx, addr); val != nil {
+			power = power.Add(servicerVotingPower(val))
+		}
+	}
+	if k.RequestorsKeeper != nil {
+		if req := k.RequestorsKeeper.Requestor(ctx, addr); req != nil {
+			power = power.Add(requestorVotingPower(req))
+		}
+	}
+	return power
+}
+
+// TotalVotingPower - The voting power of all the servicers and requestors, the stake that can't vote
+// (jailed, unstaking or unbonding) is not part of the quorum
+func (k Keeper) TotalVotingPower(ctx sdk.Ctx) sdk.BigInt {
+	power := sdk.ZeroInt()
+	if k.ServicersKeeper != nil {
+		k.ServicersKeeper.IterateAndExecuteOverVals(ctx, func(_ int64, val servicersExported.ValidatorI) (stop bool) {
+			power = power.Add(servicerVotingPower(val))
+			return false
+		})
+	}
+	if k.RequestorsKeeper != nil {
+		k.RequestorsKeeper.IterateAndExecuteOverRequestors(ctx, func(_ int64, req requestorsExported.RequestorI) (stop bool) {
+			power = power.Add(requestorVotingPower(req))
+			return false
+		})
+	}
+	return power
+}
+
+func servicerVotingPower(val servicersExported.ValidatorI) sdk.BigInt {
+	if !val.IsStaked() || val.IsJailed() {
+		return sdk.ZeroInt()
+	}
+	return val.GetTokens()
+}
+
+func requestorVotingPower(req requestorsExported.RequestorI) sdk.BigInt {
+	if !req.IsStaked() || req.IsJailed() {
+		return sdk.ZeroInt()
+	}
+	return req.GetTokens()
+}
+
+// Tally - Weighs the votes of a proposal with the current stake of the voters
+// returns whether the proposal passes, whether the deposit should be burned and the tally result
+func (k Keeper) Tally(ctx sdk.Ctx, proposal types.Proposal) (passes bool, burnDeposit bool, tally types.TallyResult) {
+	tally = types.EmptyTallyResult()
+	for _, vote := range k.GetVotes(ctx, proposal.Id) {
+		tally = tally.AddVote(vote.Option, k.VotingPower(ctx, vote.Voter))
+	}
+	totalPower := k.TotalVotingPower(ctx)
+	if !totalPower.IsPositive() {
+		return false, false, tally
+	}
+	voted := tally.Total()
+	// quorum
+	if sdk.NewDecFromInt(voted).Quo(sdk.NewDecFromInt(totalPower)).LT(k.Quorum(ctx)) {
+		return false, false, tally
+	}
+	// veto
+	if sdk.NewDecFromInt(tally.NoWithVeto).Quo(sdk.NewDecFromInt(voted)).GT(k.VetoThreshold(ctx)) {
+		return false, true, tally
+	}
+	// threshold (abstain votes count towards quorum only)
+	nonAbstain := voted.Sub(tally.Abstain)
+	if !nonAbstain.IsPositive() {
+		return false, false, tally
+	}
+	if sdk.NewDecFromInt(tally.Yes).Quo(sdk.NewDecFromInt(nonAbstain)).GT(k.Threshold(ctx)) {
+		return true, false, tally
+	}
+	return false, false, tally
+}
+
+// GetTally - The current tally of an active proposal or the final tally of a finished one
+func (k Keeper) GetTally(ctx sdk.Ctx, proposalID uint64) (types.TallyResult, sdk.Error) {
+	proposal, found := k.GetProposal(ctx, proposalID)
+	if !found {
+		return types.TallyResult{}, types.ErrProposalNotFound(types.ModuleName, proposalID)
+	}
+	if !proposal.IsActive() {
+		return proposal.FinalTally, nil
+	}
+	_, _, tally := k.Tally(ctx, proposal)
+	return tally, nil
+}
+
+// executeProposal - Applies the content of a passed proposal, bypassing the ACL
+func (k Keeper) executeProposal(ctx sdk.Ctx, proposal types.Proposal) error {
+	sender := k.AuthKeeper.GetModuleAddress(types.ModuleName)
+	content := proposal.Content
+	switch content.Type {
+	case types.ProposalTypeParamChange:
+		if _, ok := k.GetAllParamNames(ctx)[content.ParamKey]; !ok {
+			return types.ErrUnrecognizedParamKey(types.ModuleName, content.ParamKey)
+		}
+		return k.modifyParam(ctx, content.ParamKey, content.ParamVal, sender)
+	case types.ProposalTypeUpgrade:
+		res := applyUpgrade(ctx, types.NewACLKey(types.ModuleName, string(types.UpgradeKey)), content.Upgrade, sender, k)
+		if !res.IsOK() {
+			return fmt.Errorf("%s", res.Log)
+		}
+	case types.ProposalTypeDAOTransfer:
+		daoAction, err := types.DAOActionFromString(content.DaoAction)
+		if err != nil {
+			return err
+		}
+		var res sdk.Result
+		switch daoAction {
+		case types.DAOTransfer:
+			res = k.daoTransfer(ctx, sender, content.ToAddress, content.Amount)
+		case types.DAOBurn:
+			res = k.daoBurn(ctx, sender, content.Amount)
+		}
+		if !res.IsOK() {
+			return fmt.Errorf("%s", res.Log)
+		}
+	default:
+		return types.ErrInvalidProposalContentType(types.ModuleName, content.Type)
+	}
+	return nil
+}
+
+// finalizeProposal - Tallies a proposal at the end of its voting period, executes it if it passed and
+// refunds (or burns if vetoed) the deposit
+func (k Keeper) finalizeProposal(ctx sdk.Ctx, proposal types.Proposal) {
+	passes, burnDeposit, tally := k.Tally(ctx, proposal)
+	proposal.FinalTally = tally
+	proposal.Status = types.StatusRejected
+	if passes {
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.executeProposal(cacheCtx, proposal); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("unable to execute proposal %d: %s", proposal.Id, err.Error()))
+			proposal.Status = types.StatusFailed
+			proposal.ExecutionLog = err.Error()
+		} else {
+			writeCache()
+			ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+			proposal.Status = types.StatusPassed
+		}
+	}
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, proposal.Deposit))
+	var err sdk.Error
+	if burnDeposit {
+		err = k.AuthKeeper.BurnCoins(ctx, types.ModuleName, coins)
+	} else {
+		err = k.AuthKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, proposal.Proposer, coins)
+	}
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("unable to settle the deposit of proposal %d: %s", proposal.Id, err.Error()))
+	}
+	k.SetProposal(ctx, proposal)
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeProposalID, fmt.Sprintf("%d", proposal.Id)),
+		sdk.NewAttribute(types.AttributeProposalResult, proposal.Status.String()),
+	}
+	if proposal.ExecutionLog != "" {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeProposalLog, proposal.ExecutionLog))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventProposalTally, attributes...))
+}
+
+// GetProposal - Retrieve a proposal by id
+func (k Keeper) GetProposal(ctx sdk.Ctx, proposalID uint64) (proposal types.Proposal, found bool) {
+	store := ctx.KVStore(k.key)
+	bz, _ := store.Get(types.KeyForProposal(proposalID))
+	if bz == nil {
+		return proposal, false
+	}
+	if err := k.cdc.ProtoUnmarshalBinaryBare(bz, &proposal); err != nil {
+		k.Logger(ctx).Error(fmt.Sprintf("unable to unmarshal proposal %d: %s", proposalID, err.Error()))
+		return proposal, false
+	}
+	return proposal, true
+}
+
+// SetProposal - Store a proposal
+func (k Keeper) SetProposal(ctx sdk.Ctx, proposal types.Proposal) {
+	store := ctx.KVStore(k.key)
+	bz, err := k.cdc.ProtoMarshalBinaryBare(&proposal)
+	if err != nil {
+		panic(err)
+	}
+	_ = store.Set(types.KeyForProposal(proposal.Id), bz)
+}
+
+// GetAllProposals - Retrieve all of the proposals, optionally filtered by status (StatusNil for all)
+func (k Keeper) GetAllProposals(ctx sdk.Ctx, status types.ProposalStatus) (proposals types.Proposals) {
+	proposals = make(types.Proposals, 0)
+	store := ctx.KVStore(k.key)
+	iterator, _ := sdk.KVStorePrefixIterator(store, types.ProposalsKey)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var proposal types.Proposal
+		if err := k.cdc.ProtoUnmarshalBinaryBare(iterator.Value(), &proposal); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("unable to unmarshal proposal: %s", err.Error()))
+			continue
+		}
+		if status != types.StatusNil && proposal.Status != status {
+			continue
+		}
+		proposals = append(proposals, proposal)
+	}
+	return
+}
+
+// GetNextProposalID - Retrieve the id to be used by the next proposal
+func (k Keeper) GetNextProposalID(ctx sdk.Ctx) uint64 {
+	store := ctx.KVStore(k.key)
+	bz, _ := store.Get(types.ProposalIDKey)
+	if bz == nil {
+		return 1
+	}
+	return types.ProposalIDFromBytes(bz)
+}
+
+// SetNextProposalID - Store the id to be used by the next proposal
+func (k Keeper) SetNextProposalID(ctx sdk.Ctx, proposalID uint64) {
+	store := ctx.KVStore(k.key)
+	_ = store.Set(types.ProposalIDKey, types.ProposalIDToBytes(proposalID))
+}
+
+// insertActiveProposal - Add a proposal to the queue of proposals in their voting period
+func (k Keeper) insertActiveProposal(ctx sdk.Ctx, proposal types.Proposal) {
+	store := ctx.KVStore(k.key)
+	_ = store.Set(types.KeyForActiveProposal(proposal.VotingEndHeight, proposal.Id), types.ProposalIDToBytes(proposal.Id))
+}
+
+// GetVote - Retrieve the vote of an address on a proposal
+func (k Keeper) GetVote(ctx sdk.Ctx, proposalID uint64, voter sdk.Address) (vote types.Vote, found bool) {
+	store := ctx.KVStore(k.key)
+	bz, _ := store.Get(types.KeyForVote(proposalID, voter))
+	if bz == nil {
+		return vote, false
+	}
+	if err := k.cdc.ProtoUnmarshalBinaryBare(bz, &vote); err != nil {
+		return vote, false
+	}
+	return vote, true
+}
+
+// SetVote - Store a vote
+func (k Keeper) SetVote(ctx sdk.Ctx, vote types.Vote) {
+	store := ctx.KVStore(k.key)
+	bz, err := k.cdc.ProtoMarshalBinaryBare(&vote)
+	if err != nil {
+		panic(err)
+	}
+	_ = store.Set(types.KeyForVote(vote.ProposalID, vote.Voter), bz)
+}
+
+// GetVotes - Retrieve all of the votes on a proposal
+func (k Keeper) GetVotes(ctx sdk.Ctx, proposalID uint64) (votes []types.Vote) {
+	return k.iterateVotes(ctx, types.KeyForVotes(proposalID))
+}
+
+// GetAllVotes - Retrieve all of the votes on all of the proposals
+func (k Keeper) GetAllVotes(ctx sdk.Ctx) (votes []types.Vote) {
+	return k.iterateVotes(ctx, types.VotesKey)
+}
+
+func (k Keeper) iterateVotes(ctx sdk.Ctx, prefix []byte) (votes []types.Vote) {
+	votes = make([]types.Vote, 0)
+	store := ctx.KVStore(k.key)
+	iterator, _ := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var vote types.Vote
+		if err := k.cdc.ProtoUnmarshalBinaryBare(iterator.Value(), &vote); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("unable to unmarshal vote: %s", err.Error()))
+			continue
+		}
+		votes = append(votes, vote)
+	}
+	return
+}
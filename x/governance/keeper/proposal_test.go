@@ -0,0 +1,274 @@
+package keeper
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/vipernet-xyz/viper-network/codec"
+	sdk "github.com/vipernet-xyz/viper-network/types"
+	"github.com/vipernet-xyz/viper-network/x/governance/types"
+	requestorsExported "github.com/vipernet-xyz/viper-network/x/requestors/exported"
+	requestorsTypes "github.com/vipernet-xyz/viper-network/x/requestors/types"
+	servicersExported "github.com/vipernet-xyz/viper-network/x/servicers/exported"
+	servicersTypes "github.com/vipernet-xyz/viper-network/x/servicers/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type mockServicersKeeper struct {
+	validators map[string]servicersTypes.Validator
+}
+
+func (m mockServicersKeeper) IterateAndExecuteOverVals(ctx sdk.Ctx, fn func(index int64, validator servicersExported.ValidatorI) (stop bool)) {
+	i := int64(0)
+	for _, v := range m.validators {
+		if fn(i, v) {
+			return
+		}
+		i++
+	}
+}
+
+func (m mockServicersKeeper) Validator(ctx sdk.Ctx, addr sdk.Address) servicersExported.ValidatorI {
+	v, ok := m.validators[addr.String()]
+	if !ok {
+		return nil
+	}
+	return v
+}
+
+type mockRequestorsKeeper struct {
+	requestors map[string]requestorsTypes.Requestor
+}
+
+func (m mockRequestorsKeeper) IterateAndExecuteOverRequestors(ctx sdk.Ctx, fn func(index int64, requestor requestorsExported.RequestorI) (stop bool)) {
+	i := int64(0)
+	for _, r := range m.requestors {
+		if fn(i, r) {
+			return
+		}
+		i++
+	}
+}
+
+func (m mockRequestorsKeeper) Requestor(ctx sdk.Ctx, addr sdk.Address) requestorsExported.RequestorI {
+	r, ok := m.requestors[addr.String()]
+	if !ok {
+		return nil
+	}
+	return r
+}
+
+// createTestProposalKeeper returns a keeper with a staked servicer (100 tokens) and a staked requestor (50 tokens)
+func createTestProposalKeeper(t *testing.T) (sdk.Ctx, Keeper, sdk.Address, sdk.Address) {
+	ctx, k := createTestKeeperAndContext(t, false)
+	codec.UpgradeFeatureMap[codec.GovernanceKey] = -1
+	t.Cleanup(func() { codec.UpgradeFeatureMap[codec.GovernanceKey] = 0 })
+	servicer, requestor := getRandomValidatorAddress(), getRandomValidatorAddress()
+	k.ServicersKeeper = mockServicersKeeper{validators: map[string]servicersTypes.Validator{
+		servicer.String(): {Address: servicer, Status: sdk.Staked, StakedTokens: sdk.NewInt(100)},
+	}}
+	k.RequestorsKeeper = mockRequestorsKeeper{requestors: map[string]requestorsTypes.Requestor{
+		requestor.String(): {Address: requestor, Status: sdk.Staked, StakedTokens: sdk.NewInt(50)},
+	}}
+	p := k.GetParams(ctx)
+	p.MinProposalDeposit = sdk.NewInt(1000)
+	p.VotingPeriod = 10
+	k.SetParams(ctx, p)
+	return ctx, k, servicer, requestor
+}
+
+func fundAccount(t *testing.T, ctx sdk.Ctx, k Keeper, addr sdk.Address, amount sdk.BigInt) {
+	coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, amount))
+	assert.Nil(t, k.AuthKeeper.MintCoins(ctx, "FAKE", coins))
+	assert.Nil(t, k.AuthKeeper.SendCoinsFromModuleToAccount(ctx, "FAKE", addr, coins))
+}
+
+func accountBalance(ctx sdk.Ctx, k Keeper, addr sdk.Address) sdk.BigInt {
+	return k.AuthKeeper.(interface {
+		GetCoins(ctx sdk.Ctx, addr sdk.Address) sdk.Coins
+	}).GetCoins(ctx, addr).AmountOf(sdk.DefaultStakeDenom)
+}
+
+func TestKeeper_SubmitProposal(t *testing.T) {
+	ctx, k, servicer, _ := createTestProposalKeeper(t)
+	fundAccount(t, ctx, k, servicer, sdk.NewInt(5000))
+	bz, _ := k.cdc.MarshalJSON(uint64(20))
+	content := types.NewParamChangeContent("authentication/TxSigLimit", bz)
+	// below the minimum deposit
+	_, err := k.SubmitProposal(ctx, servicer, "title", "", content, sdk.NewInt(999))
+	assert.NotNil(t, err)
+	// unknown param
+	_, err = k.SubmitProposal(ctx, servicer, "title", "", types.NewParamChangeContent("authentication/Foo", bz), sdk.NewInt(1000))
+	assert.NotNil(t, err)
+	proposal, err := k.SubmitProposal(ctx, servicer, "title", "", content, sdk.NewInt(1000))
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(1), proposal.Id)
+	assert.Equal(t, ctx.BlockHeight()+10, proposal.VotingEndHeight)
+	assert.True(t, proposal.IsActive())
+	stored, found := k.GetProposal(ctx, proposal.Id)
+	assert.True(t, found)
+	assert.Equal(t, proposal.Content.ParamKey, stored.Content.ParamKey)
+	assert.Equal(t, int64(1000), k.AuthKeeper.GetModuleAccount(ctx, types.ModuleName).GetCoins().AmountOf(sdk.DefaultStakeDenom).Int64())
+	proposal2, err := k.SubmitProposal(ctx, servicer, "title", "", content, sdk.NewInt(1000))
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2), proposal2.Id)
+	assert.Len(t, k.GetAllProposals(ctx, types.StatusNil), 2)
+	// disabled when there is no voting period
+	p := k.GetParams(ctx)
+	p.VotingPeriod = 0
+	k.SetParams(ctx, p)
+	_, err = k.SubmitProposal(ctx, servicer, "title", "", content, sdk.NewInt(1000))
+	assert.NotNil(t, err)
+}
+
+func TestKeeper_AddVote(t *testing.T) {
+	ctx, k, servicer, requestor := createTestProposalKeeper(t)
+	fundAccount(t, ctx, k, servicer, sdk.NewInt(1000))
+	proposal, err := k.SubmitProposal(ctx, servicer, "title", "", types.NewUpgradeContent(types.NewUpgrade(100, "0.2.0")), sdk.NewInt(1000))
+	assert.Nil(t, err)
+	assert.Nil(t, k.AddVote(ctx, proposal.Id, servicer, types.OptionYes))
+	assert.Nil(t, k.AddVote(ctx, proposal.Id, requestor, types.OptionNo))
+	// revote overwrites
+	assert.Nil(t, k.AddVote(ctx, proposal.Id, requestor, types.OptionAbstain))
+	assert.Len(t, k.GetVotes(ctx, proposal.Id), 2)
+	// no stake
+	assert.NotNil(t, k.AddVote(ctx, proposal.Id, getRandomValidatorAddress(), types.OptionYes))
+	// unknown proposal
+	assert.NotNil(t, k.AddVote(ctx, 99, servicer, types.OptionYes))
+	tally, err := k.GetTally(ctx, proposal.Id)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(100), tally.Yes.Int64())
+	assert.Equal(t, int64(50), tally.Abstain.Int64())
+}
+
+func TestKeeper_EndBlockerPassesParamChange(t *testing.T) {
+	ctx, k, servicer, _ := createTestProposalKeeper(t)
+	fundAccount(t, ctx, k, servicer, sdk.NewInt(1000))
+	bz, _ := k.cdc.MarshalJSON(uint64(20))
+	proposal, err := k.SubmitProposal(ctx, servicer, "title", "", types.NewParamChangeContent("authentication/TxSigLimit", bz), sdk.NewInt(1000))
+	assert.Nil(t, err)
+	assert.Nil(t, k.AddVote(ctx, proposal.Id, servicer, types.OptionYes))
+	// voting period not over
+	EndBlocker(ctx.WithBlockHeight(proposal.VotingEndHeight-1), k)
+	proposal, _ = k.GetProposal(ctx, proposal.Id)
+	assert.True(t, proposal.IsActive())
+	EndBlocker(ctx.WithBlockHeight(proposal.VotingEndHeight), k)
+	proposal, _ = k.GetProposal(ctx, proposal.Id)
+	assert.Equal(t, types.StatusPassed, proposal.Status)
+	assert.Equal(t, int64(100), proposal.FinalTally.Yes.Int64())
+	space, _ := k.GetSubspace("authentication")
+	var sigLimit uint64
+	space.Get(ctx, []byte("TxSigLimit"), &sigLimit)
+	assert.Equal(t, uint64(20), sigLimit)
+	// deposit refunded
+	assert.Equal(t, int64(1000), accountBalance(ctx, k, servicer).Int64())
+	assert.True(t, k.AuthKeeper.GetModuleAccount(ctx, types.ModuleName).GetCoins().AmountOf(sdk.DefaultStakeDenom).IsZero())
+}
+
+func TestKeeper_EndBlockerFailedParamChange(t *testing.T) {
+	ctx, k, servicer, _ := createTestProposalKeeper(t)
+	fundAccount(t, ctx, k, servicer, sdk.NewInt(1000))
+	proposal, err := k.SubmitProposal(ctx, servicer, "title", "", types.NewParamChangeContent("authentication/TxSigLimit", []byte("not a number")), sdk.NewInt(1000))
+	assert.Nil(t, err)
+	assert.Nil(t, k.AddVote(ctx, proposal.Id, servicer, types.OptionYes))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	EndBlocker(ctx.WithBlockHeight(proposal.VotingEndHeight), k)
+	proposal, _ = k.GetProposal(ctx, proposal.Id)
+	assert.Equal(t, types.StatusFailed, proposal.Status)
+	assert.NotEmpty(t, proposal.ExecutionLog)
+	assert.True(t, ContainsEvent(ctx.EventManager().Events(), sdk.NewEvent(
+		types.EventProposalTally,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(types.AttributeProposalID, fmt.Sprintf("%d", proposal.Id)),
+		sdk.NewAttribute(types.AttributeProposalResult, types.StatusFailed.String()),
+		sdk.NewAttribute(types.AttributeProposalLog, proposal.ExecutionLog),
+	)))
+}
+
+func TestKeeper_EndBlockerInactive(t *testing.T) {
+	ctx, k, servicer, _ := createTestProposalKeeper(t)
+	fundAccount(t, ctx, k, servicer, sdk.NewInt(1000))
+	proposal, err := k.SubmitProposal(ctx, servicer, "title", "", types.NewUpgradeContent(types.NewUpgrade(100, "0.2.0")), sdk.NewInt(1000))
+	assert.Nil(t, err)
+	assert.Nil(t, k.AddVote(ctx, proposal.Id, servicer, types.OptionYes))
+	// the proposals are not tallied before the activation
+	codec.UpgradeFeatureMap[codec.GovernanceKey] = 0
+	EndBlocker(ctx.WithBlockHeight(proposal.VotingEndHeight), k)
+	proposal, _ = k.GetProposal(ctx, proposal.Id)
+	assert.True(t, proposal.IsActive())
+}
+
+func TestKeeper_EndBlockerPassesUpgrade(t *testing.T) {
+	ctx, k, servicer, requestor := createTestProposalKeeper(t)
+	fundAccount(t, ctx, k, requestor, sdk.NewInt(1000))
+	proposal, err := k.SubmitProposal(ctx, requestor, "title", "", types.NewUpgradeContent(types.NewUpgrade(100, "0.2.0")), sdk.NewInt(1000))
+	assert.Nil(t, err)
+	assert.Nil(t, k.AddVote(ctx, proposal.Id, servicer, types.OptionYes))
+	assert.Nil(t, k.AddVote(ctx, proposal.Id, requestor, types.OptionNo))
+	EndBlocker(ctx.WithBlockHeight(proposal.VotingEndHeight), k)
+	proposal, _ = k.GetProposal(ctx, proposal.Id)
+	assert.Equal(t, types.StatusPassed, proposal.Status)
+	assert.Equal(t, int64(100), k.GetUpgrade(ctx).Height)
+	assert.Equal(t, "0.2.0", k.GetUpgrade(ctx).Version)
+}
+
+func TestKeeper_EndBlockerRejects(t *testing.T) {
+	ctx, k, servicer, requestor := createTestProposalKeeper(t)
+	fundAccount(t, ctx, k, requestor, sdk.NewInt(2000))
+	// no quorum: only the requestor (1/3 of the stake) votes
+	noQuorum, err := k.SubmitProposal(ctx, requestor, "title", "", types.NewUpgradeContent(types.NewUpgrade(100, "0.2.0")), sdk.NewInt(1000))
+	assert.Nil(t, err)
+	assert.Nil(t, k.AddVote(ctx, noQuorum.Id, requestor, types.OptionYes))
+	// vetoed: the deposit is burned
+	vetoed, err := k.SubmitProposal(ctx, requestor, "title", "", types.NewUpgradeContent(types.NewUpgrade(100, "0.2.0")), sdk.NewInt(1000))
+	assert.Nil(t, err)
+	assert.Nil(t, k.AddVote(ctx, vetoed.Id, requestor, types.OptionYes))
+	assert.Nil(t, k.AddVote(ctx, vetoed.Id, servicer, types.OptionNoWithVeto))
+	EndBlocker(ctx.WithBlockHeight(vetoed.VotingEndHeight), k)
+	noQuorum, _ = k.GetProposal(ctx, noQuorum.Id)
+	vetoed, _ = k.GetProposal(ctx, vetoed.Id)
+	assert.Equal(t, types.StatusRejected, noQuorum.Status)
+	assert.Equal(t, types.StatusRejected, vetoed.Status)
+	assert.Equal(t, int64(0), k.GetUpgrade(ctx).Height)
+	// the first deposit was refunded, the second burned
+	assert.Equal(t, int64(1000), accountBalance(ctx, k, requestor).Int64())
+	assert.True(t, k.AuthKeeper.GetModuleAccount(ctx, types.ModuleName).GetCoins().AmountOf(sdk.DefaultStakeDenom).IsZero())
+	assert.Len(t, k.GetAllProposals(ctx, types.StatusRejected), 2)
+	assert.Len(t, k.GetAllProposals(ctx, types.StatusPassed), 0)
+}
+
+func TestKeeper_TallyIgnoresStakeThatCantVote(t *testing.T) {
+	ctx, k, servicer, _ := createTestProposalKeeper(t)
+	jailed, unstaking := getRandomValidatorAddress(), getRandomValidatorAddress()
+	validators := k.ServicersKeeper.(mockServicersKeeper).validators
+	validators[jailed.String()] = servicersTypes.Validator{Address: jailed, Status: sdk.Staked, Jailed: true, StakedTokens: sdk.NewInt(400)}
+	validators[unstaking.String()] = servicersTypes.Validator{Address: unstaking, Status: sdk.Unstaking, StakedTokens: sdk.NewInt(300)}
+	fundAccount(t, ctx, k, servicer, sdk.NewInt(1000))
+	proposal, err := k.SubmitProposal(ctx, servicer, "title", "", types.NewUpgradeContent(types.NewUpgrade(100, "0.2.0")), sdk.NewInt(1000))
+	assert.Nil(t, err)
+	// the jailed servicer can't vote
+	assert.NotNil(t, k.AddVote(ctx, proposal.Id, jailed, types.OptionNo))
+	assert.Nil(t, k.AddVote(ctx, proposal.Id, servicer, types.OptionYes))
+	// the quorum is measured against the stake that can vote only
+	assert.Equal(t, int64(150), k.TotalVotingPower(ctx).Int64())
+	passes, burnDeposit, tally := k.Tally(ctx, proposal)
+	assert.True(t, passes)
+	assert.False(t, burnDeposit)
+	assert.Equal(t, int64(100), tally.Yes.Int64())
+}
+
+func TestKeeper_EndBlockerDAOTransfer(t *testing.T) {
+	ctx, k, servicer, _ := createTestProposalKeeper(t)
+	fundAccount(t, ctx, k, servicer, sdk.NewInt(1000))
+	assert.Nil(t, k.AuthKeeper.MintCoins(ctx, types.DAOAccountName, sdk.NewCoins(sdk.NewCoin(sdk.DefaultStakeDenom, sdk.NewInt(500)))))
+	recipient := getRandomValidatorAddress()
+	proposal, err := k.SubmitProposal(ctx, servicer, "title", "", types.NewDAOTransferContent(types.DAOTransferString, recipient, sdk.NewInt(600)), sdk.NewInt(1000))
+	assert.Nil(t, err)
+	assert.Nil(t, k.AddVote(ctx, proposal.Id, servicer, types.OptionYes))
+	EndBlocker(ctx.WithBlockHeight(proposal.VotingEndHeight), k)
+	proposal, _ = k.GetProposal(ctx, proposal.Id)
+	// the dao does not hold enough tokens, so the passed proposal fails to execute
+	assert.Equal(t, types.StatusFailed, proposal.Status)
+	assert.Equal(t, int64(500), k.GetDAOTokens(ctx).Int64())
+}
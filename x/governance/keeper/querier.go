@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	"github.com/vipernet-xyz/viper-network/codec"
 	sdk "github.com/vipernet-xyz/viper-network/types"
 	"github.com/vipernet-xyz/viper-network/x/governance/types"
@@ -20,6 +22,14 @@ func NewQuerier(k Keeper) sdk.Querier {
 			return queryDAOOwner(ctx, k)
 		case types.QueryUpgrade:
 			return queryUpgrade(ctx, k)
+		case types.QueryProposal:
+			return queryProposal(ctx, req, k)
+		case types.QueryProposals:
+			return queryProposals(ctx, req, k)
+		case types.QueryVotes:
+			return queryVotes(ctx, req, k)
+		case types.QueryTally:
+			return queryTally(ctx, req, k)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown governance query endpoint")
 		}
@@ -61,3 +71,71 @@ func queryUpgrade(ctx sdk.Ctx, k Keeper) ([]byte, sdk.Error) {
 	}
 	return res, nil
 }
+
+func queryProposal(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryProposalParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	proposal, found := k.GetProposal(ctx, params.ProposalID)
+	if !found {
+		return nil, types.ErrProposalNotFound(types.ModuleName, params.ProposalID)
+	}
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, proposal)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+	return res, nil
+}
+
+func queryProposals(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryProposalsParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	status := types.StatusNil
+	if params.Status != "" {
+		var er sdk.Error
+		status, er = types.ProposalStatusFromString(params.Status)
+		if er != nil {
+			return nil, er
+		}
+	}
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, k.GetAllProposals(ctx, status))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+	return res, nil
+}
+
+func queryVotes(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryProposalParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, k.GetVotes(ctx, params.ProposalID))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+	return res, nil
+}
+
+func queryTally(ctx sdk.Ctx, req abci.RequestQuery, k Keeper) ([]byte, sdk.Error) {
+	var params types.QueryProposalParams
+	err := types.ModuleCdc.UnmarshalJSON(req.Data, &params)
+	if err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+	tally, er := k.GetTally(ctx, params.ProposalID)
+	if er != nil {
+		return nil, er
+	}
+	res, err := codec.MarshalJSONIndent(types.ModuleCdc, tally)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("failed to JSON marshal result: %s", err.Error()))
+	}
+	return res, nil
+}
@@ -88,6 +88,12 @@ func handleUpgradeAfterUpdate(ctx sdk.Ctx, aclKey string, paramValue interface{}
 	if err := k.VerifyACL(ctx, aclKey, owner); err != nil {
 		return err.Result()
 	}
+	return applyUpgrade(ctx, aclKey, paramValue, owner, k)
+}
+
+// applyUpgrade sets the upgrade param without checking the ACL, it is used by both the ACL owner
+// override and by passed governance proposals
+func applyUpgrade(ctx sdk.Ctx, aclKey string, paramValue interface{}, owner sdk.Address, k Keeper) sdk.Result {
 	subspaceName, paramKey := types.SplitACLKey(aclKey)
 	space, ok := k.spaces[subspaceName]
 	if !ok {
@@ -157,14 +163,28 @@ func (k Keeper) ModifyParam(ctx sdk.Ctx, aclKey string, paramValue []byte, owner
 	if err := k.VerifyACL(ctx, aclKey, owner); err != nil {
 		return err.Result()
 	}
+	err := k.modifyParam(ctx, aclKey, paramValue, owner)
+	// a failed update was ignored before the governance upgrade
+	if err != nil && types.ModuleCdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.GovernanceKey) {
+		subspaceName, paramKey := types.SplitACLKey(aclKey)
+		return types.ErrSettingParameter(k.codespace, subspaceName, paramKey, string(paramValue), err.Error()).Result()
+	}
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
 
+// modifyParam updates the parameter without checking the ACL, it is used by both the ACL owner
+// override and by passed governance proposals
+func (k Keeper) modifyParam(ctx sdk.Ctx, aclKey string, paramValue []byte, owner sdk.Address) error {
 	subspaceName, paramKey := types.SplitACLKey(aclKey)
 	space, ok := k.spaces[subspaceName]
 	if !ok {
 		k.Logger(ctx).Error(types.ErrSubspaceNotFound(types.ModuleName, subspaceName).Error())
 		os.Exit(1)
 	}
-	_ = space.Update(ctx, []byte(paramKey), paramValue)
+	err := space.Update(ctx, []byte(paramKey), paramValue)
+	if err != nil {
+		return err
+	}
 	k.spaces[subspaceName] = space
 	// create the event
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -180,5 +200,5 @@ func (k Keeper) ModifyParam(ctx sdk.Ctx, aclKey string, paramValue []byte, owner
 			sdk.NewAttribute(sdk.AttributeKeySender, owner.String()),
 		),
 	})
-	return sdk.Result{Events: ctx.EventManager().Events()}
+	return nil
 }
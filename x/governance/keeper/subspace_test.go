@@ -3,6 +3,7 @@ package keeper
 import (
 	"testing"
 
+	"github.com/vipernet-xyz/viper-network/codec"
 	sdk "github.com/vipernet-xyz/viper-network/types"
 	"github.com/vipernet-xyz/viper-network/x/governance/types"
 
@@ -11,6 +12,19 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 )
 
+func TestModifyParam_InvalidValue(t *testing.T) {
+	var aclKey = types.NewACLKey(types.ModuleName, string(types.DAOOwnerKey))
+	ctx, k := createTestKeeperAndContext(t, false)
+	owner := k.GetACL(ctx).GetOwner(aclKey)
+	// the failed update is ignored before the activation
+	res := k.ModifyParam(ctx, aclKey, []byte("not an address"), owner)
+	assert.Zero(t, res.Code)
+	codec.UpgradeFeatureMap[codec.GovernanceKey] = -1
+	defer func() { codec.UpgradeFeatureMap[codec.GovernanceKey] = 0 }()
+	res = k.ModifyParam(ctx, aclKey, []byte("not an address"), owner)
+	assert.Equal(t, types.CodeSettingParameter, res.Code)
+}
+
 func TestModifyParam(t *testing.T) {
 	addr := getRandomValidatorAddress()
 	var aclKey = types.NewACLKey(types.ModuleName, string(types.DAOOwnerKey))
@@ -153,5 +153,5 @@ func (am AppModule) activateAdditionalParametersACL(ctx sdk.Ctx) {
 // EndBlock returns the end blocker for the staking module. It returns no validator
 // updates.
 func (am AppModule) EndBlock(ctx sdk.Ctx, req abci.RequestEndBlock) []abci.ValidatorUpdate {
-	return []abci.ValidatorUpdate{}
+	return keeper.EndBlocker(ctx, am.keeper)
 }
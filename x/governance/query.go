@@ -61,3 +61,59 @@ func QueryUpgrade(cdc *codec.Codec, tmNode rpcclient.Client, height int64) (upgr
 	}
 	return u, err
 }
+
+func QueryProposal(cdc *codec.Codec, tmNode rpcclient.Client, proposalID uint64, height int64) (proposal types.Proposal, err error) {
+	cliCtx := util.NewCLIContext(tmNode, nil, "").WithCodec(cdc).WithHeight(height)
+	params, err := cdc.MarshalJSON(types.QueryProposalParams{ProposalID: proposalID})
+	if err != nil {
+		return proposal, err
+	}
+	bz, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.StoreKey, types.QueryProposal), params)
+	if err != nil {
+		return proposal, err
+	}
+	err = cdc.UnmarshalJSON(bz, &proposal)
+	return proposal, err
+}
+
+func QueryProposals(cdc *codec.Codec, tmNode rpcclient.Client, status string, height int64) (proposals types.Proposals, err error) {
+	cliCtx := util.NewCLIContext(tmNode, nil, "").WithCodec(cdc).WithHeight(height)
+	params, err := cdc.MarshalJSON(types.QueryProposalsParams{Status: status})
+	if err != nil {
+		return nil, err
+	}
+	bz, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.StoreKey, types.QueryProposals), params)
+	if err != nil {
+		return nil, err
+	}
+	err = cdc.UnmarshalJSON(bz, &proposals)
+	return proposals, err
+}
+
+func QueryVotes(cdc *codec.Codec, tmNode rpcclient.Client, proposalID uint64, height int64) (votes []types.Vote, err error) {
+	cliCtx := util.NewCLIContext(tmNode, nil, "").WithCodec(cdc).WithHeight(height)
+	params, err := cdc.MarshalJSON(types.QueryProposalParams{ProposalID: proposalID})
+	if err != nil {
+		return nil, err
+	}
+	bz, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.StoreKey, types.QueryVotes), params)
+	if err != nil {
+		return nil, err
+	}
+	err = cdc.UnmarshalJSON(bz, &votes)
+	return votes, err
+}
+
+func QueryTally(cdc *codec.Codec, tmNode rpcclient.Client, proposalID uint64, height int64) (tally types.TallyResult, err error) {
+	cliCtx := util.NewCLIContext(tmNode, nil, "").WithCodec(cdc).WithHeight(height)
+	params, err := cdc.MarshalJSON(types.QueryProposalParams{ProposalID: proposalID})
+	if err != nil {
+		return tally, err
+	}
+	bz, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.StoreKey, types.QueryTally), params)
+	if err != nil {
+		return tally, err
+	}
+	err = cdc.UnmarshalJSON(bz, &tally)
+	return tally, err
+}
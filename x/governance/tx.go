@@ -48,6 +48,24 @@ func DAOTransferTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase,
 	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, &msg, legacyCodec)
 }
 
+func CreateVestingAccountTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, fromAddress, toAddress sdk.Address, amount sdk.BigInt, vestingType string, startTime, endTime int64, periods []types.VestingPeriod, passphrase string, fee int64, legacyCodec bool) (*sdk.TxResponse, error) {
+	msg := types.MsgCreateVestingAccount{
+		FromAddress: fromAddress,
+		ToAddress:   toAddress,
+		Amount:      amount,
+		VestingType: vestingType,
+		StartTime:   startTime,
+		EndTime:     endTime,
+		Periods:     periods,
+	}
+	txBuilder, cliCtx := newTx(cdc, &msg, fromAddress, tmNode, keybase, passphrase, fee)
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, &msg, legacyCodec)
+}
+
 func UpgradeTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, fromAddress sdk.Address, upgrade types.Upgrade, passphrase string, fee int64, legacyCodec bool) (*sdk.TxResponse, error) {
 	msg := types.MsgUpgrade{
 		Address: fromAddress,
@@ -61,6 +79,36 @@ func UpgradeTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, fro
 	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, &msg, legacyCodec)
 }
 
+func SubmitProposalTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, proposer sdk.Address, title, description string, content types.ProposalContent, deposit sdk.BigInt, passphrase string, fee int64, legacyCodec bool) (*sdk.TxResponse, error) {
+	msg := types.MsgSubmitProposal{
+		Proposer:    proposer,
+		Title:       title,
+		Description: description,
+		Content:     content,
+		Deposit:     deposit,
+	}
+	txBuilder, cliCtx := newTx(cdc, &msg, proposer, tmNode, keybase, passphrase, fee)
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, &msg, legacyCodec)
+}
+
+func VoteTx(cdc *codec.Codec, tmNode client.Client, keybase keys.Keybase, voter sdk.Address, proposalID uint64, option types.VoteOption, passphrase string, fee int64, legacyCodec bool) (*sdk.TxResponse, error) {
+	msg := types.MsgVote{
+		ProposalID: proposalID,
+		Voter:      voter,
+		Option:     option,
+	}
+	txBuilder, cliCtx := newTx(cdc, &msg, voter, tmNode, keybase, passphrase, fee)
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+	return util.CompleteAndBroadcastTxCLI(txBuilder, cliCtx, &msg, legacyCodec)
+}
+
 func newTx(cdc *codec.Codec, msg sdk.ProtoMsg, fromAddr sdk.Address, tmNode client.Client, keybase keys.Keybase, passphrase string, fee int64) (txBuilder authentication.TxBuilder, cliCtx util.CLIContext) {
 	genDoc, err := tmNode.Genesis()
 	if err != nil {
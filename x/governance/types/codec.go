@@ -21,11 +21,16 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterStructure(MsgDAOTransfer{}, "governance/msg_dao_transfer")
 	cdc.RegisterStructure(MsgUpgrade{}, "governance/msg_upgrade")
 	cdc.RegisterStructure(MsgGenerateDiscountKey{}, "governance/MsgGenerateDiscountKey")
+	cdc.RegisterStructure(MsgSubmitProposal{}, "governance/msg_submit_proposal")
+	cdc.RegisterStructure(MsgVote{}, "governance/msg_vote")
+	cdc.RegisterStructure(MsgCreateVestingAccount{}, "governance/msg_create_vesting_account")
 	cdc.RegisterInterface("x.interface.nil", (*interface{})(nil))
 	cdc.RegisterStructure(ACL{}, "governance/non_map_acl")
 	cdc.RegisterStructure(Upgrade{}, "governance/upgrade")
-	cdc.RegisterImplementation((*sdk.ProtoMsg)(nil), &MsgChangeParam{}, &MsgDAOTransfer{}, &MsgUpgrade{}, &MsgGenerateDiscountKey{})
-	cdc.RegisterImplementation((*sdk.Msg)(nil), &MsgChangeParam{}, &MsgDAOTransfer{}, &MsgUpgrade{}, &MsgGenerateDiscountKey{})
+	cdc.RegisterStructure(Proposal{}, "governance/proposal")
+	cdc.RegisterStructure(Vote{}, "governance/vote")
+	cdc.RegisterImplementation((*sdk.ProtoMsg)(nil), &MsgChangeParam{}, &MsgDAOTransfer{}, &MsgUpgrade{}, &MsgGenerateDiscountKey{}, &MsgSubmitProposal{}, &MsgVote{}, &MsgCreateVestingAccount{})
+	cdc.RegisterImplementation((*sdk.Msg)(nil), &MsgChangeParam{}, &MsgDAOTransfer{}, &MsgUpgrade{}, &MsgGenerateDiscountKey{}, &MsgSubmitProposal{}, &MsgVote{}, &MsgCreateVestingAccount{})
 	ModuleCdc = cdc
 }
 
@@ -21,8 +21,77 @@ const (
 	CodeEmptyVersionUpgrade           sdk.CodeType = 10
 	CodeUnauthorizedHeightParamChange sdk.CodeType = 11
 	CodeUnrecognizedClientType        sdk.CodeType = 12
+	CodeProposalNotFound              sdk.CodeType = 13
+	CodeProposalNotActive             sdk.CodeType = 14
+	CodeUnrecognizedProposalType      sdk.CodeType = 15
+	CodeInvalidVoteOption             sdk.CodeType = 16
+	CodeInsufficientProposalDeposit   sdk.CodeType = 17
+	CodeProposalsDisabled             sdk.CodeType = 18
+	CodeInvalidProposalTitle          sdk.CodeType = 19
+	CodeUnrecognizedParamKey          sdk.CodeType = 20
+	CodeInvalidProposalStatus         sdk.CodeType = 21
+	CodeNoVotingPower                 sdk.CodeType = 22
+	CodeInvalidVestingSchedule        sdk.CodeType = 23
+	CodeProposalsInactive             sdk.CodeType = 24
+	CodeVestingAccountsInactive       sdk.CodeType = 25
 )
 
+func ErrProposalNotFound(codespace sdk.CodespaceType, proposalID uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeProposalNotFound, fmt.Sprintf("proposal %d not found", proposalID))
+}
+
+func ErrProposalNotActive(codespace sdk.CodespaceType, proposalID uint64) sdk.Error {
+	return sdk.NewError(codespace, CodeProposalNotActive, fmt.Sprintf("proposal %d is not in its voting period", proposalID))
+}
+
+func ErrInvalidProposalContentType(codespace sdk.CodespaceType, proposalType string) sdk.Error {
+	return sdk.NewError(codespace, CodeUnrecognizedProposalType, "unrecognized proposal type: "+proposalType)
+}
+
+func ErrInvalidVoteOption(codespace sdk.CodespaceType, option string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidVoteOption, "invalid vote option: "+option)
+}
+
+func ErrInsufficientProposalDeposit(codespace sdk.CodespaceType, deposit, minDeposit sdk.BigInt) sdk.Error {
+	return sdk.NewError(codespace, CodeInsufficientProposalDeposit, fmt.Sprintf("the proposal deposit %s is below the minimum deposit of %s", deposit, minDeposit))
+}
+
+func ErrProposalsDisabled(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeProposalsDisabled, "proposals are disabled: the voting period param is not set")
+}
+
+func ErrInvalidProposalTitle(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidProposalTitle, fmt.Sprintf("the proposal title must be non empty and the title and description must be less than %d and %d characters", MaxProposalTitleLength, MaxProposalDescriptionLength))
+}
+
+func ErrUnrecognizedParamKey(codespace sdk.CodespaceType, aclKey string) sdk.Error {
+	return sdk.NewError(codespace, CodeUnrecognizedParamKey, fmt.Sprintf("the key: %s is not a recognized parameter", aclKey))
+}
+
+func ErrInvalidProposalStatus(codespace sdk.CodespaceType, status string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidProposalStatus, "invalid proposal status: "+status)
+}
+
+func ErrNoVotingPower(codespace sdk.CodespaceType, voter sdk.Address) sdk.Error {
+	return sdk.NewError(codespace, CodeNoVotingPower, fmt.Sprintf("account %s is neither a staked servicer nor a staked requestor", voter))
+}
+
+func ErrInvalidVestingSchedule(codespace sdk.CodespaceType, reason string) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidVestingSchedule, "invalid vesting schedule: "+reason)
+}
+
+func ErrProposalsInactive(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeProposalsInactive, "the governance proposals are not activated yet")
+}
+
+func ErrVestingAccountsInactive(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeVestingAccountsInactive, "the vesting accounts are not activated yet")
+}
+
+func ErrEmptyVersionUpgrade(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeEmptyVersionUpgrade, "the upgrade version must not be empty")
+}
+
 func ErrZeroHeightUpgrade(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeZeroHeightUpgrade, "the upgrade Height must not be zero")
 }
@@ -1,11 +1,20 @@
 package types
 
 const (
-	EventMessage           = "message"
-	EventDAOTransfer       = "dao_transfer"
-	EventDAOBurn           = "dao_burn"
-	EventParamChange       = "param_change"
-	EventUpgrade           = "upgrade"
-	EventMustUpgrade       = "must_upgrade"
-	AttributeValueCategory = ModuleName
+	EventMessage            = "message"
+	EventDAOTransfer        = "dao_transfer"
+	EventDAOBurn            = "dao_burn"
+	EventCreateVesting      = "create_vesting_account"
+	EventParamChange        = "param_change"
+	EventUpgrade            = "upgrade"
+	EventMustUpgrade        = "must_upgrade"
+	EventSubmitProposal     = "submit_proposal"
+	EventProposalVote       = "proposal_vote"
+	EventProposalTally      = "proposal_tally"
+	AttributeProposalID     = "proposal_id"
+	AttributeProposalType   = "proposal_type"
+	AttributeVoteOption     = "option"
+	AttributeProposalResult = "proposal_result"
+	AttributeProposalLog    = "proposal_log"
+	AttributeValueCategory  = ModuleName
 )
@@ -3,6 +3,7 @@ package types
 import (
 	sdk "github.com/vipernet-xyz/viper-network/types"
 	"github.com/vipernet-xyz/viper-network/x/authentication/exported"
+	requestorsExported "github.com/vipernet-xyz/viper-network/x/requestors/exported"
 	requestorsTypes "github.com/vipernet-xyz/viper-network/x/requestors/types"
 	servicersExported "github.com/vipernet-xyz/viper-network/x/servicers/exported"
 )
@@ -27,6 +28,8 @@ type AuthKeeper interface {
 	MintCoins(ctx sdk.Ctx, moduleName string, amt sdk.Coins) sdk.Error
 	// burn coins
 	BurnCoins(ctx sdk.Ctx, name string, amt sdk.Coins) sdk.Error
+	// create a vesting account funded by a module account
+	CreateVestingAccountFromModule(ctx sdk.Ctx, senderModule string, acc exported.VestingAccount) sdk.Error
 }
 
 type PosKeeper interface {
@@ -41,3 +44,15 @@ type PosKeeper interface {
 	SessionBlockFrequency(ctx sdk.Ctx) (res int64)
 	StakeDenom(ctx sdk.Ctx) (res string)
 }
+
+// ServicersKeeper defines the expected servicers keeper used to weigh the votes of staked servicers (noalias)
+type ServicersKeeper interface {
+	IterateAndExecuteOverVals(ctx sdk.Ctx, fn func(index int64, validator servicersExported.ValidatorI) (stop bool))
+	Validator(ctx sdk.Ctx, addr sdk.Address) servicersExported.ValidatorI
+}
+
+// RequestorsKeeper defines the expected requestors keeper used to weigh the votes of staked requestors (noalias)
+type RequestorsKeeper interface {
+	IterateAndExecuteOverRequestors(ctx sdk.Ctx, fn func(index int64, requestor requestorsExported.RequestorI) (stop bool))
+	Requestor(ctx sdk.Ctx, addr sdk.Address) requestorsExported.RequestorI
+}
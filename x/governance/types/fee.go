@@ -1,15 +1,21 @@
 package types
 
 const (
-	DAOTransferFee    = 10000
-	MsgChangeParamFee = 10000
-	MsgUpgradeFee     = 10000
+	DAOTransferFee       = 10000
+	MsgChangeParamFee    = 10000
+	MsgUpgradeFee        = 10000
+	MsgSubmitProposalFee = 10000
+	MsgVoteFee           = 10000
+	MsgCreateVestingFee  = 10000
 )
 
 var (
 	GovFeeMap = map[string]int64{
-		MsgDAOTransferName: DAOTransferFee,
-		MsgChangeParamName: MsgChangeParamFee,
-		MsgUpgradeName:     MsgUpgradeFee,
+		MsgDAOTransferName:          DAOTransferFee,
+		MsgChangeParamName:          MsgChangeParamFee,
+		MsgUpgradeName:              MsgUpgradeFee,
+		MsgSubmitProposalName:       MsgSubmitProposalFee,
+		MsgVoteName:                 MsgVoteFee,
+		MsgCreateVestingAccountName: MsgCreateVestingFee,
 	}
 )
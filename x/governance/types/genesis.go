@@ -10,6 +10,8 @@ import (
 type GenesisState struct {
 	Params    Params     `json:"params" yaml:"params"`
 	DAOTokens sdk.BigInt `json:"DAO_Tokens"`
+	Proposals Proposals  `json:"proposals,omitempty"`
+	Votes     []Vote     `json:"votes,omitempty"`
 }
 
 // NewGenesisState - Create a new genesis state
@@ -31,5 +33,15 @@ func ValidateGenesis(data GenesisState) error {
 	if data.Params.ACL == nil {
 		return ErrInvalidACL(ModuleName, fmt.Errorf("nil acl"))
 	}
+	for _, proposal := range data.Proposals {
+		if err := proposal.Content.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	for _, vote := range data.Votes {
+		if !vote.Option.IsValid() {
+			return ErrInvalidVoteOption(ModuleName, vote.Option.String())
+		}
+	}
 	return nil
 }
@@ -10,13 +10,18 @@ var (
 	_ sdk.ProtoMsg = &MsgDAOTransfer{}
 	_ sdk.ProtoMsg = &MsgUpgrade{}
 	_ sdk.ProtoMsg = &MsgGenerateDiscountKey{}
+	_ sdk.ProtoMsg = &MsgSubmitProposal{}
+	_ sdk.ProtoMsg = &MsgVote{}
 )
 
 const (
-	MsgDAOTransferName         = "dao_tranfer"
-	MsgChangeParamName         = "change_param"
-	MsgUpgradeName             = "upgrade"
-	MsgGenerateDiscountKeyName = "generate_discount_key"
+	MsgDAOTransferName          = "dao_tranfer"
+	MsgChangeParamName          = "change_param"
+	MsgUpgradeName              = "upgrade"
+	MsgGenerateDiscountKeyName  = "generate_discount_key"
+	MsgSubmitProposalName       = "submit_proposal"
+	MsgVoteName                 = "vote"
+	MsgCreateVestingAccountName = "create_vesting_account"
 )
 
 //----------------------------------------------------------------------------------------------------------------------
@@ -222,3 +227,102 @@ func (msg MsgUpgrade) ValidateBasic() sdk.Error {
 	}
 	return nil
 }
+
+//----------------------------------------------------------------------------------------------------------------------
+
+// MsgSubmitProposal structure for submitting a governance proposal with a deposit
+// type MsgSubmitProposal struct {
+// 	Proposer    sdk.Address     `json:"proposer"`
+// 	Title       string          `json:"title"`
+// 	Description string          `json:"description"`
+// 	Content     ProposalContent `json:"content"`
+// 	Deposit     sdk.BigInt      `json:"deposit"`
+// }
+
+// Route provides router key for msg
+func (msg MsgSubmitProposal) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgSubmitProposal) Type() string { return MsgSubmitProposalName }
+
+// GetFee get fee for msg
+func (msg MsgSubmitProposal) GetFee() sdk.BigInt {
+	return sdk.NewInt(GovFeeMap[msg.Type()])
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgSubmitProposal) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.Proposer}
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgSubmitProposal) GetRecipient() sdk.Address {
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgSubmitProposal) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check
+func (msg MsgSubmitProposal) ValidateBasic() sdk.Error {
+	if msg.Proposer == nil {
+		return sdk.ErrInvalidAddress("nil proposer address")
+	}
+	if len(msg.Title) == 0 || len(msg.Title) > MaxProposalTitleLength || len(msg.Description) > MaxProposalDescriptionLength {
+		return ErrInvalidProposalTitle(ModuleName)
+	}
+	if msg.Deposit.BigInt() == nil || !msg.Deposit.IsPositive() {
+		return ErrInsufficientProposalDeposit(ModuleName, msg.Deposit, sdk.OneInt())
+	}
+	return msg.Content.ValidateBasic()
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+// MsgVote structure for voting on an active governance proposal
+// type MsgVote struct {
+// 	ProposalID uint64      `json:"proposal_id"`
+// 	Voter      sdk.Address `json:"voter"`
+// 	Option     VoteOption  `json:"option"`
+// }
+
+// Route provides router key for msg
+func (msg MsgVote) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgVote) Type() string { return MsgVoteName }
+
+// GetFee get fee for msg
+func (msg MsgVote) GetFee() sdk.BigInt {
+	return sdk.NewInt(GovFeeMap[msg.Type()])
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgVote) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.Voter}
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgVote) GetRecipient() sdk.Address {
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgVote) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check
+func (msg MsgVote) ValidateBasic() sdk.Error {
+	if msg.Voter == nil {
+		return sdk.ErrInvalidAddress("nil voter address")
+	}
+	if !msg.Option.IsValid() {
+		return ErrInvalidVoteOption(ModuleName, msg.Option.String())
+	}
+	return nil
+}
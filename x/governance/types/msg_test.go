@@ -146,3 +146,82 @@ func TestMsgGenerateDiscountKey_ValidateBasic(t *testing.T) {
 	}
 	assert.NotNil(t, m.ValidateBasic())
 }
+
+func TestMsgSubmitProposal_ValidateBasic(t *testing.T) {
+	cdc := makeTestCodec()
+	bytes, _ := cdc.MarshalJSON(int64(23))
+	content := NewParamChangeContent("authentication/TxSigLimit", bytes)
+	m := MsgSubmitProposal{
+		Proposer:    getRandomValidatorAddress(),
+		Title:       "raise the sig limit",
+		Description: "more signatures per tx",
+		Content:     content,
+		Deposit:     types.NewInt(100),
+	}
+	assert.Nil(t, m.ValidateBasic())
+	m2 := m
+	m2.Proposer = nil
+	assert.NotNil(t, m2.ValidateBasic())
+	m2 = m
+	m2.Title = ""
+	assert.NotNil(t, m2.ValidateBasic())
+	m2 = m
+	m2.Deposit = types.ZeroInt()
+	assert.NotNil(t, m2.ValidateBasic())
+	m2 = m
+	m2.Content = NewParamChangeContent("", bytes)
+	assert.NotNil(t, m2.ValidateBasic())
+}
+
+func TestMsgVote_ValidateBasic(t *testing.T) {
+	m := MsgVote{
+		ProposalID: 1,
+		Voter:      getRandomValidatorAddress(),
+		Option:     OptionYes,
+	}
+	assert.Nil(t, m.ValidateBasic())
+	m2 := m
+	m2.Voter = nil
+	assert.NotNil(t, m2.ValidateBasic())
+	m2 = m
+	m2.Option = OptionEmpty
+	assert.NotNil(t, m2.ValidateBasic())
+}
+
+func TestMsgCreateVestingAccount_ValidateBasic(t *testing.T) {
+	m := MsgCreateVestingAccount{
+		FromAddress: getRandomValidatorAddress(),
+		ToAddress:   getRandomValidatorAddress(),
+		Amount:      types.NewInt(100),
+		VestingType: ContinuousVestingString,
+		StartTime:   1000,
+		EndTime:     2000,
+	}
+	assert.Nil(t, m.ValidateBasic())
+	m.StartTime = 2000
+	assert.NotNil(t, m.ValidateBasic())
+	m.VestingType = DelayedVestingString
+	assert.Nil(t, m.ValidateBasic())
+	m.Amount = types.ZeroInt()
+	assert.NotNil(t, m.ValidateBasic())
+	m = MsgCreateVestingAccount{
+		FromAddress: getRandomValidatorAddress(),
+		ToAddress:   getRandomValidatorAddress(),
+		Amount:      types.NewInt(100),
+		VestingType: PeriodicVestingString,
+		StartTime:   1000,
+		Periods:     []VestingPeriod{{Length: 10, Amount: types.NewInt(40)}, {Length: 10, Amount: types.NewInt(60)}},
+	}
+	assert.Nil(t, m.ValidateBasic())
+	m.Periods = m.Periods[:1]
+	assert.NotNil(t, m.ValidateBasic())
+	m.VestingType = "linear"
+	assert.NotNil(t, m.ValidateBasic())
+	m = MsgCreateVestingAccount{
+		FromAddress: getRandomValidatorAddress(),
+		Amount:      types.NewInt(100),
+		VestingType: DelayedVestingString,
+		EndTime:     2000,
+	}
+	assert.NotNil(t, m.ValidateBasic())
+}
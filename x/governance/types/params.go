@@ -12,13 +12,28 @@ import (
 const DefaultParamspace = ModuleName
 
 // Default parameter values
-const ()
+const (
+	DefaultVotingPeriod = int64(192) // blocks
+)
+
+var (
+	DefaultMinProposalDeposit = sdk.NewInt(1000000000)
+	DefaultQuorum             = sdk.NewDecWithPrec(334, 3)
+	DefaultThreshold          = sdk.NewDecWithPrec(5, 1)
+	DefaultVetoThreshold      = sdk.NewDecWithPrec(334, 3)
+)
 
 // Parameter keys
 var (
 	ACLKey      = []byte("acl")
 	DAOOwnerKey = []byte("daoOwner")
 	UpgradeKey  = []byte("upgrade")
+
+	MinProposalDepositKey = []byte("minProposalDeposit")
+	VotingPeriodKey       = []byte("votingPeriod")
+	QuorumKey             = []byte("quorum")
+	ThresholdKey          = []byte("threshold")
+	VetoThresholdKey      = []byte("vetoThreshold")
 )
 
 var _ sdk.ParamSet = (*Params)(nil)
@@ -28,6 +43,12 @@ type Params struct {
 	ACL      ACL         `json:"acl"`
 	DAOOwner sdk.Address `json:"dao_owner"`
 	Upgrade  Upgrade     `json:"upgrade"`
+
+	MinProposalDeposit sdk.BigInt `json:"min_proposal_deposit"` // minimum deposit (in stake denom) to submit a proposal
+	VotingPeriod       int64      `json:"voting_period"`        // number of blocks a proposal accepts votes, zero disables proposals
+	Quorum             sdk.BigDec `json:"quorum"`               // minimum fraction of the total stake that must vote
+	Threshold          sdk.BigDec `json:"threshold"`            // minimum fraction of yes votes (excluding abstain) to pass
+	VetoThreshold      sdk.BigDec `json:"veto_threshold"`       // fraction of no_with_veto votes that rejects and burns the deposit
 }
 
 // NewParams creates a new Params object
@@ -51,6 +72,11 @@ func (p *Params) ParamSetPairs() sdk.ParamSetPairs {
 		{Key: ACLKey, Value: &p.ACL},
 		{Key: DAOOwnerKey, Value: &p.DAOOwner},
 		{Key: UpgradeKey, Value: &p.Upgrade},
+		{Key: MinProposalDepositKey, Value: &p.MinProposalDeposit},
+		{Key: VotingPeriodKey, Value: &p.VotingPeriod},
+		{Key: QuorumKey, Value: &p.Quorum},
+		{Key: ThresholdKey, Value: &p.Threshold},
+		{Key: VetoThresholdKey, Value: &p.VetoThreshold},
 	}
 }
 
@@ -67,6 +93,12 @@ func DefaultParams() Params {
 		ACL:      acl,
 		DAOOwner: sdk.Address{},
 		Upgrade:  u,
+
+		MinProposalDeposit: DefaultMinProposalDeposit,
+		VotingPeriod:       DefaultVotingPeriod,
+		Quorum:             DefaultQuorum,
+		Threshold:          DefaultThreshold,
+		VetoThreshold:      DefaultVetoThreshold,
 	}
 }
 
@@ -77,5 +109,10 @@ func (p Params) String() string {
 	sb.WriteString(fmt.Sprintf("ACLKey: %v\n", p.ACL))
 	sb.WriteString(fmt.Sprintf("DAOOwnerKey: %s\n", p.DAOOwner))
 	sb.WriteString(fmt.Sprintf("UpgradeKey: %v\n", p.Upgrade))
+	sb.WriteString(fmt.Sprintf("MinProposalDeposit: %s\n", p.MinProposalDeposit))
+	sb.WriteString(fmt.Sprintf("VotingPeriod: %d\n", p.VotingPeriod))
+	sb.WriteString(fmt.Sprintf("Quorum: %s\n", p.Quorum))
+	sb.WriteString(fmt.Sprintf("Threshold: %s\n", p.Threshold))
+	sb.WriteString(fmt.Sprintf("VetoThreshold: %s\n", p.VetoThreshold))
 	return sb.String()
 }
@@ -0,0 +1,303 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+
+	sdk "github.com/vipernet-xyz/viper-network/types"
+)
+
+const (
+	ProposalTypeParamChange = "param_change"
+	ProposalTypeUpgrade     = "upgrade"
+	ProposalTypeDAOTransfer = "dao_transfer"
+
+	MaxProposalTitleLength       = 140
+	MaxProposalDescriptionLength = 10000
+)
+
+var (
+	ProposalsKey            = []byte{0x01} // prefix for each key to a proposal
+	ActiveProposalsQueueKey = []byte{0x02} // prefix for the queue of proposals in their voting period
+	VotesKey                = []byte{0x03} // prefix for each key to a vote
+	ProposalIDKey           = []byte{0x04} // key for the next proposal id
+)
+
+// KeyForProposal generates the key for the proposal with id
+func KeyForProposal(proposalID uint64) []byte {
+	return append(ProposalsKey, ProposalIDToBytes(proposalID)...)
+}
+
+// KeyForActiveProposal generates the key for a proposal in the voting queue, sorted by voting end height
+func KeyForActiveProposal(votingEndHeight int64, proposalID uint64) []byte {
+	return append(KeyForActiveProposalsByHeight(votingEndHeight), ProposalIDToBytes(proposalID)...)
+}
+
+// KeyForActiveProposalsByHeight generates the queue prefix of all the proposals ending at votingEndHeight
+func KeyForActiveProposalsByHeight(votingEndHeight int64) []byte {
+	return append(ActiveProposalsQueueKey, sdk.Uint64ToBigEndian(uint64(votingEndHeight))...)
+}
+
+// KeyForVotes generates the prefix of all the votes for a proposal
+func KeyForVotes(proposalID uint64) []byte {
+	return append(VotesKey, ProposalIDToBytes(proposalID)...)
+}
+
+// KeyForVote generates the key for the vote of voter on a proposal
+func KeyForVote(proposalID uint64, voter sdk.Address) []byte {
+	return append(KeyForVotes(proposalID), voter.Bytes()...)
+}
+
+// ProposalIDToBytes returns the big endian bytes of a proposal id
+func ProposalIDToBytes(proposalID uint64) []byte {
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, proposalID)
+	return bz
+}
+
+// ProposalIDFromBytes returns a proposal id from its big endian bytes
+func ProposalIDFromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
+
+// ProposalIDFromActiveQueueKey removes the prefix and height bytes from an active queue key to expose the id
+func ProposalIDFromActiveQueueKey(key []byte) uint64 {
+	return ProposalIDFromBytes(key[len(ActiveProposalsQueueKey)+8:])
+}
+
+// NewParamChangeContent returns the content of a parameter change proposal
+func NewParamChangeContent(aclKey string, paramValue []byte) ProposalContent {
+	return ProposalContent{
+		Type:     ProposalTypeParamChange,
+		ParamKey: aclKey,
+		ParamVal: paramValue,
+		Amount:   sdk.ZeroInt(),
+	}
+}
+
+// NewUpgradeContent returns the content of an upgrade proposal
+func NewUpgradeContent(upgrade Upgrade) ProposalContent {
+	return ProposalContent{
+		Type:    ProposalTypeUpgrade,
+		Upgrade: upgrade,
+		Amount:  sdk.ZeroInt(),
+	}
+}
+
+// NewDAOTransferContent returns the content of a dao transfer (or burn) proposal
+func NewDAOTransferContent(action string, toAddress sdk.Address, amount sdk.BigInt) ProposalContent {
+	return ProposalContent{
+		Type:      ProposalTypeDAOTransfer,
+		ToAddress: toAddress,
+		Amount:    amount,
+		DaoAction: action,
+	}
+}
+
+// ValidateBasic quick validity check of the proposal content
+func (pc ProposalContent) ValidateBasic() sdk.Error {
+	switch pc.Type {
+	case ProposalTypeParamChange:
+		if pc.ParamKey == "" || !strings.Contains(pc.ParamKey, ACLKeySep) {
+			return ErrEmptyKey(ModuleName)
+		}
+		if pc.ParamVal == nil {
+			return ErrEmptyValue(ModuleName)
+		}
+	case ProposalTypeUpgrade:
+		if pc.Upgrade.UpgradeHeight() == 0 {
+			return ErrZeroHeightUpgrade(ModuleName)
+		}
+		if pc.Upgrade.UpgradeVersion() == "" {
+			return ErrEmptyVersionUpgrade(ModuleName)
+		}
+	case ProposalTypeDAOTransfer:
+		if pc.Amount.BigInt() == nil || !pc.Amount.IsPositive() {
+			return ErrZeroValueDAOAction(ModuleName)
+		}
+		daoAction, err := DAOActionFromString(pc.DaoAction)
+		if err != nil {
+			return err
+		}
+		if daoAction == DAOTransfer && pc.ToAddress == nil {
+			return sdk.ErrInvalidAddress("nil to address")
+		}
+	default:
+		return ErrInvalidProposalContentType(ModuleName, pc.Type)
+	}
+	return nil
+}
+
+// String implements the stringer interface
+func (pc ProposalContent) String() string {
+	switch pc.Type {
+	case ProposalTypeParamChange:
+		return fmt.Sprintf("%s: %s to %s", pc.Type, pc.ParamKey, string(pc.ParamVal))
+	case ProposalTypeUpgrade:
+		return fmt.Sprintf("%s: version %s at height %d %v", pc.Type, pc.Upgrade.Version, pc.Upgrade.Height, pc.Upgrade.Features)
+	case ProposalTypeDAOTransfer:
+		return fmt.Sprintf("%s: %s %s to %s", pc.Type, pc.DaoAction, pc.Amount, pc.ToAddress)
+	}
+	return pc.Type
+}
+
+// NewProposal creates a proposal in its voting period
+func NewProposal(id uint64, title, description string, content ProposalContent, proposer sdk.Address, deposit sdk.BigInt, submitHeight, votingEndHeight int64) Proposal {
+	return Proposal{
+		Id:              id,
+		Title:           title,
+		Description:     description,
+		Content:         content,
+		Proposer:        proposer,
+		Deposit:         deposit,
+		SubmitHeight:    submitHeight,
+		VotingEndHeight: votingEndHeight,
+		Status:          StatusVotingPeriod,
+		FinalTally:      EmptyTallyResult(),
+	}
+}
+
+// IsActive returns whether or not the proposal is still accepting votes
+func (p Proposal) IsActive() bool {
+	return p.Status == StatusVotingPeriod
+}
+
+// String implements the stringer interface
+func (p Proposal) String() string {
+	return fmt.Sprintf(`Proposal %d:
+  Title:             %s
+  Content:           %s
+  Proposer:          %s
+  Deposit:           %s
+  Status:            %s
+  Submit Height:     %d
+  Voting End Height: %d
+  Final Tally:       %s
+  Execution Log:     %s`,
+		p.Id, p.Title, p.Content, p.Proposer, p.Deposit, p.Status, p.SubmitHeight, p.VotingEndHeight, p.FinalTally, p.ExecutionLog)
+}
+
+type Proposals []Proposal
+
+// String implements the stringer interface
+func (ps Proposals) String() (out string) {
+	for _, p := range ps {
+		out += p.String() + "\n"
+	}
+	return strings.TrimSpace(out)
+}
+
+// String implements the stringer interface
+func (ps ProposalStatus) String() string {
+	switch ps {
+	case StatusVotingPeriod:
+		return "voting_period"
+	case StatusPassed:
+		return "passed"
+	case StatusRejected:
+		return "rejected"
+	case StatusFailed:
+		return "failed"
+	}
+	return ""
+}
+
+// ProposalStatusFromString returns the proposal status from its string representation
+func ProposalStatusFromString(s string) (ProposalStatus, sdk.Error) {
+	switch s {
+	case "voting_period":
+		return StatusVotingPeriod, nil
+	case "passed":
+		return StatusPassed, nil
+	case "rejected":
+		return StatusRejected, nil
+	case "failed":
+		return StatusFailed, nil
+	}
+	return StatusNil, ErrInvalidProposalStatus(ModuleName, s)
+}
+
+// String implements the stringer interface
+func (vo VoteOption) String() string {
+	switch vo {
+	case OptionYes:
+		return "yes"
+	case OptionAbstain:
+		return "abstain"
+	case OptionNo:
+		return "no"
+	case OptionNoWithVeto:
+		return "no_with_veto"
+	}
+	return ""
+}
+
+// IsValid returns whether or not the option is a recognized vote option
+func (vo VoteOption) IsValid() bool {
+	return vo == OptionYes || vo == OptionAbstain || vo == OptionNo || vo == OptionNoWithVeto
+}
+
+// VoteOptionFromString returns the vote option from its string representation
+func VoteOptionFromString(s string) (VoteOption, sdk.Error) {
+	switch strings.ToLower(s) {
+	case "yes":
+		return OptionYes, nil
+	case "abstain":
+		return OptionAbstain, nil
+	case "no":
+		return OptionNo, nil
+	case "no_with_veto", "veto":
+		return OptionNoWithVeto, nil
+	}
+	return OptionEmpty, ErrInvalidVoteOption(ModuleName, s)
+}
+
+// NewVote creates a vote for a proposal
+func NewVote(proposalID uint64, voter sdk.Address, option VoteOption) Vote {
+	return Vote{
+		ProposalID: proposalID,
+		Voter:      voter,
+		Option:     option,
+	}
+}
+
+// String implements the stringer interface
+func (v Vote) String() string {
+	return fmt.Sprintf("voter %s voted %s on proposal %d", v.Voter, v.Option, v.ProposalID)
+}
+
+// EmptyTallyResult returns a tally with no voting power
+func EmptyTallyResult() TallyResult {
+	return TallyResult{
+		Yes:        sdk.ZeroInt(),
+		Abstain:    sdk.ZeroInt(),
+		No:         sdk.ZeroInt(),
+		NoWithVeto: sdk.ZeroInt(),
+	}
+}
+
+// AddVote adds the voting power to the option of the tally
+func (tr TallyResult) AddVote(option VoteOption, power sdk.BigInt) TallyResult {
+	switch option {
+	case OptionYes:
+		tr.Yes = tr.Yes.Add(power)
+	case OptionAbstain:
+		tr.Abstain = tr.Abstain.Add(power)
+	case OptionNo:
+		tr.No = tr.No.Add(power)
+	case OptionNoWithVeto:
+		tr.NoWithVeto = tr.NoWithVeto.Add(power)
+	}
+	return tr
+}
+
+// Total returns the sum of all the voting power in the tally
+func (tr TallyResult) Total() sdk.BigInt {
+	return tr.Yes.Add(tr.Abstain).Add(tr.No).Add(tr.NoWithVeto)
+}
+
+// String implements the stringer interface
+func (tr TallyResult) String() string {
+	return fmt.Sprintf("yes: %s abstain: %s no: %s no_with_veto: %s", tr.Yes, tr.Abstain, tr.No, tr.NoWithVeto)
+}
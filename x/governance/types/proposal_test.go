@@ -0,0 +1,80 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/vipernet-xyz/viper-network/types"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProposalContent_ValidateBasic(t *testing.T) {
+	cdc := makeTestCodec()
+	bytes, _ := cdc.MarshalJSON(int64(23))
+	tests := []struct {
+		name     string
+		content  ProposalContent
+		hasError bool
+	}{
+		{"valid param change", NewParamChangeContent("authentication/TxSigLimit", bytes), false},
+		{"param change without subspace", NewParamChangeContent("TxSigLimit", bytes), true},
+		{"param change without value", NewParamChangeContent("authentication/TxSigLimit", nil), true},
+		{"valid upgrade", NewUpgradeContent(NewUpgrade(100, "0.1.0")), false},
+		{"upgrade at zero height", NewUpgradeContent(NewUpgrade(0, "0.1.0")), true},
+		{"upgrade without version", NewUpgradeContent(NewUpgrade(100, "")), true},
+		{"valid dao transfer", NewDAOTransferContent(DAOTransferString, getRandomValidatorAddress(), types.OneInt()), false},
+		{"valid dao burn", NewDAOTransferContent(DAOBurnString, nil, types.OneInt()), false},
+		{"dao transfer without recipient", NewDAOTransferContent(DAOTransferString, nil, types.OneInt()), true},
+		{"dao transfer of zero", NewDAOTransferContent(DAOTransferString, getRandomValidatorAddress(), types.ZeroInt()), true},
+		{"unknown type", ProposalContent{Type: "foo"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.hasError, tt.content.ValidateBasic() != nil)
+		})
+	}
+}
+
+func TestVoteOptionFromString(t *testing.T) {
+	for _, option := range []VoteOption{OptionYes, OptionAbstain, OptionNo, OptionNoWithVeto} {
+		o, err := VoteOptionFromString(option.String())
+		assert.Nil(t, err)
+		assert.Equal(t, option, o)
+	}
+	o, err := VoteOptionFromString("VETO")
+	assert.Nil(t, err)
+	assert.Equal(t, OptionNoWithVeto, o)
+	_, err = VoteOptionFromString("maybe")
+	assert.NotNil(t, err)
+	assert.False(t, OptionEmpty.IsValid())
+}
+
+func TestProposalStatusFromString(t *testing.T) {
+	for _, status := range []ProposalStatus{StatusVotingPeriod, StatusPassed, StatusRejected, StatusFailed} {
+		s, err := ProposalStatusFromString(status.String())
+		assert.Nil(t, err)
+		assert.Equal(t, status, s)
+	}
+	_, err := ProposalStatusFromString("pending")
+	assert.NotNil(t, err)
+}
+
+func TestTallyResult_AddVote(t *testing.T) {
+	tally := EmptyTallyResult().
+		AddVote(OptionYes, types.NewInt(10)).
+		AddVote(OptionNo, types.NewInt(5)).
+		AddVote(OptionAbstain, types.NewInt(3)).
+		AddVote(OptionNoWithVeto, types.NewInt(2)).
+		AddVote(OptionYes, types.NewInt(1))
+	assert.Equal(t, int64(11), tally.Yes.Int64())
+	assert.Equal(t, int64(5), tally.No.Int64())
+	assert.Equal(t, int64(3), tally.Abstain.Int64())
+	assert.Equal(t, int64(2), tally.NoWithVeto.Int64())
+	assert.Equal(t, int64(21), tally.Total().Int64())
+}
+
+func TestProposalKeys(t *testing.T) {
+	key := KeyForActiveProposal(120, 7)
+	assert.Equal(t, uint64(7), ProposalIDFromActiveQueueKey(key))
+	assert.Equal(t, uint64(7), ProposalIDFromBytes(ProposalIDToBytes(7)))
+}
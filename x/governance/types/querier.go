@@ -17,6 +17,10 @@ const (
 	QueryDAO                           = "dao"
 	QueryUpgrade                       = "upgrade"
 	QueryDAOOwner                      = "daoOwner"
+	QueryProposal                      = "proposal"
+	QueryProposals                     = "proposals"
+	QueryVotes                         = "votes"
+	QueryTally                         = "tally"
 )
 
 type QueryACLParams struct{}
@@ -24,3 +28,11 @@ type QueryACLParams struct{}
 type QueryDAOParams struct{}
 
 type QueryUpgradeParams struct{}
+
+type QueryProposalParams struct {
+	ProposalID uint64 `json:"proposal_id"`
+}
+
+type QueryProposalsParams struct {
+	Status string `json:"status"` // optional status filter: voting_period, passed, rejected or failed
+}
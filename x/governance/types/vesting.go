@@ -0,0 +1,118 @@
+package types
+
+import (
+	sdk "github.com/vipernet-xyz/viper-network/types"
+	"github.com/vipernet-xyz/viper-network/x/authentication/exported"
+	authTypes "github.com/vipernet-xyz/viper-network/x/authentication/types"
+)
+
+const (
+	ContinuousVestingString = "continuous"
+	DelayedVestingString    = "delayed"
+	PeriodicVestingString   = "periodic"
+)
+
+var _ sdk.ProtoMsg = &MsgCreateVestingAccount{}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+// MsgCreateVestingAccount structure for creating a vesting account funded by the dao
+// type MsgCreateVestingAccount struct {
+// 	FromAddress sdk.Address     `json:"from_address"`
+// 	ToAddress   sdk.Address     `json:"to_address"`
+// 	Amount      sdk.BigInt      `json:"amount"`
+// 	VestingType string          `json:"vesting_type"`
+// 	StartTime   int64           `json:"start_time"`
+// 	EndTime     int64           `json:"end_time"`
+// 	Periods     []VestingPeriod `json:"periods"`
+// }
+
+// Route provides router key for msg
+func (msg MsgCreateVestingAccount) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgCreateVestingAccount) Type() string { return MsgCreateVestingAccountName }
+
+// GetFee get fee for msg
+func (msg MsgCreateVestingAccount) GetFee() sdk.BigInt {
+	return sdk.NewInt(GovFeeMap[msg.Type()])
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgCreateVestingAccount) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.FromAddress}
+}
+
+// GetRecipient return the vesting account address
+func (msg MsgCreateVestingAccount) GetRecipient() sdk.Address {
+	return msg.ToAddress
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgCreateVestingAccount) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check
+func (msg MsgCreateVestingAccount) ValidateBasic() sdk.Error {
+	if msg.FromAddress == nil {
+		return sdk.ErrInvalidAddress("nil from address")
+	}
+	if msg.ToAddress == nil {
+		return sdk.ErrInvalidAddress("nil to address")
+	}
+	if msg.Amount.IsZero() || msg.Amount.IsNegative() {
+		return ErrZeroValueDAOAction(ModuleName)
+	}
+	switch msg.VestingType {
+	case ContinuousVestingString:
+		if msg.StartTime <= 0 || msg.StartTime >= msg.EndTime {
+			return ErrInvalidVestingSchedule(ModuleName, "the start time must be positive and before the end time")
+		}
+	case DelayedVestingString:
+		if msg.EndTime <= 0 {
+			return ErrInvalidVestingSchedule(ModuleName, "the end time must be positive")
+		}
+	case PeriodicVestingString:
+		if msg.StartTime <= 0 || len(msg.Periods) == 0 {
+			return ErrInvalidVestingSchedule(ModuleName, "a periodic vesting needs a positive start time and vesting periods")
+		}
+		total := sdk.ZeroInt()
+		for _, period := range msg.Periods {
+			if period.Length <= 0 || period.Amount.IsZero() || period.Amount.IsNegative() {
+				return ErrInvalidVestingSchedule(ModuleName, "the vesting periods must have a positive length and amount")
+			}
+			total = total.Add(period.Amount)
+		}
+		if !total.Equal(msg.Amount) {
+			return ErrInvalidVestingSchedule(ModuleName, "the vesting periods must add up to the amount")
+		}
+	default:
+		return ErrInvalidVestingSchedule(ModuleName, "unrecognized vesting type: "+msg.VestingType)
+	}
+	if msg.VestingType != PeriodicVestingString && len(msg.Periods) != 0 {
+		return ErrInvalidVestingSchedule(ModuleName, "only a periodic vesting can have vesting periods")
+	}
+	return nil
+}
+
+// VestingAccount returns the vesting account created by the message, funded with amount of denom
+func (msg MsgCreateVestingAccount) VestingAccount(denom string) exported.VestingAccount {
+	baseAcc := &authTypes.BaseAccount{
+		Address: msg.ToAddress,
+		Coins:   sdk.NewCoins(sdk.NewCoin(denom, msg.Amount)),
+	}
+	switch msg.VestingType {
+	case DelayedVestingString:
+		return authTypes.NewDelayedVestingAccount(baseAcc, msg.EndTime)
+	case PeriodicVestingString:
+		periods := make(authTypes.Periods, len(msg.Periods))
+		for i, period := range msg.Periods {
+			periods[i] = authTypes.Period{Length: period.Length, Amount: sdk.NewCoins(sdk.NewCoin(denom, period.Amount))}
+		}
+		return authTypes.NewPeriodicVestingAccount(baseAcc, msg.StartTime, periods)
+	default:
+		return authTypes.NewContinuousVestingAccount(baseAcc, msg.StartTime, msg.EndTime)
+	}
+}
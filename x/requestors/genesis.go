@@ -30,6 +30,11 @@ func InitGenesis(ctx sdk.Ctx, keeper keeper.Keeper, supplyKeeper types.AuthKeepe
 			stakedTokens = stakedTokens.Add(requestor.GetTokens())
 		}
 	}
+	// the unbonding stakes stay in the staked pool until they are released
+	for _, unbonding := range data.UnbondingStakes {
+		keeper.SetUnbondingStake(ctx, unbonding)
+		stakedTokens = stakedTokens.Add(unbonding.Amount)
+	}
 	stakedCoins := sdk.NewCoins(sdk.NewCoin(posKeeper.StakeDenom(ctx), stakedTokens))
 	// check if the staked pool accounts exists
 	stakedPool := keeper.GetStakedPool(ctx)
@@ -47,6 +52,10 @@ func InitGenesis(ctx sdk.Ctx, keeper keeper.Keeper, supplyKeeper types.AuthKeepe
 			log.Fatal(fmt.Errorf("%s module account total does not equal the amount in each requestor account", types.StakedPoolName))
 		}
 	}
+	// set the client revocations
+	for _, revokedClient := range data.RevokedClients {
+		keeper.SetRevokedClient(ctx, revokedClient)
+	}
 	// add coins to the total supply
 	keeper.AccountKeeper.SetSupply(ctx, keeper.AccountKeeper.GetSupply(ctx).Inflate(stakedCoins))
 	// set the params set in the keeper
@@ -58,9 +67,11 @@ func ExportGenesis(ctx sdk.Ctx, keeper keeper.Keeper) types.GenesisState {
 	params := keeper.GetParams(ctx)
 	requestors := keeper.GetAllRequestors(ctx)
 	return types.GenesisState{
-		Params:     params,
-		Requestors: requestors,
-		Exported:   true,
+		Params:          params,
+		Requestors:      requestors,
+		Exported:        true,
+		RevokedClients:  keeper.GetAllRevokedClients(ctx),
+		UnbondingStakes: keeper.GetAllUnbondingStakes(ctx),
 	}
 }
 
@@ -75,6 +86,16 @@ func ValidateGenesis(data types.GenesisState) error {
 	if err != nil {
 		return err
 	}
+	for _, revokedClient := range data.RevokedClients {
+		if err := revokedClient.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	for _, unbonding := range data.UnbondingStakes {
+		if err := unbonding.ValidateBasic(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
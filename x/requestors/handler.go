@@ -3,7 +3,9 @@ package pos
 import (
 	"fmt"
 	"reflect"
+	"time"
 
+	"github.com/vipernet-xyz/viper-network/codec"
 	crypto "github.com/vipernet-xyz/viper-network/crypto/codec"
 	sdk "github.com/vipernet-xyz/viper-network/types"
 	"github.com/vipernet-xyz/viper-network/x/requestors/keeper"
@@ -24,6 +26,10 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 			return handleMsgBeginUnstake(ctx, msg, k)
 		case types.MsgUnjail:
 			return handleMsgUnjail(ctx, msg, k)
+		case types.MsgRevokeClient:
+			return handleMsgRevokeClient(ctx, msg, k)
+		case types.MsgUnstakePartial:
+			return handleMsgUnstakePartial(ctx, msg, k)
 		default:
 			errMsg := fmt.Sprintf("unrecognized staking message type: %T", msg)
 			return sdk.ErrUnknownRequest(errMsg).Result()
@@ -115,3 +121,46 @@ func handleMsgUnjail(ctx sdk.Ctx, msg types.MsgUnjail, k keeper.Keeper) sdk.Resu
 	)
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
+
+// Requestors revoke the authentication tokens of a client once the feature is activated by an upgrade
+func handleMsgRevokeClient(ctx sdk.Ctx, msg types.MsgRevokeClient, k keeper.Keeper) sdk.Result {
+	if !types.ModuleCdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.TokenRevocationKey) {
+		return types.ErrRevocationInactive(k.Codespace()).Result()
+	}
+	if err := k.RevokeClient(ctx, msg.RequestorAddress, msg.ClientPublicKey); err != nil {
+		return err.Result()
+	}
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+// Requestors remove the stake above the minimum once the feature is activated by an upgrade
+func handleMsgUnstakePartial(ctx sdk.Ctx, msg types.MsgUnstakePartial, k keeper.Keeper) sdk.Result {
+	if !types.ModuleCdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.PartialUnstakeKey) {
+		return types.ErrUnstakePartialInactive(k.Codespace()).Result()
+	}
+	requestor, err := k.ValidateUnstakePartial(ctx, msg)
+	if err != nil {
+		ctx.Logger().Error(fmt.Sprintf("Requestor Partial Unstake Validation Not Successful, at height: %d", ctx.BlockHeight()) + msg.Address.String())
+		return err.Result()
+	}
+	unbonding, err := k.UnstakePartialRequestor(ctx, requestor, msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+	// create the event
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnstakePartial,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, unbonding.CompletionTime.Format(time.RFC3339)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
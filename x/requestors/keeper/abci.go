@@ -12,5 +12,7 @@ func BeginBlocker(ctx sdk.Ctx, _ abci.RequestBeginBlock, k Keeper) {}
 func EndBlocker(ctx sdk.Ctx, k Keeper) []abci.ValidatorUpdate {
 	// Unstake all mature requestors from the unstakeing queue.
 	k.unstakeAllMatureRequestors(ctx)
+	// Release the mature stakes of the partial unstakes.
+	k.releaseMatureUnbondingStakes(ctx)
 	return []abci.ValidatorUpdate{}
 }
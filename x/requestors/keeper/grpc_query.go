@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/vipernet-xyz/viper-network/types"
+	"github.com/vipernet-xyz/viper-network/x/requestors/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ types.QueryServer = queryServer{}
+
+type queryServer struct {
+	k Keeper
+}
+
+// NewQueryServer returns the implementation of the grpc query service of the module
+func NewQueryServer(k Keeper) types.QueryServer {
+	return queryServer{k: k}
+}
+
+// Requestors implements the Query/Requestors gRPC method
+func (q queryServer) Requestors(c context.Context, req *types.QueryRequestorsRequest) (*types.QueryRequestorsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	opts := types.QueryRequestorsWithOpts{
+		Page:          int(req.Page),
+		Limit:         int(req.PerPage),
+		StakingStatus: sdk.StakeStatus(req.StakingStatus),
+		Blockchain:    req.Blockchain,
+		GeoZone:       req.GeoZone,
+	}
+	if opts.Page <= 0 {
+		opts.Page = 1
+	}
+	page := paginate(opts.Page, opts.Limit, q.k.GetAllRequestorsWithOpts(ctx, opts), int(q.k.GetParams(ctx).MaxRequestors))
+	requestors := make([]types.ProtoRequestor, 0, len(page.Result))
+	for _, r := range page.Result {
+		requestors = append(requestors, r.ToProto())
+	}
+	return &types.QueryRequestorsResponse{Requestors: requestors, TotalPages: int64(page.Total), Page: int64(page.Page)}, nil
+}
+
+// Requestor implements the Query/Requestor gRPC method
+func (q queryServer) Requestor(c context.Context, req *types.QueryRequestorRequest) (*types.QueryRequestorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	addr, err := sdk.AddressFromHex(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	requestor, found := q.k.GetRequestor(sdk.UnwrapSDKContext(c), addr)
+	if !found {
+		return nil, status.Error(codes.NotFound, types.ErrNoRequestorFound(types.DefaultCodespace).Error())
+	}
+	return &types.QueryRequestorResponse{Requestor: requestor.ToProto()}, nil
+}
+
+// UnbondingStakes implements the Query/UnbondingStakes gRPC method
+func (q queryServer) UnbondingStakes(c context.Context, req *types.QueryUnbondingStakesRequest) (*types.QueryUnbondingStakesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	addr, err := sdk.AddressFromHex(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryUnbondingStakesResponse{UnbondingStakes: q.k.GetUnbondingStakes(sdk.UnwrapSDKContext(c), addr)}, nil
+}
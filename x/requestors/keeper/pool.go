@@ -37,7 +37,7 @@ func (k Keeper) GetStakedPool(ctx sdk.Ctx) (stakedPool exported.ModuleAccountI)
 // coinsFromStakedToUnstkaed - Transfer coins from the module account to the requestor -> used in unstaking
 func (k Keeper) coinsFromStakedToUnstaked(ctx sdk.Ctx, requestor types.Requestor) sdk.Error {
 	coins := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), requestor.StakedTokens))
-	err := k.AccountKeeper.SendCoinsFromModuleToAccount(ctx, types.StakedPoolName, requestor.Address, coins)
+	err := k.AccountKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.StakedPoolName, requestor.Address, coins)
 	if err != nil {
 		return err
 	}
@@ -50,7 +50,7 @@ func (k Keeper) coinsFromUnstakedToStaked(ctx sdk.Ctx, requestor types.Requestor
 		return sdk.ErrInternal("cannot stake a negative amount of coins")
 	}
 	coins := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), amount))
-	err := k.AccountKeeper.SendCoinsFromAccountToModule(ctx, sdk.Address(requestor.Address), types.StakedPoolName, coins)
+	err := k.AccountKeeper.DelegateCoinsFromAccountToModule(ctx, sdk.Address(requestor.Address), types.StakedPoolName, coins)
 	if err != nil {
 		return err
 	}
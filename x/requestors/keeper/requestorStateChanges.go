@@ -364,7 +364,12 @@ func (k Keeper) BurnRequestorStake(ctx sdk.Ctx, requestor types.Requestor, amoun
 	logger := k.Logger(ctx)
 	tokensToBurn := sdk.MinInt(amount, requestor.StakedTokens)
 	tokensToBurn = sdk.MaxInt(tokensToBurn, sdk.ZeroInt())
-	requestor, err := k.removeRequestorTokens(ctx, requestor, amount)
+	// the amount beyond the stake is burned from the stakes the requestor is unbonding
+	unbondingBurned := sdk.ZeroInt()
+	if amount.GT(tokensToBurn) {
+		unbondingBurned = k.burnUnbondingStakes(ctx, requestor.Address, amount.Sub(tokensToBurn))
+	}
+	requestor, err := k.removeRequestorTokens(ctx, requestor, tokensToBurn)
 	if err != nil {
 		k.Logger(ctx).Error("could not remove staked tokens: " + err.Error() + "\nfor requestor " + requestor.Address.String())
 		return
@@ -385,5 +390,5 @@ func (k Keeper) BurnRequestorStake(ctx sdk.Ctx, requestor types.Requestor, amoun
 	}
 	// Log that burn occured
 	logger.Info(fmt.Sprintf("requestor %s burned by amount of %s; burned %v tokens",
-		requestor.GetAddress(), amount.String(), tokensToBurn))
+		requestor.GetAddress(), amount.String(), tokensToBurn.Add(unbondingBurned)))
 }
@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"fmt"
+	"os"
+
+	sdk "github.com/vipernet-xyz/viper-network/types"
+	"github.com/vipernet-xyz/viper-network/x/requestors/types"
+)
+
+// SetRevokedClient stores the revocation of the client public key by the requestor
+func (k Keeper) SetRevokedClient(ctx sdk.Ctx, revokedClient types.RevokedClient) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.Cdc.ProtoMarshalBinaryBare(&revokedClient)
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Errorf("error marshalling revoked client %v at height: %d, err: %s", revokedClient, ctx.BlockHeight(), err.Error()).Error())
+		os.Exit(1)
+	}
+	_ = store.Set(types.KeyForRevokedClient(revokedClient.RequestorAddress, revokedClient.ClientPublicKey), bz)
+}
+
+// IsClientRevoked returns true if the requestor revoked the client public key
+func (k Keeper) IsClientRevoked(ctx sdk.Ctx, requestorAddr sdk.Address, clientPubKey string) bool {
+	store := ctx.KVStore(k.storeKey)
+	found, _ := store.Has(types.KeyForRevokedClient(requestorAddr, clientPubKey))
+	return found
+}
+
+// GetRevokedClients returns the client public keys revoked by the requestor
+func (k Keeper) GetRevokedClients(ctx sdk.Ctx, requestorAddr sdk.Address) (clientPubKeys []string) {
+	k.iterateRevokedClients(ctx, types.KeyForRevokedClientsOfRequestor(requestorAddr), func(revokedClient types.RevokedClient) (stop bool) {
+		clientPubKeys = append(clientPubKeys, revokedClient.ClientPublicKey)
+		return false
+	})
+	return
+}
+
+// GetAllRevokedClients returns the revocations of all the requestors
+func (k Keeper) GetAllRevokedClients(ctx sdk.Ctx) (revokedClients []types.RevokedClient) {
+	k.iterateRevokedClients(ctx, types.RevokedClientsKey, func(revokedClient types.RevokedClient) (stop bool) {
+		revokedClients = append(revokedClients, revokedClient)
+		return false
+	})
+	return
+}
+
+func (k Keeper) iterateRevokedClients(ctx sdk.Ctx, prefix []byte, process func(types.RevokedClient) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter, _ := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var revokedClient types.RevokedClient
+		if err := k.Cdc.ProtoUnmarshalBinaryBare(iter.Value(), &revokedClient); err != nil {
+			k.Logger(ctx).Error(fmt.Errorf("error while iterating revoked clients: unmarshalling %v at height: %d, err: %s", iter.Value(), ctx.BlockHeight(), err.Error()).Error())
+			continue
+		}
+		if process(revokedClient) {
+			return
+		}
+	}
+}
+
+// RevokeClient revokes the authentication tokens the requestor issued to the client public key.
+// The servicers reject the relays of the client from the next session on.
+func (k Keeper) RevokeClient(ctx sdk.Ctx, requestorAddr sdk.Address, clientPubKey string) sdk.Error {
+	if _, found := k.GetRequestor(ctx, requestorAddr); !found {
+		return types.ErrNoRequestorFound(k.Codespace())
+	}
+	if k.IsClientRevoked(ctx, requestorAddr, clientPubKey) {
+		return types.ErrClientRevoked(k.Codespace())
+	}
+	k.SetRevokedClient(ctx, types.NewRevokedClient(requestorAddr, clientPubKey, ctx.BlockHeight()))
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRevokeClient,
+			sdk.NewAttribute(types.AttributeKeyRequestor, requestorAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyClient, clientPubKey),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, requestorAddr.String()),
+		),
+	})
+	return nil
+}
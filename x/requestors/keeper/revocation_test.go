@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/vipernet-xyz/viper-network/x/requestors/types"
+)
+
+func TestKeeper_RevokeClient(t *testing.T) {
+	requestor := getStakedRequestor()
+	clientPubKey := getRandomPubKey().RawString()
+	context, _, keeper := createTestInput(t, true)
+	// only an existing requestor revokes its clients
+	err := keeper.RevokeClient(context, requestor.Address, clientPubKey)
+	assert.Equal(t, types.CodeInvalidRequestor, err.Code())
+	keeper.SetRequestor(context, requestor)
+	assert.False(t, keeper.IsClientRevoked(context, requestor.Address, clientPubKey))
+	assert.Nil(t, keeper.RevokeClient(context, requestor.Address, clientPubKey))
+	assert.True(t, keeper.IsClientRevoked(context, requestor.Address, clientPubKey))
+	assert.Equal(t, []string{clientPubKey}, keeper.GetRevokedClients(context, requestor.Address))
+	// a client is revoked once
+	err = keeper.RevokeClient(context, requestor.Address, clientPubKey)
+	assert.Equal(t, types.CodeClientRevoked, err.Code())
+	// the revocations are per requestor
+	other := getStakedRequestor()
+	keeper.SetRequestor(context, other)
+	assert.False(t, keeper.IsClientRevoked(context, other.Address, clientPubKey))
+	assert.Empty(t, keeper.GetRevokedClients(context, other.Address))
+	assert.Nil(t, keeper.RevokeClient(context, other.Address, clientPubKey))
+	revokedClients := keeper.GetAllRevokedClients(context)
+	assert.Len(t, revokedClients, 2)
+	for _, rc := range revokedClients {
+		assert.Equal(t, clientPubKey, rc.ClientPublicKey)
+		assert.Equal(t, context.BlockHeight(), rc.Height)
+	}
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdk "github.com/vipernet-xyz/viper-network/types"
+	"github.com/vipernet-xyz/viper-network/x/requestors/types"
+)
+
+func TestRequestorStateChange_UnstakePartial(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	requestor := getStakedRequestor()
+	keeper.SetRequestor(context, requestor)
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	excess := requestor.StakedTokens.Sub(sdk.NewInt(keeper.MinimumStake(context)))
+	msg := types.MsgUnstakePartial{Address: requestor.Address, Amount: excess.Add(sdk.OneInt())}
+	// the stake can't go below the minimum
+	_, err := keeper.ValidateUnstakePartial(context, msg)
+	require.NotNil(t, err)
+	assert.Equal(t, types.CodeUnstakePartialAmount, err.Code())
+	// the requestor must be staked
+	_, err = keeper.ValidateUnstakePartial(context, types.MsgUnstakePartial{Address: getRandomRequestorAddress(), Amount: excess})
+	assert.NotNil(t, err)
+	msg.Amount = excess
+	requestor, err = keeper.ValidateUnstakePartial(context, msg)
+	require.Nil(t, err)
+	unbonding, err := keeper.UnstakePartialRequestor(context, requestor, msg.Amount)
+	require.Nil(t, err)
+	assert.Equal(t, types.NewUnbondingStake(requestor.Address, excess, context.BlockHeight(),
+		context.BlockHeader().Time.Add(keeper.UnStakingTime(context))), unbonding)
+	requestor, found := keeper.GetRequestor(context, requestor.Address)
+	require.True(t, found)
+	assert.Equal(t, sdk.NewInt(keeper.MinimumStake(context)), requestor.StakedTokens)
+	assert.Equal(t, []types.UnbondingStake{unbonding}, keeper.GetUnbondingStakes(context, requestor.Address))
+	// a burn beyond the stake takes from the unbonding stake
+	keeper.BurnRequestorStake(context, requestor, requestor.StakedTokens.Add(sdk.NewInt(1000)))
+	unbondings := keeper.GetUnbondingStakes(context, requestor.Address)
+	require.Len(t, unbondings, 1)
+	assert.Equal(t, excess.Sub(sdk.NewInt(1000)), unbondings[0].Amount)
+	// the stake is released once the unstaking time is over
+	balance := keeper.AccountKeeper.GetCoins(context, requestor.Address).AmountOf(keeper.StakeDenom(context))
+	keeper.releaseMatureUnbondingStakes(context.WithBlockTime(unbonding.CompletionTime.Add(-time.Second)))
+	assert.Len(t, keeper.GetUnbondingStakes(context, requestor.Address), 1)
+	keeper.releaseMatureUnbondingStakes(context.WithBlockTime(unbonding.CompletionTime))
+	assert.Empty(t, keeper.GetAllUnbondingStakes(context))
+	assert.Equal(t, balance.Add(unbondings[0].Amount), keeper.AccountKeeper.GetCoins(context, requestor.Address).AmountOf(keeper.StakeDenom(context)))
+}
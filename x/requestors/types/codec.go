@@ -13,8 +13,10 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterStructure(MsgStake{}, "requestors/MsgRequestorStake")
 	cdc.RegisterStructure(MsgBeginUnstake{}, "requestors/MsgRequestorBeginUnstake")
 	cdc.RegisterStructure(MsgUnjail{}, "requestors/MsgRequestorUnjail")
-	cdc.RegisterImplementation((*sdk.ProtoMsg)(nil), &MsgStake{}, &MsgBeginUnstake{}, &MsgUnjail{})
-	cdc.RegisterImplementation((*sdk.Msg)(nil), &MsgStake{}, &MsgBeginUnstake{}, &MsgUnjail{})
+	cdc.RegisterStructure(MsgRevokeClient{}, "requestors/MsgRequestorRevokeClient")
+	cdc.RegisterStructure(MsgUnstakePartial{}, "requestors/MsgRequestorUnstakePartial")
+	cdc.RegisterImplementation((*sdk.ProtoMsg)(nil), &MsgStake{}, &MsgBeginUnstake{}, &MsgUnjail{}, &MsgRevokeClient{}, &MsgUnstakePartial{})
+	cdc.RegisterImplementation((*sdk.Msg)(nil), &MsgStake{}, &MsgBeginUnstake{}, &MsgUnjail{}, &MsgRevokeClient{}, &MsgUnstakePartial{})
 	ModuleCdc = cdc
 }
 
@@ -10,25 +10,30 @@ import (
 type CodeType = sdk.CodeType
 
 const (
-	DefaultCodespace          sdk.CodespaceType = ModuleName
-	CodeInvalidRequestor      CodeType          = 101
-	CodeInvalidInput          CodeType          = 103
-	CodeRequestorJailed       CodeType          = 104
-	CodeRequestorNotJailed    CodeType          = 105
-	CodeMissingSelfDelegation CodeType          = 106
-	CodeInvalidStatus         CodeType          = 110
-	CodeMinimumStake          CodeType          = 111
-	CodeNotEnoughCoins        CodeType          = 112
-	CodeInvalidStakeAmount    CodeType          = 115
-	CodeNoChains              CodeType          = 116
-	CodeInvalidNetworkID      CodeType          = 117
-	CodeTooManyChains         CodeType          = 118
-	CodeInvalidGeoZone        CodeType          = 119
-	CodeMaxRequestors         CodeType          = 120
-	CodeMinimumEditStake      CodeType          = 121
-	CodeNoGeoZones            CodeType          = 122
-	CodeNoServicers           CodeType          = 123
-	CodeNumServicers          CodeType          = 124
+	DefaultCodespace           sdk.CodespaceType = ModuleName
+	CodeInvalidRequestor       CodeType          = 101
+	CodeInvalidInput           CodeType          = 103
+	CodeRequestorJailed        CodeType          = 104
+	CodeRequestorNotJailed     CodeType          = 105
+	CodeMissingSelfDelegation  CodeType          = 106
+	CodeInvalidStatus          CodeType          = 110
+	CodeMinimumStake           CodeType          = 111
+	CodeNotEnoughCoins         CodeType          = 112
+	CodeInvalidStakeAmount     CodeType          = 115
+	CodeNoChains               CodeType          = 116
+	CodeInvalidNetworkID       CodeType          = 117
+	CodeTooManyChains          CodeType          = 118
+	CodeInvalidGeoZone         CodeType          = 119
+	CodeMaxRequestors          CodeType          = 120
+	CodeMinimumEditStake       CodeType          = 121
+	CodeNoGeoZones             CodeType          = 122
+	CodeNoServicers            CodeType          = 123
+	CodeNumServicers           CodeType          = 124
+	CodeInvalidRevocation      CodeType          = 125
+	CodeClientRevoked          CodeType          = 126
+	CodeRevocationInactive     CodeType          = 127
+	CodeUnstakePartialAmount   CodeType          = 128
+	CodeUnstakePartialInactive CodeType          = 129
 )
 
 func ErrTooManyChains(Codespace sdk.CodespaceType) sdk.Error {
@@ -118,3 +123,23 @@ func ErrMinimumEditStake(codespace sdk.CodespaceType) sdk.Error {
 func ErrNumServicers(Codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(Codespace, CodeNumServicers, "Number of servicer's out of range")
 }
+
+func ErrInvalidRevocation(codespace sdk.CodespaceType, err error) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidRevocation, "the client revocation is invalid: "+err.Error())
+}
+
+func ErrClientRevoked(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeClientRevoked, "the client public key is already revoked by the requestor")
+}
+
+func ErrRevocationInactive(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeRevocationInactive, "the client revocations are not activated yet")
+}
+
+func ErrUnstakePartialAmount(codespace sdk.CodespaceType, err error) sdk.Error {
+	return sdk.NewError(codespace, CodeUnstakePartialAmount, "invalid partial unstake amount: "+err.Error())
+}
+
+func ErrUnstakePartialInactive(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeUnstakePartialInactive, "the partial unstakes are not activated yet")
+}
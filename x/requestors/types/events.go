@@ -7,6 +7,11 @@ const (
 	EventTypeStake             = "stake"
 	EventTypeBeginUnstake      = "begin_unstake"
 	EventTypeUnstake           = "unstake"
+	EventTypeRevokeClient      = "revoke_client"
+	EventTypeUnstakePartial    = "unstake_partial"
+	EventTypeCompleteUnbonding = "complete_unbonding"
+	AttributeKeyCompletionTime = "completion_time"
 	AttributeKeyRequestor      = "requestor"
+	AttributeKeyClient         = "client"
 	AttributeValueCategory     = ModuleName
 )
@@ -36,6 +36,10 @@ type AuthKeeper interface {
 	SendCoinsFromModuleToAccount(ctx sdk.Ctx, senderModule string, recipientAddr sdk.Address, amt sdk.Coins) sdk.Error
 	// send coins from validator to module
 	SendCoinsFromAccountToModule(ctx sdk.Ctx, senderAddr sdk.Address, recipientModule string, amt sdk.Coins) sdk.Error
+	// delegate coins from an account to a staking module, the vesting coins can be delegated
+	DelegateCoinsFromAccountToModule(ctx sdk.Ctx, senderAddr sdk.Address, recipientModule string, amt sdk.Coins) sdk.Error
+	// undelegate coins from a staking module to an account
+	UndelegateCoinsFromModuleToAccount(ctx sdk.Ctx, senderModule string, recipientAddr sdk.Address, amt sdk.Coins) sdk.Error
 	// mint coins
 	MintCoins(ctx sdk.Ctx, moduleName string, amt sdk.Coins) sdk.Error
 	// burn coins
@@ -1,15 +1,19 @@
 package types
 
 const (
-	StakeFee   = 10000
-	UnstakeFee = 10000
-	UnjailFee  = 10000
+	StakeFee          = 10000
+	UnstakeFee        = 10000
+	UnstakePartialFee = 10000
+	UnjailFee         = 10000
+	RevokeFee         = 10000
 )
 
 var (
 	RequestorFeeMap = map[string]int64{
-		MsgRequestorStakeName:   StakeFee,
-		MsgRequestorUnstakeName: UnstakeFee,
-		MsgRequestorUnjailName:  UnjailFee,
+		MsgRequestorStakeName:          StakeFee,
+		MsgRequestorUnstakeName:        UnstakeFee,
+		MsgRequestorUnstakePartialName: UnstakePartialFee,
+		MsgRequestorUnjailName:         UnjailFee,
+		MsgRequestorRevokeClientName:   RevokeFee,
 	}
 )
@@ -5,6 +5,10 @@ type GenesisState struct {
 	Params     Params     `json:"params" yaml:"params"`
 	Requestors Requestors `json:"requestors" yaml:"requestors"`
 	Exported   bool       `json:"exported" yaml:"exported"`
+	// the client public keys revoked by the requestors
+	RevokedClients []RevokedClient `json:"revoked_clients,omitempty" yaml:"revoked_clients"`
+	// the stakes removed by partial unstakes that are not released yet
+	UnbondingStakes []UnbondingStake `json:"unbonding_stakes,omitempty" yaml:"unbonding_stakes"`
 }
 
 // get raw genesis raw message for testing
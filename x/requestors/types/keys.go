@@ -17,10 +17,13 @@ const (
 )
 
 var (
-	AllRequestorsKey       = []byte{0x01} // prefix for each key to a requestor
-	StakedRequestorsKey    = []byte{0x02} // prefix for each key to a staked requestor index, sorted by power
-	UnstakingRequestorsKey = []byte{0x03} // prefix for unstaking requestor
-	BurnRequestorKey       = []byte{0x04} // prefix for awarding requestors
+	AllRequestorsKey        = []byte{0x01} // prefix for each key to a requestor
+	StakedRequestorsKey     = []byte{0x02} // prefix for each key to a staked requestor index, sorted by power
+	UnstakingRequestorsKey  = []byte{0x03} // prefix for unstaking requestor
+	BurnRequestorKey        = []byte{0x04} // prefix for awarding requestors
+	RevokedClientsKey       = []byte{0x05} // prefix for the client public keys revoked by the requestors
+	UnbondingStakesKey      = []byte{0x06} // prefix for the stakes removed by partial unstakes
+	UnbondingStakesQueueKey = []byte{0x07} // prefix for the unbonding stakes by completion time
 )
 
 // Removes the prefix bytes from a key to expose true address
@@ -49,6 +52,36 @@ func KeyForRequestorBurn(address sdk.Address) []byte {
 	return append(BurnRequestorKey, address...)
 }
 
+// generates the key for the client public key revoked by the requestor
+func KeyForRevokedClient(requestorAddr sdk.Address, clientPubKey string) []byte {
+	return append(KeyForRevokedClientsOfRequestor(requestorAddr), []byte(clientPubKey)...)
+}
+
+// generates the prefix of the client public keys revoked by the requestor
+func KeyForRevokedClientsOfRequestor(requestorAddr sdk.Address) []byte {
+	return append(append([]byte{}, RevokedClientsKey...), requestorAddr.Bytes()...)
+}
+
+// generates the key for the unbonding stake of the requestor completing at the time
+func KeyForUnbondingStake(addr sdk.Address, completionTime time.Time) []byte {
+	return append(KeyForUnbondingStakesOfRequestor(addr), sdk.FormatTimeBytes(completionTime)...)
+}
+
+// generates the prefix of the unbonding stakes of the requestor
+func KeyForUnbondingStakesOfRequestor(addr sdk.Address) []byte {
+	return append(append([]byte{}, UnbondingStakesKey...), addr.Bytes()...)
+}
+
+// generates the key for the unbonding stake of the requestor in the queue, ordered by completion time
+func KeyForUnbondingStakeInQueue(completionTime time.Time, addr sdk.Address) []byte {
+	return append(KeyForUnbondingStakesQueueTime(completionTime), addr.Bytes()...)
+}
+
+// generates the prefix of the unbonding stakes completing at the time in the queue
+func KeyForUnbondingStakesQueueTime(completionTime time.Time) []byte {
+	return append(append([]byte{}, UnbondingStakesQueueKey...), sdk.FormatTimeBytes(completionTime)...)
+}
+
 // get the power ranking key of a requestor
 // NOTE the larger values are of higher value
 func getStakedValPowerRankKey(requestor Requestor) []byte {
@@ -446,3 +446,33 @@ func TestMsgRequestorUnjail_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgRequestorUnstakePartial_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgUnstakePartial
+		want sdk.Error
+	}{
+		{
+			name: "errs if no Address",
+			msg:  MsgUnstakePartial{Amount: sdk.OneInt()},
+			want: ErrNilRequestorAddr(DefaultCodespace),
+		},
+		{
+			name: "errs if no amount",
+			msg:  MsgUnstakePartial{Address: msgRequestorUnjail.RequestorAddr, Amount: sdk.ZeroInt()},
+			want: ErrUnstakePartialAmount(DefaultCodespace, fmt.Errorf("the amount must be positive")),
+		},
+		{
+			name: "returns nil if valid address and amount",
+			msg:  MsgUnstakePartial{Address: msgRequestorUnjail.RequestorAddr, Amount: sdk.OneInt()},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateBasic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,1716 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: x/requestors/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type QueryRequestorsRequest struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height"`
+	// 1 for the unstaking requestors, 2 for the staked requestors, 0 for both
+	StakingStatus int32  `protobuf:"varint,2,opt,name=staking_status,json=stakingStatus,proto3" json:"staking_status"`
+	Blockchain    string `protobuf:"bytes,3,opt,name=blockchain,proto3" json:"blockchain"`
+	GeoZone       string `protobuf:"bytes,4,opt,name=geo_zone,json=geoZone,proto3" json:"geo_zone"`
+	Page          int64  `protobuf:"varint,5,opt,name=page,proto3" json:"page"`
+	PerPage       int64  `protobuf:"varint,6,opt,name=per_page,json=perPage,proto3" json:"per_page"`
+}
+
+func (m *QueryRequestorsRequest) Reset()         { *m = QueryRequestorsRequest{} }
+func (m *QueryRequestorsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRequestorsRequest) ProtoMessage()    {}
+func (*QueryRequestorsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ebee304f97dd53ad, []int{0}
+}
+func (m *QueryRequestorsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRequestorsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRequestorsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRequestorsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRequestorsRequest.Merge(m, src)
+}
+func (m *QueryRequestorsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRequestorsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRequestorsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRequestorsRequest proto.InternalMessageInfo
+
+func (m *QueryRequestorsRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryRequestorsRequest) GetStakingStatus() int32 {
+	if m != nil {
+		return m.StakingStatus
+	}
+	return 0
+}
+
+func (m *QueryRequestorsRequest) GetBlockchain() string {
+	if m != nil {
+		return m.Blockchain
+	}
+	return ""
+}
+
+func (m *QueryRequestorsRequest) GetGeoZone() string {
+	if m != nil {
+		return m.GeoZone
+	}
+	return ""
+}
+
+func (m *QueryRequestorsRequest) GetPage() int64 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+func (m *QueryRequestorsRequest) GetPerPage() int64 {
+	if m != nil {
+		return m.PerPage
+	}
+	return 0
+}
+
+type QueryRequestorsResponse struct {
+	Requestors []ProtoRequestor `protobuf:"bytes,1,rep,name=requestors,proto3" json:"requestors"`
+	TotalPages int64            `protobuf:"varint,2,opt,name=total_pages,json=totalPages,proto3" json:"total_pages"`
+	Page       int64            `protobuf:"varint,3,opt,name=page,proto3" json:"page"`
+}
+
+func (m *QueryRequestorsResponse) Reset()         { *m = QueryRequestorsResponse{} }
+func (m *QueryRequestorsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRequestorsResponse) ProtoMessage()    {}
+func (*QueryRequestorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ebee304f97dd53ad, []int{1}
+}
+func (m *QueryRequestorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRequestorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRequestorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRequestorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRequestorsResponse.Merge(m, src)
+}
+func (m *QueryRequestorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRequestorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRequestorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRequestorsResponse proto.InternalMessageInfo
+
+func (m *QueryRequestorsResponse) GetRequestors() []ProtoRequestor {
+	if m != nil {
+		return m.Requestors
+	}
+	return nil
+}
+
+func (m *QueryRequestorsResponse) GetTotalPages() int64 {
+	if m != nil {
+		return m.TotalPages
+	}
+	return 0
+}
+
+func (m *QueryRequestorsResponse) GetPage() int64 {
+	if m != nil {
+		return m.Page
+	}
+	return 0
+}
+
+type QueryRequestorRequest struct {
+	Height  int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address"`
+}
+
+func (m *QueryRequestorRequest) Reset()         { *m = QueryRequestorRequest{} }
+func (m *QueryRequestorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRequestorRequest) ProtoMessage()    {}
+func (*QueryRequestorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ebee304f97dd53ad, []int{2}
+}
+func (m *QueryRequestorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRequestorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRequestorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRequestorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRequestorRequest.Merge(m, src)
+}
+func (m *QueryRequestorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRequestorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRequestorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRequestorRequest proto.InternalMessageInfo
+
+func (m *QueryRequestorRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryRequestorRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QueryRequestorResponse struct {
+	Requestor ProtoRequestor `protobuf:"bytes,1,opt,name=requestor,proto3" json:"requestor"`
+}
+
+func (m *QueryRequestorResponse) Reset()         { *m = QueryRequestorResponse{} }
+func (m *QueryRequestorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRequestorResponse) ProtoMessage()    {}
+func (*QueryRequestorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ebee304f97dd53ad, []int{3}
+}
+func (m *QueryRequestorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRequestorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRequestorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRequestorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRequestorResponse.Merge(m, src)
+}
+func (m *QueryRequestorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRequestorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRequestorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRequestorResponse proto.InternalMessageInfo
+
+func (m *QueryRequestorResponse) GetRequestor() ProtoRequestor {
+	if m != nil {
+		return m.Requestor
+	}
+	return ProtoRequestor{}
+}
+
+type QueryUnbondingStakesRequest struct {
+	Height  int64  `protobuf:"varint,1,opt,name=height,proto3" json:"height"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address"`
+}
+
+func (m *QueryUnbondingStakesRequest) Reset()         { *m = QueryUnbondingStakesRequest{} }
+func (m *QueryUnbondingStakesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingStakesRequest) ProtoMessage()    {}
+func (*QueryUnbondingStakesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ebee304f97dd53ad, []int{4}
+}
+func (m *QueryUnbondingStakesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingStakesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingStakesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnbondingStakesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingStakesRequest.Merge(m, src)
+}
+func (m *QueryUnbondingStakesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingStakesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingStakesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnbondingStakesRequest proto.InternalMessageInfo
+
+func (m *QueryUnbondingStakesRequest) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *QueryUnbondingStakesRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type QueryUnbondingStakesResponse struct {
+	UnbondingStakes []UnbondingStake `protobuf:"bytes,1,rep,name=unbonding_stakes,json=unbondingStakes,proto3" json:"unbonding_stakes"`
+}
+
+func (m *QueryUnbondingStakesResponse) Reset()         { *m = QueryUnbondingStakesResponse{} }
+func (m *QueryUnbondingStakesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnbondingStakesResponse) ProtoMessage()    {}
+func (*QueryUnbondingStakesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ebee304f97dd53ad, []int{5}
+}
+func (m *QueryUnbondingStakesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnbondingStakesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnbondingStakesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUnbondingStakesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnbondingStakesResponse.Merge(m, src)
+}
+func (m *QueryUnbondingStakesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnbondingStakesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnbondingStakesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUnbondingStakesResponse proto.InternalMessageInfo
+
+func (m *QueryUnbondingStakesResponse) GetUnbondingStakes() []UnbondingStake {
+	if m != nil {
+		return m.UnbondingStakes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryRequestorsRequest)(nil), "x.requestors.QueryRequestorsRequest")
+	proto.RegisterType((*QueryRequestorsResponse)(nil), "x.requestors.QueryRequestorsResponse")
+	proto.RegisterType((*QueryRequestorRequest)(nil), "x.requestors.QueryRequestorRequest")
+	proto.RegisterType((*QueryRequestorResponse)(nil), "x.requestors.QueryRequestorResponse")
+	proto.RegisterType((*QueryUnbondingStakesRequest)(nil), "x.requestors.QueryUnbondingStakesRequest")
+	proto.RegisterType((*QueryUnbondingStakesResponse)(nil), "x.requestors.QueryUnbondingStakesResponse")
+}
+
+func init() { proto.RegisterFile("x/requestors/query.proto", fileDescriptor_ebee304f97dd53ad) }
+
+var fileDescriptor_ebee304f97dd53ad = []byte{
+	// 647 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcb, 0x6e, 0xd3, 0x4c,
+	0x14, 0xce, 0x34, 0xbd, 0xe5, 0xb4, 0x7f, 0xf3, 0x33, 0xe2, 0x62, 0x85, 0x28, 0x0e, 0xa6, 0x55,
+	0x53, 0x50, 0x63, 0x5a, 0x36, 0x80, 0x58, 0x79, 0x8f, 0x54, 0xa6, 0x62, 0xd3, 0x4d, 0xe5, 0xb4,
+	0xa3, 0x89, 0x95, 0x32, 0xe3, 0x7a, 0x26, 0xa5, 0x2d, 0x62, 0x41, 0x77, 0xec, 0x90, 0x58, 0xf0,
+	0x12, 0xbc, 0x01, 0x0f, 0x40, 0x97, 0x95, 0xd8, 0xb0, 0xb2, 0x50, 0xcb, 0xca, 0x4f, 0x81, 0x3c,
+	0x76, 0x1c, 0x27, 0x0d, 0x4d, 0x59, 0xb0, 0x49, 0xce, 0xe5, 0x3b, 0xe7, 0x7c, 0xe7, 0x62, 0x1b,
+	0x8c, 0x43, 0x3b, 0xa0, 0xfb, 0x5d, 0x2a, 0x95, 0x08, 0xa4, 0xbd, 0xdf, 0xa5, 0xc1, 0x51, 0xd3,
+	0x0f, 0x84, 0x12, 0x78, 0xfe, 0xb0, 0xd9, 0xf7, 0x54, 0x6e, 0x32, 0xc1, 0x84, 0x76, 0xd8, 0xb1,
+	0x94, 0x60, 0x2a, 0x55, 0x26, 0x04, 0xdb, 0xa3, 0xb6, 0xeb, 0x7b, 0xb6, 0xcb, 0xb9, 0x50, 0xae,
+	0xf2, 0x04, 0x97, 0xa9, 0xb7, 0x9c, 0xc5, 0xf7, 0x0c, 0x5d, 0xde, 0x12, 0x7c, 0xd7, 0xe3, 0x2c,
+	0x31, 0x58, 0x9f, 0x27, 0xe0, 0xf6, 0xcb, 0xb8, 0x26, 0xc9, 0x2a, 0xa5, 0x12, 0xb6, 0x60, 0xba,
+	0x4d, 0x3d, 0xd6, 0x56, 0x06, 0xaa, 0xa3, 0x46, 0xd1, 0x81, 0x28, 0x34, 0x53, 0x0b, 0x49, 0xff,
+	0xf1, 0x53, 0x58, 0x90, 0xca, 0xed, 0x78, 0x9c, 0x6d, 0x4b, 0xe5, 0xaa, 0xae, 0x34, 0x26, 0xea,
+	0xa8, 0x31, 0xe5, 0xe0, 0x28, 0x34, 0x87, 0x3c, 0xe4, 0xbf, 0x54, 0xdf, 0xd4, 0x2a, 0x6e, 0x02,
+	0xb4, 0xf6, 0xc4, 0x4e, 0x67, 0xa7, 0xed, 0x7a, 0xdc, 0x28, 0xd6, 0x51, 0xa3, 0xe4, 0x2c, 0x44,
+	0xa1, 0x99, 0xb3, 0x92, 0x9c, 0x8c, 0x97, 0x61, 0x96, 0x51, 0xb1, 0x7d, 0x2c, 0x38, 0x35, 0x26,
+	0x35, 0x7a, 0x3e, 0x0a, 0xcd, 0xcc, 0x46, 0x66, 0x18, 0x15, 0x5b, 0x82, 0x53, 0x5c, 0x85, 0x49,
+	0xdf, 0x65, 0xd4, 0x98, 0xd2, 0xac, 0x67, 0xa3, 0xd0, 0xd4, 0x3a, 0xd1, 0xbf, 0x71, 0x1a, 0x9f,
+	0x06, 0xdb, 0x1a, 0x31, 0xad, 0x11, 0x3a, 0x4d, 0xcf, 0x46, 0x66, 0x7c, 0x1a, 0x6c, 0xb8, 0x8c,
+	0x5a, 0x5f, 0x11, 0xdc, 0xb9, 0x34, 0x19, 0xe9, 0x0b, 0x2e, 0x29, 0xde, 0x00, 0xe8, 0x6f, 0xc6,
+	0x40, 0xf5, 0x62, 0x63, 0x6e, 0xbd, 0xda, 0xcc, 0xaf, 0xab, 0xb9, 0x11, 0x8f, 0x37, 0x0b, 0x75,
+	0xf0, 0x69, 0x68, 0x16, 0xe2, 0xee, 0xfa, 0x10, 0x92, 0x93, 0xf1, 0x23, 0x98, 0x53, 0x42, 0xb9,
+	0x7b, 0x9a, 0x44, 0x32, 0xc5, 0xa2, 0x53, 0x8e, 0x42, 0x33, 0x6f, 0x26, 0xa0, 0x95, 0x98, 0x9e,
+	0xcc, 0xda, 0x2c, 0x8e, 0x6a, 0xd3, 0x6a, 0xc1, 0xad, 0x41, 0xf2, 0x7f, 0xb3, 0xd5, 0x25, 0x98,
+	0x71, 0x77, 0x77, 0x03, 0x2a, 0x13, 0x22, 0x25, 0x67, 0x2e, 0x0a, 0xcd, 0x9e, 0x89, 0xf4, 0x04,
+	0x8b, 0x0d, 0x9f, 0x4e, 0x36, 0x9f, 0x17, 0x50, 0xca, 0x7a, 0xd3, 0x75, 0xc6, 0x8d, 0xe7, 0x46,
+	0x3a, 0x9e, 0x7e, 0x18, 0xe9, 0x8b, 0x56, 0x1b, 0xee, 0xea, 0x42, 0xaf, 0x7a, 0xc7, 0xbb, 0xa9,
+	0xdc, 0x0e, 0x95, 0xff, 0xa0, 0xa5, 0xf7, 0x08, 0xaa, 0xa3, 0x4b, 0xa5, 0x9d, 0xb9, 0xf0, 0x7f,
+	0xf6, 0x08, 0xc5, 0x87, 0xdd, 0xa1, 0x7f, 0xd8, 0xff, 0x60, 0x02, 0xc7, 0x48, 0x1b, 0xbc, 0x14,
+	0x4d, 0xca, 0xdd, 0x01, 0xa4, 0x5c, 0xff, 0x56, 0x84, 0x29, 0xcd, 0x01, 0x9f, 0x20, 0x80, 0xfe,
+	0xf5, 0xe1, 0xc5, 0xc1, 0x0a, 0xa3, 0x1f, 0xdb, 0xca, 0xd2, 0x18, 0x54, 0xd2, 0x88, 0xb5, 0x7c,
+	0xf2, 0xfd, 0xd7, 0xa7, 0x89, 0x7b, 0xd8, 0xb4, 0x0f, 0x3c, 0x9f, 0x06, 0xf9, 0x77, 0xd0, 0xc1,
+	0x5a, 0x4e, 0xc3, 0x1f, 0x10, 0x94, 0xb2, 0x78, 0x7c, 0xff, 0xaa, 0xec, 0x3d, 0x0a, 0x8b, 0x57,
+	0x83, 0x52, 0x06, 0x6b, 0x9a, 0xc1, 0x43, 0xbc, 0x32, 0x86, 0x81, 0xfd, 0x36, 0xdd, 0xce, 0x3b,
+	0xfc, 0x05, 0x41, 0x79, 0x68, 0x33, 0x78, 0x65, 0x44, 0xb1, 0xd1, 0x87, 0x52, 0x79, 0x70, 0x1d,
+	0x68, 0xca, 0xce, 0xd1, 0xec, 0x9e, 0xe3, 0x67, 0xd7, 0x66, 0x67, 0x0f, 0xaf, 0xd6, 0x21, 0xa7,
+	0xe7, 0x35, 0x74, 0x76, 0x5e, 0x43, 0x3f, 0xcf, 0x6b, 0xe8, 0xe3, 0x45, 0xad, 0x70, 0x76, 0x51,
+	0x2b, 0xfc, 0xb8, 0xa8, 0x15, 0xb6, 0x9e, 0x30, 0x4f, 0xb5, 0xbb, 0xad, 0xe6, 0x8e, 0x78, 0x9d,
+	0xe4, 0xe7, 0x54, 0xad, 0x1e, 0x1e, 0x1d, 0x27, 0xca, 0x2a, 0xa7, 0xea, 0x8d, 0x08, 0x3a, 0xf6,
+	0xc0, 0xa7, 0x41, 0x1d, 0xf9, 0x54, 0xb6, 0xa6, 0xf5, 0x7b, 0xfb, 0xf1, 0xef, 0x01, 0x00, 0xf1,
+	0x90, 0xc6, 0xdd, 0x37, 0x06, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Requestors returns the page of the requestors matching the filters
+	Requestors(ctx context.Context, in *QueryRequestorsRequest, opts ...grpc.CallOption) (*QueryRequestorsResponse, error)
+	// Requestor returns the requestor of the address
+	Requestor(ctx context.Context, in *QueryRequestorRequest, opts ...grpc.CallOption) (*QueryRequestorResponse, error)
+	// UnbondingStakes returns the stakes removed from the requestor of the address by partial unstakes
+	UnbondingStakes(ctx context.Context, in *QueryUnbondingStakesRequest, opts ...grpc.CallOption) (*QueryUnbondingStakesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Requestors(ctx context.Context, in *QueryRequestorsRequest, opts ...grpc.CallOption) (*QueryRequestorsResponse, error) {
+	out := new(QueryRequestorsResponse)
+	err := c.cc.Invoke(ctx, "/x.requestors.Query/Requestors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Requestor(ctx context.Context, in *QueryRequestorRequest, opts ...grpc.CallOption) (*QueryRequestorResponse, error) {
+	out := new(QueryRequestorResponse)
+	err := c.cc.Invoke(ctx, "/x.requestors.Query/Requestor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnbondingStakes(ctx context.Context, in *QueryUnbondingStakesRequest, opts ...grpc.CallOption) (*QueryUnbondingStakesResponse, error) {
+	out := new(QueryUnbondingStakesResponse)
+	err := c.cc.Invoke(ctx, "/x.requestors.Query/UnbondingStakes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Requestors returns the page of the requestors matching the filters
+	Requestors(context.Context, *QueryRequestorsRequest) (*QueryRequestorsResponse, error)
+	// Requestor returns the requestor of the address
+	Requestor(context.Context, *QueryRequestorRequest) (*QueryRequestorResponse, error)
+	// UnbondingStakes returns the stakes removed from the requestor of the address by partial unstakes
+	UnbondingStakes(context.Context, *QueryUnbondingStakesRequest) (*QueryUnbondingStakesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Requestors(ctx context.Context, req *QueryRequestorsRequest) (*QueryRequestorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Requestors not implemented")
+}
+func (*UnimplementedQueryServer) Requestor(ctx context.Context, req *QueryRequestorRequest) (*QueryRequestorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Requestor not implemented")
+}
+func (*UnimplementedQueryServer) UnbondingStakes(ctx context.Context, req *QueryUnbondingStakesRequest) (*QueryUnbondingStakesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondingStakes not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Requestors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequestorsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Requestors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/x.requestors.Query/Requestors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Requestors(ctx, req.(*QueryRequestorsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Requestor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRequestorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Requestor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/x.requestors.Query/Requestor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Requestor(ctx, req.(*QueryRequestorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnbondingStakes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondingStakesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnbondingStakes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/x.requestors.Query/UnbondingStakes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnbondingStakes(ctx, req.(*QueryUnbondingStakesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "x.requestors.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Requestors",
+			Handler:    _Query_Requestors_Handler,
+		},
+		{
+			MethodName: "Requestor",
+			Handler:    _Query_Requestor_Handler,
+		},
+		{
+			MethodName: "UnbondingStakes",
+			Handler:    _Query_UnbondingStakes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "x/requestors/query.proto",
+}
+
+func (m *QueryRequestorsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequestorsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequestorsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PerPage != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerPage))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Page != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.GeoZone) > 0 {
+		i -= len(m.GeoZone)
+		copy(dAtA[i:], m.GeoZone)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GeoZone)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Blockchain) > 0 {
+		i -= len(m.Blockchain)
+		copy(dAtA[i:], m.Blockchain)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Blockchain)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.StakingStatus != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StakingStatus))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRequestorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequestorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequestorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Page != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Page))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TotalPages != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TotalPages))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Requestors) > 0 {
+		for iNdEx := len(m.Requestors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Requestors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRequestorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequestorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequestorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRequestorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRequestorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRequestorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Requestor.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingStakesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingStakesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingStakesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnbondingStakesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnbondingStakesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnbondingStakesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UnbondingStakes) > 0 {
+		for iNdEx := len(m.UnbondingStakes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnbondingStakes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryRequestorsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	if m.StakingStatus != 0 {
+		n += 1 + sovQuery(uint64(m.StakingStatus))
+	}
+	l = len(m.Blockchain)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.GeoZone)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Page != 0 {
+		n += 1 + sovQuery(uint64(m.Page))
+	}
+	if m.PerPage != 0 {
+		n += 1 + sovQuery(uint64(m.PerPage))
+	}
+	return n
+}
+
+func (m *QueryRequestorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Requestors) > 0 {
+		for _, e := range m.Requestors {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.TotalPages != 0 {
+		n += 1 + sovQuery(uint64(m.TotalPages))
+	}
+	if m.Page != 0 {
+		n += 1 + sovQuery(uint64(m.Page))
+	}
+	return n
+}
+
+func (m *QueryRequestorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRequestorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Requestor.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryUnbondingStakesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovQuery(uint64(m.Height))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnbondingStakesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UnbondingStakes) > 0 {
+		for _, e := range m.UnbondingStakes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryRequestorsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRequestorsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRequestorsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakingStatus", wireType)
+			}
+			m.StakingStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StakingStatus |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blockchain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Blockchain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GeoZone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GeoZone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerPage", wireType)
+			}
+			m.PerPage = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerPage |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRequestorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRequestorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRequestorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requestors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requestors = append(m.Requestors, ProtoRequestor{})
+			if err := m.Requestors[len(m.Requestors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPages", wireType)
+			}
+			m.TotalPages = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalPages |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Page", wireType)
+			}
+			m.Page = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Page |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRequestorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRequestorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRequestorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRequestorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRequestorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRequestorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requestor", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Requestor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingStakesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingStakesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingStakesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnbondingStakesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnbondingStakesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnbondingStakesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingStakes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnbondingStakes = append(m.UnbondingStakes, UnbondingStake{})
+			if err := m.UnbondingStakes[len(m.UnbondingStakes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
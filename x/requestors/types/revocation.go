@@ -0,0 +1,85 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	sdk "github.com/vipernet-xyz/viper-network/types"
+)
+
+const (
+	MsgRequestorRevokeClientName = "requestor_revoke_client"
+)
+
+// NewRevokedClient creates the revocation of the client public key by the requestor at the block height
+func NewRevokedClient(requestorAddr sdk.Address, clientPubKey string, height int64) RevokedClient {
+	return RevokedClient{
+		RequestorAddress: requestorAddr,
+		ClientPublicKey:  clientPubKey,
+		Height:           height,
+	}
+}
+
+// ValidateBasic does a stateless check of the revocation
+func (rc RevokedClient) ValidateBasic() sdk.Error {
+	if rc.RequestorAddress.Empty() {
+		return ErrNilRequestorAddr(DefaultCodespace)
+	}
+	if err := ValidateClientPublicKey(rc.ClientPublicKey); err != nil {
+		return err
+	}
+	if rc.Height < 0 {
+		return ErrInvalidRevocation(DefaultCodespace, fmt.Errorf("negative height: %d", rc.Height))
+	}
+	return nil
+}
+
+// ValidateClientPublicKey checks the format of a hex encoded client public key
+func ValidateClientPublicKey(pubKey string) sdk.Error {
+	bz, err := hex.DecodeString(pubKey)
+	if err != nil {
+		return ErrInvalidRevocation(DefaultCodespace, err)
+	}
+	if len(bz) == 0 {
+		return ErrInvalidRevocation(DefaultCodespace, fmt.Errorf("empty client public key"))
+	}
+	return nil
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+var _ sdk.ProtoMsg = &MsgRevokeClient{}
+
+// Route provides router key for msg
+func (msg MsgRevokeClient) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgRevokeClient) Type() string { return MsgRequestorRevokeClientName }
+
+// GetFee get fee for msg
+func (msg MsgRevokeClient) GetFee() sdk.BigInt {
+	return sdk.NewInt(RequestorFeeMap[msg.Type()])
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgRevokeClient) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.RequestorAddress}
+}
+
+func (msg MsgRevokeClient) GetRecipient() sdk.Address {
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgRevokeClient) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check for revoking a client
+func (msg MsgRevokeClient) ValidateBasic() sdk.Error {
+	if msg.RequestorAddress.Empty() {
+		return ErrNilRequestorAddr(DefaultCodespace)
+	}
+	return ValidateClientPublicKey(msg.ClientPublicKey)
+}
@@ -0,0 +1,667 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: revocation.proto
+
+package types
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_vipernet_xyz_viper_network_types "github.com/vipernet-xyz/viper-network/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RevokedClient is a client public key the requestor revoked the authentication tokens of
+type RevokedClient struct {
+	RequestorAddress github_com_vipernet_xyz_viper_network_types.Address `protobuf:"bytes,1,opt,name=requestor_address,json=requestorAddress,proto3,casttype=github.com/vipernet-xyz/viper-network/types.Address" json:"requestor_address" yaml:"requestor_address"`
+	ClientPublicKey  string                                              `protobuf:"bytes,2,opt,name=client_public_key,json=clientPublicKey,proto3" json:"client_public_key" yaml:"client_public_key"`
+	// the block height of the revocation
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height" yaml:"height"`
+}
+
+func (m *RevokedClient) Reset()         { *m = RevokedClient{} }
+func (m *RevokedClient) String() string { return proto.CompactTextString(m) }
+func (*RevokedClient) ProtoMessage()    {}
+func (*RevokedClient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45d11da40e7382a0, []int{0}
+}
+func (m *RevokedClient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevokedClient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevokedClient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RevokedClient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokedClient.Merge(m, src)
+}
+func (m *RevokedClient) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevokedClient) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokedClient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokedClient proto.InternalMessageInfo
+
+type MsgRevokeClient struct {
+	RequestorAddress github_com_vipernet_xyz_viper_network_types.Address `protobuf:"bytes,1,opt,name=requestor_address,json=requestorAddress,proto3,casttype=github.com/vipernet-xyz/viper-network/types.Address" json:"requestor_address" yaml:"requestor_address"`
+	ClientPublicKey  string                                              `protobuf:"bytes,2,opt,name=client_public_key,json=clientPublicKey,proto3" json:"client_public_key" yaml:"client_public_key"`
+}
+
+func (m *MsgRevokeClient) Reset()         { *m = MsgRevokeClient{} }
+func (m *MsgRevokeClient) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeClient) ProtoMessage()    {}
+func (*MsgRevokeClient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45d11da40e7382a0, []int{1}
+}
+func (m *MsgRevokeClient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeClient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeClient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeClient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeClient.Merge(m, src)
+}
+func (m *MsgRevokeClient) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeClient) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeClient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeClient proto.InternalMessageInfo
+
+func (*MsgRevokeClient) XXX_MessageName() string {
+	return "x.requestors.MsgRevokeClient"
+}
+func init() {
+	proto.RegisterType((*RevokedClient)(nil), "x.requestors.RevokedClient")
+	proto.RegisterType((*MsgRevokeClient)(nil), "x.requestors.MsgRevokeClient")
+}
+
+func init() { proto.RegisterFile("revocation.proto", fileDescriptor_45d11da40e7382a0) }
+
+var fileDescriptor_45d11da40e7382a0 = []byte{
+	// 342 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x92, 0xb1, 0x6b, 0xfa, 0x40,
+	0x14, 0xc7, 0x73, 0x0a, 0xf2, 0xfb, 0x05, 0x45, 0x0d, 0x1d, 0x42, 0x0b, 0x39, 0xc9, 0xe4, 0x62,
+	0x42, 0x71, 0x29, 0x6e, 0x4d, 0xc7, 0x52, 0x28, 0x19, 0x0b, 0x45, 0x34, 0x3e, 0x92, 0x43, 0xcd,
+	0xa5, 0x77, 0xa7, 0x35, 0xfd, 0x0b, 0x1c, 0xbb, 0xb4, 0xb3, 0xf4, 0x7f, 0x29, 0x74, 0x74, 0xec,
+	0x14, 0x8a, 0x2e, 0xc5, 0xd1, 0xb1, 0x53, 0x31, 0x97, 0xea, 0x90, 0xa5, 0x7b, 0xb7, 0x7b, 0xdf,
+	0xcf, 0xbd, 0xc7, 0xf7, 0x7d, 0x79, 0x6a, 0x8d, 0xc1, 0x94, 0x7a, 0x3d, 0x41, 0x68, 0x68, 0x45,
+	0x8c, 0x0a, 0xaa, 0x95, 0x67, 0x16, 0x83, 0xbb, 0x09, 0x70, 0x41, 0x19, 0x3f, 0x3e, 0xf2, 0xa9,
+	0x4f, 0x53, 0x60, 0xef, 0x5e, 0xf2, 0x8f, 0xf9, 0x5a, 0x50, 0x2b, 0x2e, 0x4c, 0xe9, 0x10, 0x06,
+	0x17, 0x23, 0x02, 0xa1, 0xd0, 0x9e, 0x90, 0x5a, 0xdf, 0xb7, 0x75, 0x7b, 0x83, 0x01, 0x03, 0xce,
+	0x75, 0xd4, 0x40, 0xcd, 0xb2, 0x13, 0x6c, 0x12, 0x9c, 0x87, 0xdb, 0x04, 0xeb, 0x71, 0x6f, 0x3c,
+	0xea, 0x98, 0x39, 0x64, 0x7e, 0x25, 0xb8, 0xed, 0x13, 0x11, 0x4c, 0xfa, 0x96, 0x47, 0xc7, 0xf6,
+	0x94, 0x44, 0xc0, 0x42, 0x10, 0xad, 0x59, 0xfc, 0x20, 0x8b, 0x56, 0x08, 0xe2, 0x9e, 0xb2, 0xa1,
+	0x2d, 0xe2, 0x08, 0xb8, 0x75, 0x2e, 0xfb, 0xdc, 0xda, 0x7e, 0x54, 0xa6, 0x68, 0xb7, 0x6a, 0xdd,
+	0x4b, 0x1d, 0x76, 0xa3, 0x49, 0x7f, 0x44, 0xbc, 0xee, 0x10, 0x62, 0xbd, 0xd0, 0x40, 0xcd, 0xff,
+	0xce, 0xe9, 0xce, 0x56, 0x0e, 0x1e, 0x6c, 0xe5, 0x90, 0xe9, 0x56, 0xa5, 0x76, 0x9d, 0x4a, 0x97,
+	0x10, 0x6b, 0x6d, 0xb5, 0x14, 0x00, 0xf1, 0x03, 0xa1, 0x17, 0x1b, 0xa8, 0x59, 0x74, 0x4e, 0x36,
+	0x09, 0xce, 0x94, 0x6d, 0x82, 0x2b, 0x72, 0x90, 0xac, 0x4d, 0x37, 0x03, 0x9d, 0x7f, 0xf3, 0x05,
+	0x56, 0x3e, 0x17, 0x18, 0x99, 0xcf, 0x05, 0xb5, 0x7a, 0xc5, 0x7d, 0x19, 0xe5, 0x9f, 0x4e, 0xb2,
+	0x53, 0xfe, 0x09, 0x65, 0xfe, 0x82, 0x91, 0xe3, 0xbe, 0xad, 0x0c, 0xb4, 0x5c, 0x19, 0xe8, 0x63,
+	0x65, 0xa0, 0xc7, 0xb5, 0xa1, 0x2c, 0xd7, 0x86, 0xf2, 0xbe, 0x36, 0x94, 0x9b, 0xb3, 0xdf, 0x6d,
+	0x33, 0xb3, 0x0f, 0x47, 0x2c, 0x57, 0xeb, 0x97, 0xd2, 0xdb, 0x6d, 0x7f, 0x0f, 0x00, 0xbf, 0xdc,
+	0x7e, 0x5b, 0xf3, 0x02, 0x00, 0x00,
+}
+
+func (this *RevokedClient) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RevokedClient)
+	if !ok {
+		that2, ok := that.(RevokedClient)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.RequestorAddress, that1.RequestorAddress) {
+		return false
+	}
+	if this.ClientPublicKey != that1.ClientPublicKey {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	return true
+}
+func (this *MsgRevokeClient) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgRevokeClient)
+	if !ok {
+		that2, ok := that.(MsgRevokeClient)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.RequestorAddress, that1.RequestorAddress) {
+		return false
+	}
+	if this.ClientPublicKey != that1.ClientPublicKey {
+		return false
+	}
+	return true
+}
+func (m *RevokedClient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevokedClient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevokedClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintRevocation(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ClientPublicKey) > 0 {
+		i -= len(m.ClientPublicKey)
+		copy(dAtA[i:], m.ClientPublicKey)
+		i = encodeVarintRevocation(dAtA, i, uint64(len(m.ClientPublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RequestorAddress) > 0 {
+		i -= len(m.RequestorAddress)
+		copy(dAtA[i:], m.RequestorAddress)
+		i = encodeVarintRevocation(dAtA, i, uint64(len(m.RequestorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeClient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeClient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeClient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientPublicKey) > 0 {
+		i -= len(m.ClientPublicKey)
+		copy(dAtA[i:], m.ClientPublicKey)
+		i = encodeVarintRevocation(dAtA, i, uint64(len(m.ClientPublicKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RequestorAddress) > 0 {
+		i -= len(m.RequestorAddress)
+		copy(dAtA[i:], m.RequestorAddress)
+		i = encodeVarintRevocation(dAtA, i, uint64(len(m.RequestorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRevocation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRevocation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RevokedClient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RequestorAddress)
+	if l > 0 {
+		n += 1 + l + sovRevocation(uint64(l))
+	}
+	l = len(m.ClientPublicKey)
+	if l > 0 {
+		n += 1 + l + sovRevocation(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovRevocation(uint64(m.Height))
+	}
+	return n
+}
+
+func (m *MsgRevokeClient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RequestorAddress)
+	if l > 0 {
+		n += 1 + l + sovRevocation(uint64(l))
+	}
+	l = len(m.ClientPublicKey)
+	if l > 0 {
+		n += 1 + l + sovRevocation(uint64(l))
+	}
+	return n
+}
+
+func sovRevocation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRevocation(x uint64) (n int) {
+	return sovRevocation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RevokedClient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRevocation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevokedClient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevokedClient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestorAddress = append(m.RequestorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.RequestorAddress == nil {
+				m.RequestorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientPublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientPublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRevocation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeClient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRevocation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeClient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeClient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestorAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequestorAddress = append(m.RequestorAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.RequestorAddress == nil {
+				m.RequestorAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientPublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevocation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientPublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRevocation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRevocation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRevocation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRevocation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRevocation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRevocation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRevocation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRevocation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRevocation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRevocation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRevocation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRevocation = fmt.Errorf("proto: unexpected end of group")
+)
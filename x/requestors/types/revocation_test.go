@@ -0,0 +1,36 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	sdk "github.com/vipernet-xyz/viper-network/types"
+)
+
+func TestMsgRevokeClient_ValidateBasic(t *testing.T) {
+	addr := sdk.Address(pk.Address())
+	tests := []struct {
+		name     string
+		msg      MsgRevokeClient
+		hasError bool
+	}{
+		{"errs if no address", MsgRevokeClient{ClientPublicKey: pk.RawString()}, true},
+		{"errs if no client public key", MsgRevokeClient{RequestorAddress: addr}, true},
+		{"errs if the client public key is not hex", MsgRevokeClient{RequestorAddress: addr, ClientPublicKey: "not hex"}, true},
+		{"returns nil if valid", MsgRevokeClient{RequestorAddress: addr, ClientPublicKey: pk.RawString()}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.hasError, tt.msg.ValidateBasic() != nil)
+		})
+	}
+}
+
+func TestMsgRevokeClient_Signers(t *testing.T) {
+	addr := sdk.Address(pk.Address())
+	msg := MsgRevokeClient{RequestorAddress: addr, ClientPublicKey: pk.RawString()}
+	assert.Equal(t, []sdk.Address{addr}, msg.GetSigners())
+	assert.Equal(t, RouterKey, msg.Route())
+	assert.Equal(t, MsgRequestorRevokeClientName, msg.Type())
+	assert.Equal(t, sdk.NewInt(RevokeFee), msg.GetFee())
+}
@@ -0,0 +1,77 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/vipernet-xyz/viper-network/types"
+)
+
+const (
+	MsgRequestorUnstakePartialName = "requestor_unstake_partial"
+)
+
+// NewUnbondingStake creates the unbonding stake of the amount removed from the requestor at the block height
+func NewUnbondingStake(address sdk.Address, amount sdk.BigInt, height int64, completionTime time.Time) UnbondingStake {
+	return UnbondingStake{
+		Address:        address,
+		Amount:         amount,
+		CreationHeight: height,
+		CompletionTime: completionTime,
+	}
+}
+
+// ValidateBasic does a stateless check of the unbonding stake
+func (u UnbondingStake) ValidateBasic() sdk.Error {
+	if u.Address.Empty() {
+		return ErrNilRequestorAddr(DefaultCodespace)
+	}
+	if u.Amount.IsZero() || u.Amount.IsNegative() {
+		return ErrUnstakePartialAmount(DefaultCodespace, fmt.Errorf("the unbonding amount must be positive"))
+	}
+	if u.CreationHeight < 0 {
+		return ErrUnstakePartialAmount(DefaultCodespace, fmt.Errorf("negative creation height: %d", u.CreationHeight))
+	}
+	return nil
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+var _ sdk.ProtoMsg = &MsgUnstakePartial{}
+
+// Route provides router key for msg
+func (msg MsgUnstakePartial) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgUnstakePartial) Type() string { return MsgRequestorUnstakePartialName }
+
+// GetFee get fee for msg
+func (msg MsgUnstakePartial) GetFee() sdk.BigInt {
+	return sdk.NewInt(RequestorFeeMap[msg.Type()])
+}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgUnstakePartial) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.Address}
+}
+
+func (msg MsgUnstakePartial) GetRecipient() sdk.Address {
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgUnstakePartial) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check for removing part of the stake of a requestor
+func (msg MsgUnstakePartial) ValidateBasic() sdk.Error {
+	if msg.Address.Empty() {
+		return ErrNilRequestorAddr(DefaultCodespace)
+	}
+	if msg.Amount.IsZero() || msg.Amount.IsNegative() {
+		return ErrUnstakePartialAmount(DefaultCodespace, fmt.Errorf("the amount must be positive"))
+	}
+	return nil
+}
@@ -0,0 +1,751 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: x/requestors/unbonding.proto
+
+package types
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	github_com_vipernet_xyz_viper_network_types "github.com/vipernet-xyz/viper-network/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// UnbondingStake is the stake removed from a requestor by a partial unstake. It is sent back to the requestor
+// at the completion time and can be burned with the stake of the requestor until then
+type UnbondingStake struct {
+	Address github_com_vipernet_xyz_viper_network_types.Address `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/vipernet-xyz/viper-network/types.Address" json:"address" yaml:"address"`
+	Amount  github_com_vipernet_xyz_viper_network_types.BigInt  `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/vipernet-xyz/viper-network/types.BigInt" json:"amount" yaml:"amount"`
+	// the block height of the partial unstake
+	CreationHeight int64     `protobuf:"varint,3,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height" yaml:"creation_height"`
+	CompletionTime time.Time `protobuf:"bytes,4,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time" yaml:"completion_time"`
+}
+
+func (m *UnbondingStake) Reset()         { *m = UnbondingStake{} }
+func (m *UnbondingStake) String() string { return proto.CompactTextString(m) }
+func (*UnbondingStake) ProtoMessage()    {}
+func (*UnbondingStake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d69173116a6abf5, []int{0}
+}
+func (m *UnbondingStake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbondingStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbondingStake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnbondingStake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingStake.Merge(m, src)
+}
+func (m *UnbondingStake) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbondingStake) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingStake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbondingStake proto.InternalMessageInfo
+
+type MsgUnstakePartial struct {
+	Address github_com_vipernet_xyz_viper_network_types.Address `protobuf:"bytes,1,opt,name=Address,proto3,casttype=github.com/vipernet-xyz/viper-network/types.Address" json:"application_address" yaml:"application_address"`
+	Amount  github_com_vipernet_xyz_viper_network_types.BigInt  `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/vipernet-xyz/viper-network/types.BigInt" json:"amount" yaml:"amount"`
+}
+
+func (m *MsgUnstakePartial) Reset()      { *m = MsgUnstakePartial{} }
+func (*MsgUnstakePartial) ProtoMessage() {}
+func (*MsgUnstakePartial) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d69173116a6abf5, []int{1}
+}
+func (m *MsgUnstakePartial) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnstakePartial) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnstakePartial.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnstakePartial) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnstakePartial.Merge(m, src)
+}
+func (m *MsgUnstakePartial) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnstakePartial) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnstakePartial.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnstakePartial proto.InternalMessageInfo
+
+func (*MsgUnstakePartial) XXX_MessageName() string {
+	return "x.requestors.MsgUnstakePartial"
+}
+func init() {
+	proto.RegisterType((*UnbondingStake)(nil), "x.requestors.UnbondingStake")
+	proto.RegisterType((*MsgUnstakePartial)(nil), "x.requestors.MsgUnstakePartial")
+}
+
+func init() { proto.RegisterFile("x/requestors/unbonding.proto", fileDescriptor_6d69173116a6abf5) }
+
+var fileDescriptor_6d69173116a6abf5 = []byte{
+	// 468 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x93, 0xb1, 0x6f, 0xd3, 0x40,
+	0x14, 0xc6, 0x7d, 0x0d, 0x6a, 0x91, 0x29, 0xa9, 0x30, 0x15, 0x8a, 0x22, 0xe4, 0x8b, 0x3c, 0x65,
+	0x89, 0x2d, 0xb5, 0x42, 0x42, 0xd9, 0xe2, 0x09, 0x06, 0x24, 0x64, 0x1a, 0x06, 0x16, 0x74, 0x49,
+	0x8e, 0xcb, 0xa9, 0xf6, 0x9d, 0xb9, 0x3b, 0x43, 0xd2, 0x89, 0x09, 0x75, 0xec, 0xc8, 0x58, 0xf8,
+	0x6b, 0xba, 0x20, 0x75, 0x44, 0x08, 0x1d, 0x28, 0x59, 0x50, 0xc6, 0x8c, 0x4c, 0xc8, 0x67, 0x5b,
+	0xc1, 0x65, 0x01, 0x16, 0x36, 0xdf, 0xf7, 0xbd, 0xfb, 0xde, 0xbb, 0xf7, 0x93, 0xed, 0xbb, 0xb3,
+	0x40, 0xe0, 0x97, 0x19, 0x96, 0x8a, 0x0b, 0x19, 0x64, 0x6c, 0xc4, 0xd9, 0x84, 0x32, 0xe2, 0xa7,
+	0x82, 0x2b, 0xee, 0xec, 0xce, 0xfc, 0x8d, 0xdb, 0xde, 0x27, 0x9c, 0x70, 0x63, 0x04, 0xf9, 0x57,
+	0x51, 0xd3, 0x86, 0x84, 0x73, 0x12, 0xe3, 0xc0, 0x9c, 0x46, 0xd9, 0x8b, 0x40, 0xd1, 0x04, 0x4b,
+	0x85, 0x92, 0xb4, 0x28, 0xf0, 0x3e, 0x36, 0xec, 0xe6, 0xb0, 0x0a, 0x7e, 0xa2, 0xd0, 0x31, 0x76,
+	0x98, 0xbd, 0x83, 0x26, 0x13, 0x81, 0xa5, 0x6c, 0x81, 0x0e, 0xe8, 0xee, 0x86, 0x47, 0x2b, 0x0d,
+	0x2b, 0x69, 0xad, 0x61, 0x73, 0x8e, 0x92, 0xb8, 0xef, 0x95, 0x82, 0xf7, 0x43, 0xc3, 0x43, 0x42,
+	0xd5, 0x34, 0x1b, 0xf9, 0x63, 0x9e, 0x04, 0xaf, 0x68, 0x8a, 0x05, 0xc3, 0xaa, 0x37, 0x9b, 0x9f,
+	0x14, 0x87, 0x1e, 0xc3, 0xea, 0x35, 0x17, 0xc7, 0x81, 0x9a, 0xa7, 0x58, 0xfa, 0x83, 0xe2, 0x5e,
+	0x54, 0x25, 0x3a, 0x89, 0xbd, 0x8d, 0x12, 0x9e, 0x31, 0xd5, 0xda, 0x32, 0xed, 0x86, 0x17, 0x1a,
+	0x5a, 0x9f, 0x35, 0x3c, 0xf8, 0x9b, 0xd4, 0x90, 0x92, 0x87, 0x4c, 0xad, 0x34, 0x2c, 0xb3, 0xd6,
+	0x1a, 0xde, 0x2c, 0xe7, 0x34, 0x67, 0x2f, 0x2a, 0x0d, 0xe7, 0xa9, 0xbd, 0x37, 0x16, 0x18, 0x29,
+	0xca, 0xd9, 0xf3, 0x29, 0xa6, 0x64, 0xaa, 0x5a, 0x8d, 0x0e, 0xe8, 0x36, 0xc2, 0xde, 0x4a, 0xc3,
+	0xab, 0xd6, 0x5a, 0xc3, 0x3b, 0x45, 0xcc, 0x15, 0xc3, 0x8b, 0x9a, 0x95, 0xf2, 0xc0, 0x08, 0xce,
+	0x89, 0xbd, 0x37, 0xe6, 0x49, 0x1a, 0x63, 0x53, 0x95, 0xef, 0xb9, 0x75, 0xad, 0x03, 0xba, 0x37,
+	0x0e, 0xda, 0x7e, 0x01, 0xc1, 0xaf, 0x20, 0xf8, 0x47, 0x15, 0x84, 0xf0, 0x5e, 0xfe, 0x56, 0xd3,
+	0xb7, 0x7e, 0xf5, 0x97, 0xbe, 0x75, 0xc3, 0x3b, 0xfb, 0x0a, 0x41, 0xd4, 0xdc, 0xa8, 0x79, 0x56,
+	0xff, 0xfa, 0xe9, 0x39, 0xb4, 0xbe, 0x9f, 0x43, 0xe0, 0xbd, 0xdf, 0xb2, 0x6f, 0x3d, 0x92, 0x64,
+	0xc8, 0x64, 0xce, 0xf2, 0x31, 0x12, 0x8a, 0xa2, 0xd8, 0x79, 0x0b, 0xec, 0x9d, 0x41, 0x8d, 0x69,
+	0xbc, 0xd2, 0xf0, 0x36, 0x4a, 0xd3, 0x98, 0x8e, 0x8b, 0x67, 0x6d, 0xf8, 0xb6, 0xcb, 0xbd, 0xfd,
+	0x6e, 0xfe, 0x3b, 0xeb, 0xc1, 0x7f, 0x61, 0xdd, 0xdf, 0xcf, 0xf7, 0xf2, 0xae, 0xdc, 0xcd, 0xe9,
+	0x07, 0x08, 0xde, 0x7c, 0xe9, 0x80, 0x30, 0xba, 0x58, 0xb8, 0xe0, 0x72, 0xe1, 0x82, 0x6f, 0x0b,
+	0x17, 0x9c, 0x2d, 0x5d, 0xeb, 0x72, 0xe9, 0x5a, 0x9f, 0x96, 0xae, 0xf5, 0xec, 0xfe, 0x9f, 0x8d,
+	0x51, 0xfb, 0x2d, 0xcd, 0x4c, 0xa3, 0x6d, 0x03, 0xf7, 0xf0, 0xe7, 0x00, 0x48, 0xce, 0x54, 0x8b,
+	0xb3, 0x03, 0x00, 0x00,
+}
+
+func (this *UnbondingStake) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UnbondingStake)
+	if !ok {
+		that2, ok := that.(UnbondingStake)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Address, that1.Address) {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if this.CreationHeight != that1.CreationHeight {
+		return false
+	}
+	if !this.CompletionTime.Equal(that1.CompletionTime) {
+		return false
+	}
+	return true
+}
+func (this *MsgUnstakePartial) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUnstakePartial)
+	if !ok {
+		that2, ok := that.(MsgUnstakePartial)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Address, that1.Address) {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	return true
+}
+func (m *UnbondingStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbondingStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintUnbonding(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x22
+	if m.CreationHeight != 0 {
+		i = encodeVarintUnbonding(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintUnbonding(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintUnbonding(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnstakePartial) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnstakePartial) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnstakePartial) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintUnbonding(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintUnbonding(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintUnbonding(dAtA []byte, offset int, v uint64) int {
+	offset -= sovUnbonding(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UnbondingStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovUnbonding(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovUnbonding(uint64(l))
+	if m.CreationHeight != 0 {
+		n += 1 + sovUnbonding(uint64(m.CreationHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovUnbonding(uint64(l))
+	return n
+}
+
+func (m *MsgUnstakePartial) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovUnbonding(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovUnbonding(uint64(l))
+	return n
+}
+
+func sovUnbonding(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozUnbonding(x uint64) (n int) {
+	return sovUnbonding(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *MsgUnstakePartial) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MsgUnstakePartial{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Amount:` + fmt.Sprintf("%v", this.Amount) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringUnbonding(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *UnbondingStake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUnbonding
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingStake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingStake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUnbonding(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnstakePartial) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUnbonding
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnstakePartial: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnstakePartial: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUnbonding(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipUnbonding(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowUnbonding
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthUnbonding
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupUnbonding
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthUnbonding
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthUnbonding        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowUnbonding          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupUnbonding = fmt.Errorf("proto: unexpected end of group")
+)
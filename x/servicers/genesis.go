@@ -53,6 +53,27 @@ func InitGenesis(ctx sdk.Ctx, keeper keeper.Keeper, supplyKeeper types.AuthKeepe
 			stakedTokens = stakedTokens.Add(validator.GetTokens())
 		}
 	}
+	// set the unbonding stakes, their tokens are in the staked pool until they are released
+	for _, unbonding := range data.UnbondingStakes {
+		keeper.SetUnbondingStake(ctx, unbonding)
+		stakedTokens = stakedTokens.Add(unbonding.Amount)
+	}
+	// set the delegations, their tokens are part of the staked tokens of the validators
+	for _, delegation := range data.Delegations {
+		keeper.SetDelegation(ctx, delegation)
+	}
+	// set the unbonding delegations, their tokens are in the staked pool until they are released
+	for _, unbonding := range data.UnbondingDelegations {
+		keeper.SetUnbondingDelegation(ctx, unbonding)
+		stakedTokens = stakedTokens.Add(unbonding.Amount)
+	}
+	// set the redelegations that are still slashable for their source validator
+	for _, redelegation := range data.Redelegations {
+		keeper.SetRedelegation(ctx, redelegation)
+	}
+	for _, commission := range data.Commissions {
+		keeper.SetCommission(ctx, commission)
+	}
 	// take the staked amount and create the corresponding coins object
 	stakedCoins := sdk.NewCoins(sdk.NewCoin(keeper.StakeDenom(ctx), stakedTokens))
 	// check if the staked pool accounts exists
@@ -153,6 +174,11 @@ func ExportGenesis(ctx sdk.Ctx, keeper keeper.Keeper) types.GenesisState {
 		SigningInfos:             signingInfos,
 		MissedBlocks:             missedBlocks,
 		PreviousProposer:         prevProposer,
+		UnbondingStakes:          keeper.GetAllUnbondingStakes(ctx),
+		Delegations:              keeper.GetAllDelegations(ctx),
+		UnbondingDelegations:     keeper.GetAllUnbondingDelegations(ctx),
+		Redelegations:            keeper.GetAllRedelegations(ctx),
+		Commissions:              keeper.GetAllCommissions(ctx),
 	}
 }
 
@@ -167,6 +193,31 @@ func ValidateGenesis(data types.GenesisState) error {
 	if err != nil {
 		return err
 	}
+	for _, unbonding := range data.UnbondingStakes {
+		if err := unbonding.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	for _, delegation := range data.Delegations {
+		if err := delegation.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	for _, unbonding := range data.UnbondingDelegations {
+		if err := unbonding.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	for _, redelegation := range data.Redelegations {
+		if err := redelegation.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+	for _, commission := range data.Commissions {
+		if err := commission.ValidateBasic(); err != nil {
+			return err
+		}
+	}
 	downtime := data.Params.SlashFractionDowntime
 	if downtime.IsNegative() || downtime.GT(sdk.OneDec()) {
 		return fmt.Errorf("Slashing fraction downtime should be less than or equal to one and greater than zero, is %s", downtime.String())
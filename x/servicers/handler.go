@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/vipernet-xyz/viper-network/codec"
 	crypto "github.com/vipernet-xyz/viper-network/crypto/codec"
 	sdk "github.com/vipernet-xyz/viper-network/types"
 	"github.com/vipernet-xyz/viper-network/x/servicers/keeper"
@@ -32,6 +33,16 @@ func NewHandler(k keeper.Keeper) sdk.Handler {
 				return handleMsgPause(ctx, msg, k)
 			case types.MsgUnpause:
 				return handleMsgUnpause(ctx, msg, k)
+			case types.MsgUnstakePartial:
+				return handleMsgUnstakePartial(ctx, msg, k)
+			case types.MsgDelegate:
+				return handleMsgDelegate(ctx, msg, k)
+			case types.MsgUndelegate:
+				return handleMsgUndelegate(ctx, msg, k)
+			case types.MsgRedelegate:
+				return handleMsgRedelegate(ctx, msg, k)
+			case types.MsgSetCommission:
+				return handleMsgSetCommission(ctx, msg, k)
 			default:
 				errMsg := fmt.Sprintf("unrecognized staking message type: %T", msg)
 				return sdk.ErrUnknownRequest(errMsg).Result()
@@ -47,6 +58,10 @@ func handleStake(ctx sdk.Ctx, msg types.MsgStake, k keeper.Keeper, signer crypto
 	if err != nil {
 		return err.Result()
 	}
+	// the signer key is unknown when executed on behalf of an account that never exposed it
+	if signer == nil {
+		return sdk.ErrUnauthorized("the public key of the stake signer is unknown").Result()
+	}
 
 	pk := msg.PublicKey
 	addr := pk.Address()
@@ -123,6 +138,171 @@ func handleMsgBeginUnstake(ctx sdk.Ctx, msg types.MsgBeginUnstake, k keeper.Keep
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
 
+// Validators remove the stake above the minimum once the feature is activated by an upgrade,
+// the amount is released after the unstaking time
+func handleMsgUnstakePartial(ctx sdk.Ctx, msg types.MsgUnstakePartial, k keeper.Keeper) sdk.Result {
+	defer sdk.TimeTrack(time.Now())
+
+	if !types.ModuleCdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.PartialUnstakeKey) {
+		return types.ErrUnstakePartialInactive(k.Codespace()).Result()
+	}
+	ctx.Logger().Info("Partial Unstake Message received from " + msg.Address.String())
+	validator, err := k.ValidateUnstakePartial(ctx, msg)
+	if err != nil {
+		return err.Result()
+	}
+	unbonding, err := k.UnstakePartialValidator(ctx, validator, msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+	// create the event
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnstakePartial,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, unbonding.CompletionTime.Format(time.RFC3339)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Address.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgDelegate(ctx sdk.Ctx, msg types.MsgDelegate, k keeper.Keeper) sdk.Result {
+	defer sdk.TimeTrack(time.Now())
+
+	if !types.ModuleCdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.DelegationKey) {
+		return types.ErrDelegationInactive(k.Codespace()).Result()
+	}
+	ctx.Logger().Info("Delegate Message received from " + msg.Delegator.String())
+	validator, err := k.ValidateDelegate(ctx, msg)
+	if err != nil {
+		return err.Result()
+	}
+	if err := k.Delegate(ctx, validator, msg.Delegator, msg.Amount); err != nil {
+		return err.Result()
+	}
+	// create the event
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDelegate,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.Validator.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Delegator.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgUndelegate(ctx sdk.Ctx, msg types.MsgUndelegate, k keeper.Keeper) sdk.Result {
+	defer sdk.TimeTrack(time.Now())
+
+	if !types.ModuleCdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.DelegationKey) {
+		return types.ErrDelegationInactive(k.Codespace()).Result()
+	}
+	ctx.Logger().Info("Undelegate Message received from " + msg.Delegator.String())
+	delegation, err := k.ValidateUndelegate(ctx, msg)
+	if err != nil {
+		return err.Result()
+	}
+	unbonding, err := k.Undelegate(ctx, delegation, msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+	// create the event
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUndelegate,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.Validator.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, unbonding.CompletionTime.Format(time.RFC3339)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Delegator.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgRedelegate(ctx sdk.Ctx, msg types.MsgRedelegate, k keeper.Keeper) sdk.Result {
+	defer sdk.TimeTrack(time.Now())
+
+	if !types.ModuleCdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.DelegationKey) {
+		return types.ErrDelegationInactive(k.Codespace()).Result()
+	}
+	ctx.Logger().Info("Redelegate Message received from " + msg.Delegator.String())
+	delegation, dst, err := k.ValidateRedelegate(ctx, msg)
+	if err != nil {
+		return err.Result()
+	}
+	redelegation, err := k.Redelegate(ctx, delegation, dst, msg.Amount)
+	if err != nil {
+		return err.Result()
+	}
+	// create the event
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRedelegate,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.SrcValidator.String()),
+			sdk.NewAttribute(types.AttributeKeyDstValidator, msg.DstValidator.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionTime, redelegation.CompletionTime.Format(time.RFC3339)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Delegator.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
+func handleMsgSetCommission(ctx sdk.Ctx, msg types.MsgSetCommission, k keeper.Keeper) sdk.Result {
+	defer sdk.TimeTrack(time.Now())
+
+	if !types.ModuleCdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.DelegationKey) {
+		return types.ErrDelegationInactive(k.Codespace()).Result()
+	}
+	ctx.Logger().Info("Set Commission Message received from " + msg.Signer.String())
+	if err := k.ValidateSetCommission(ctx, msg); err != nil {
+		return err.Result()
+	}
+	k.SetCommission(ctx, types.NewCommission(msg.Address, msg.Rate))
+	// create the event
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSetCommission,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer.String()),
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyCommissionRate, msg.Rate.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Signer.String()),
+		),
+	})
+	return sdk.Result{Events: ctx.EventManager().Events()}
+}
+
 // Validators must submit a transaction to unjail itself after todo
 // having been jailed (and thus unstaked) for downtime
 func handleMsgUnjail(ctx sdk.Ctx, msg types.MsgUnjail, k keeper.Keeper) sdk.Result {
@@ -69,5 +69,10 @@ func EndBlocker(ctx sdk.Ctx, k Keeper) []abci.ValidatorUpdate {
 	validatorUpdates := k.UpdateTendermintValidators(ctx)
 	// Unstake all mature validators from the unstaking queue.
 	k.unstakeAllMatureValidators(ctx)
+	// Release the unbonding stakes of the partial unstakes that are mature.
+	k.releaseMatureUnbondingStakes(ctx)
+	// Release the unbonding delegations and the redelegations that are mature.
+	k.releaseMatureUnbondingDelegations(ctx)
+	k.releaseMatureRedelegations(ctx)
 	return validatorUpdates
 }
@@ -0,0 +1,600 @@
+package keeper
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/vipernet-xyz/viper-network/codec"
+	sdk "github.com/vipernet-xyz/viper-network/types"
+	"github.com/vipernet-xyz/viper-network/x/servicers/types"
+)
+
+// SetDelegation - Store the delegation and its position in the delegator index
+func (k Keeper) SetDelegation(ctx sdk.Ctx, delegation types.Delegation) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.Cdc.ProtoMarshalBinaryBare(&delegation)
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Errorf("error marshalling delegation %v at height: %d, err: %s", delegation, ctx.BlockHeight(), err.Error()).Error())
+		os.Exit(1)
+	}
+	key := types.KeyForDelegation(delegation.ValidatorAddress, delegation.DelegatorAddress)
+	_ = store.Set(key, bz)
+	_ = store.Set(types.KeyForDelegationByDelegator(delegation.DelegatorAddress, delegation.ValidatorAddress), key)
+}
+
+// GetDelegation - Retrieve the delegation of the delegator to the validator
+func (k Keeper) GetDelegation(ctx sdk.Ctx, delegator, validator sdk.Address) (delegation types.Delegation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz, _ := store.Get(types.KeyForDelegation(validator, delegator))
+	if bz == nil {
+		return delegation, false
+	}
+	if err := k.Cdc.ProtoUnmarshalBinaryBare(bz, &delegation); err != nil {
+		k.Logger(ctx).Error(fmt.Errorf("error unmarshalling delegation %v at height: %d, err: %s", bz, ctx.BlockHeight(), err.Error()).Error())
+		return delegation, false
+	}
+	return delegation, true
+}
+
+// deleteDelegation - Remove the delegation and its position in the delegator index
+func (k Keeper) deleteDelegation(ctx sdk.Ctx, delegation types.Delegation) {
+	store := ctx.KVStore(k.storeKey)
+	_ = store.Delete(types.KeyForDelegation(delegation.ValidatorAddress, delegation.DelegatorAddress))
+	_ = store.Delete(types.KeyForDelegationByDelegator(delegation.DelegatorAddress, delegation.ValidatorAddress))
+}
+
+// GetValidatorDelegations - Retrieve the delegations to the validator
+func (k Keeper) GetValidatorDelegations(ctx sdk.Ctx, validator sdk.Address) (delegations []types.Delegation) {
+	delegations = make([]types.Delegation, 0)
+	k.iterateStoredValues(ctx, types.KeyForDelegationsOfValidator(validator), func(bz []byte) {
+		var delegation types.Delegation
+		if k.unmarshalStoredValue(ctx, bz, &delegation) {
+			delegations = append(delegations, delegation)
+		}
+	})
+	return
+}
+
+// GetDelegatorDelegations - Retrieve the delegations of the delegator
+func (k Keeper) GetDelegatorDelegations(ctx sdk.Ctx, delegator sdk.Address) (delegations []types.Delegation) {
+	delegations = make([]types.Delegation, 0)
+	// the delegator index stores the keys of the delegations
+	var validators []sdk.Address
+	k.iterateStoredValues(ctx, types.KeyForDelegationsOfDelegator(delegator), func(key []byte) {
+		validators = append(validators, key[len(types.DelegationsKey):len(key)-len(delegator)])
+	})
+	for _, validator := range validators {
+		if delegation, found := k.GetDelegation(ctx, delegator, validator); found {
+			delegations = append(delegations, delegation)
+		}
+	}
+	return
+}
+
+// GetAllDelegations - Retrieve the delegations to all the validators
+func (k Keeper) GetAllDelegations(ctx sdk.Ctx) (delegations []types.Delegation) {
+	k.iterateStoredValues(ctx, types.DelegationsKey, func(bz []byte) {
+		var delegation types.Delegation
+		if k.unmarshalStoredValue(ctx, bz, &delegation) {
+			delegations = append(delegations, delegation)
+		}
+	})
+	return
+}
+
+// GetDelegatedTokens - Retrieve the part of the staked tokens of the validator delegated by the token holders
+func (k Keeper) GetDelegatedTokens(ctx sdk.Ctx, validator sdk.Address) sdk.BigInt {
+	delegated := sdk.ZeroInt()
+	for _, delegation := range k.GetValidatorDelegations(ctx, validator) {
+		delegated = delegated.Add(delegation.Amount)
+	}
+	return delegated
+}
+
+// GetSelfStake - Retrieve the part of the staked tokens of the validator staked by its operator
+func (k Keeper) GetSelfStake(ctx sdk.Ctx, validator types.Validator) sdk.BigInt {
+	return validator.StakedTokens.Sub(k.GetDelegatedTokens(ctx, validator.Address))
+}
+
+// SetUnbondingDelegation - Store the unbonding delegation and its position in the unbonding queue
+func (k Keeper) SetUnbondingDelegation(ctx sdk.Ctx, unbonding types.UnbondingDelegation) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.Cdc.ProtoMarshalBinaryBare(&unbonding)
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Errorf("error marshalling unbonding delegation %v at height: %d, err: %s", unbonding, ctx.BlockHeight(), err.Error()).Error())
+		os.Exit(1)
+	}
+	key := types.KeyForUnbondingDelegation(unbonding.ValidatorAddress, unbonding.DelegatorAddress, unbonding.CompletionTime)
+	_ = store.Set(key, bz)
+	_ = store.Set(types.KeyForUnbondingDelegationInQueue(unbonding.CompletionTime, unbonding.ValidatorAddress, unbonding.DelegatorAddress), key)
+}
+
+// getUnbondingDelegation - Retrieve the unbonding delegation stored at the key
+func (k Keeper) getUnbondingDelegation(ctx sdk.Ctx, key []byte) (unbonding types.UnbondingDelegation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz, _ := store.Get(key)
+	if bz == nil {
+		return unbonding, false
+	}
+	return unbonding, k.unmarshalStoredValue(ctx, bz, &unbonding)
+}
+
+// deleteUnbondingDelegation - Remove the unbonding delegation and its position in the unbonding queue
+func (k Keeper) deleteUnbondingDelegation(ctx sdk.Ctx, unbonding types.UnbondingDelegation) {
+	store := ctx.KVStore(k.storeKey)
+	_ = store.Delete(types.KeyForUnbondingDelegation(unbonding.ValidatorAddress, unbonding.DelegatorAddress, unbonding.CompletionTime))
+	_ = store.Delete(types.KeyForUnbondingDelegationInQueue(unbonding.CompletionTime, unbonding.ValidatorAddress, unbonding.DelegatorAddress))
+}
+
+// GetValidatorUnbondingDelegations - Retrieve the unbonding delegations from the validator
+func (k Keeper) GetValidatorUnbondingDelegations(ctx sdk.Ctx, validator sdk.Address) (unbondings []types.UnbondingDelegation) {
+	unbondings = make([]types.UnbondingDelegation, 0)
+	k.iterateStoredValues(ctx, types.KeyForUnbondingDelegationsOfValidator(validator), func(bz []byte) {
+		var unbonding types.UnbondingDelegation
+		if k.unmarshalStoredValue(ctx, bz, &unbonding) {
+			unbondings = append(unbondings, unbonding)
+		}
+	})
+	return
+}
+
+// GetDelegatorUnbondingDelegations - Retrieve the unbonding delegations of the delegator
+func (k Keeper) GetDelegatorUnbondingDelegations(ctx sdk.Ctx, delegator sdk.Address) (unbondings []types.UnbondingDelegation) {
+	unbondings = make([]types.UnbondingDelegation, 0)
+	for _, unbonding := range k.GetAllUnbondingDelegations(ctx) {
+		if unbonding.DelegatorAddress.Equals(delegator) {
+			unbondings = append(unbondings, unbonding)
+		}
+	}
+	return
+}
+
+// GetAllUnbondingDelegations - Retrieve the unbonding delegations from all the validators
+func (k Keeper) GetAllUnbondingDelegations(ctx sdk.Ctx) (unbondings []types.UnbondingDelegation) {
+	k.iterateStoredValues(ctx, types.UnbondingDelegationsKey, func(bz []byte) {
+		var unbonding types.UnbondingDelegation
+		if k.unmarshalStoredValue(ctx, bz, &unbonding) {
+			unbondings = append(unbondings, unbonding)
+		}
+	})
+	return
+}
+
+// SetRedelegation - Store the redelegation, its position in the destination index and in the redelegation queue
+func (k Keeper) SetRedelegation(ctx sdk.Ctx, redelegation types.Redelegation) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.Cdc.ProtoMarshalBinaryBare(&redelegation)
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Errorf("error marshalling redelegation %v at height: %d, err: %s", redelegation, ctx.BlockHeight(), err.Error()).Error())
+		os.Exit(1)
+	}
+	key := types.KeyForRedelegation(redelegation.SrcValidatorAddress, redelegation.DelegatorAddress, redelegation.DstValidatorAddress, redelegation.CompletionTime)
+	_ = store.Set(key, bz)
+	_ = store.Set(types.KeyForRedelegationByDst(redelegation.DstValidatorAddress, redelegation.DelegatorAddress, redelegation.SrcValidatorAddress, redelegation.CompletionTime), key)
+	_ = store.Set(types.KeyForRedelegationInQueue(redelegation.CompletionTime, redelegation.SrcValidatorAddress, redelegation.DelegatorAddress, redelegation.DstValidatorAddress), key)
+}
+
+// getRedelegation - Retrieve the redelegation stored at the key
+func (k Keeper) getRedelegation(ctx sdk.Ctx, key []byte) (redelegation types.Redelegation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz, _ := store.Get(key)
+	if bz == nil {
+		return redelegation, false
+	}
+	return redelegation, k.unmarshalStoredValue(ctx, bz, &redelegation)
+}
+
+// deleteRedelegation - Remove the redelegation, its position in the destination index and in the redelegation queue
+func (k Keeper) deleteRedelegation(ctx sdk.Ctx, redelegation types.Redelegation) {
+	store := ctx.KVStore(k.storeKey)
+	_ = store.Delete(types.KeyForRedelegation(redelegation.SrcValidatorAddress, redelegation.DelegatorAddress, redelegation.DstValidatorAddress, redelegation.CompletionTime))
+	_ = store.Delete(types.KeyForRedelegationByDst(redelegation.DstValidatorAddress, redelegation.DelegatorAddress, redelegation.SrcValidatorAddress, redelegation.CompletionTime))
+	_ = store.Delete(types.KeyForRedelegationInQueue(redelegation.CompletionTime, redelegation.SrcValidatorAddress, redelegation.DelegatorAddress, redelegation.DstValidatorAddress))
+}
+
+// GetValidatorRedelegations - Retrieve the redelegations from the source validator
+func (k Keeper) GetValidatorRedelegations(ctx sdk.Ctx, src sdk.Address) (redelegations []types.Redelegation) {
+	redelegations = make([]types.Redelegation, 0)
+	k.iterateStoredValues(ctx, types.KeyForRedelegationsOfValidator(src), func(bz []byte) {
+		var redelegation types.Redelegation
+		if k.unmarshalStoredValue(ctx, bz, &redelegation) {
+			redelegations = append(redelegations, redelegation)
+		}
+	})
+	return
+}
+
+// GetDelegatorRedelegations - Retrieve the redelegations of the delegator
+func (k Keeper) GetDelegatorRedelegations(ctx sdk.Ctx, delegator sdk.Address) (redelegations []types.Redelegation) {
+	redelegations = make([]types.Redelegation, 0)
+	for _, redelegation := range k.GetAllRedelegations(ctx) {
+		if redelegation.DelegatorAddress.Equals(delegator) {
+			redelegations = append(redelegations, redelegation)
+		}
+	}
+	return
+}
+
+// GetAllRedelegations - Retrieve the redelegations from all the validators
+func (k Keeper) GetAllRedelegations(ctx sdk.Ctx) (redelegations []types.Redelegation) {
+	k.iterateStoredValues(ctx, types.RedelegationsKey, func(bz []byte) {
+		var redelegation types.Redelegation
+		if k.unmarshalStoredValue(ctx, bz, &redelegation) {
+			redelegations = append(redelegations, redelegation)
+		}
+	})
+	return
+}
+
+// hasReceivingRedelegation - Check if the delegator has a redelegation to the validator that is still slashable
+func (k Keeper) hasReceivingRedelegation(ctx sdk.Ctx, delegator, dst sdk.Address) bool {
+	store := ctx.KVStore(k.storeKey)
+	iter, _ := sdk.KVStorePrefixIterator(store, types.KeyForRedelegationsToValidator(dst, delegator))
+	defer iter.Close()
+	return iter.Valid()
+}
+
+// SetCommission - Store the commission of the validator
+func (k Keeper) SetCommission(ctx sdk.Ctx, commission types.Commission) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.Cdc.ProtoMarshalBinaryBare(&commission)
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Errorf("error marshalling commission %v at height: %d, err: %s", commission, ctx.BlockHeight(), err.Error()).Error())
+		os.Exit(1)
+	}
+	_ = store.Set(types.KeyForCommission(commission.ValidatorAddress), bz)
+}
+
+// GetCommission - Retrieve the commission of the validator, the operator takes no commission until it sets one
+func (k Keeper) GetCommission(ctx sdk.Ctx, validator sdk.Address) (commission types.Commission) {
+	store := ctx.KVStore(k.storeKey)
+	bz, _ := store.Get(types.KeyForCommission(validator))
+	if bz == nil || !k.unmarshalStoredValue(ctx, bz, &commission) {
+		return types.NewCommission(validator, sdk.ZeroDec())
+	}
+	return commission
+}
+
+// deleteCommission - Remove the commission of the validator
+func (k Keeper) deleteCommission(ctx sdk.Ctx, validator sdk.Address) {
+	store := ctx.KVStore(k.storeKey)
+	_ = store.Delete(types.KeyForCommission(validator))
+}
+
+// GetAllCommissions - Retrieve the commissions set by the validators
+func (k Keeper) GetAllCommissions(ctx sdk.Ctx) (commissions []types.Commission) {
+	k.iterateStoredValues(ctx, types.CommissionsKey, func(bz []byte) {
+		var commission types.Commission
+		if k.unmarshalStoredValue(ctx, bz, &commission) {
+			commissions = append(commissions, commission)
+		}
+	})
+	return
+}
+
+func (k Keeper) iterateStoredValues(ctx sdk.Ctx, prefix []byte, process func(bz []byte)) {
+	store := ctx.KVStore(k.storeKey)
+	iter, _ := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		process(iter.Value())
+	}
+}
+
+func (k Keeper) unmarshalStoredValue(ctx sdk.Ctx, bz []byte, value codec.ProtoMarshaler) bool {
+	if err := k.Cdc.ProtoUnmarshalBinaryBare(bz, value); err != nil {
+		k.Logger(ctx).Error(fmt.Errorf("error unmarshalling %v at height: %d, err: %s", bz, ctx.BlockHeight(), err.Error()).Error())
+		return false
+	}
+	return true
+}
+
+// ValidateDelegate - Check the delegator can delegate the amount to the validator
+func (k Keeper) ValidateDelegate(ctx sdk.Ctx, msg types.MsgDelegate) (types.Validator, sdk.Error) {
+	validator, err := k.validateDelegationValidator(ctx, msg.Validator)
+	if err != nil {
+		return validator, err
+	}
+	if !k.AccountKeeper.HasCoins(ctx, msg.Delegator, sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), msg.Amount))) {
+		return validator, types.ErrNotEnoughCoins(k.Codespace())
+	}
+	return validator, nil
+}
+
+// validateDelegationValidator - Check the validator can receive delegations
+func (k Keeper) validateDelegationValidator(ctx sdk.Ctx, addr sdk.Address) (types.Validator, sdk.Error) {
+	validator, found := k.GetValidator(ctx, addr)
+	if !found {
+		return validator, types.ErrNoValidatorFound(k.Codespace())
+	}
+	if !validator.IsStaked() {
+		return validator, types.ErrValidatorStatus(k.Codespace())
+	}
+	if validator.IsJailed() {
+		return validator, types.ErrValidatorJailed(k.Codespace())
+	}
+	if k.IsWaitingValidator(ctx, validator.Address) {
+		return validator, types.ErrValidatorWaitingToUnstake(k.Codespace())
+	}
+	return validator, nil
+}
+
+// Delegate - Store ops to add the amount of the delegator to the stake of the validator
+func (k Keeper) Delegate(ctx sdk.Ctx, validator types.Validator, delegator sdk.Address, amount sdk.BigInt) sdk.Error {
+	if err := k.coinsFromUnstakedToStaked(ctx, delegator, amount); err != nil {
+		return err
+	}
+	if _, err := k.addValidatorTokens(ctx, validator, amount); err != nil {
+		return sdk.ErrInternal(err.Error())
+	}
+	delegation, found := k.GetDelegation(ctx, delegator, validator.Address)
+	if !found {
+		delegation = types.NewDelegation(delegator, validator.Address, sdk.ZeroInt())
+	}
+	delegation.Amount = delegation.Amount.Add(amount)
+	k.SetDelegation(ctx, delegation)
+	ctx.Logger().Info(fmt.Sprintf("Delegated %s from %s to validator %s", amount, delegator, validator.Address))
+	return nil
+}
+
+// ValidateUndelegate - Check the delegator can undelegate the amount from the validator
+func (k Keeper) ValidateUndelegate(ctx sdk.Ctx, msg types.MsgUndelegate) (types.Delegation, sdk.Error) {
+	delegation, found := k.GetDelegation(ctx, msg.Delegator, msg.Validator)
+	if !found {
+		return delegation, types.ErrNoDelegation(k.Codespace())
+	}
+	if delegation.Amount.LT(msg.Amount) {
+		return delegation, types.ErrDelegationAmount(k.Codespace(), fmt.Errorf("the delegation of %s is lower than %s", delegation.Amount, msg.Amount))
+	}
+	return delegation, nil
+}
+
+// Undelegate - Store ops to move the amount of the delegation to the unbonding queue,
+// the amount stays in the staked pool until the unstaking time is over
+func (k Keeper) Undelegate(ctx sdk.Ctx, delegation types.Delegation, amount sdk.BigInt) (types.UnbondingDelegation, sdk.Error) {
+	validator, found := k.GetValidator(ctx, delegation.ValidatorAddress)
+	if !found {
+		return types.UnbondingDelegation{}, types.ErrNoValidatorFound(k.Codespace())
+	}
+	if _, err := k.removeValidatorTokens(ctx, validator, amount); err != nil {
+		return types.UnbondingDelegation{}, sdk.ErrInternal(err.Error())
+	}
+	k.removeDelegationTokens(ctx, delegation, amount)
+	unbonding := types.NewUnbondingDelegation(delegation.DelegatorAddress, delegation.ValidatorAddress, amount, ctx.BlockHeight(),
+		ctx.BlockHeader().Time.Add(k.UnStakingTime(ctx)))
+	// the undelegations of the same block complete together
+	key := types.KeyForUnbondingDelegation(unbonding.ValidatorAddress, unbonding.DelegatorAddress, unbonding.CompletionTime)
+	if existing, found := k.getUnbondingDelegation(ctx, key); found {
+		unbonding.Amount = unbonding.Amount.Add(existing.Amount)
+	}
+	k.SetUnbondingDelegation(ctx, unbonding)
+	ctx.Logger().Info(fmt.Sprintf("Began undelegating %s from %s to validator %s", amount, delegation.DelegatorAddress, delegation.ValidatorAddress))
+	return unbonding, nil
+}
+
+// ValidateRedelegate - Check the delegator can move the amount from the source to the destination validator
+func (k Keeper) ValidateRedelegate(ctx sdk.Ctx, msg types.MsgRedelegate) (types.Delegation, types.Validator, sdk.Error) {
+	delegation, err := k.ValidateUndelegate(ctx, types.MsgUndelegate{Delegator: msg.Delegator, Validator: msg.SrcValidator, Amount: msg.Amount})
+	if err != nil {
+		return delegation, types.Validator{}, err
+	}
+	dst, err := k.validateDelegationValidator(ctx, msg.DstValidator)
+	if err != nil {
+		return delegation, dst, err
+	}
+	// the stake redelegated to the source validator is still slashable for its own source validator
+	if k.hasReceivingRedelegation(ctx, msg.Delegator, msg.SrcValidator) {
+		return delegation, dst, types.ErrInvalidRedelegation(k.Codespace(), fmt.Errorf("the stake redelegated to %s can't be redelegated until the unstaking time is over", msg.SrcValidator))
+	}
+	return delegation, dst, nil
+}
+
+// Redelegate - Store ops to move the amount of the delegation to the destination validator, the redelegation
+// is slashable for the infractions of the source validator until the unstaking time is over
+func (k Keeper) Redelegate(ctx sdk.Ctx, delegation types.Delegation, dst types.Validator, amount sdk.BigInt) (types.Redelegation, sdk.Error) {
+	src, found := k.GetValidator(ctx, delegation.ValidatorAddress)
+	if !found {
+		return types.Redelegation{}, types.ErrNoValidatorFound(k.Codespace())
+	}
+	if _, err := k.removeValidatorTokens(ctx, src, amount); err != nil {
+		return types.Redelegation{}, sdk.ErrInternal(err.Error())
+	}
+	if _, err := k.addValidatorTokens(ctx, dst, amount); err != nil {
+		return types.Redelegation{}, sdk.ErrInternal(err.Error())
+	}
+	k.removeDelegationTokens(ctx, delegation, amount)
+	dstDelegation, found := k.GetDelegation(ctx, delegation.DelegatorAddress, dst.Address)
+	if !found {
+		dstDelegation = types.NewDelegation(delegation.DelegatorAddress, dst.Address, sdk.ZeroInt())
+	}
+	dstDelegation.Amount = dstDelegation.Amount.Add(amount)
+	k.SetDelegation(ctx, dstDelegation)
+	redelegation := types.NewRedelegation(delegation.DelegatorAddress, src.Address, dst.Address, amount, ctx.BlockHeight(),
+		ctx.BlockHeader().Time.Add(k.UnStakingTime(ctx)))
+	// the redelegations of the same block complete together
+	key := types.KeyForRedelegation(redelegation.SrcValidatorAddress, redelegation.DelegatorAddress, redelegation.DstValidatorAddress, redelegation.CompletionTime)
+	if existing, found := k.getRedelegation(ctx, key); found {
+		redelegation.Amount = redelegation.Amount.Add(existing.Amount)
+	}
+	k.SetRedelegation(ctx, redelegation)
+	ctx.Logger().Info(fmt.Sprintf("Redelegated %s from %s to validator %s from validator %s", amount, delegation.DelegatorAddress, dst.Address, src.Address))
+	return redelegation, nil
+}
+
+// removeDelegationTokens - Update the amount of the delegation, the delegation is removed once empty
+func (k Keeper) removeDelegationTokens(ctx sdk.Ctx, delegation types.Delegation, amount sdk.BigInt) {
+	delegation.Amount = delegation.Amount.Sub(amount)
+	if delegation.Amount.IsZero() || delegation.Amount.IsNegative() {
+		k.deleteDelegation(ctx, delegation)
+		return
+	}
+	k.SetDelegation(ctx, delegation)
+}
+
+// ValidateSetCommission - Check the signer can set the commission of the validator
+func (k Keeper) ValidateSetCommission(ctx sdk.Ctx, msg types.MsgSetCommission) sdk.Error {
+	validator, found := k.GetValidator(ctx, msg.Address)
+	if !found {
+		return types.ErrNoValidatorFound(k.Codespace())
+	}
+	if err, valid := ValidateValidatorMsgSigner(validator, msg.Signer, k); !valid {
+		return err
+	}
+	return nil
+}
+
+// releaseMatureUnbondingDelegations - Send the unbonding delegations that finished their unstaking period to their delegators
+func (k Keeper) releaseMatureUnbondingDelegations(ctx sdk.Ctx) {
+	for _, key := range k.matureQueueKeys(ctx, types.UnbondingDelegationsQueueKey, types.KeyForUnbondingDelegationsQueueTime(ctx.BlockHeader().Time)) {
+		unbonding, found := k.getUnbondingDelegation(ctx, key)
+		if !found {
+			ctx.Logger().Error(fmt.Sprintf("unbonding delegation in the queue was not found at height: %d", ctx.BlockHeight()))
+			continue
+		}
+		k.deleteUnbondingDelegation(ctx, unbonding)
+		coins := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), unbonding.Amount))
+		if err := k.AccountKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.StakedPoolName, unbonding.DelegatorAddress, coins); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("unable to send the unbonding delegation of %s: %s", unbonding.DelegatorAddress, err.Error()))
+			continue
+		}
+		ctx.Logger().Info(fmt.Sprintf("Finished undelegating %s from %s to validator %s", unbonding.Amount, unbonding.DelegatorAddress, unbonding.ValidatorAddress))
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCompleteUndelegation,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(sdk.AttributeKeySender, unbonding.DelegatorAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyValidator, unbonding.ValidatorAddress.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, unbonding.Amount.String()),
+			),
+		)
+	}
+}
+
+// releaseMatureRedelegations - Remove the redelegations that are no longer slashable for their source validator
+func (k Keeper) releaseMatureRedelegations(ctx sdk.Ctx) {
+	for _, key := range k.matureQueueKeys(ctx, types.RedelegationsQueueKey, types.KeyForRedelegationsQueueTime(ctx.BlockHeader().Time)) {
+		redelegation, found := k.getRedelegation(ctx, key)
+		if !found {
+			ctx.Logger().Error(fmt.Sprintf("redelegation in the queue was not found at height: %d", ctx.BlockHeight()))
+			continue
+		}
+		k.deleteRedelegation(ctx, redelegation)
+	}
+}
+
+// releaseDelegations - Send the delegations back to the delegators when the validator finishes unstaking,
+// they waited the unstaking time with the validator. Returns the validator without the delegated tokens
+func (k Keeper) releaseDelegations(ctx sdk.Ctx, validator types.Validator) types.Validator {
+	for _, delegation := range k.GetValidatorDelegations(ctx, validator.Address) {
+		k.deleteDelegation(ctx, delegation)
+		amount := sdk.MinInt(delegation.Amount, validator.StakedTokens)
+		coins := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), amount))
+		if err := k.AccountKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.StakedPoolName, delegation.DelegatorAddress, coins); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("unable to send the delegation of %s: %s", delegation.DelegatorAddress, err.Error()))
+			continue
+		}
+		validator.StakedTokens = validator.StakedTokens.Sub(amount)
+	}
+	k.deleteCommission(ctx, validator.Address)
+	return validator
+}
+
+// rewardDelegators - Mint the share of the relay reward of each delegator, after the commission of the operator,
+// pro-rata to their part of the staked tokens of the validator. Returns the reward left to the operator
+func (k Keeper) rewardDelegators(ctx sdk.Ctx, validator types.Validator, reward sdk.BigInt) sdk.BigInt {
+	delegations := k.GetValidatorDelegations(ctx, validator.Address)
+	if len(delegations) == 0 || validator.StakedTokens.IsZero() || validator.StakedTokens.IsNegative() {
+		return reward
+	}
+	commission := reward.ToDec().Mul(k.GetCommission(ctx, validator.Address).Rate).TruncateInt()
+	toDelegators := reward.Sub(commission)
+	toOperator := reward
+	for _, delegation := range delegations {
+		share := toDelegators.Mul(delegation.Amount).Quo(validator.StakedTokens)
+		if share.IsZero() || share.IsNegative() {
+			continue
+		}
+		k.mint(ctx, share, delegation.DelegatorAddress)
+		toOperator = toOperator.Sub(share)
+	}
+	return toOperator
+}
+
+// slashDelegations - Remove from the delegations their part of the tokens burned from the staked tokens of the validator
+func (k Keeper) slashDelegations(ctx sdk.Ctx, validator types.Validator, burned sdk.BigInt) {
+	if burned.IsZero() || burned.IsNegative() || validator.StakedTokens.IsZero() || validator.StakedTokens.IsNegative() {
+		return
+	}
+	for _, delegation := range k.GetValidatorDelegations(ctx, validator.Address) {
+		k.removeDelegationTokens(ctx, delegation, delegation.Amount.Mul(burned).Quo(validator.StakedTokens))
+	}
+}
+
+// slashUnbondingDelegations - Burn the slash factor of the delegations undelegated from the validator since the
+// infraction, they contributed to the power of the validator at the infraction height. Returns the burned amount
+func (k Keeper) slashUnbondingDelegations(ctx sdk.Ctx, addr sdk.Address, infractionHeight int64, slashFactor sdk.BigDec) sdk.BigInt {
+	burned := sdk.ZeroInt()
+	for _, unbonding := range k.GetValidatorUnbondingDelegations(ctx, addr) {
+		if unbonding.CreationHeight < infractionHeight {
+			continue
+		}
+		tokensToBurn := sdk.MinInt(unbonding.Amount.ToDec().Mul(slashFactor).TruncateInt(), unbonding.Amount)
+		if !tokensToBurn.IsPositive() {
+			continue
+		}
+		if err := k.burnStakedTokens(ctx, tokensToBurn); err != nil {
+			k.Logger(ctx).Error("could not burn unbonding delegation: " + err.Error() + "\nfor validator " + addr.String())
+			continue
+		}
+		unbonding.Amount = unbonding.Amount.Sub(tokensToBurn)
+		if unbonding.Amount.IsZero() {
+			k.deleteUnbondingDelegation(ctx, unbonding)
+		} else {
+			k.SetUnbondingDelegation(ctx, unbonding)
+		}
+		burned = burned.Add(tokensToBurn)
+	}
+	return burned
+}
+
+// slashRedelegations - Burn the slash factor of the delegations redelegated from the validator since the infraction
+// from the stake of their destination validators. Returns the burned amount
+func (k Keeper) slashRedelegations(ctx sdk.Ctx, addr sdk.Address, infractionHeight int64, slashFactor sdk.BigDec) sdk.BigInt {
+	burned := sdk.ZeroInt()
+	for _, redelegation := range k.GetValidatorRedelegations(ctx, addr) {
+		if redelegation.CreationHeight < infractionHeight {
+			continue
+		}
+		delegation, found := k.GetDelegation(ctx, redelegation.DelegatorAddress, redelegation.DstValidatorAddress)
+		if !found {
+			continue
+		}
+		dst, found := k.GetValidator(ctx, redelegation.DstValidatorAddress)
+		if !found {
+			continue
+		}
+		// the redelegated stake may already be undelegated or slashed on the destination validator
+		tokensToBurn := sdk.MinInt(redelegation.Amount.ToDec().Mul(slashFactor).TruncateInt(), delegation.Amount)
+		tokensToBurn = sdk.MinInt(tokensToBurn, dst.StakedTokens)
+		if !tokensToBurn.IsPositive() {
+			continue
+		}
+		if _, err := k.removeValidatorTokens(ctx, dst, tokensToBurn); err != nil {
+			k.Logger(ctx).Error("could not remove redelegated tokens: " + err.Error() + "\nfor validator " + dst.Address.String())
+			continue
+		}
+		if err := k.burnStakedTokens(ctx, tokensToBurn); err != nil {
+			k.Logger(ctx).Error("could not burn redelegated tokens: " + err.Error() + "\nfor validator " + dst.Address.String())
+			continue
+		}
+		k.removeDelegationTokens(ctx, delegation, tokensToBurn)
+		redelegation.Amount = redelegation.Amount.Sub(tokensToBurn)
+		if redelegation.Amount.IsZero() {
+			k.deleteRedelegation(ctx, redelegation)
+		} else {
+			k.SetRedelegation(ctx, redelegation)
+		}
+		burned = burned.Add(tokensToBurn)
+	}
+	return burned
+}
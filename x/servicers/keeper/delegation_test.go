@@ -0,0 +1,191 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdk "github.com/vipernet-xyz/viper-network/types"
+	"github.com/vipernet-xyz/viper-network/x/servicers/types"
+)
+
+func TestDelegation_DelegateAndUndelegate(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	validator := getStakedValidator()
+	validator.OutputAddress = getRandomValidatorAddress()
+	keeper.SetValidator(context, validator)
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	delegator := getRandomValidatorAddress()
+	amount := sdk.NewInt(1000000)
+	msg := types.MsgDelegate{Delegator: delegator, Validator: validator.Address, Amount: amount}
+	// the delegator must own the delegated amount
+	_, err := keeper.ValidateDelegate(context, msg)
+	require.NotNil(t, err)
+	assert.Equal(t, types.CodeNotEnoughCoins, err.Code())
+	keeper.mint(context, amount, delegator)
+	validator, err = keeper.ValidateDelegate(context, msg)
+	require.Nil(t, err)
+	require.Nil(t, keeper.Delegate(context, validator, delegator, amount))
+	assert.True(t, keeper.GetBalance(context, delegator).IsZero())
+	validator, found := keeper.GetValidator(context, validator.Address)
+	require.True(t, found)
+	assert.Equal(t, sdk.NewInt(100000000000).Add(amount), validator.StakedTokens)
+	assert.Equal(t, amount, keeper.GetDelegatedTokens(context, validator.Address))
+	assert.Equal(t, sdk.NewInt(100000000000), keeper.GetSelfStake(context, validator))
+	delegation := types.NewDelegation(delegator, validator.Address, amount)
+	assert.Equal(t, []types.Delegation{delegation}, keeper.GetDelegatorDelegations(context, delegator))
+	// the delegation can't be undelegated above its amount
+	_, err = keeper.ValidateUndelegate(context, types.MsgUndelegate{Delegator: delegator, Validator: validator.Address, Amount: amount.Add(sdk.OneInt())})
+	require.NotNil(t, err)
+	assert.Equal(t, types.CodeDelegationAmount, err.Code())
+	half := amount.QuoRaw(2)
+	delegation, err = keeper.ValidateUndelegate(context, types.MsgUndelegate{Delegator: delegator, Validator: validator.Address, Amount: half})
+	require.Nil(t, err)
+	unbonding, err := keeper.Undelegate(context, delegation, half)
+	require.Nil(t, err)
+	validator, found = keeper.GetValidator(context, validator.Address)
+	require.True(t, found)
+	assert.Equal(t, sdk.NewInt(100000000000).Add(half), validator.StakedTokens)
+	assert.Equal(t, []types.UnbondingDelegation{unbonding}, keeper.GetDelegatorUnbondingDelegations(context, delegator))
+	// the undelegated amount is released once the unstaking time is over
+	keeper.releaseMatureUnbondingDelegations(context.WithBlockTime(unbonding.CompletionTime.Add(-time.Second)))
+	assert.True(t, keeper.GetBalance(context, delegator).IsZero())
+	keeper.releaseMatureUnbondingDelegations(context.WithBlockTime(unbonding.CompletionTime))
+	assert.Empty(t, keeper.GetValidatorUnbondingDelegations(context, validator.Address))
+	assert.Equal(t, half, keeper.GetBalance(context, delegator))
+	// the delegations are released with the stake of the validator
+	keeper.FinishUnstakingValidator(context, validator)
+	assert.Equal(t, amount, keeper.GetBalance(context, delegator))
+	assert.Equal(t, sdk.NewInt(100000000000), keeper.GetBalance(context, validator.OutputAddress))
+	assert.Empty(t, keeper.GetDelegatorDelegations(context, delegator))
+}
+
+func TestDelegation_Redelegate(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	src, dst := getStakedValidator(), getStakedValidator()
+	keeper.SetValidator(context, src)
+	keeper.SetValidator(context, dst)
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	delegator := getRandomValidatorAddress()
+	amount := sdk.NewInt(1000000)
+	keeper.SetDelegation(context, types.NewDelegation(delegator, src.Address, amount))
+	msg := types.MsgRedelegate{Delegator: delegator, SrcValidator: src.Address, DstValidator: dst.Address, Amount: amount}
+	delegation, dst, err := keeper.ValidateRedelegate(context, msg)
+	require.Nil(t, err)
+	redelegation, err := keeper.Redelegate(context, delegation, dst, amount)
+	require.Nil(t, err)
+	assert.Empty(t, keeper.GetValidatorDelegations(context, src.Address))
+	assert.Equal(t, []types.Delegation{types.NewDelegation(delegator, dst.Address, amount)}, keeper.GetValidatorDelegations(context, dst.Address))
+	src, _ = keeper.GetValidator(context, src.Address)
+	dst, _ = keeper.GetValidator(context, dst.Address)
+	assert.Equal(t, sdk.NewInt(100000000000).Sub(amount), src.StakedTokens)
+	assert.Equal(t, sdk.NewInt(100000000000).Add(amount), dst.StakedTokens)
+	// the redelegated stake can't be redelegated again until the redelegation is mature
+	msg = types.MsgRedelegate{Delegator: delegator, SrcValidator: dst.Address, DstValidator: src.Address, Amount: amount}
+	_, _, err = keeper.ValidateRedelegate(context, msg)
+	require.NotNil(t, err)
+	assert.Equal(t, types.CodeInvalidRedelegation, err.Code())
+	// the redelegation is slashed on the destination for the infractions of the source
+	burned := keeper.slashRedelegations(context, src.Address, redelegation.CreationHeight, sdk.NewDecWithPrec(1, 1))
+	assert.Equal(t, amount.QuoRaw(10), burned)
+	delegation, found := keeper.GetDelegation(context, delegator, dst.Address)
+	require.True(t, found)
+	assert.Equal(t, amount.Sub(burned), delegation.Amount)
+	keeper.releaseMatureRedelegations(context.WithBlockTime(redelegation.CompletionTime))
+	assert.Empty(t, keeper.GetDelegatorRedelegations(context, delegator))
+	msg.Amount = delegation.Amount
+	_, _, err = keeper.ValidateRedelegate(context, msg)
+	assert.Nil(t, err)
+}
+
+func TestDelegation_RewardAndSlash(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	validator := getStakedValidator()
+	// half of the stake is delegated
+	validator.StakedTokens = sdk.NewInt(200000000000)
+	keeper.SetValidator(context, validator)
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	delegator := getRandomValidatorAddress()
+	keeper.SetDelegation(context, types.NewDelegation(delegator, validator.Address, sdk.NewInt(100000000000)))
+	keeper.SetCommission(context, types.NewCommission(validator.Address, sdk.NewDecWithPrec(1, 1)))
+	// the delegators share the reward after the commission, pro-rata to their stake
+	toOperator := keeper.rewardDelegators(context, validator, sdk.NewInt(1000))
+	assert.Equal(t, sdk.NewInt(550), toOperator)
+	assert.Equal(t, sdk.NewInt(450), keeper.GetBalance(context, delegator))
+	// the delegations bear their part of the slash
+	keeper.simpleSlash(context, validator.Address, sdk.NewInt(20000000000))
+	delegation, found := keeper.GetDelegation(context, delegator, validator.Address)
+	require.True(t, found)
+	assert.Equal(t, sdk.NewInt(90000000000), delegation.Amount)
+	validator, found = keeper.GetValidator(context, validator.Address)
+	require.True(t, found)
+	assert.Equal(t, sdk.NewInt(180000000000), validator.StakedTokens)
+	assert.Equal(t, sdk.NewInt(90000000000), keeper.GetSelfStake(context, validator))
+}
+
+func TestDelegation_EditStakeAfterDelegate(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	validator := getStakedValidator()
+	keeper.SetValidator(context, validator)
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	delegator := getRandomValidatorAddress()
+	amount := sdk.NewInt(1000000)
+	keeper.mint(context, amount, delegator)
+	require.Nil(t, keeper.Delegate(context, validator, delegator, amount))
+	validator, found := keeper.GetValidator(context, validator.Address)
+	require.True(t, found)
+	// the operator edits with its own stake, the delegations are not counted
+	selfStake := sdk.NewInt(100000000000)
+	require.Nil(t, keeper.ValidateEditStake(context, validator, validator, selfStake, validator.Address))
+	err := keeper.ValidateEditStake(context, validator, validator, selfStake.Sub(sdk.OneInt()), validator.Address)
+	require.NotNil(t, err)
+	assert.Equal(t, types.CodeMinimumEditStake, err.Code())
+	// a bump only takes the difference with the self stake from the operator
+	bump := sdk.NewInt(10)
+	keeper.mint(context, bump, validator.Address)
+	require.Nil(t, keeper.ValidateEditStake(context, validator, validator, selfStake.Add(bump), validator.Address))
+	require.Nil(t, keeper.EditStakeValidator(context, validator, validator, selfStake.Add(bump), validator.PublicKey))
+	assert.True(t, keeper.GetBalance(context, validator.Address).IsZero())
+	validator, found = keeper.GetValidator(context, validator.Address)
+	require.True(t, found)
+	assert.Equal(t, selfStake.Add(bump).Add(amount), validator.StakedTokens)
+	assert.Equal(t, selfStake.Add(bump), keeper.GetSelfStake(context, validator))
+	assert.Equal(t, amount, keeper.GetDelegatedTokens(context, validator.Address))
+}
+
+func TestDelegation_SimpleSlashLeavingDelegations(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	src, dst := getStakedValidator(), getStakedValidator()
+	amount := sdk.NewInt(1000000)
+	src.StakedTokens = src.StakedTokens.Add(amount).Add(amount)
+	keeper.SetValidator(context, src)
+	keeper.SetValidator(context, dst)
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	undelegator, redelegator := getRandomValidatorAddress(), getRandomValidatorAddress()
+	keeper.SetDelegation(context, types.NewDelegation(undelegator, src.Address, amount))
+	keeper.SetDelegation(context, types.NewDelegation(redelegator, src.Address, amount))
+	delegation, found := keeper.GetDelegation(context, undelegator, src.Address)
+	require.True(t, found)
+	unbonding, err := keeper.Undelegate(context, delegation, amount)
+	require.Nil(t, err)
+	delegation, found = keeper.GetDelegation(context, redelegator, src.Address)
+	require.True(t, found)
+	_, err = keeper.Redelegate(context, delegation, dst, amount)
+	require.Nil(t, err)
+	src, _ = keeper.GetValidator(context, src.Address)
+	assert.Equal(t, sdk.NewInt(100000000000), src.StakedTokens)
+	// a tenth of the stake is slashed, the delegations leaving the validator lose a tenth too
+	keeper.simpleSlash(context, src.Address, sdk.NewInt(10000000000))
+	unbondings := keeper.GetDelegatorUnbondingDelegations(context, undelegator)
+	require.Len(t, unbondings, 1)
+	assert.Equal(t, unbonding.CompletionTime, unbondings[0].CompletionTime)
+	assert.Equal(t, amount.QuoRaw(10).MulRaw(9), unbondings[0].Amount)
+	delegation, found = keeper.GetDelegation(context, redelegator, dst.Address)
+	require.True(t, found)
+	assert.Equal(t, amount.QuoRaw(10).MulRaw(9), delegation.Amount)
+	// the rest of the slash is burned from the validator
+	src, found = keeper.GetValidator(context, src.Address)
+	require.True(t, found)
+	assert.Equal(t, sdk.NewInt(90000000000).Add(amount.QuoRaw(10).MulRaw(2)), src.StakedTokens)
+}
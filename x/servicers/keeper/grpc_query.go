@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/vipernet-xyz/viper-network/types"
+	"github.com/vipernet-xyz/viper-network/x/servicers/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var _ types.QueryServer = queryServer{}
+
+type queryServer struct {
+	k Keeper
+}
+
+// NewQueryServer returns the implementation of the grpc query service of the module
+func NewQueryServer(k Keeper) types.QueryServer {
+	return queryServer{k: k}
+}
+
+// Servicers implements the Query/Servicers gRPC method
+func (q queryServer) Servicers(c context.Context, req *types.QueryServicersRequest) (*types.QueryServicersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	opts := types.QueryValidatorsParams{
+		StakingStatus: sdk.StakeStatus(req.StakingStatus),
+		JailedStatus:  int(req.JailedStatus),
+		PausedStatus:  int(req.PausedStatus),
+		Blockchain:    req.Blockchain,
+		GeoZone:       req.GeoZone,
+		Page:          int(req.Page),
+		Limit:         int(req.PerPage),
+	}
+	if opts.Page <= 0 {
+		opts.Page = 1
+	}
+	page := paginate(opts.Page, opts.Limit, q.k.GetAllValidatorsWithOpts(ctx, opts), int(q.k.MaxValidators(ctx)))
+	servicers := make([]types.ProtoValidator, 0, len(page.Result))
+	for _, v := range page.Result {
+		servicers = append(servicers, v.ToProto())
+	}
+	return &types.QueryServicersResponse{Servicers: servicers, TotalPages: int64(page.Total), Page: int64(page.Page)}, nil
+}
+
+// Servicer implements the Query/Servicer gRPC method
+func (q queryServer) Servicer(c context.Context, req *types.QueryServicerRequest) (*types.QueryServicerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	addr, err := sdk.AddressFromHex(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	validator, found := q.k.GetValidator(sdk.UnwrapSDKContext(c), addr)
+	if !found {
+		return nil, status.Error(codes.NotFound, types.ErrNoValidatorFound(types.DefaultCodespace).Error())
+	}
+	return &types.QueryServicerResponse{Servicer: validator.ToProto()}, nil
+}
+
+// SigningInfo implements the Query/SigningInfo gRPC method
+func (q queryServer) SigningInfo(c context.Context, req *types.QuerySigningInfoRequest) (*types.QuerySigningInfoResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	addr, err := sdk.AddressFromHex(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	info, found := q.k.GetValidatorSigningInfo(sdk.UnwrapSDKContext(c), addr)
+	if !found {
+		return nil, status.Error(codes.NotFound, types.ErrNoSigningInfoFound(types.DefaultCodespace, addr).Error())
+	}
+	return &types.QuerySigningInfoResponse{SigningInfo: info}, nil
+}
+
+// UnbondingStakes implements the Query/UnbondingStakes gRPC method
+func (q queryServer) UnbondingStakes(c context.Context, req *types.QueryUnbondingStakesRequest) (*types.QueryUnbondingStakesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	addr, err := sdk.AddressFromHex(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	return &types.QueryUnbondingStakesResponse{UnbondingStakes: q.k.GetUnbondingStakes(sdk.UnwrapSDKContext(c), addr)}, nil
+}
+
+// Delegations implements the Query/Delegations gRPC method
+func (q queryServer) Delegations(c context.Context, req *types.QueryDelegationsRequest) (*types.QueryDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	addr, err := sdk.AddressFromHex(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryDelegationsResponse{
+		Delegations: q.k.GetValidatorDelegations(ctx, addr),
+		Commission:  q.k.GetCommission(ctx, addr),
+	}, nil
+}
+
+// DelegatorDelegations implements the Query/DelegatorDelegations gRPC method
+func (q queryServer) DelegatorDelegations(c context.Context, req *types.QueryDelegatorDelegationsRequest) (*types.QueryDelegatorDelegationsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	addr, err := sdk.AddressFromHex(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryDelegatorDelegationsResponse{
+		Delegations:          q.k.GetDelegatorDelegations(ctx, addr),
+		UnbondingDelegations: q.k.GetDelegatorUnbondingDelegations(ctx, addr),
+		Redelegations:        q.k.GetDelegatorRedelegations(ctx, addr),
+	}, nil
+}
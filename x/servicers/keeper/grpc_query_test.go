@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/vipernet-xyz/viper-network/types"
+	"github.com/vipernet-xyz/viper-network/x/servicers/types"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestQueryServer_Servicers(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	staked := getStakedValidator()
+	unstaking := getUnstakingValidator()
+	keeper.SetValidator(context, staked)
+	keeper.SetValidator(context, unstaking)
+	server, c := NewQueryServer(keeper), sdk.WrapSDKContext(context.(sdk.Context))
+
+	res, err := server.Servicers(c, &types.QueryServicersRequest{StakingStatus: int32(sdk.Staked), PerPage: 10})
+	assert.Nil(t, err)
+	assert.Len(t, res.Servicers, 1)
+	assert.Equal(t, staked.Address, res.Servicers[0].Address)
+	assert.Equal(t, int64(1), res.Page)
+	assert.Equal(t, int64(1), res.TotalPages)
+
+	res, err = server.Servicers(c, &types.QueryServicersRequest{PerPage: 10})
+	assert.Nil(t, err)
+	assert.Len(t, res.Servicers, 2)
+
+	_, err = server.Servicers(c, nil)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestQueryServer_Servicer(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	validator := getStakedValidator()
+	keeper.SetValidator(context, validator)
+	server, c := NewQueryServer(keeper), sdk.WrapSDKContext(context.(sdk.Context))
+
+	res, err := server.Servicer(c, &types.QueryServicerRequest{Address: validator.Address.String()})
+	assert.Nil(t, err)
+	assert.Equal(t, validator.Address, res.Servicer.Address)
+	assert.Equal(t, validator.StakedTokens, res.Servicer.StakedTokens)
+
+	_, err = server.Servicer(c, &types.QueryServicerRequest{Address: getRandomValidatorAddress().String()})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+	_, err = server.Servicer(c, &types.QueryServicerRequest{Address: "not hex"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+}
@@ -29,7 +29,7 @@ func (k Keeper) GetStakedPool(ctx sdk.Ctx) (stakedPool exported.ModuleAccountI)
 func (k Keeper) coinsFromStakedToUnstaked(ctx sdk.Ctx, validator types.Validator) error {
 	coins := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), validator.StakedTokens))
 	output, _ := k.GetValidatorOutputAddress(ctx, validator.Address)
-	err := k.AccountKeeper.SendCoinsFromModuleToAccount(ctx, types.StakedPoolName, output, coins)
+	err := k.AccountKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.StakedPoolName, output, coins)
 	if err != nil {
 		return fmt.Errorf("unable to send coins from staked to unstaked for address: %s", validator.Address)
 	}
@@ -42,7 +42,7 @@ func (k Keeper) coinsFromUnstakedToStaked(ctx sdk.Ctx, address sdk.Address, amou
 		return sdk.ErrInternal("cannot send a negative")
 	}
 	coins := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), amount))
-	err := k.AccountKeeper.SendCoinsFromAccountToModule(ctx, address, types.StakedPoolName, coins)
+	err := k.AccountKeeper.DelegateCoinsFromAccountToModule(ctx, address, types.StakedPoolName, coins)
 	return err
 }
 
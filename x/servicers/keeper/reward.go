@@ -45,7 +45,10 @@ func (k Keeper) RewardForRelays(ctx sdk.Ctx, reportCard viperTypes.MsgSubmitQoSR
 	// Validate requestor and mint rewards accordingly
 	toNode, toFeeCollector := k.ServicerReward(ctx, coins)
 	if toNode.IsPositive() {
-		k.mint(ctx, toNode, address)
+		// the delegators share the reward of the servicer after the commission of the operator
+		if toOperator := k.rewardDelegators(ctx, validator, toNode); toOperator.IsPositive() {
+			k.mint(ctx, toOperator, address)
+		}
 	}
 	if toFeeCollector.IsPositive() {
 		k.mint(ctx, toFeeCollector, k.getFeePool(ctx).GetAddress())
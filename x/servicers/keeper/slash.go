@@ -31,7 +31,7 @@ func (k Keeper) SlashFisherman(ctx sdk.Ctx, height int64, address sdk.Address) {
 	fraction := k.SlashFractionFisherman(ctx)
 
 	// Burn the calculated amount from the fisherman's stake
-	k.slash(ctx, address, height, val.ConsensusPower(), fraction)
+	k.slash(ctx, address, height, val.ConsensusPower(), fraction, types.AttributeValueInvalidReportCard)
 
 	// Log the slashing event
 	ctx.Logger().Info(fmt.Sprintf("Fisherman %s slashed for submitting invalid report card, Burned.", address.String()))
@@ -48,16 +48,7 @@ func (k Keeper) BurnforNoActivity(ctx sdk.Ctx, height int64, addr sdk.Address) {
 	fraction := k.SlashFractionNoActivity(ctx)
 
 	// Slash the validator for inactivity
-	k.slash(ctx, addr, height, validator.GetConsensusPower(), fraction)
-
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeSlash,
-			sdk.NewAttribute(types.AttributeKeyAddress, validator.GetAddress().String()),
-			sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", validator.GetConsensusPower())),
-			sdk.NewAttribute(types.AttributeKeyReason, "NoActivity"),
-		),
-	)
+	k.slash(ctx, addr, height, validator.GetConsensusPower(), fraction, types.AttributeValueNoActivity)
 }
 
 // simpleSlash - Slash validator for an infraction committed at a known height
@@ -68,9 +59,20 @@ func (k Keeper) simpleSlash(ctx sdk.Ctx, addr sdk.Address, amount sdk.BigInt) {
 	if validator.Address.Empty() {
 		return // invalid simple slash
 	}
+	// the delegations leaving the validator bear the fraction of the stake slashed, the height of the infraction is
+	// not known so all of them are slashed
+	slashFactor := sdk.OneDec()
+	if validator.StakedTokens.IsPositive() {
+		slashFactor = sdk.MinDec(amount.ToDec().Quo(validator.StakedTokens.ToDec()), sdk.OneDec())
+	}
+	unbondingBurned := k.slashUnbondingDelegations(ctx, addr, 0, slashFactor)
+	unbondingBurned = unbondingBurned.Add(k.slashRedelegations(ctx, addr, 0, slashFactor))
+	slashAmount := sdk.MaxInt(amount.Sub(unbondingBurned), sdk.ZeroInt())
 	// cannot decrease balance below zero
-	tokensToBurn := sdk.MinInt(amount, validator.StakedTokens)
+	tokensToBurn := sdk.MinInt(slashAmount, validator.StakedTokens)
 	tokensToBurn = sdk.MaxInt(tokensToBurn, sdk.ZeroInt()) // defensive.
+	// the delegations bear their part of the slash
+	k.slashDelegations(ctx, validator, tokensToBurn)
 	validator, err := k.removeValidatorTokens(ctx, validator, tokensToBurn)
 	if err != nil {
 		k.Logger(ctx).Error("could not remove staked tokens in simpleSlash: " + err.Error() + "\nfor validator " + addr.String())
@@ -81,8 +83,11 @@ func (k Keeper) simpleSlash(ctx sdk.Ctx, addr sdk.Address, amount sdk.BigInt) {
 		k.Logger(ctx).Error("could not burn staked tokens in simpleSlash: " + err.Error() + "\nfor validator " + addr.String())
 		return
 	}
+	// the rest is burned from the stake the validator is unbonding
+	unbondingBurned = unbondingBurned.Add(k.burnUnbondingStakes(ctx, addr, slashAmount.Sub(tokensToBurn)))
+	emitSlashEvent(ctx, addr, validator.GetConsensusPower(), tokensToBurn.Add(unbondingBurned), types.AttributeValueChallenge)
 	// if falls below minimum force burn all of the stake
-	if validator.GetTokens().LT(sdk.NewInt(k.MinimumStake(ctx))) {
+	if k.GetSelfStake(ctx, validator).LT(sdk.NewInt(k.MinimumStake(ctx))) {
 		var err error
 		err = k.ForceValidatorUnstake(ctx, validator)
 
@@ -119,8 +124,8 @@ func (k Keeper) validateSimpleSlash(ctx sdk.Ctx, addr sdk.Address, amount sdk.Bi
 }
 
 // slash - Slash a validator for an infraction committed at a known height
-// Find the contributing stake at that height and burn the specified slashFactor
-func (k Keeper) slash(ctx sdk.Ctx, addr sdk.Address, infractionHeight, power int64, slashFactor sdk.BigDec) {
+// Find the contributing stake at that height and burn the specified slashFactor, the reason is emitted with the slash event
+func (k Keeper) slash(ctx sdk.Ctx, addr sdk.Address, infractionHeight, power int64, slashFactor sdk.BigDec, reason string) {
 	// error check slash
 	validator := k.validateSlash(ctx, addr, infractionHeight, power, slashFactor)
 	if validator.Address == nil {
@@ -130,9 +135,16 @@ func (k Keeper) slash(ctx sdk.Ctx, addr sdk.Address, infractionHeight, power int
 	// Amount of slashing = slash slashFactor * power at time of infraction
 	amount := sdk.TokensFromConsensusPower(power)
 	slashAmount := amount.ToDec().Mul(slashFactor).TruncateInt()
+	// the stake unbonding since the infraction was part of the power, it is slashed first
+	unbondingBurned := k.slashUnbondingStakes(ctx, addr, infractionHeight, slashFactor)
+	unbondingBurned = unbondingBurned.Add(k.slashUnbondingDelegations(ctx, addr, infractionHeight, slashFactor))
+	unbondingBurned = unbondingBurned.Add(k.slashRedelegations(ctx, addr, infractionHeight, slashFactor))
+	slashAmount = sdk.MaxInt(slashAmount.Sub(unbondingBurned), sdk.ZeroInt())
 	// cannot decrease balance below zero
 	tokensToBurn := sdk.MinInt(slashAmount, validator.StakedTokens)
 	tokensToBurn = sdk.MaxInt(tokensToBurn, sdk.ZeroInt()) // defensive.
+	// the delegations bear their part of the slash
+	k.slashDelegations(ctx, validator, tokensToBurn)
 	// Deduct from validator's staked tokens and update the validator.
 	// Burn the slashed tokens from the pool account and decrease the total supply.
 	validator, err := k.removeValidatorTokens(ctx, validator, tokensToBurn)
@@ -145,8 +157,9 @@ func (k Keeper) slash(ctx sdk.Ctx, addr sdk.Address, infractionHeight, power int
 		k.Logger(ctx).Error("could not burn staked tokens in slash: " + err.Error() + "\nfor validator " + addr.String())
 		return
 	}
+	emitSlashEvent(ctx, addr, power, tokensToBurn.Add(unbondingBurned), reason)
 	// if falls below minimum force burn all of the stake
-	if validator.GetTokens().LT(sdk.NewInt(k.MinimumStake(ctx))) {
+	if k.GetSelfStake(ctx, validator).LT(sdk.NewInt(k.MinimumStake(ctx))) {
 		var err error
 		err = k.ForceValidatorUnstake(ctx, validator)
 
@@ -160,6 +173,19 @@ func (k Keeper) slash(ctx sdk.Ctx, addr sdk.Address, infractionHeight, power int
 		validator.GetAddress(), slashFactor.String(), tokensToBurn))
 }
 
+// emitSlashEvent - Emit the event of the tokens burned from the stake of a validator
+func emitSlashEvent(ctx sdk.Ctx, addr sdk.Address, power int64, burned sdk.BigInt, reason string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSlash,
+			sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, burned.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		),
+	)
+}
+
 // validateSlash - Check if slash  is possible
 func (k Keeper) validateSlash(ctx sdk.Ctx, addr sdk.Address, infractionHeight int64, power int64, slashFactor sdk.BigDec) types.Validator {
 	logger := k.Logger(ctx)
@@ -201,15 +227,7 @@ func (k Keeper) handleDoubleSign(ctx sdk.Ctx, addr crypto.Address, infractionHei
 	// slash validator
 	// `power` is the int64 power of the validator as provided to/by Tendermint. This value is validator.StakedTokens as
 	// sent to Tendermint via ABCI, and now received as evidence. The fraction is passed in to separately to slash
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.EventTypeSlash,
-			sdk.NewAttribute(types.AttributeKeyAddress, address.String()),
-			sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
-			sdk.NewAttribute(types.AttributeKeyReason, types.AttributeValueDoubleSign),
-		),
-	)
-	k.slash(ctx, address, distributionHeight, power, fraction)
+	k.slash(ctx, address, distributionHeight, power, fraction, types.AttributeValueDoubleSign)
 	// todo fix once tendermint is patched
 }
 
@@ -289,7 +307,7 @@ func (k Keeper) handleValidatorSignature(ctx sdk.Ctx, addr sdk.Address, power in
 			// height where the infraction occured
 			slashHeight := ctx.BlockHeight() - sdk.ValidatorUpdateDelay - 1
 			// slash them based on their power
-			k.slash(ctx, addr, slashHeight, power, slashFractionDowtime)
+			k.slash(ctx, addr, slashHeight, power, slashFractionDowtime, types.AttributeValueMissingSignature)
 			// reset the signing info
 			signInfo.ResetSigningInfo()
 			// clear the validator missed at
@@ -309,7 +327,7 @@ func (k Keeper) handleValidatorSignature(ctx sdk.Ctx, addr sdk.Address, power in
 			validator.ReportCard.TotalLatencyScore.LT(minScore) {
 			// Slash and jail the validator
 			slashHeight := ctx.BlockHeight() - sdk.ValidatorUpdateDelay - 1
-			k.slash(ctx, addr, slashHeight, power, slashFractionBadPerformance)
+			k.slash(ctx, addr, slashHeight, power, slashFractionBadPerformance, types.AttributeValueBadPerformance)
 			// reset the signing info
 			signInfo.ResetSigningInfo()
 			// clear the validator missed at
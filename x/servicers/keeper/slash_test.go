@@ -431,7 +431,7 @@ func TestSlash(t *testing.T) {
 				fraction = keeper.SlashFractionDoubleSign(context)
 			}
 
-			keeper.slash(context, sdk.Address(cryptoAddr), infractionHeight, test.args.power, fraction)
+			keeper.slash(context, sdk.Address(cryptoAddr), infractionHeight, test.args.power, fraction, types.AttributeValueDoubleSign)
 			validator, found := keeper.GetValidator(context, sdk.Address(cryptoAddr))
 			if !found {
 				t.Fail()
@@ -0,0 +1,206 @@
+package keeper
+
+import (
+	"fmt"
+	"os"
+
+	sdk "github.com/vipernet-xyz/viper-network/types"
+	"github.com/vipernet-xyz/viper-network/x/servicers/types"
+)
+
+// SetUnbondingStake - Store the unbonding stake and its position in the unbonding queue
+func (k Keeper) SetUnbondingStake(ctx sdk.Ctx, unbonding types.UnbondingStake) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := k.Cdc.ProtoMarshalBinaryBare(&unbonding)
+	if err != nil {
+		k.Logger(ctx).Error(fmt.Errorf("error marshalling unbonding stake %v at height: %d, err: %s", unbonding, ctx.BlockHeight(), err.Error()).Error())
+		os.Exit(1)
+	}
+	_ = store.Set(types.KeyForUnbondingStake(unbonding.Address, unbonding.CompletionTime), bz)
+	_ = store.Set(types.KeyForUnbondingStakeInQueue(unbonding.CompletionTime, unbonding.Address), types.KeyForUnbondingStake(unbonding.Address, unbonding.CompletionTime))
+}
+
+// GetUnbondingStakes - Retrieve the unbonding stakes of the validator, ordered by completion time
+func (k Keeper) GetUnbondingStakes(ctx sdk.Ctx, addr sdk.Address) (unbondings []types.UnbondingStake) {
+	unbondings = make([]types.UnbondingStake, 0)
+	k.iterateUnbondingStakes(ctx, types.KeyForUnbondingStakesOfValidator(addr), func(unbonding types.UnbondingStake) (stop bool) {
+		unbondings = append(unbondings, unbonding)
+		return false
+	})
+	return
+}
+
+// GetAllUnbondingStakes - Retrieve the unbonding stakes of all the validators
+func (k Keeper) GetAllUnbondingStakes(ctx sdk.Ctx) (unbondings []types.UnbondingStake) {
+	k.iterateUnbondingStakes(ctx, types.UnbondingStakesKey, func(unbonding types.UnbondingStake) (stop bool) {
+		unbondings = append(unbondings, unbonding)
+		return false
+	})
+	return
+}
+
+// getUnbondingStake - Retrieve the unbonding stake stored at the key
+func (k Keeper) getUnbondingStake(ctx sdk.Ctx, key []byte) (unbonding types.UnbondingStake, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz, _ := store.Get(key)
+	if bz == nil {
+		return unbonding, false
+	}
+	if err := k.Cdc.ProtoUnmarshalBinaryBare(bz, &unbonding); err != nil {
+		k.Logger(ctx).Error(fmt.Errorf("error unmarshalling unbonding stake %v at height: %d, err: %s", bz, ctx.BlockHeight(), err.Error()).Error())
+		return unbonding, false
+	}
+	return unbonding, true
+}
+
+// deleteUnbondingStake - Remove the unbonding stake and its position in the unbonding queue
+func (k Keeper) deleteUnbondingStake(ctx sdk.Ctx, unbonding types.UnbondingStake) {
+	store := ctx.KVStore(k.storeKey)
+	_ = store.Delete(types.KeyForUnbondingStake(unbonding.Address, unbonding.CompletionTime))
+	_ = store.Delete(types.KeyForUnbondingStakeInQueue(unbonding.CompletionTime, unbonding.Address))
+}
+
+func (k Keeper) iterateUnbondingStakes(ctx sdk.Ctx, prefix []byte, process func(types.UnbondingStake) (stop bool)) {
+	store := ctx.KVStore(k.storeKey)
+	iter, _ := sdk.KVStorePrefixIterator(store, prefix)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		var unbonding types.UnbondingStake
+		if err := k.Cdc.ProtoUnmarshalBinaryBare(iter.Value(), &unbonding); err != nil {
+			k.Logger(ctx).Error(fmt.Errorf("error while iterating unbonding stakes: unmarshalling %v at height: %d, err: %s", iter.Value(), ctx.BlockHeight(), err.Error()).Error())
+			continue
+		}
+		if process(unbonding) {
+			return
+		}
+	}
+}
+
+// ValidateUnstakePartial - Check the validator can remove the amount from its stake
+func (k Keeper) ValidateUnstakePartial(ctx sdk.Ctx, msg types.MsgUnstakePartial) (types.Validator, sdk.Error) {
+	validator, found := k.GetValidator(ctx, msg.Address)
+	if !found {
+		return validator, types.ErrNoValidatorFound(k.Codespace())
+	}
+	if err, valid := ValidateValidatorMsgSigner(validator, msg.Signer, k); !valid {
+		return validator, err
+	}
+	// must be staked to remove part of the stake
+	if !validator.IsStaked() {
+		return validator, types.ErrValidatorStatus(k.Codespace())
+	}
+	if validator.IsJailed() {
+		return validator, types.ErrValidatorJailed(k.Codespace())
+	}
+	if k.IsWaitingValidator(ctx, validator.Address) {
+		return validator, types.ErrValidatorWaitingToUnstake(k.Codespace())
+	}
+	// only the stake of the operator above the minimum can be removed, the delegations are removed by their delegators
+	selfStake := k.GetSelfStake(ctx, validator)
+	if selfStake.Sub(msg.Amount).LT(sdk.NewInt(k.MinimumStake(ctx))) {
+		return validator, types.ErrUnstakePartialAmount(k.Codespace(),
+			fmt.Errorf("the stake of %s can't go below the minimum stake of %d", selfStake, k.MinimumStake(ctx)))
+	}
+	return validator, nil
+}
+
+// UnstakePartialValidator - Store ops to move the amount from the stake of the validator to the unbonding queue,
+// the amount stays in the staked pool until the unstaking time is over
+func (k Keeper) UnstakePartialValidator(ctx sdk.Ctx, validator types.Validator, amount sdk.BigInt) (types.UnbondingStake, sdk.Error) {
+	validator, err := k.removeValidatorTokens(ctx, validator, amount)
+	if err != nil {
+		return types.UnbondingStake{}, sdk.ErrInternal(err.Error())
+	}
+	output, _ := k.GetValidatorOutputAddress(ctx, validator.Address)
+	unbonding := types.NewUnbondingStake(validator.Address, output, amount, ctx.BlockHeight(), ctx.BlockHeader().Time.Add(k.UnStakingTime(ctx)))
+	// the partial unstakes of the same block complete together
+	if existing, found := k.getUnbondingStake(ctx, types.KeyForUnbondingStake(unbonding.Address, unbonding.CompletionTime)); found {
+		unbonding.Amount = unbonding.Amount.Add(existing.Amount)
+	}
+	k.SetUnbondingStake(ctx, unbonding)
+	ctx.Logger().Info(fmt.Sprintf("Began unbonding %s of the stake of validator %s", amount, validator.Address))
+	return unbonding, nil
+}
+
+// releaseMatureUnbondingStakes - Send the unbonding stakes that finished their unstaking period to their output address
+func (k Keeper) releaseMatureUnbondingStakes(ctx sdk.Ctx) {
+	// the queue stores the keys of the unbonding stakes
+	for _, key := range k.matureQueueKeys(ctx, types.UnbondingStakesQueueKey, types.KeyForUnbondingStakesQueueTime(ctx.BlockHeader().Time)) {
+		unbonding, found := k.getUnbondingStake(ctx, key)
+		if !found {
+			ctx.Logger().Error(fmt.Sprintf("unbonding stake in the queue was not found at height: %d", ctx.BlockHeight()))
+			continue
+		}
+		k.deleteUnbondingStake(ctx, unbonding)
+		coins := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), unbonding.Amount))
+		if err := k.AccountKeeper.UndelegateCoinsFromModuleToAccount(ctx, types.StakedPoolName, unbonding.OutputAddress, coins); err != nil {
+			k.Logger(ctx).Error(fmt.Sprintf("unable to send the unbonding stake of %s: %s", unbonding.Address, err.Error()))
+			continue
+		}
+		ctx.Logger().Info(fmt.Sprintf("Finished unbonding %s of the stake of validator %s", unbonding.Amount, unbonding.Address))
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCompleteUnbonding,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(sdk.AttributeKeySender, unbonding.Address.String()),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, unbonding.Amount.String()),
+			),
+		)
+	}
+}
+
+// matureQueueKeys - Retrieve the keys stored in the queue up to the time prefix, included
+func (k Keeper) matureQueueKeys(ctx sdk.Ctx, queue, timePrefix []byte) (keys [][]byte) {
+	store := ctx.KVStore(k.storeKey)
+	iterator, _ := store.Iterator(queue, sdk.PrefixEndBytes(timePrefix))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Value())
+	}
+	return
+}
+
+// slashUnbondingStakes - Burn the slash factor of the stakes the validator began unbonding since the infraction,
+// they contributed to the power of the validator at the infraction height. Returns the burned amount
+func (k Keeper) slashUnbondingStakes(ctx sdk.Ctx, addr sdk.Address, infractionHeight int64, slashFactor sdk.BigDec) sdk.BigInt {
+	burned := sdk.ZeroInt()
+	for _, unbonding := range k.GetUnbondingStakes(ctx, addr) {
+		if unbonding.CreationHeight < infractionHeight {
+			continue
+		}
+		burned = burned.Add(k.burnUnbondingStake(ctx, unbonding, unbonding.Amount.ToDec().Mul(slashFactor).TruncateInt()))
+	}
+	return burned
+}
+
+// burnUnbondingStakes - Burn up to the amount from the unbonding stakes of the validator, in the order of their
+// completion. Returns the burned amount
+func (k Keeper) burnUnbondingStakes(ctx sdk.Ctx, addr sdk.Address, amount sdk.BigInt) sdk.BigInt {
+	burned := sdk.ZeroInt()
+	for _, unbonding := range k.GetUnbondingStakes(ctx, addr) {
+		if !amount.Sub(burned).IsPositive() {
+			break
+		}
+		burned = burned.Add(k.burnUnbondingStake(ctx, unbonding, amount.Sub(burned)))
+	}
+	return burned
+}
+
+// burnUnbondingStake - Burn up to the amount from the unbonding stake, returns the burned amount
+func (k Keeper) burnUnbondingStake(ctx sdk.Ctx, unbonding types.UnbondingStake, amount sdk.BigInt) sdk.BigInt {
+	tokensToBurn := sdk.MinInt(amount, unbonding.Amount)
+	if !tokensToBurn.IsPositive() {
+		return sdk.ZeroInt()
+	}
+	if err := k.burnStakedTokens(ctx, tokensToBurn); err != nil {
+		k.Logger(ctx).Error("could not burn unbonding stake: " + err.Error() + "\nfor validator " + unbonding.Address.String())
+		return sdk.ZeroInt()
+	}
+	unbonding.Amount = unbonding.Amount.Sub(tokensToBurn)
+	if unbonding.Amount.IsZero() {
+		k.deleteUnbondingStake(ctx, unbonding)
+	} else {
+		k.SetUnbondingStake(ctx, unbonding)
+	}
+	return tokensToBurn
+}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sdk "github.com/vipernet-xyz/viper-network/types"
+	"github.com/vipernet-xyz/viper-network/x/servicers/types"
+)
+
+func TestValidatorStateChange_UnstakePartial(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	validator := getStakedValidator()
+	validator.OutputAddress = getRandomValidatorAddress()
+	keeper.SetValidator(context, validator)
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	excess := validator.StakedTokens.Sub(sdk.NewInt(keeper.MinimumStake(context)))
+	msg := types.MsgUnstakePartial{Address: validator.Address, Amount: excess.Add(sdk.OneInt()), Signer: validator.Address}
+	// the stake can't go below the minimum
+	_, err := keeper.ValidateUnstakePartial(context, msg)
+	require.NotNil(t, err)
+	assert.Equal(t, types.CodeUnstakePartialAmount, err.Code())
+	// only the operator or the output address sign the partial unstake
+	msg.Amount, msg.Signer = excess, getRandomValidatorAddress()
+	_, err = keeper.ValidateUnstakePartial(context, msg)
+	assert.NotNil(t, err)
+	msg.Signer = validator.OutputAddress
+	validator, err = keeper.ValidateUnstakePartial(context, msg)
+	require.Nil(t, err)
+	unbonding, err := keeper.UnstakePartialValidator(context, validator, msg.Amount)
+	require.Nil(t, err)
+	assert.Equal(t, types.NewUnbondingStake(validator.Address, validator.OutputAddress, excess, context.BlockHeight(),
+		context.BlockHeader().Time.Add(keeper.UnStakingTime(context))), unbonding)
+	validator, found := keeper.GetValidator(context, validator.Address)
+	require.True(t, found)
+	assert.Equal(t, sdk.NewInt(keeper.MinimumStake(context)), validator.StakedTokens)
+	assert.Equal(t, []types.UnbondingStake{unbonding}, keeper.GetUnbondingStakes(context, validator.Address))
+	assert.Equal(t, []types.UnbondingStake{unbonding}, keeper.GetAllUnbondingStakes(context))
+	// the stake is released once the unstaking time is over
+	keeper.releaseMatureUnbondingStakes(context.WithBlockTime(unbonding.CompletionTime.Add(-time.Second)))
+	assert.Len(t, keeper.GetUnbondingStakes(context, validator.Address), 1)
+	assert.True(t, keeper.GetBalance(context, validator.OutputAddress).IsZero())
+	keeper.releaseMatureUnbondingStakes(context.WithBlockTime(unbonding.CompletionTime))
+	assert.Empty(t, keeper.GetUnbondingStakes(context, validator.Address))
+	assert.Equal(t, excess, keeper.GetBalance(context, validator.OutputAddress))
+}
+
+func TestSlash_UnbondingStakes(t *testing.T) {
+	context, _, keeper := createTestInput(t, true)
+	validator := getStakedValidator()
+	keeper.SetValidator(context, validator)
+	addMintedCoinsToModule(t, context, &keeper, types.StakedPoolName)
+	completion := context.BlockHeader().Time.Add(keeper.UnStakingTime(context))
+	before := types.NewUnbondingStake(validator.Address, validator.Address, sdk.NewInt(1000), 10, completion)
+	after := types.NewUnbondingStake(validator.Address, validator.Address, sdk.NewInt(2000), 20, completion.Add(time.Hour))
+	keeper.SetUnbondingStake(context, before)
+	keeper.SetUnbondingStake(context, after)
+	// only the stakes unbonding since the infraction are slashed
+	burned := keeper.slashUnbondingStakes(context, validator.Address, 15, sdk.NewDecWithPrec(5, 1))
+	assert.Equal(t, sdk.NewInt(1000), burned)
+	unbondings := keeper.GetUnbondingStakes(context, validator.Address)
+	require.Len(t, unbondings, 2)
+	assert.Equal(t, sdk.NewInt(1000), unbondings[0].Amount)
+	assert.Equal(t, sdk.NewInt(1000), unbondings[1].Amount)
+	// the burns take from the stakes completing first
+	burned = keeper.burnUnbondingStakes(context, validator.Address, sdk.NewInt(1500))
+	assert.Equal(t, sdk.NewInt(1500), burned)
+	unbondings = keeper.GetUnbondingStakes(context, validator.Address)
+	require.Len(t, unbondings, 1)
+	assert.Equal(t, sdk.NewInt(500), unbondings[0].Amount)
+	assert.Equal(t, int64(20), unbondings[0].CreationHeight)
+	burned = keeper.burnUnbondingStakes(context, validator.Address, sdk.NewInt(1500))
+	assert.Equal(t, sdk.NewInt(500), burned)
+	assert.Empty(t, keeper.GetUnbondingStakes(context, validator.Address))
+}
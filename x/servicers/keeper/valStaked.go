@@ -120,6 +120,17 @@ func (k Keeper) removeValidatorTokens(ctx sdk.Ctx, v types.Validator, tokensToRe
 	return v, nil
 }
 
+// addValidatorTokens - Update the staked tokens of an existing validator, update the validators power index key
+func (k Keeper) addValidatorTokens(ctx sdk.Ctx, v types.Validator, tokensToAdd sdk.BigInt) (types.Validator, error) {
+	k.deleteValidatorFromStakingSet(ctx, v)
+	v, err := v.AddStakedTokens(tokensToAdd)
+	if err != nil {
+		return v, err
+	}
+	k.SetValidator(ctx, v)
+	return v, nil
+}
+
 // GetStakedValidators - Retrieve StakedValidators
 func (k Keeper) GetStakedValidators(ctx sdk.Ctx) (validators []exported.ValidatorI) {
 	store := ctx.KVStore(k.storeKey)
@@ -13,6 +13,7 @@ import (
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	sdk "github.com/vipernet-xyz/viper-network/types"
+	authexported "github.com/vipernet-xyz/viper-network/x/authentication/exported"
 	"github.com/vipernet-xyz/viper-network/x/servicers/types"
 )
 
@@ -139,6 +140,12 @@ func (k Keeper) ValidateValidatorStaking(ctx sdk.Ctx, validatorNew types.Validat
 		return types.ErrNilOutputAddr(k.codespace)
 	}
 
+	// the vesting coins staked by a vesting account are returned to it when unstaking,
+	// so the locked coins can't be transferred through the output address
+	if err := k.validateVestingOutputAddress(ctx, validatorCur, found, validatorNew, signerAddress); err != nil {
+		return err
+	}
+
 	coin := sdk.NewCoins(sdk.NewCoin(k.StakeDenom(ctx), amount))
 
 	if int64(len(validatorNew.Chains)) > k.MaxChains(ctx) {
@@ -185,6 +192,21 @@ func (k Keeper) ValidateValidatorStaking(ctx sdk.Ctx, validatorNew types.Validat
 	return nil
 }
 
+// validateVestingOutputAddress - Check the output address is the staking vesting account and
+// that the output address of a vesting account isn't edited
+func (k Keeper) validateVestingOutputAddress(ctx sdk.Ctx, validatorCur types.Validator, found bool, validatorNew types.Validator, signerAddress sdk.Address) sdk.Error {
+	if _, ok := k.AccountKeeper.GetAccount(ctx, signerAddress).(authexported.VestingAccount); ok && !validatorNew.OutputAddress.Equals(signerAddress) {
+		return types.ErrVestingOutputAddr(k.codespace)
+	}
+	if !found || validatorCur.OutputAddress == nil || validatorCur.OutputAddress.Equals(validatorNew.OutputAddress) {
+		return nil
+	}
+	if _, ok := k.AccountKeeper.GetAccount(ctx, validatorCur.OutputAddress).(authexported.VestingAccount); ok {
+		return types.ErrVestingOutputAddr(k.codespace)
+	}
+	return nil
+}
+
 // ValidateValidatorMsgSigner Check Validator Signature
 func ValidateValidatorMsgSigner(validator types.Validator, signerAddress sdk.Address, k Keeper) (sdk.Error, bool) {
 	//check if outputAddress is defined, if not only the operator/servicer signature is valid
@@ -202,8 +224,8 @@ func ValidateValidatorMsgSigner(validator types.Validator, signerAddress sdk.Add
 
 // ValidateEditStake - Validate the updates to a current staked validator
 func (k Keeper) ValidateEditStake(ctx sdk.Ctx, currentValidator, newValidtor types.Validator, amount sdk.BigInt, signer sdk.Address) sdk.Error {
-	// ensure not staking less
-	diff := amount.Sub(currentValidator.StakedTokens)
+	// ensure not staking less, the amount is the stake of the operator without the delegations
+	diff := amount.Sub(k.GetSelfStake(ctx, currentValidator))
 	if diff.IsNegative() {
 		return types.ErrMinimumEditStake(k.codespace)
 	}
@@ -279,8 +301,8 @@ func (k Keeper) StakeValidator(ctx sdk.Ctx, validator types.Validator, amount sd
 // EditStakeValidator - Edit an already staked validator with the staking message
 func (k Keeper) EditStakeValidator(ctx sdk.Ctx, currentValidator, updatedValidator types.Validator, amount sdk.BigInt, signer crypto.PublicKey) sdk.Error {
 	origValForDeletion := currentValidator
-	// get the difference in coins
-	diff := amount.Sub(currentValidator.StakedTokens)
+	// get the difference in coins with the stake of the operator, the delegations are not its own
+	diff := amount.Sub(k.GetSelfStake(ctx, currentValidator))
 	// if they bumped the stake amount
 	if diff.IsPositive() {
 		// send the coins from address to staked module account
@@ -407,6 +429,8 @@ func (k Keeper) ValidateValidatorFinishUnstaking(ctx sdk.Ctx, validator types.Va
 func (k Keeper) FinishUnstakingValidator(ctx sdk.Ctx, validator types.Validator) {
 	// delete the validator from the unstaking queue
 	k.deleteUnstakingValidator(ctx, validator)
+	// send the delegated tokens back to the delegators
+	validator = k.releaseDelegations(ctx, validator)
 	// amount unstaked = stakedTokens
 	amount := validator.StakedTokens
 	// send the tokens from staking module account to validator account
@@ -640,6 +664,12 @@ func (k Keeper) UnjailValidator(ctx sdk.Ctx, addr sdk.Address) {
 	k.SetValidator(ctx, validator)
 	k.ResetValidatorSigningInfo(ctx, addr)
 	k.Logger(ctx).Info(fmt.Sprintf("validator %s unjailed", addr))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnjail,
+			sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+		),
+	)
 }
 
 // PauseNode - Pause a validator
@@ -726,6 +756,12 @@ func (k Keeper) UnpauseNode(ctx sdk.Ctx, addr sdk.Address) {
 	validator.Paused = false
 	k.SetValidator(ctx, validator)
 	k.Logger(ctx).Info(fmt.Sprintf("validator %s unpaused", addr))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUnpause,
+			sdk.NewAttribute(types.AttributeKeyAddress, addr.String()),
+		),
+	)
 }
 
 // ValidateUnpauseNodeMessage - Check unpause node message
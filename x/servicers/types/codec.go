@@ -19,10 +19,15 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterStructure(MsgStake{}, "pos/MsgStake")
 	cdc.RegisterStructure(MsgPause{}, "pos/MsgPause")
 	cdc.RegisterStructure(MsgUnpause{}, "pos/MsgUnPause")
+	cdc.RegisterStructure(MsgUnstakePartial{}, "pos/MsgUnstakePartial")
+	cdc.RegisterStructure(MsgDelegate{}, "pos/MsgDelegate")
+	cdc.RegisterStructure(MsgUndelegate{}, "pos/MsgUndelegate")
+	cdc.RegisterStructure(MsgRedelegate{}, "pos/MsgRedelegate")
+	cdc.RegisterStructure(MsgSetCommission{}, "pos/MsgSetCommission")
 	cdc.RegisterImplementation((*sdk.ProtoMsg)(nil), &MsgUnjail{}, &MsgBeginUnstake{}, &MsgSend{}, &MsgStake{},
-		&MsgPause{}, &MsgUnpause{})
+		&MsgPause{}, &MsgUnpause{}, &MsgUnstakePartial{}, &MsgDelegate{}, &MsgUndelegate{}, &MsgRedelegate{}, &MsgSetCommission{})
 	cdc.RegisterImplementation((*sdk.Msg)(nil), &MsgUnjail{}, &MsgBeginUnstake{}, &MsgSend{}, &MsgStake{},
-		&MsgPause{}, &MsgUnpause{})
+		&MsgPause{}, &MsgUnpause{}, &MsgUnstakePartial{}, &MsgDelegate{}, &MsgUndelegate{}, &MsgRedelegate{}, &MsgSetCommission{})
 	cdc.RegisterInterface("servicers/validatorI", (*exported.ValidatorI)(nil), &Validator{})
 	ModuleCdc = cdc
 }
@@ -0,0 +1,257 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/vipernet-xyz/viper-network/types"
+)
+
+const (
+	MsgDelegateName      = "delegate"
+	MsgUndelegateName    = "undelegate"
+	MsgRedelegateName    = "redelegate"
+	MsgSetCommissionName = "set_commission"
+)
+
+// NewDelegation creates the delegation of the amount from the delegator to the validator
+func NewDelegation(delegator, validator sdk.Address, amount sdk.BigInt) Delegation {
+	return Delegation{
+		DelegatorAddress: delegator,
+		ValidatorAddress: validator,
+		Amount:           amount,
+	}
+}
+
+// ValidateBasic does a stateless check of the delegation
+func (d Delegation) ValidateBasic() sdk.Error {
+	if d.DelegatorAddress.Empty() {
+		return ErrNilDelegatorAddr(DefaultCodespace)
+	}
+	if d.ValidatorAddress.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if d.Amount.IsZero() || d.Amount.IsNegative() {
+		return ErrDelegationAmount(DefaultCodespace, fmt.Errorf("the delegated amount must be positive"))
+	}
+	return nil
+}
+
+// NewUnbondingDelegation creates the unbonding of the amount undelegated from the validator at the block height
+func NewUnbondingDelegation(delegator, validator sdk.Address, amount sdk.BigInt, height int64, completionTime time.Time) UnbondingDelegation {
+	return UnbondingDelegation{
+		DelegatorAddress: delegator,
+		ValidatorAddress: validator,
+		Amount:           amount,
+		CreationHeight:   height,
+		CompletionTime:   completionTime,
+	}
+}
+
+// ValidateBasic does a stateless check of the unbonding delegation
+func (u UnbondingDelegation) ValidateBasic() sdk.Error {
+	if err := NewDelegation(u.DelegatorAddress, u.ValidatorAddress, u.Amount).ValidateBasic(); err != nil {
+		return err
+	}
+	if u.CreationHeight < 0 {
+		return ErrInvalidCreationHeight(DefaultCodespace, u.CreationHeight)
+	}
+	return nil
+}
+
+// NewRedelegation creates the redelegation of the amount from the source to the destination validator at the block height
+func NewRedelegation(delegator, src, dst sdk.Address, amount sdk.BigInt, height int64, completionTime time.Time) Redelegation {
+	return Redelegation{
+		DelegatorAddress:    delegator,
+		SrcValidatorAddress: src,
+		DstValidatorAddress: dst,
+		Amount:              amount,
+		CreationHeight:      height,
+		CompletionTime:      completionTime,
+	}
+}
+
+// ValidateBasic does a stateless check of the redelegation
+func (r Redelegation) ValidateBasic() sdk.Error {
+	if err := NewDelegation(r.DelegatorAddress, r.SrcValidatorAddress, r.Amount).ValidateBasic(); err != nil {
+		return err
+	}
+	if r.DstValidatorAddress.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if r.SrcValidatorAddress.Equals(r.DstValidatorAddress) {
+		return ErrInvalidRedelegation(DefaultCodespace, fmt.Errorf("the source and destination validators are the same"))
+	}
+	if r.CreationHeight < 0 {
+		return ErrInvalidCreationHeight(DefaultCodespace, r.CreationHeight)
+	}
+	return nil
+}
+
+// NewCommission creates the commission of the validator
+func NewCommission(validator sdk.Address, rate sdk.BigDec) Commission {
+	return Commission{
+		ValidatorAddress: validator,
+		Rate:             rate,
+	}
+}
+
+// ValidateBasic does a stateless check of the commission
+func (c Commission) ValidateBasic() sdk.Error {
+	if c.ValidatorAddress.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	return validateCommissionRate(c.Rate)
+}
+
+func validateCommissionRate(rate sdk.BigDec) sdk.Error {
+	if rate.IsNil() || rate.IsNegative() || rate.GT(sdk.OneDec()) {
+		return ErrInvalidCommission(DefaultCodespace)
+	}
+	return nil
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+var (
+	_ sdk.ProtoMsg = &MsgDelegate{}
+	_ sdk.ProtoMsg = &MsgUndelegate{}
+	_ sdk.ProtoMsg = &MsgRedelegate{}
+	_ sdk.ProtoMsg = &MsgSetCommission{}
+)
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgDelegate) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.Delegator}
+}
+
+func (msg MsgDelegate) GetRecipient() sdk.Address {
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgDelegate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check, stateless
+func (msg MsgDelegate) ValidateBasic() sdk.Error {
+	return NewDelegation(msg.Delegator, msg.Validator, msg.Amount).ValidateBasic()
+}
+
+// Route provides router key for msg
+func (msg MsgDelegate) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgDelegate) Type() string { return MsgDelegateName }
+
+// GetFee get fee for msg
+func (msg MsgDelegate) GetFee() sdk.BigInt {
+	return sdk.NewInt(NodeFeeMap[msg.Type()])
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgUndelegate) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.Delegator}
+}
+
+func (msg MsgUndelegate) GetRecipient() sdk.Address {
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgUndelegate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check, stateless
+func (msg MsgUndelegate) ValidateBasic() sdk.Error {
+	return NewDelegation(msg.Delegator, msg.Validator, msg.Amount).ValidateBasic()
+}
+
+// Route provides router key for msg
+func (msg MsgUndelegate) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgUndelegate) Type() string { return MsgUndelegateName }
+
+// GetFee get fee for msg
+func (msg MsgUndelegate) GetFee() sdk.BigInt {
+	return sdk.NewInt(NodeFeeMap[msg.Type()])
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgRedelegate) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.Delegator}
+}
+
+func (msg MsgRedelegate) GetRecipient() sdk.Address {
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgRedelegate) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check, stateless
+func (msg MsgRedelegate) ValidateBasic() sdk.Error {
+	return NewRedelegation(msg.Delegator, msg.SrcValidator, msg.DstValidator, msg.Amount, 0, time.Time{}).ValidateBasic()
+}
+
+// Route provides router key for msg
+func (msg MsgRedelegate) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgRedelegate) Type() string { return MsgRedelegateName }
+
+// GetFee get fee for msg
+func (msg MsgRedelegate) GetFee() sdk.BigInt {
+	return sdk.NewInt(NodeFeeMap[msg.Type()])
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgSetCommission) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.Signer, msg.Address}
+}
+
+func (msg MsgSetCommission) GetRecipient() sdk.Address {
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgSetCommission) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check, stateless
+func (msg MsgSetCommission) ValidateBasic() sdk.Error {
+	if msg.Address.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if msg.Signer.Empty() {
+		return ErrNilSignerAddr(DefaultCodespace)
+	}
+	return validateCommissionRate(msg.Rate)
+}
+
+// Route provides router key for msg
+func (msg MsgSetCommission) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgSetCommission) Type() string { return MsgSetCommissionName }
+
+// GetFee get fee for msg
+func (msg MsgSetCommission) GetFee() sdk.BigInt {
+	return sdk.NewInt(NodeFeeMap[msg.Type()])
+}
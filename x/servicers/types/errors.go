@@ -43,6 +43,16 @@ const (
 	CodeValidatorNotPaused          CodeType          = 131
 	CodeValidatorPaused             CodeType          = 132
 	CodeValidatorUnstaked           CodeType          = 133
+	CodeVestingOutputAddr           CodeType          = 134
+	CodeUnstakePartialAmount        CodeType          = 135
+	CodeUnstakePartialInactive      CodeType          = 136
+	CodeDelegationInactive          CodeType          = 137
+	CodeNilDelegatorAddr            CodeType          = 138
+	CodeDelegationAmount            CodeType          = 139
+	CodeNoDelegation                CodeType          = 140
+	CodeInvalidCommission           CodeType          = 141
+	CodeInvalidRedelegation         CodeType          = 142
+	CodeInvalidCreationHeight       CodeType          = 143
 )
 
 func ErrTooManyChains(codespace sdk.CodespaceType) sdk.Error {
@@ -189,3 +199,43 @@ func ErrValidatorPaused(codespace sdk.CodespaceType) sdk.Error {
 func ErrValidatorUnstaked(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeValidatorPaused, "validator unstaked")
 }
+
+func ErrVestingOutputAddr(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeVestingOutputAddr, "the coins staked by a vesting account must be returned to it, the output address must be the vesting account")
+}
+
+func ErrUnstakePartialAmount(codespace sdk.CodespaceType, err error) sdk.Error {
+	return sdk.NewError(codespace, CodeUnstakePartialAmount, "invalid partial unstake amount: "+err.Error())
+}
+
+func ErrUnstakePartialInactive(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeUnstakePartialInactive, "the partial unstakes are not activated yet")
+}
+
+func ErrDelegationInactive(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeDelegationInactive, "the delegations are not activated yet")
+}
+
+func ErrNilDelegatorAddr(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNilDelegatorAddr, "delegator address is nil")
+}
+
+func ErrDelegationAmount(codespace sdk.CodespaceType, err error) sdk.Error {
+	return sdk.NewError(codespace, CodeDelegationAmount, "invalid delegation amount: "+err.Error())
+}
+
+func ErrNoDelegation(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNoDelegation, "no delegation found for the delegator and validator")
+}
+
+func ErrInvalidCommission(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidCommission, "the commission rate must be between 0 and 1")
+}
+
+func ErrInvalidRedelegation(codespace sdk.CodespaceType, err error) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidRedelegation, "invalid redelegation: "+err.Error())
+}
+
+func ErrInvalidCreationHeight(codespace sdk.CodespaceType, height int64) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidCreationHeight, fmt.Sprintf("invalid creation height: %d", height))
+}
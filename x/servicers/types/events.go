@@ -13,16 +13,32 @@ const (
 	EventTypeRequestorAllocation     = "requestor_allocation"
 	EventTypeSlash                   = "slash"
 	EventTypeJail                    = "jail"
+	EventTypeUnjail                  = "unjail"
 	EventTypeLiveness                = "liveness"
 	EventTypePause                   = "paused"
+	EventTypeUnpause                 = "unpaused"
+	EventTypeUnstakePartial          = "unstake_partial"
+	EventTypeCompleteUnbonding       = "complete_unbonding"
+	EventTypeDelegate                = "delegate"
+	EventTypeUndelegate              = "undelegate"
+	EventTypeRedelegate              = "redelegate"
+	EventTypeSetCommission           = "set_commission"
+	EventTypeCompleteUndelegation    = "complete_undelegation"
 	AttributeKeyAddress              = "address"
 	AttributeKeyHeight               = "height"
 	AttributeKeyPower                = "power"
 	AttributeKeyReason               = "reason"
 	AttributeKeyJailed               = "jailed"
 	AttributeKeyMissedBlocks         = "missed_blocks"
+	AttributeKeyCompletionTime       = "completion_time"
+	AttributeKeyDstValidator         = "destination_validator"
+	AttributeKeyCommissionRate       = "commission_rate"
 	AttributeValueDoubleSign         = "double_sign"
 	AttributeValueMissingSignature   = "missing_signature"
+	AttributeValueNoActivity         = "NoActivity"
+	AttributeValueBadPerformance     = "bad_performance"
+	AttributeValueInvalidReportCard  = "invalid_report_card"
+	AttributeValueChallenge          = "challenge"
 	AttributeKeyValidator            = "validator"
 	AttributeValueCategory           = ModuleName
 )
@@ -1,17 +1,27 @@
 package types
 
 const (
-	StakeFee   = 10000
-	UnstakeFee = 10000
-	UnjailFee  = 10000
-	SendFee    = 10000
+	StakeFee          = 10000
+	UnstakeFee        = 10000
+	UnstakePartialFee = 10000
+	UnjailFee         = 10000
+	SendFee           = 10000
+	DelegateFee       = 10000
+	UndelegateFee     = 10000
+	RedelegateFee     = 10000
+	CommissionFee     = 10000
 )
 
 var (
 	NodeFeeMap = map[string]int64{
-		MsgStakeName:   StakeFee,
-		MsgUnstakeName: UnstakeFee,
-		MsgUnjailName:  UnjailFee,
-		MsgSendName:    SendFee,
+		MsgStakeName:          StakeFee,
+		MsgUnstakeName:        UnstakeFee,
+		MsgUnstakePartialName: UnstakePartialFee,
+		MsgUnjailName:         UnjailFee,
+		MsgSendName:           SendFee,
+		MsgDelegateName:       DelegateFee,
+		MsgUndelegateName:     UndelegateFee,
+		MsgRedelegateName:     RedelegateFee,
+		MsgSetCommissionName:  CommissionFee,
 	}
 )
@@ -14,6 +14,16 @@ type GenesisState struct {
 	SigningInfos             map[string]ValidatorSigningInfo `json:"signing_infos" yaml:"signing_infos"`
 	MissedBlocks             map[string][]MissedBlock        `json:"missed_blocks" yaml:"missed_blocks"`
 	PreviousProposer         sdk.Address                     `json:"previous_proposer" yaml:"previous_proposer"`
+	// the stakes removed by partial unstakes that are not released yet
+	UnbondingStakes []UnbondingStake `json:"unbonding_stakes,omitempty" yaml:"unbonding_stakes"`
+	// the delegations to the validators, they are part of the staked tokens of the validators
+	Delegations []Delegation `json:"delegations,omitempty" yaml:"delegations"`
+	// the delegations that are not released yet
+	UnbondingDelegations []UnbondingDelegation `json:"unbonding_delegations,omitempty" yaml:"unbonding_delegations"`
+	// the redelegations that are still slashable for the source validators
+	Redelegations []Redelegation `json:"redelegations,omitempty" yaml:"redelegations"`
+	// the commissions of the validators
+	Commissions []Commission `json:"commissions,omitempty" yaml:"commissions"`
 }
 
 // PrevState validator power, needed for validator set update logic
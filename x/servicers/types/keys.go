@@ -33,6 +33,16 @@ var ( // Keys for store prefixes
 	AwardValidatorKey                    = []byte{0x51} // prefix for awarding validators
 	BurnValidatorKey                     = []byte{0x52} // prefix for burning validators
 	WaitingToBeginUnstakingKey           = []byte{0x43} // prefix for waiting validators
+	UnbondingStakesKey                   = []byte{0x44} // prefix for the stakes removed by partial unstakes
+	UnbondingStakesQueueKey              = []byte{0x45} // prefix for the unbonding stakes by completion time
+	DelegationsKey                       = []byte{0x46} // prefix for the delegations by validator
+	DelegationsByDelegatorKey            = []byte{0x47} // prefix for the delegations by delegator
+	UnbondingDelegationsKey              = []byte{0x48} // prefix for the unbonding delegations by validator
+	UnbondingDelegationsQueueKey         = []byte{0x49} // prefix for the unbonding delegations by completion time
+	RedelegationsKey                     = []byte{0x4A} // prefix for the redelegations by source validator
+	RedelegationsByDstKey                = []byte{0x4B} // prefix for the redelegations by destination validator
+	RedelegationsQueueKey                = []byte{0x4C} // prefix for the redelegations by completion time
+	CommissionsKey                       = []byte{0x4D} // prefix for the commissions of the validators
 	HistoricalInfoKey                    = []byte{0x50} // prefix for the historical info
 	LastValidatorPowerKey                = []byte{0x11} // prefix for each key to a validator index, for bonded validators
 	ReportCardKey                        = []byte{0x70}
@@ -79,6 +89,101 @@ func KeyForUnstakingValidators(unstakingTime time.Time) []byte {
 	return append(UnstakingValidatorsKey, bz...) // use the unstaking time as part of the key
 }
 
+// generates the key for the unbonding stake of the validator completing at the time
+func KeyForUnbondingStake(addr sdk.Address, completionTime time.Time) []byte {
+	return append(KeyForUnbondingStakesOfValidator(addr), sdk.FormatTimeBytes(completionTime)...)
+}
+
+// generates the prefix of the unbonding stakes of the validator
+func KeyForUnbondingStakesOfValidator(addr sdk.Address) []byte {
+	return append(append([]byte{}, UnbondingStakesKey...), addr.Bytes()...)
+}
+
+// generates the key for the unbonding stake of the validator in the queue, ordered by completion time
+func KeyForUnbondingStakeInQueue(completionTime time.Time, addr sdk.Address) []byte {
+	return append(KeyForUnbondingStakesQueueTime(completionTime), addr.Bytes()...)
+}
+
+// generates the prefix of the unbonding stakes completing at the time in the queue
+func KeyForUnbondingStakesQueueTime(completionTime time.Time) []byte {
+	return append(append([]byte{}, UnbondingStakesQueueKey...), sdk.FormatTimeBytes(completionTime)...)
+}
+
+// generates the key for the delegation of the delegator to the validator
+func KeyForDelegation(validator, delegator sdk.Address) []byte {
+	return append(KeyForDelegationsOfValidator(validator), delegator.Bytes()...)
+}
+
+// generates the prefix of the delegations to the validator
+func KeyForDelegationsOfValidator(validator sdk.Address) []byte {
+	return append(append([]byte{}, DelegationsKey...), validator.Bytes()...)
+}
+
+// generates the key for the delegation of the delegator to the validator in the delegator index
+func KeyForDelegationByDelegator(delegator, validator sdk.Address) []byte {
+	return append(KeyForDelegationsOfDelegator(delegator), validator.Bytes()...)
+}
+
+// generates the prefix of the delegations of the delegator in the delegator index
+func KeyForDelegationsOfDelegator(delegator sdk.Address) []byte {
+	return append(append([]byte{}, DelegationsByDelegatorKey...), delegator.Bytes()...)
+}
+
+// generates the key for the unbonding delegation completing at the time
+func KeyForUnbondingDelegation(validator, delegator sdk.Address, completionTime time.Time) []byte {
+	return append(append(KeyForUnbondingDelegationsOfValidator(validator), delegator.Bytes()...), sdk.FormatTimeBytes(completionTime)...)
+}
+
+// generates the prefix of the unbonding delegations from the validator
+func KeyForUnbondingDelegationsOfValidator(validator sdk.Address) []byte {
+	return append(append([]byte{}, UnbondingDelegationsKey...), validator.Bytes()...)
+}
+
+// generates the key for the unbonding delegation in the queue, ordered by completion time
+func KeyForUnbondingDelegationInQueue(completionTime time.Time, validator, delegator sdk.Address) []byte {
+	return append(append(KeyForUnbondingDelegationsQueueTime(completionTime), validator.Bytes()...), delegator.Bytes()...)
+}
+
+// generates the prefix of the unbonding delegations completing at the time in the queue
+func KeyForUnbondingDelegationsQueueTime(completionTime time.Time) []byte {
+	return append(append([]byte{}, UnbondingDelegationsQueueKey...), sdk.FormatTimeBytes(completionTime)...)
+}
+
+// generates the key for the redelegation completing at the time
+func KeyForRedelegation(src, delegator, dst sdk.Address, completionTime time.Time) []byte {
+	return append(append(append(KeyForRedelegationsOfValidator(src), delegator.Bytes()...), dst.Bytes()...), sdk.FormatTimeBytes(completionTime)...)
+}
+
+// generates the prefix of the redelegations from the source validator
+func KeyForRedelegationsOfValidator(src sdk.Address) []byte {
+	return append(append([]byte{}, RedelegationsKey...), src.Bytes()...)
+}
+
+// generates the key for the redelegation in the destination validator index
+func KeyForRedelegationByDst(dst, delegator, src sdk.Address, completionTime time.Time) []byte {
+	return append(append(KeyForRedelegationsToValidator(dst, delegator), src.Bytes()...), sdk.FormatTimeBytes(completionTime)...)
+}
+
+// generates the prefix of the redelegations of the delegator to the destination validator
+func KeyForRedelegationsToValidator(dst, delegator sdk.Address) []byte {
+	return append(append(append([]byte{}, RedelegationsByDstKey...), dst.Bytes()...), delegator.Bytes()...)
+}
+
+// generates the key for the redelegation in the queue, ordered by completion time
+func KeyForRedelegationInQueue(completionTime time.Time, src, delegator, dst sdk.Address) []byte {
+	return append(append(append(KeyForRedelegationsQueueTime(completionTime), src.Bytes()...), delegator.Bytes()...), dst.Bytes()...)
+}
+
+// generates the prefix of the redelegations completing at the time in the queue
+func KeyForRedelegationsQueueTime(completionTime time.Time) []byte {
+	return append(append([]byte{}, RedelegationsQueueKey...), sdk.FormatTimeBytes(completionTime)...)
+}
+
+// generates the key for the commission of the validator
+func KeyForCommission(validator sdk.Address) []byte {
+	return append(append([]byte{}, CommissionsKey...), validator.Bytes()...)
+}
+
 // generates the key for a validator in the staking set
 func KeyForValidatorInStakingSet(validator Validator) []byte {
 	// NOTE the address doesn't need to be stored because counter bytes must always be different
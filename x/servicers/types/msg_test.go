@@ -1259,3 +1259,119 @@ func TestMsgUnpause_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgUnstakePartial_ValidateBasic(t *testing.T) {
+	type fields struct {
+		Address sdk.Address
+		Amount  sdk.BigInt
+		Signer  sdk.Address
+	}
+
+	var pub crypto.Ed25519PublicKey
+	_, err := rand.Read(pub[:])
+	if err != nil {
+		_ = err
+	}
+	validatorAddr := sdk.Address(pub.Address())
+
+	tests := []struct {
+		name   string
+		fields fields
+		want   sdk.Error
+	}{
+		{"Test ValidateBasic OK", fields{validatorAddr, sdk.NewInt(1), validatorAddr}, nil},
+		{"Test ValidateBasic Bad Address", fields{nil, sdk.NewInt(1), validatorAddr}, ErrNilValidatorAddr(DefaultCodespace)},
+		{"Test ValidateBasic Bad Signer", fields{validatorAddr, sdk.NewInt(1), nil}, ErrNilSignerAddr(DefaultCodespace)},
+		{"Test ValidateBasic Zero Amount", fields{validatorAddr, sdk.ZeroInt(), validatorAddr},
+			ErrUnstakePartialAmount(DefaultCodespace, fmt.Errorf("the amount must be positive"))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := MsgUnstakePartial{
+				Address: tt.fields.Address,
+				Amount:  tt.fields.Amount,
+				Signer:  tt.fields.Signer,
+			}
+			if got := msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateBasic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMsgDelegate_ValidateBasic(t *testing.T) {
+	type fields struct {
+		Delegator sdk.Address
+		Validator sdk.Address
+		Amount    sdk.BigInt
+	}
+
+	var pub crypto.Ed25519PublicKey
+	_, err := rand.Read(pub[:])
+	if err != nil {
+		_ = err
+	}
+	validatorAddr := sdk.Address(pub.Address())
+	delegatorAddr := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+
+	tests := []struct {
+		name   string
+		fields fields
+		want   sdk.Error
+	}{
+		{"Test ValidateBasic OK", fields{delegatorAddr, validatorAddr, sdk.NewInt(1)}, nil},
+		{"Test ValidateBasic Bad Delegator", fields{nil, validatorAddr, sdk.NewInt(1)}, ErrNilDelegatorAddr(DefaultCodespace)},
+		{"Test ValidateBasic Bad Validator", fields{delegatorAddr, nil, sdk.NewInt(1)}, ErrNilValidatorAddr(DefaultCodespace)},
+		{"Test ValidateBasic Zero Amount", fields{delegatorAddr, validatorAddr, sdk.ZeroInt()},
+			ErrDelegationAmount(DefaultCodespace, fmt.Errorf("the delegated amount must be positive"))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := MsgDelegate{
+				Delegator: tt.fields.Delegator,
+				Validator: tt.fields.Validator,
+				Amount:    tt.fields.Amount,
+			}
+			if got := msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateBasic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMsgRedelegate_ValidateBasic(t *testing.T) {
+	delegatorAddr := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	srcAddr := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	dstAddr := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	msg := MsgRedelegate{Delegator: delegatorAddr, SrcValidator: srcAddr, DstValidator: dstAddr, Amount: sdk.NewInt(1)}
+	if err := msg.ValidateBasic(); err != nil {
+		t.Errorf("ValidateBasic() = %v, want nil", err)
+	}
+	// the stake can't be redelegated to the same validator
+	msg.DstValidator = srcAddr
+	if err := msg.ValidateBasic(); err == nil || err.Code() != CodeInvalidRedelegation {
+		t.Errorf("ValidateBasic() = %v, want code %d", err, CodeInvalidRedelegation)
+	}
+}
+
+func TestMsgSetCommission_ValidateBasic(t *testing.T) {
+	validatorAddr := sdk.Address(crypto.GenerateEd25519PrivKey().PublicKey().Address())
+	tests := []struct {
+		name string
+		msg  MsgSetCommission
+		want sdk.Error
+	}{
+		{"Test ValidateBasic OK", MsgSetCommission{Address: validatorAddr, Rate: sdk.NewDecWithPrec(5, 2), Signer: validatorAddr}, nil},
+		{"Test ValidateBasic Bad Address", MsgSetCommission{Address: nil, Rate: sdk.NewDecWithPrec(5, 2), Signer: validatorAddr}, ErrNilValidatorAddr(DefaultCodespace)},
+		{"Test ValidateBasic Bad Signer", MsgSetCommission{Address: validatorAddr, Rate: sdk.NewDecWithPrec(5, 2), Signer: nil}, ErrNilSignerAddr(DefaultCodespace)},
+		{"Test ValidateBasic Nil Rate", MsgSetCommission{Address: validatorAddr, Signer: validatorAddr}, ErrInvalidCommission(DefaultCodespace)},
+		{"Test ValidateBasic Rate Above One", MsgSetCommission{Address: validatorAddr, Rate: sdk.NewDec(2), Signer: validatorAddr}, ErrInvalidCommission(DefaultCodespace)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.msg.ValidateBasic(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ValidateBasic() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
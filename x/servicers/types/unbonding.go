@@ -0,0 +1,84 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/vipernet-xyz/viper-network/types"
+)
+
+const (
+	MsgUnstakePartialName = "unstake_partial_validator"
+)
+
+// NewUnbondingStake creates the unbonding stake of the amount removed from the validator at the block height
+func NewUnbondingStake(address, outputAddress sdk.Address, amount sdk.BigInt, height int64, completionTime time.Time) UnbondingStake {
+	return UnbondingStake{
+		Address:        address,
+		OutputAddress:  outputAddress,
+		Amount:         amount,
+		CreationHeight: height,
+		CompletionTime: completionTime,
+	}
+}
+
+// ValidateBasic does a stateless check of the unbonding stake
+func (u UnbondingStake) ValidateBasic() sdk.Error {
+	if u.Address.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if u.OutputAddress.Empty() {
+		return ErrNilOutputAddr(DefaultCodespace)
+	}
+	if u.Amount.IsZero() || u.Amount.IsNegative() {
+		return ErrUnstakePartialAmount(DefaultCodespace, fmt.Errorf("the unbonding amount must be positive"))
+	}
+	if u.CreationHeight < 0 {
+		return ErrUnstakePartialAmount(DefaultCodespace, fmt.Errorf("negative creation height: %d", u.CreationHeight))
+	}
+	return nil
+}
+
+//----------------------------------------------------------------------------------------------------------------------
+
+var _ sdk.ProtoMsg = &MsgUnstakePartial{}
+
+// GetSigners return address(es) that must sign over msg.GetSignBytes()
+func (msg MsgUnstakePartial) GetSigners() []sdk.Address {
+	return []sdk.Address{msg.Signer, msg.Address}
+}
+
+func (msg MsgUnstakePartial) GetRecipient() sdk.Address {
+	return nil
+}
+
+// GetSignBytes returns the message bytes to sign over.
+func (msg MsgUnstakePartial) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+// ValidateBasic quick validity check, stateless
+func (msg MsgUnstakePartial) ValidateBasic() sdk.Error {
+	if msg.Address.Empty() {
+		return ErrNilValidatorAddr(DefaultCodespace)
+	}
+	if msg.Signer.Empty() {
+		return ErrNilSignerAddr(DefaultCodespace)
+	}
+	if msg.Amount.IsZero() || msg.Amount.IsNegative() {
+		return ErrUnstakePartialAmount(DefaultCodespace, fmt.Errorf("the amount must be positive"))
+	}
+	return nil
+}
+
+// Route provides router key for msg
+func (msg MsgUnstakePartial) Route() string { return RouterKey }
+
+// Type provides msg name
+func (msg MsgUnstakePartial) Type() string { return MsgUnstakePartialName }
+
+// GetFee get fee for msg
+func (msg MsgUnstakePartial) GetFee() sdk.BigInt {
+	return sdk.NewInt(NodeFeeMap[msg.Type()])
+}
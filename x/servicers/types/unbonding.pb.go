@@ -0,0 +1,853 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: x/servicers/unbonding.proto
+
+package types
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	github_com_vipernet_xyz_viper_network_types "github.com/vipernet-xyz/viper-network/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	reflect "reflect"
+	strings "strings"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// UnbondingStake is the stake removed from a servicer by a partial unstake. It is sent to the output address
+// at the completion time and can be slashed for the infractions of the servicer until then
+type UnbondingStake struct {
+	Address       github_com_vipernet_xyz_viper_network_types.Address `protobuf:"bytes,1,opt,name=address,proto3,casttype=github.com/vipernet-xyz/viper-network/types.Address" json:"address" yaml:"address"`
+	OutputAddress github_com_vipernet_xyz_viper_network_types.Address `protobuf:"bytes,2,opt,name=output_address,json=outputAddress,proto3,casttype=github.com/vipernet-xyz/viper-network/types.Address" json:"output_address" yaml:"output_address"`
+	Amount        github_com_vipernet_xyz_viper_network_types.BigInt  `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/vipernet-xyz/viper-network/types.BigInt" json:"amount" yaml:"amount"`
+	// the block height of the partial unstake
+	CreationHeight int64     `protobuf:"varint,4,opt,name=creation_height,json=creationHeight,proto3" json:"creation_height" yaml:"creation_height"`
+	CompletionTime time.Time `protobuf:"bytes,5,opt,name=completion_time,json=completionTime,proto3,stdtime" json:"completion_time" yaml:"completion_time"`
+}
+
+func (m *UnbondingStake) Reset()         { *m = UnbondingStake{} }
+func (m *UnbondingStake) String() string { return proto.CompactTextString(m) }
+func (*UnbondingStake) ProtoMessage()    {}
+func (*UnbondingStake) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b0b7cac563caec2, []int{0}
+}
+func (m *UnbondingStake) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnbondingStake) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnbondingStake.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnbondingStake) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnbondingStake.Merge(m, src)
+}
+func (m *UnbondingStake) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnbondingStake) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnbondingStake.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnbondingStake proto.InternalMessageInfo
+
+type MsgUnstakePartial struct {
+	Address github_com_vipernet_xyz_viper_network_types.Address `protobuf:"bytes,1,opt,name=Address,proto3,casttype=github.com/vipernet-xyz/viper-network/types.Address" json:"validator_address" yaml:"validator_address"`
+	Amount  github_com_vipernet_xyz_viper_network_types.BigInt  `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/vipernet-xyz/viper-network/types.BigInt" json:"amount" yaml:"amount"`
+	Signer  github_com_vipernet_xyz_viper_network_types.Address `protobuf:"bytes,3,opt,name=Signer,proto3,casttype=github.com/vipernet-xyz/viper-network/types.Address" json:"signer_address" yaml:"signer_address"`
+}
+
+func (m *MsgUnstakePartial) Reset()      { *m = MsgUnstakePartial{} }
+func (*MsgUnstakePartial) ProtoMessage() {}
+func (*MsgUnstakePartial) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0b0b7cac563caec2, []int{1}
+}
+func (m *MsgUnstakePartial) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnstakePartial) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnstakePartial.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnstakePartial) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnstakePartial.Merge(m, src)
+}
+func (m *MsgUnstakePartial) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnstakePartial) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnstakePartial.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnstakePartial proto.InternalMessageInfo
+
+func (*MsgUnstakePartial) XXX_MessageName() string {
+	return "x.servicers.MsgUnstakePartial"
+}
+func init() {
+	proto.RegisterType((*UnbondingStake)(nil), "x.servicers.UnbondingStake")
+	proto.RegisterType((*MsgUnstakePartial)(nil), "x.servicers.MsgUnstakePartial")
+}
+
+func init() { proto.RegisterFile("x/servicers/unbonding.proto", fileDescriptor_0b0b7cac563caec2) }
+
+var fileDescriptor_0b0b7cac563caec2 = []byte{
+	// 525 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x31, 0x6f, 0xd3, 0x40,
+	0x18, 0xcd, 0x35, 0xa5, 0x45, 0x2e, 0x4d, 0x55, 0xab, 0x20, 0x2b, 0x48, 0xbe, 0xc8, 0x53, 0x96,
+	0xd8, 0x52, 0x2b, 0x84, 0x94, 0xad, 0x99, 0x60, 0x40, 0x82, 0xb4, 0x61, 0x60, 0xa9, 0x2e, 0xc9,
+	0x71, 0x39, 0x35, 0xbe, 0xb3, 0xce, 0xe7, 0x90, 0x64, 0x42, 0x4c, 0x65, 0xeb, 0x08, 0x5b, 0xc5,
+	0xaf, 0xe9, 0xd8, 0x11, 0x10, 0x3a, 0x50, 0xb2, 0xa0, 0x8c, 0x19, 0x99, 0x90, 0xcf, 0x36, 0x8d,
+	0x93, 0x05, 0x32, 0xb0, 0xe5, 0x7b, 0xef, 0xbb, 0xf7, 0xee, 0xf2, 0x9e, 0x6c, 0x3c, 0x1c, 0x7a,
+	0x21, 0x16, 0x03, 0xda, 0xc1, 0x22, 0xf4, 0x22, 0xd6, 0xe6, 0xac, 0x4b, 0x19, 0x71, 0x03, 0xc1,
+	0x25, 0x37, 0x77, 0x86, 0xee, 0x1f, 0xb2, 0x7c, 0x40, 0x38, 0xe1, 0x1a, 0xf7, 0xe2, 0x5f, 0xc9,
+	0x4a, 0x19, 0x12, 0xce, 0x49, 0x1f, 0x7b, 0x7a, 0x6a, 0x47, 0xaf, 0x3d, 0x49, 0x7d, 0x1c, 0x4a,
+	0xe4, 0x07, 0xc9, 0x82, 0xf3, 0x65, 0xd3, 0x28, 0xb5, 0x32, 0xdd, 0x13, 0x89, 0xce, 0xb1, 0xc9,
+	0x8c, 0x6d, 0xd4, 0xed, 0x0a, 0x1c, 0x86, 0x16, 0xa8, 0x80, 0xea, 0xbd, 0xc6, 0xe9, 0x4c, 0xc1,
+	0x0c, 0x9a, 0x2b, 0x58, 0x1a, 0x21, 0xbf, 0x5f, 0x77, 0x52, 0xc0, 0xf9, 0xa5, 0xe0, 0x11, 0xa1,
+	0xb2, 0x17, 0xb5, 0xdd, 0x0e, 0xf7, 0xbd, 0x01, 0x0d, 0xb0, 0x60, 0x58, 0xd6, 0x86, 0xa3, 0x71,
+	0x32, 0xd4, 0x18, 0x96, 0x6f, 0xb8, 0x38, 0xf7, 0xe4, 0x28, 0xc0, 0xa1, 0x7b, 0x9c, 0x9c, 0x6b,
+	0x66, 0x8a, 0xe6, 0x7b, 0x60, 0x94, 0x78, 0x24, 0x83, 0x48, 0x9e, 0x65, 0xbe, 0x1b, 0xda, 0xb7,
+	0x3d, 0x53, 0x70, 0x89, 0x99, 0x2b, 0x78, 0x3f, 0xb1, 0xcf, 0xe3, 0x6b, 0xdf, 0x62, 0x37, 0xd1,
+	0x49, 0x47, 0xd3, 0x37, 0xb6, 0x90, 0xcf, 0x23, 0x26, 0xad, 0xa2, 0xbe, 0x42, 0xeb, 0x5a, 0xc1,
+	0xc2, 0x57, 0x05, 0x0f, 0xff, 0x45, 0xbb, 0x41, 0xc9, 0x53, 0x26, 0x67, 0x0a, 0xa6, 0x5a, 0x73,
+	0x05, 0x77, 0xd3, 0xff, 0x4c, 0xcf, 0x4e, 0x33, 0x25, 0xcc, 0x97, 0xc6, 0x5e, 0x47, 0x60, 0x24,
+	0x29, 0x67, 0x67, 0x3d, 0x4c, 0x49, 0x4f, 0x5a, 0x9b, 0x15, 0x50, 0x2d, 0x36, 0x6a, 0x33, 0x05,
+	0x97, 0xa9, 0xb9, 0x82, 0x0f, 0x12, 0x99, 0x25, 0xc2, 0x69, 0x96, 0x32, 0xe4, 0x89, 0x06, 0xcc,
+	0xb1, 0xb1, 0xd7, 0xe1, 0x7e, 0xd0, 0xc7, 0x7a, 0x2b, 0xce, 0xdc, 0xba, 0x53, 0x01, 0xd5, 0x9d,
+	0xc3, 0xb2, 0x9b, 0x14, 0xc2, 0xcd, 0x0a, 0xe1, 0x9e, 0x66, 0x85, 0x68, 0x3c, 0x8a, 0xdf, 0xaa,
+	0x7d, 0xf3, 0x47, 0x17, 0x7c, 0xf3, 0x84, 0x73, 0xf9, 0x1d, 0x82, 0x66, 0xe9, 0x16, 0x8d, 0xb5,
+	0xea, 0x77, 0x2f, 0xae, 0x60, 0xe1, 0xe7, 0x15, 0x04, 0xce, 0xc7, 0xa2, 0xb1, 0xff, 0x2c, 0x24,
+	0x2d, 0x16, 0xc6, 0xbd, 0x7a, 0x8e, 0x84, 0xa4, 0xa8, 0x6f, 0xbe, 0x03, 0xc6, 0xf6, 0x71, 0xae,
+	0x5f, 0xbd, 0x99, 0x82, 0xfb, 0x03, 0xd4, 0xa7, 0x5d, 0x24, 0xb9, 0x58, 0x88, 0xda, 0x4a, 0x6c,
+	0x57, 0xa8, 0xf5, 0x3b, 0xb7, 0x9a, 0xf3, 0xc6, 0xff, 0xc8, 0x79, 0x6c, 0x6c, 0x9d, 0x50, 0xc2,
+	0xb0, 0xb0, 0x8a, 0xb7, 0xcd, 0x0e, 0x35, 0xb2, 0xda, 0xec, 0x3c, 0xbe, 0xf6, 0x5b, 0x53, 0xc7,
+	0xfa, 0x41, 0x9c, 0xc7, 0x87, 0x34, 0x93, 0x8b, 0x4f, 0x10, 0xbc, 0xfd, 0x56, 0x01, 0x8d, 0x17,
+	0xd7, 0x13, 0x1b, 0xdc, 0x4c, 0x6c, 0xf0, 0x63, 0x62, 0x83, 0xcb, 0xa9, 0x5d, 0xb8, 0x99, 0xda,
+	0x85, 0xcf, 0x53, 0xbb, 0xf0, 0xea, 0xf1, 0xdf, 0x99, 0x2d, 0x7e, 0x98, 0xb4, 0x71, 0x7b, 0x4b,
+	0x77, 0xea, 0xe8, 0xf7, 0x00, 0x22, 0x60, 0x14, 0xfa, 0xb4, 0x04, 0x00, 0x00,
+}
+
+func (this *UnbondingStake) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UnbondingStake)
+	if !ok {
+		that2, ok := that.(UnbondingStake)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Address, that1.Address) {
+		return false
+	}
+	if !bytes.Equal(this.OutputAddress, that1.OutputAddress) {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if this.CreationHeight != that1.CreationHeight {
+		return false
+	}
+	if !this.CompletionTime.Equal(that1.CompletionTime) {
+		return false
+	}
+	return true
+}
+func (this *MsgUnstakePartial) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUnstakePartial)
+	if !ok {
+		that2, ok := that.(MsgUnstakePartial)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Address, that1.Address) {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if !bytes.Equal(this.Signer, that1.Signer) {
+		return false
+	}
+	return true
+}
+func (m *UnbondingStake) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnbondingStake) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnbondingStake) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CompletionTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintUnbonding(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if m.CreationHeight != 0 {
+		i = encodeVarintUnbonding(dAtA, i, uint64(m.CreationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintUnbonding(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.OutputAddress) > 0 {
+		i -= len(m.OutputAddress)
+		copy(dAtA[i:], m.OutputAddress)
+		i = encodeVarintUnbonding(dAtA, i, uint64(len(m.OutputAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintUnbonding(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnstakePartial) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnstakePartial) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnstakePartial) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintUnbonding(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintUnbonding(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintUnbonding(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintUnbonding(dAtA []byte, offset int, v uint64) int {
+	offset -= sovUnbonding(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UnbondingStake) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovUnbonding(uint64(l))
+	}
+	l = len(m.OutputAddress)
+	if l > 0 {
+		n += 1 + l + sovUnbonding(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovUnbonding(uint64(l))
+	if m.CreationHeight != 0 {
+		n += 1 + sovUnbonding(uint64(m.CreationHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CompletionTime)
+	n += 1 + l + sovUnbonding(uint64(l))
+	return n
+}
+
+func (m *MsgUnstakePartial) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovUnbonding(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovUnbonding(uint64(l))
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovUnbonding(uint64(l))
+	}
+	return n
+}
+
+func sovUnbonding(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozUnbonding(x uint64) (n int) {
+	return sovUnbonding(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *MsgUnstakePartial) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&MsgUnstakePartial{`,
+		`Address:` + fmt.Sprintf("%v", this.Address) + `,`,
+		`Amount:` + fmt.Sprintf("%v", this.Amount) + `,`,
+		`Signer:` + fmt.Sprintf("%v", this.Signer) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func valueToStringUnbonding(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *UnbondingStake) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUnbonding
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnbondingStake: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnbondingStake: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputAddress", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutputAddress = append(m.OutputAddress[:0], dAtA[iNdEx:postIndex]...)
+			if m.OutputAddress == nil {
+				m.OutputAddress = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreationHeight", wireType)
+			}
+			m.CreationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CompletionTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUnbonding(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnstakePartial) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowUnbonding
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnstakePartial: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnstakePartial: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = append(m.Address[:0], dAtA[iNdEx:postIndex]...)
+			if m.Address == nil {
+				m.Address = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = append(m.Signer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signer == nil {
+				m.Signer = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipUnbonding(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthUnbonding
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipUnbonding(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowUnbonding
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowUnbonding
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthUnbonding
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupUnbonding
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthUnbonding
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthUnbonding        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowUnbonding          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupUnbonding = fmt.Errorf("proto: unexpected end of group")
+)
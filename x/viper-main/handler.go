@@ -3,12 +3,15 @@ package vipernet
 import (
 	"fmt"
 	"reflect"
+	"strconv"
 	"time"
 
 	crypto "github.com/vipernet-xyz/viper-network/crypto/codec"
 	sdk "github.com/vipernet-xyz/viper-network/types"
 	"github.com/vipernet-xyz/viper-network/x/viper-main/keeper"
 	"github.com/vipernet-xyz/viper-network/x/viper-main/types"
+
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 // "NewHandler" - Returns a handler for "vipernet" type messages.
@@ -53,7 +56,10 @@ func handleClaimMsg(ctx sdk.Ctx, k keeper.Keeper, msg types.MsgClaim) sdk.Result
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeClaim,
-			sdk.NewAttribute(types.AttributeKeyValidator, msg.FromAddress.String()),
+			append(sessionAttributes(msg.SessionHeader, msg.EvidenceType),
+				sdk.NewAttribute(types.AttributeKeyValidator, msg.FromAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyTotalProofs, strconv.FormatInt(msg.TotalProofs, 10)),
+			)...,
 		),
 	})
 	return sdk.Result{Events: ctx.EventManager().Events()}
@@ -100,22 +106,19 @@ func handleProofMsg(ctx sdk.Ctx, k keeper.Keeper, proof types.MsgProof) sdk.Resu
 		// Set report card with max score of 1
 
 		k.SetReportCard(ctx, qos)
-		tokens, _, _, err := k.ExecuteProof(ctx, proof, qos, claim)
+		tokens, burned, _, err := k.ExecuteProof(ctx, proof, qos, claim)
 		if err != nil {
 			return err.Result()
 		}
 		k.HandleFishermanSlash(ctx, claim.SessionHeader, ctx.BlockHeight())
 		processSelf(ctx, proof.GetSigners()[0], claim.SessionHeader, claim.EvidenceType, tokens)
 		ctx.EventManager().EmitEvents(sdk.Events{
-			sdk.NewEvent(
-				types.EventTypeProof,
-				sdk.NewAttribute(types.AttributeKeyValidator, addr.String()),
-			),
+			newProofEvent(addr, claim, tokens, burned),
 		})
 		return sdk.Result{Events: ctx.EventManager().Events()}
 	}
 	// valid claim message so execute according to type
-	tokens, _, _, err := k.ExecuteProof(ctx, proof, reportCard, claim)
+	tokens, burned, _, err := k.ExecuteProof(ctx, proof, reportCard, claim)
 	if err != nil {
 		return err.Result()
 	}
@@ -125,10 +128,7 @@ func handleProofMsg(ctx sdk.Ctx, k keeper.Keeper, proof types.MsgProof) sdk.Resu
 
 	// create the event
 	ctx.EventManager().EmitEvents(sdk.Events{
-		sdk.NewEvent(
-			types.EventTypeProof,
-			sdk.NewAttribute(types.AttributeKeyValidator, addr.String()),
-		),
+		newProofEvent(addr, claim, tokens, burned),
 	})
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
@@ -147,18 +147,62 @@ func handleSubmitReportCardMsg(ctx sdk.Ctx, k keeper.Keeper, msg types.MsgSubmit
 		return sdk.ErrInternal(err.Error()).Result()
 	}
 
+	k.HandleUnavailableServicer(ctx, msg)
 	processResult(ctx, msg.FishermanAddress, msg.SessionHeader, msg.EvidenceType, msg.Report)
 	// create the event
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeSubmitReportCard,
-			sdk.NewAttribute(types.AttributeKeyValidator, msg.ServicerAddress.String()),
+			append(sessionAttributes(msg.SessionHeader, msg.EvidenceType),
+				sdk.NewAttribute(types.AttributeKeyValidator, msg.ServicerAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyFisherman, msg.FishermanAddress.String()),
+				sdk.NewAttribute(types.AttributeKeyLatency, msg.Report.LatencyScore.String()),
+				sdk.NewAttribute(types.AttributeKeyAvailability, msg.Report.AvailabilityScore.String()),
+				sdk.NewAttribute(types.AttributeKeyReliability, msg.Report.ReliabilityScore.String()),
+			)...,
 		),
 	})
 
 	return sdk.Result{Events: ctx.EventManager().Events()}
 }
 
+// "newProofEvent" - Returns the event of an executed proof with the tokens minted for it
+func newProofEvent(addr sdk.Address, claim types.MsgClaim, minted, burned sdk.BigInt) abci.Event {
+	return sdk.NewEvent(
+		types.EventTypeProof,
+		append(sessionAttributes(claim.SessionHeader, claim.EvidenceType),
+			sdk.NewAttribute(types.AttributeKeyValidator, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyTotalProofs, strconv.FormatInt(claim.TotalProofs, 10)),
+			sdk.NewAttribute(types.AttributeKeyMinted, minted.String()),
+			sdk.NewAttribute(types.AttributeKeyBurned, burned.String()),
+		)...,
+	)
+}
+
+// "sessionAttributes" - Returns the event attributes of the session of a claim, proof or report card
+func sessionAttributes(header types.SessionHeader, evidenceType types.EvidenceType) []sdk.Attribute {
+	return []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyChain, header.Chain),
+		sdk.NewAttribute(types.AttributeKeyGeoZone, header.GeoZone),
+		sdk.NewAttribute(types.AttributeKeySessionHeight, strconv.FormatInt(header.SessionBlockHeight, 10)),
+		sdk.NewAttribute(types.AttributeKeyEvidenceType, evidenceTypeName(evidenceType)),
+	}
+}
+
+// "evidenceTypeName" - Returns the name of the evidence type, as parsed by EvidenceTypeFromString
+func evidenceTypeName(evidenceType types.EvidenceType) string {
+	switch evidenceType {
+	case types.RelayEvidence:
+		return "relay"
+	case types.ChallengeEvidence:
+		return "challenge"
+	case types.FishermanTestEvidence:
+		return "test"
+	default:
+		return strconv.Itoa(int(evidenceType))
+	}
+}
+
 func processSelf(ctx sdk.Ctx, signer sdk.Address, header types.SessionHeader, evidenceType types.EvidenceType, tokens sdk.BigInt) {
 	node, ok := types.GlobalViperNodes[signer.String()]
 	if !ok {
@@ -19,6 +19,31 @@ func AATGeneration(requestorPubKey string, clientPubKey string, key crypto.Priva
 		ClientPublicKey:    clientPubKey,
 		RequestorSignature: "",
 	}
+	return signAAT(aat, key)
+}
+
+// "ScopedAATGeneration" - Generates a scoped requestor authentication token, that expires at the expiration height
+// (never if zero), is only valid on the chains and in the geozones (all of them if empty) and caps the relays of
+// the client per session (no cap if zero). The key corresponds to the requestor public key keypair.
+func ScopedAATGeneration(requestorPubKey string, clientPubKey string, expirationHeight int64, chains, geoZones []string, maxRelays int64, key crypto.PrivateKey) (vc.AAT, sdk.Error) {
+	// create the aat object
+	aat := vc.AAT{
+		Version:            vc.ScopedTokenVersion,
+		RequestorPublicKey: requestorPubKey,
+		ClientPublicKey:    clientPubKey,
+		RequestorSignature: "",
+		ExpirationHeight:   expirationHeight,
+		Chains:             chains,
+		GeoZones:           geoZones,
+		MaxRelays:          maxRelays,
+	}
+	if err := aat.ValidateScopeFields(); err != nil {
+		return vc.AAT{}, vc.NewInvalidTokenError(vc.ModuleName, err)
+	}
+	return signAAT(aat, key)
+}
+
+func signAAT(aat vc.AAT, key crypto.PrivateKey) (vc.AAT, sdk.Error) {
 	// marshal aat using json
 	sig, err := key.Sign(aat.Hash())
 	if err != nil {
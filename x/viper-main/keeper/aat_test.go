@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/vipernet-xyz/viper-network/crypto/keys/mintkey"
+	"github.com/vipernet-xyz/viper-network/x/viper-main/types"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -21,3 +22,22 @@ func TestAATGeneration(t *testing.T) {
 	assert.NotNil(t, res)
 	assert.Nil(t, res.Validate())
 }
+
+func TestScopedAATGeneration(t *testing.T) {
+	passphrase := "test"
+	kb := NewTestKeybase()
+	kp, err := kb.Create(passphrase)
+	assert.Nil(t, err)
+	privkey, err := mintkey.UnarmorDecryptPrivKey(kp.PrivKeyArmor, passphrase)
+	assert.Nil(t, err)
+	requestorPubKey := kp.PublicKey
+	res, err := ScopedAATGeneration(requestorPubKey.RawString(), requestorPubKey.RawString(), 100, []string{"0001"}, []string{"0001"}, 10, privkey)
+	assert.Nil(t, err)
+	assert.Equal(t, types.ScopedTokenVersion, res.Version)
+	assert.Nil(t, res.Validate())
+	// invalid scopes are not signed
+	_, err = ScopedAATGeneration(requestorPubKey.RawString(), requestorPubKey.RawString(), -1, nil, nil, 0, privkey)
+	assert.NotNil(t, err)
+	_, err = ScopedAATGeneration(requestorPubKey.RawString(), requestorPubKey.RawString(), 0, []string{"not hex"}, nil, 0, privkey)
+	assert.NotNil(t, err)
+}
@@ -75,6 +75,11 @@ func (k Keeper) SendClaimTx(ctx sdk.Ctx, keeper Keeper, n client.Client, node *v
 			}
 			continue
 		}
+		// skip the claim if it was sent and is not due again yet
+		submission, due := dueSubmission(ctx, node, vc.ClaimSubmission, evidence.SessionHeader, evidenceType, nil, evidence.SessionBlockHeight+k.ClaimSubmissionWindow(ctx)*k.BlocksPerSession(ctx))
+		if !due {
+			continue
+		}
 		app, found := k.GetRequestorFromPublicKey(sessionCtx, evidence.RequestorPubKey)
 		if !found {
 			ctx.Logger().Error(fmt.Sprintf("an error occurred creating the claim transaction with app %s not found with evidence %v", evidence.RequestorPubKey, evidence))
@@ -93,9 +98,12 @@ func (k Keeper) SendClaimTx(ctx sdk.Ctx, keeper Keeper, n client.Client, node *v
 		}
 
 		// send in the evidence header, the total relays completed, and the merkle root (ensures data integrity)
-		if _, err := claimTx(node.PrivateKey, cliCtx, txBuilder, evidence.SessionHeader, evidence.NumOfProofs, root, evidenceType); err != nil {
+		res, err := claimTx(node.PrivateKey, cliCtx, txBuilder, evidence.SessionHeader, evidence.NumOfProofs, root, evidenceType)
+		if err != nil {
 			ctx.Logger().Error(fmt.Sprintf("an error occured executing the claim transaciton: \n%s", err.Error()))
 		}
+		// track the claim until it is included
+		recordSubmission(ctx, node, submission, res, err)
 	}
 }
 
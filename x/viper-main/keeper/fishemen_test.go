@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/vipernet-xyz/viper-network/codec"
 	sdk "github.com/vipernet-xyz/viper-network/types"
 	"github.com/vipernet-xyz/viper-network/x/viper-main/types"
 	vc "github.com/vipernet-xyz/viper-network/x/viper-main/types"
@@ -69,6 +70,29 @@ func TestHandleFishermanTrigger(t *testing.T) {
 	assert.NotEmpty(t, resp.Proof)
 }
 
+func TestKeeper_HandleUnavailableServicer(t *testing.T) {
+	ctx, servicers, _, _, keeper, _, _ := createTestInput(t, false)
+	addr := servicers[0].GetAddress()
+	msg := vc.MsgSubmitQoSReport{ServicerAddress: addr, Report: vc.ViperQoSReport{ServicerAddress: addr, AvailabilityScore: sdk.ZeroDec()}}
+	isPaused := func() bool {
+		servicer, found := keeper.GetNode(ctx, addr)
+		assert.True(t, found)
+		return servicer.IsPaused()
+	}
+	// nothing happens before the activation
+	keeper.HandleUnavailableServicer(ctx, msg)
+	assert.False(t, isPaused())
+	codec.UpgradeFeatureMap[codec.UnavailableServicerKey] = -1
+	defer func() { codec.UpgradeFeatureMap[codec.UnavailableServicerKey] = 0 }()
+	// a servicer answering some of the samples is kept
+	available := msg
+	available.Report.AvailabilityScore = sdk.NewDecWithPrec(5, 1)
+	keeper.HandleUnavailableServicer(ctx, available)
+	assert.False(t, isPaused())
+	keeper.HandleUnavailableServicer(ctx, msg)
+	assert.True(t, isPaused())
+}
+
 func TestCalculateLatencyScores(t *testing.T) {
 	// Create a sample dataset of servicer results
 	results := map[string]*vc.ServicerResults{
@@ -7,8 +7,8 @@ import (
 	"math/rand"
 	"time"
 
+	"github.com/vipernet-xyz/viper-network/codec"
 	sdk "github.com/vipernet-xyz/viper-network/types"
-	"github.com/vipernet-xyz/viper-network/x/servicers/exported"
 	vc "github.com/vipernet-xyz/viper-network/x/viper-main/types"
 )
 
@@ -20,6 +20,13 @@ func (k Keeper) HandleFishermanTrigger(ctx sdk.Ctx, trigger vc.FishermenTrigger)
 		}
 		return resp, nil
 	}
+	// the client and requestor limits are only taken for a trigger signed by the client of a token of the requestor,
+	// the other triggers are limited by their source ip
+	if trigger.Proof.ValidateBasic() == nil {
+		if err := vc.GlobalRateLimiter().AllowRelay(trigger.Proof); err != nil {
+			return nil, err
+		}
+	}
 
 	// Introduce a longer delay before triggering sampling.
 	minDelay := 5000
@@ -66,6 +73,8 @@ func triggerIsEmpty(trigger vc.FishermenTrigger) bool {
 		trigger.Proof.Token.RequestorSignature == ""
 }
 
+// "StartServicersSampling" - Schedules the sampling of the servicers of the session, the rounds are run by the
+// sampling scheduler on every block until the session ends
 func (k Keeper) StartServicersSampling(ctx sdk.Ctx, trigger vc.FishermenTrigger) sdk.Error {
 	sessionHeader := vc.SessionHeader{
 		RequestorPubKey:    trigger.Proof.Token.RequestorPublicKey,
@@ -74,64 +83,83 @@ func (k Keeper) StartServicersSampling(ctx sdk.Ctx, trigger vc.FishermenTrigger)
 		NumServicers:       trigger.Proof.NumServicers,
 		SessionBlockHeight: trigger.Proof.SessionBlockHeight,
 	}
-
-	latestSessionBlockHeight := k.GetLatestSessionBlockHeight(ctx)
-	sessionCtx, er := ctx.PrevCtx(latestSessionBlockHeight)
-	if er != nil {
-		return sdk.ErrInternal(er.Error())
-	}
 	session, found := vc.GetSession(sessionHeader, vc.GlobalSessionCache)
 	if !found {
 		return sdk.ErrInternal("Session not found")
 	}
-
-	actualServicers := make([]exported.ValidatorI, len(session.SessionServicers))
-	for i, addr := range session.SessionServicers {
-		actualServicers[i], _ = k.GetNode(sessionCtx, addr)
+	latestSessionBlockHeight := k.GetLatestSessionBlockHeight(ctx)
+	sessionCtx, er := ctx.PrevCtx(latestSessionBlockHeight)
+	if er != nil {
+		return sdk.ErrInternal(er.Error())
 	}
-
 	fisherman := vc.GetViperNode()
-	fishermanAddr := fisherman.GetAddress()
-
-	hostedBlockchains := k.GetHostedBlockchains()
-	fishermanValidator, _ := k.GetNode(ctx, fishermanAddr)
-	availabilityScore := make(map[string][]bool)
-
-	rpcURL := fishermanValidator.GetServiceURL()
+	_, err := vc.GlobalSamplingScheduler().Add(vc.SamplingSchedule{
+		SessionHeader: sessionHeader,
+		Token:         trigger.Proof.Token,
+		Account:       trigger.Account,
+		Servicers:     session.SessionServicers,
+		EndHeight:     sessionHeader.SessionBlockHeight + k.BlocksPerSession(sessionCtx) - 1,
+	}, fisherman.TestStore)
+	return err
+}
 
-	sender := vc.NewSender(rpcURL, []string{rpcURL})
-	selfPk := fisherman.PrivateKey.RawString()
-	signer, err := vc.NewSignerFromPrivateKey(selfPk)
-	if err != nil {
-		return sdk.ErrInternal("Error creating signer")
+// "DispatchSampling" - Resumes the persisted sampling schedules and runs their due rounds
+func (k Keeper) DispatchSampling(ctx sdk.Ctx) {
+	fisherman := vc.GetViperNode()
+	if fisherman == nil {
+		return
 	}
+	scheduler := vc.GlobalSamplingScheduler()
+	scheduler.Resume(fisherman.TestStore)
+	scheduler.Dispatch(ctx.BlockHeight(), k.samplingRound(ctx, fisherman))
+}
 
-	// Function to send sample relays
-	SendSampleRelays := func() {
-
-		for _, servicer := range actualServicers {
+// "samplingRound" - Sends a sample relay to every servicer of the schedule and stores the test results. The round runs
+// on a worker of the scheduler, outside of the block execution, so it never writes to the state: the unavailable
+// servicers are punished when the report card built from the test results is submitted
+func (k Keeper) samplingRound(ctx sdk.Ctx, fisherman *vc.ViperNode) vc.SamplingRound {
+	return func(schedule *vc.SamplingSchedule) {
+		sessionHeader := schedule.SessionHeader
+		sessionCtx, er := ctx.PrevCtx(sessionHeader.SessionBlockHeight)
+		if er != nil {
+			ctx.Logger().Error(fmt.Sprintf("could not get the session ctx for sampling: %s", er.Error()))
+			return
+		}
+		fishermanValidator, found := k.GetNode(ctx, fisherman.GetAddress())
+		if !found {
+			return
+		}
+		hostedBlockchains := k.GetHostedBlockchains()
+		rpcURL := fishermanValidator.GetServiceURL()
+		sender := vc.NewSender(rpcURL, []string{rpcURL})
+		signer, err := vc.NewSignerFromPrivateKey(fisherman.PrivateKey.RawString())
+		if err != nil {
+			ctx.Logger().Error("Error creating signer")
+			return
+		}
+		trigger := schedule.Trigger(signer)
+		for _, addr := range schedule.Servicers {
+			servicer, found := k.GetNode(sessionCtx, addr)
+			if !found {
+				continue
+			}
 			relayer := vc.NewRelayer(*signer, *sender)
 			startTime := time.Now()
-			Blockchain := trigger.Proof.Blockchain
-			resp, er := relayer.SendSampleRelay(sessionHeader.SessionBlockHeight, Blockchain, trigger, servicer, fishermanValidator, hostedBlockchains)
+			resp, er := relayer.SendSampleRelay(sessionHeader.SessionBlockHeight, trigger.Proof.Blockchain, trigger, servicer, fishermanValidator, hostedBlockchains)
+			if resp == nil {
+				ctx.Logger().Error(fmt.Sprintf("could not send the sample relay: %v", er))
+				continue
+			}
 			isAvailable := resp.Availability
-			latency := resp.Latency
-			isReliable := resp.Reliability
 			// Store availability metric for the servicer
-			availabilityScore[servicer.GetAddress().String()] = append(availabilityScore[servicer.GetAddress().String()], isAvailable)
-
-			// Check if servicer has been consistently unavailable and pause if needed
-			if len(availabilityScore[servicer.GetAddress().String()]) >= 5 && !anyTrue(availabilityScore[servicer.GetAddress().String()][len(availabilityScore[servicer.GetAddress().String()])-5:]) {
-				k.posKeeper.BurnforNoActivity(ctx, ctx.BlockHeight(), servicer.GetAddress())
-				k.posKeeper.PauseNode(ctx, servicer.GetAddress())
-			}
+			schedule.Availability[addr.String()] = append(schedule.Availability[addr.String()], isAvailable)
 
 			testResult := vc.TestResult{
 				ServicerAddress: servicer.GetAddress(),
 				Timestamp:       startTime,
-				Latency:         latency,
+				Latency:         resp.Latency,
 				IsAvailable:     isAvailable,
-				IsReliable:      isReliable,
+				IsReliable:      resp.Reliability,
 			}
 
 			if er != nil {
@@ -142,44 +170,25 @@ func (k Keeper) StartServicersSampling(ctx sdk.Ctx, trigger vc.FishermenTrigger)
 			if err := testResult.Validate(*resp, sessionHeader, fisherman); err != nil {
 				ctx.Logger().Error(fmt.Sprintf("invalid test result: %s", err.Error()))
 				continue
-			} else {
-				testResult.Store(sessionHeader, fisherman.TestStore)
 			}
-
+			testResult.Store(sessionHeader, fisherman.TestStore)
 		}
 	}
-
-	// Start a goroutine to listen for the signal and send Sample Relays at random intervals
-	go func() {
-		for {
-			blocksPerSession := k.BlocksPerSession(sessionCtx)
-			minSleep := 1000
-			maxSleep := 3000
-			time.Sleep(time.Duration(rand.Intn(maxSleep-minSleep)+minSleep) * time.Millisecond)
-			blockHeight, _ := sender.GetBlockHeight()
-
-			if int64(blockHeight) <= sessionHeader.SessionBlockHeight+blocksPerSession-1 {
-				SendSampleRelays()
-			} else {
-				return // Session has ended
-			}
-
-			// Sleep for a random interval before sending the next sample relays
-			time.Sleep(time.Duration(15+rand.Intn(20)) * time.Second)
-		}
-	}()
-
-	return nil
 }
 
-// Utility function to check if any value in the provided slice is true
-func anyTrue(booleans []bool) bool {
-	for _, b := range booleans {
-		if b {
-			return true
-		}
+// "HandleUnavailableServicer" - Burns and pauses the servicer of a report card that was unavailable for every sample
+// of the session, it is applied with the report card so every node changes the state at the same height
+func (k Keeper) HandleUnavailableServicer(ctx sdk.Ctx, msg vc.MsgSubmitQoSReport) {
+	if !vc.ModuleCdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.UnavailableServicerKey) {
+		return
+	}
+	if !msg.Report.AvailabilityScore.IsZero() {
+		return
+	}
+	k.posKeeper.BurnforNoActivity(ctx, ctx.BlockHeight(), msg.ServicerAddress)
+	if err := k.posKeeper.PauseNode(ctx, msg.ServicerAddress); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("could not pause the unavailable servicer %s: %s", msg.ServicerAddress, err.Error()))
 	}
-	return false
 }
 
 func init() {
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"context"
+	"math"
+
+	sdk "github.com/vipernet-xyz/viper-network/types"
+	"github.com/vipernet-xyz/viper-network/x/authentication/util"
+	"github.com/vipernet-xyz/viper-network/x/viper-main/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultQueryPerPage = 30
+	maxQueryPerPage     = 10000
+)
+
+var _ types.QueryServer = queryServer{}
+
+type queryServer struct {
+	k Keeper
+}
+
+// NewQueryServer returns the implementation of the grpc query service of the module
+func NewQueryServer(k Keeper) types.QueryServer {
+	return queryServer{k: k}
+}
+
+// Claims implements the Query/Claims gRPC method
+func (q queryServer) Claims(c context.Context, req *types.QueryClaimsRequest) (*types.QueryClaimsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	var claims []types.MsgClaim
+	if req.Address != "" {
+		addr, err := sdk.AddressFromHex(req.Address)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if claims, err = q.k.GetClaims(ctx, addr); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	} else {
+		claims = q.k.GetAllClaims(ctx)
+	}
+	page, start, end, totalPages := pageBounds(len(claims), req.Page, req.PerPage)
+	return &types.QueryClaimsResponse{Claims: append([]types.MsgClaim{}, claims[start:end]...), TotalPages: totalPages, Page: page}, nil
+}
+
+// ReportCards implements the Query/ReportCards gRPC method
+func (q queryServer) ReportCards(c context.Context, req *types.QueryReportCardsRequest) (*types.QueryReportCardsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	var reportCards []types.MsgSubmitQoSReport
+	if req.Address != "" {
+		addr, err := sdk.AddressFromHex(req.Address)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if reportCards, err = q.k.GetReportCards(ctx, addr); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	} else {
+		reportCards = q.k.GetAllReportCards(ctx)
+	}
+	page, start, end, totalPages := pageBounds(len(reportCards), req.Page, req.PerPage)
+	return &types.QueryReportCardsResponse{ReportCards: append([]types.MsgSubmitQoSReport{}, reportCards[start:end]...), TotalPages: totalPages, Page: page}, nil
+}
+
+// pageBounds returns the bounds of the page in the items and the number of pages, an out of bounds page is empty
+func pageBounds(numItems int, page, perPage int64) (p int64, start, end int, totalPages int64) {
+	if page <= 0 {
+		page = 1
+	}
+	if perPage <= 0 {
+		perPage = defaultQueryPerPage
+	}
+	if perPage > maxQueryPerPage {
+		perPage = maxQueryPerPage
+	}
+	totalPages = int64(math.Ceil(float64(numItems) / float64(perPage)))
+	if totalPages < 1 {
+		totalPages = 1
+	}
+	start, end = util.Paginate(numItems, int(page), int(perPage), maxQueryPerPage)
+	if start < 0 || end < 0 {
+		return page, 0, 0, totalPages
+	}
+	return page, start, end, totalPages
+}
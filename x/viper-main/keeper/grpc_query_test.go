@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPageBounds(t *testing.T) {
+	tests := []struct {
+		name               string
+		numItems           int
+		page, perPage      int64
+		wantPage           int64
+		wantStart, wantEnd int
+		wantTotalPages     int64
+	}{
+		{"defaults", 5, 0, 0, 1, 0, 5, 1},
+		{"first page", 5, 1, 2, 1, 0, 2, 3},
+		{"last page", 5, 3, 2, 3, 4, 5, 3},
+		{"out of bounds page", 5, 4, 2, 4, 0, 0, 3},
+		{"no items", 0, 1, 2, 1, 0, 0, 1},
+		{"per page over the max", maxQueryPerPage + 1, 1, maxQueryPerPage + 5, 1, 0, maxQueryPerPage, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, start, end, totalPages := pageBounds(tt.numItems, tt.page, tt.perPage)
+			assert.Equal(t, tt.wantPage, page)
+			assert.Equal(t, tt.wantStart, start)
+			assert.Equal(t, tt.wantEnd, end)
+			assert.Equal(t, tt.wantTotalPages, totalPages)
+		})
+	}
+}
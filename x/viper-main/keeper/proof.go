@@ -8,6 +8,7 @@ import (
 	"reflect"
 	"time"
 
+	"github.com/vipernet-xyz/viper-network/codec"
 	crypto "github.com/vipernet-xyz/viper-network/crypto/codec"
 	sdk "github.com/vipernet-xyz/viper-network/types"
 	"github.com/vipernet-xyz/viper-network/x/authentication"
@@ -73,6 +74,11 @@ func (k Keeper) SendProofTx(ctx sdk.Ctx, n client.Client, node *vc.ViperNode, pr
 			claim := claimReportCard.claim
 			reportCard := claimReportCard.reportCard
 			reportFound := claimReportCard.found
+			// skip the proof if it was sent and is not due again yet
+			submission, due := dueSubmission(ctx, node, vc.ProofSubmission, sessionHeader, claim.EvidenceType, nil, claim.ExpirationHeight-1)
+			if !due {
+				continue
+			}
 
 			evidence, err := vc.GetEvidence(sessionHeader, claim.EvidenceType, sdk.ZeroInt(), node.EvidenceStore)
 			if err != nil || evidence.Proofs == nil || len(evidence.Proofs) == 0 {
@@ -172,10 +178,12 @@ func (k Keeper) SendProofTx(ctx sdk.Ctx, n client.Client, node *vc.ViperNode, pr
 				return
 			}
 			// Send the proof TX
-			_, err = proofTx(cliCtx, txBuilder, claimMProof, claimLeaf, claim.EvidenceType, reportMProof, reportLeaf, reportCard.EvidenceType)
+			res, err := proofTx(cliCtx, txBuilder, claimMProof, claimLeaf, claim.EvidenceType, reportMProof, reportLeaf, reportCard.EvidenceType)
 			if err != nil {
 				ctx.Logger().Error(err.Error())
 			}
+			// track the proof until it is included
+			recordSubmission(ctx, node, submission, res, err)
 		}
 	}
 }
@@ -236,6 +244,10 @@ func (k Keeper) ValidateProof(ctx sdk.Ctx, proof vc.MsgProof) (servicerAddr sdk.
 	if er != nil {
 		return nil, reportCard, claim, er, 1
 	}
+	// the relays served on a revoked or out of scope token are not paid
+	if er := k.validateProofToken(ctx, sessionCtx, requestor.GetAddress(), proof.GetClaimLeaf()); er != nil {
+		return servicerAddr, reportCard, claim, er, 1
+	}
 	if len(proof.ReportMerkleProof.HashRanges) == 0 || proof.ReportLeaf == nil {
 		return servicerAddr, reportCard, claim, vc.NewNoReportCardError(vc.ModuleName), 2
 	}
@@ -288,6 +300,28 @@ func (k Keeper) ValidateProof(ctx sdk.Ctx, proof vc.MsgProof) (servicerAddr sdk.
 	return servicerAddr, reportCard, claim, nil, 0
 }
 
+// "validateProofToken" - Checks the token of a relay proof against the clients revoked by the requestor at the start
+// of the session and against its scope, once activated by an upgrade. The height of the relay is not part of the proof,
+// so the expiration of the token is checked at the start of the session
+func (k Keeper) validateProofToken(ctx sdk.Ctx, sessionCtx sdk.Ctx, requestorAddr sdk.Address, leaf vc.Proof) sdk.Error {
+	if !vc.ModuleCdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.TokenRevocationKey) {
+		return nil
+	}
+	var rp vc.RelayProof
+	switch p := leaf.(type) {
+	case vc.RelayProof:
+		rp = p
+	case *vc.RelayProof:
+		rp = *p
+	default:
+		return nil
+	}
+	if k.requestorKeeper.IsClientRevoked(sessionCtx, requestorAddr, rp.Token.ClientPublicKey) {
+		return vc.NewRevokedClientError(vc.ModuleName)
+	}
+	return rp.Token.ValidateScope(rp.Blockchain, rp.GeoZone, rp.SessionBlockHeight)
+}
+
 func (k Keeper) ExecuteProof(ctx sdk.Ctx, proof vc.MsgProof, reportCard vc.MsgSubmitQoSReport, claim vc.MsgClaim) (tokensMinted sdk.BigInt, tokensToBurn sdk.BigInt, updatedReportCard servicersTypes.ReportCard, err sdk.Error) {
 	//requestor address
 	pk, _ := crypto.NewPublicKey(claim.SessionHeader.RequestorPubKey)
@@ -7,6 +7,10 @@ import (
 
 	"time"
 
+	"github.com/vipernet-xyz/viper-network/codec"
+	sdk "github.com/vipernet-xyz/viper-network/types"
+	requestorsKeeper "github.com/vipernet-xyz/viper-network/x/requestors/keeper"
+	requestorsTypes "github.com/vipernet-xyz/viper-network/x/requestors/types"
 	"github.com/vipernet-xyz/viper-network/x/viper-main/types"
 
 	"github.com/stretchr/testify/assert"
@@ -61,3 +65,31 @@ func TestPseudoRandomSelection(t *testing.T) {
 	// 	fmt.Printf("index %d, was selected %d times\n", i, dataArr[i])
 	// }
 }
+
+func TestKeeper_ValidateProofToken(t *testing.T) {
+	ctx, _, requestors, _, keeper, _, _ := createTestInput(t, false)
+	requestorAddr := requestors[0].Address
+	clientPubKey := getRandomPubKey().RawString()
+	token := types.AAT{Version: types.ScopedTokenVersion, ClientPublicKey: clientPubKey, ExpirationHeight: 10, Chains: []string{"0001"}}
+	proof := types.RelayProof{Blockchain: "0001", SessionBlockHeight: 1, Token: token}
+	revoked := types.RelayProof{Blockchain: "0001", SessionBlockHeight: 1, Token: types.AAT{ClientPublicKey: getRandomPubKey().RawString()}}
+	keeper.requestorKeeper.(requestorsKeeper.Keeper).SetRevokedClient(ctx, requestorsTypes.RevokedClient{RequestorAddress: requestorAddr, ClientPublicKey: revoked.Token.ClientPublicKey})
+	outOfScope := proof
+	outOfScope.Blockchain = "0002"
+	expired := proof
+	expired.SessionBlockHeight = 11
+	// the tokens are not checked on chain before the upgrade
+	assert.Nil(t, keeper.validateProofToken(ctx, ctx, requestorAddr, revoked))
+	codec.UpgradeFeatureMap[codec.TokenRevocationKey] = -1
+	defer func() { codec.UpgradeFeatureMap[codec.TokenRevocationKey] = 0 }()
+	assert.Nil(t, keeper.validateProofToken(ctx, ctx, requestorAddr, proof))
+	err := keeper.validateProofToken(ctx, ctx, requestorAddr, revoked)
+	assert.NotNil(t, err)
+	assert.Equal(t, sdk.CodeType(types.CodeRevokedClientError), err.Code())
+	err = keeper.validateProofToken(ctx, ctx, requestorAddr, outOfScope)
+	assert.NotNil(t, err)
+	assert.Equal(t, sdk.CodeType(types.CodeUnauthorizedTokenChainError), err.Code())
+	err = keeper.validateProofToken(ctx, ctx, requestorAddr, &expired)
+	assert.NotNil(t, err)
+	assert.Equal(t, sdk.CodeType(types.CodeExpiredTokenError), err.Code())
+}
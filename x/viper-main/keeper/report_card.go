@@ -138,6 +138,11 @@ func (k Keeper) SendReportCardTx(ctx sdk.Ctx, keeper Keeper, n client.Client, no
 
 		// Process each servicer's test results in the session
 		for servicerAddr, sr := range results {
+			// skip the report card if it was sent and is not due again yet
+			submission, due := dueSubmission(ctx, node, vc.ReportCardSubmission, sessionHeader, vc.FishermanTestEvidence, sr.ServicerAddress, sessionHeader.SessionBlockHeight+k.ReportCardSubmissionWindow(ctx)*k.BlocksPerSession(ctx))
+			if !due {
+				continue
+			}
 			// Get latency score for the servicer
 			latencyScore := latencyScores[servicerAddr]
 			// Calculate availability, reliability, and other scores
@@ -174,9 +179,12 @@ func (k Keeper) SendReportCardTx(ctx sdk.Ctx, keeper Keeper, n client.Client, no
 			numOfTestResults := mProof_Leaf[servicerAddr].NumOfTests
 
 			// Send in the report card
-			if _, err := reportCardTx(node.PrivateKey, cliCtx, txBuilder, sessionHeader, sr.ServicerAddress, *qosReport, reportMProof, reportLeaf, numOfTestResults, vc.FishermanTestEvidence); err != nil {
+			res, err := reportCardTx(node.PrivateKey, cliCtx, txBuilder, sessionHeader, sr.ServicerAddress, *qosReport, reportMProof, reportLeaf, numOfTestResults, vc.FishermanTestEvidence)
+			if err != nil {
 				ctx.Logger().Error(fmt.Sprintf("An error occurred executing the report card transaction: \n%s", err.Error()))
 			}
+			// track the report card until it is included
+			recordSubmission(ctx, node, submission, res, err)
 		}
 	}
 }
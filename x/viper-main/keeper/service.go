@@ -20,6 +20,10 @@ func (k Keeper) HandleRelay(ctx sdk.Ctx, relay vc.Relay) (*vc.RelayResponse, sdk
 		// For legacy support, we are intentionally returning the invalid block height error.
 		return nil, vc.NewInvalidBlockHeightError(vc.ModuleName)
 	}
+	// refuse the relay while the hosted chain is behind its head
+	if !vc.GlobalSyncMonitor().IsInSync(relay.Proof.Blockchain) {
+		return nil, vc.NewChainOutOfSyncError(vc.ModuleName)
+	}
 
 	var node *vc.ViperNode
 	var nodeAddress sdk.Address
@@ -66,8 +70,15 @@ func (k Keeper) HandleRelay(ctx sdk.Ctx, relay vc.Relay) (*vc.RelayResponse, sdk
 		}
 		return nil, err
 	}
-	// store the proof before execution, because the proof corresponds to the previous relay
-	relay.Proof.Store(maxPossibleRelays, node.EvidenceStore)
+	// the client and requestor limits are taken once the relay is authenticated
+	if err := vc.GlobalRateLimiter().AllowRelay(relay.Proof); err != nil {
+		return nil, err
+	}
+	// store the proofs before execution, because the proof corresponds to the previous relay,
+	// every call of a json-rpc batch is stored as a relay
+	for _, proof := range relay.AllProofs() {
+		proof.Store(maxPossibleRelays, node.EvidenceStore)
+	}
 
 	// attempt to execute
 	respPayload, err := relay.Execute(hostedBlockchains, &nodeAddress)
@@ -106,114 +117,92 @@ func (k Keeper) HandleRelay(ctx sdk.Ctx, relay vc.Relay) (*vc.RelayResponse, sdk
 	return resp, nil
 }
 
-// HandleWebsocketRelay handles a WebSocket relay request to a non-native (external) blockchain
-func (k Keeper) HandleWebsocketRelay(ctx sdk.Ctx, relay vc.Relay) (chan *vc.RelayResponse, error) {
-	// Create a channel for sending multiple relay responses
-	resChan := make(chan *vc.RelayResponse)
-
-	// Get the latest session block height because this relay will correspond with the latest session
+// HandleWebsocketRelay handles a relay frame of a websocket relay session, the request is forwarded to the
+// pooled upstream connection of the chain and the signed response is delivered asynchronously to the session
+func (k Keeper) HandleWebsocketRelay(ctx sdk.Ctx, session *vc.WebsocketSession, relay vc.Relay) sdk.Error {
+	// get the latest session block height because this relay will correspond with the latest session
 	sessionBlockHeight := relay.Proof.SessionBlockHeight
-
-	// Check if the session height is within tolerance
 	if !k.IsProofSessionHeightWithinTolerance(ctx, sessionBlockHeight) {
-		// For legacy support, return the invalid block height error.
-		close(resChan) // Close the channel since there won't be any further responses
-		return nil, vc.NewInvalidBlockHeightError(vc.ModuleName)
+		// For legacy support, we are intentionally returning the invalid block height error.
+		return vc.NewInvalidBlockHeightError(vc.ModuleName)
 	}
-
-	// Initialize node and node address
-	var node *vc.ViperNode
-	var nodeAddress sdk.Address
-
-	// Determine the node based on the configuration
-	if vc.GlobalViperConfig.LeanViper {
-		// If lean viper is enabled, grab the targeted servicer through the relay proof
-		servicerRelayPublicKey, err := crypto.NewPublicKey(relay.Proof.ServicerPubKey)
-		if err != nil {
-			close(resChan) // Close the channel since there won't be any further responses
-			return nil, fmt.Errorf("Could not convert servicer hex to public key: %v", err)
-		}
-		nodeAddress = sdk.GetAddress(servicerRelayPublicKey)
-		node, err = vc.GetViperNodeByAddress(&nodeAddress)
-		if err != nil {
-			close(resChan) // Close the channel since there won't be any further responses
-			return nil, fmt.Errorf("Failed to find correct servicer PK: %v", err)
-		}
-	} else {
-		// Get self node (validator) from the current state
-		node = vc.GetViperNode()
-		nodeAddress = node.GetAddress()
+	// refuse the relay while the hosted chain is behind its head
+	if !vc.GlobalSyncMonitor().IsInSync(relay.Proof.Blockchain) {
+		return vc.NewChainOutOfSyncError(vc.ModuleName)
 	}
-
-	// Retrieve the non-native blockchains your node is hosting
+	node, nodeAddress, err := k.websocketNode(relay.Proof)
+	if err != nil {
+		return err
+	}
+	// retrieve the nonNative blockchains your node is hosting
 	hostedBlockchains := k.GetHostedBlockchains()
-
-	// Ensure the relay is valid
-	maxPossibleRelays, header, err := relay.ValidateWebsocket(ctx, k.posKeeper, k.requestorKeeper, k, hostedBlockchains, sessionBlockHeight, node)
+	// ensure the validity of the relay
+	maxPossibleRelays, _, err := relay.ValidateWebsocket(ctx, k.posKeeper, k.requestorKeeper, k, hostedBlockchains, sessionBlockHeight, node)
 	if err != nil {
-		// Handle invalid relay
-		// Send an error response to the WebSocket client
-		close(resChan) // Close the channel since there won't be any further responses
-		return nil, fmt.Errorf("Error validating relay: %v", err)
+		if vc.GlobalViperConfig.RelayErrors {
+			ctx.Logger().Error(
+				fmt.Sprintf("could not validate websocket relay for app: %s for chainID: %v with error: %s",
+					relay.Proof.ServicerPubKey,
+					relay.Proof.Blockchain,
+					err.Error(),
+				),
+			)
+		}
+		return err
+	}
+	// store the proof before execution, because the proof corresponds to the previous relay
+	relay.Proof.Store(maxPossibleRelays, node.EvidenceStore)
+	// forward the request over the pooled upstream connection
+	if err := session.Forward(hostedBlockchains, relay, node); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("could not send websocket relay with error: %s", err.Error()))
+		vc.GlobalServiceMetric().AddErrorFor(relay.Proof.Blockchain, &nodeAddress)
+		return err
 	}
+	vc.GlobalServiceMetric().AddRelayFor(relay.Proof.Blockchain, &nodeAddress)
+	return nil
+}
 
-	// Process the relay asynchronously
-	go func() {
-		defer close(resChan)
-		// Execute the relay and get the channel for response payloads
-		respChan, err := relay.ExecuteWebSocket(hostedBlockchains, &nodeAddress)
+// HandleWebsocketCredits validates the proofs paying for the subscription messages pushed over a websocket relay session,
+// each proof is recorded as relay evidence when a subscription message is delivered with it
+func (k Keeper) HandleWebsocketCredits(ctx sdk.Ctx, session *vc.WebsocketSession, proofs []vc.RelayProof) sdk.Error {
+	hostedBlockchains := k.GetHostedBlockchains()
+	for _, proof := range proofs {
+		if !k.IsProofSessionHeightWithinTolerance(ctx, proof.SessionBlockHeight) {
+			return vc.NewInvalidBlockHeightError(vc.ModuleName)
+		}
+		node, _, err := k.websocketNode(proof)
 		if err != nil {
-			// Handle execution error
-			// Send an error response to the WebSocket client
-			res := &vc.RelayResponse{
-				Response: fmt.Sprintf("Error: %s", err.Error()),
-			}
-			resChan <- res
-			return
+			return err
 		}
-
-		// Iterate over responses from the channel and send them to the WebSocket client
-		for resp := range respChan {
-			// Sign the response
-			sig, signErr := node.PrivateKey.Sign(resp.Hash())
-			if signErr != nil {
-				// Handle signing error
-				// Send an error response to the WebSocket client
-				res := &vc.RelayResponse{
-					Response: fmt.Sprintf("Error: %s", signErr.Error()),
-				}
-				resChan <- res
-				return
-			}
-
-			// Attach the signature to the response
-			resp.Signature = hex.EncodeToString(sig)
-			relay.Proof.RequestHash = string(resp.Hash())
-			// Store the proof
-			relay.Proof.Store(maxPossibleRelays, node.EvidenceStore)
-
-			// Check evidence, uniqueness, and relay count
-			evidence, totalRelays := vc.GetTotalProofs(header, vc.RelayEvidence, maxPossibleRelays, node.EvidenceStore)
-			if node.EvidenceStore.IsSealed(evidence) {
-				err = vc.NewSealedEvidenceError(vc.ModuleName)
-				return
-			}
-			if !vc.IsUniqueProof(relay.Proof, evidence) {
-				err = vc.NewDuplicateProofError(vc.ModuleName)
-				return
-			}
-			if sdk.NewInt(totalRelays).GTE(maxPossibleRelays) {
-				err = vc.NewOverServiceError(vc.ModuleName)
-				return
-			}
-
-			// Send the response to the WebSocket client
-			resChan <- resp
+		maxPossibleRelays, _, err := proof.ValidateWebsocket(ctx, k.posKeeper, k.requestorKeeper, k, hostedBlockchains, proof.SessionBlockHeight, node)
+		if err != nil {
+			return err
+		}
+		if err := session.AddCredit(vc.WebsocketCredit{Proof: proof, MaxPossibleRelays: maxPossibleRelays, Node: node}); err != nil {
+			return err
 		}
-	}()
+	}
+	return nil
+}
 
-	// Return the channel for WebSocket responses
-	return resChan, err
+// websocketNode returns the servicer targeted by the proof
+func (k Keeper) websocketNode(proof vc.RelayProof) (*vc.ViperNode, sdk.Address, sdk.Error) {
+	if !vc.GlobalViperConfig.LeanViper {
+		// get self node (your validator) from the current state
+		node := vc.GetViperNode()
+		return node, node.GetAddress(), nil
+	}
+	// if lean viper enabled, grab the targeted servicer through the relay proof
+	servicerRelayPublicKey, err := crypto.NewPublicKey(proof.ServicerPubKey)
+	if err != nil {
+		return nil, nil, sdk.ErrInternal("Could not convert servicer hex to public key")
+	}
+	nodeAddress := sdk.GetAddress(servicerRelayPublicKey)
+	node, err := vc.GetViperNodeByAddress(&nodeAddress)
+	if err != nil {
+		return nil, nil, sdk.ErrInternal("Failed to find correct servicer PK")
+	}
+	return node, nodeAddress, nil
 }
 
 // "HandleChallenge" - Handles a client relay response challenge request
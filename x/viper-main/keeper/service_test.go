@@ -2,8 +2,13 @@ package keeper
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	crypto "github.com/vipernet-xyz/viper-network/crypto/codec"
 	"github.com/vipernet-xyz/viper-network/crypto/keys"
@@ -12,7 +17,9 @@ import (
 	requestorsTypes "github.com/vipernet-xyz/viper-network/x/requestors/types"
 	"github.com/vipernet-xyz/viper-network/x/viper-main/types"
 
+	"github.com/gorilla/websocket"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"gopkg.in/h2non/gock.v1"
 )
 
@@ -343,7 +350,26 @@ func setupHandleWebsocketRelayTest(t *testing.T) (
 	return
 }
 
-func testWebsocketRelayAt(
+// newWebsocketChainServer mocks the websocket endpoint of the hosted chain, requests are echoed back with a result
+func newWebsocketChainServer() *httptest.Server {
+	upgrader := websocket.Upgrader{}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			var req map[string]json.RawMessage
+			if err := conn.ReadJSON(&req); err != nil {
+				return
+			}
+			_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":`+string(req["id"])+`,"result":"Geth/v1.10.0"}`))
+		}
+	}))
+}
+
+func newTestRelayAt(
 	t *testing.T,
 	ctx sdk.Ctx,
 	keeper Keeper,
@@ -353,7 +379,8 @@ func testWebsocketRelayAt(
 	chain string,
 	geozone string,
 	numServicers int64,
-) (chan *types.RelayResponse, error) {
+	payload types.Payload,
+) types.Relay {
 	clientPubKey := clientPrivateKey.PublicKey()
 	appPubKey := appPrivateKey.PublicKey()
 	blocksPerSesssion := keeper.BlocksPerSession(ctx)
@@ -361,18 +388,8 @@ func testWebsocketRelayAt(
 		((clientBlockHeight-1)/blocksPerSesssion)*blocksPerSesssion + 1
 
 	validWebsocketRelay := types.Relay{
-		Payload: types.Payload{
-			Data: `{
-			"jsonrpc":"2.0",
-			"method":"web3_clientVersion",
-			"params":[],
-			"id":67
-		}`,
-			Method:  "",
-			Path:    "",
-			Headers: nil,
-		},
-		Meta: types.RelayMeta{BlockHeight: clientSessionHeight},
+		Payload: payload,
+		Meta:    types.RelayMeta{BlockHeight: clientSessionHeight},
 		Proof: types.RelayProof{
 			Entropy:            rand.Int63(),
 			SessionBlockHeight: clientSessionHeight,
@@ -403,13 +420,62 @@ func testWebsocketRelayAt(
 	}
 	assert.Nil(t, er)
 	validWebsocketRelay.Proof.Signature = hex.EncodeToString(clientSig)
+	return validWebsocketRelay
+}
 
-	return keeper.HandleWebsocketRelay(ctx, validWebsocketRelay)
+// cacheTestSession caches the session of the proof with this node as a servicer,
+// so the relay tests do not depend on the pseudorandom servicer selection
+func cacheTestSession(proof types.RelayProof) {
+	node := types.GetViperNode()
+	servicers := make(types.SessionServicers, proof.NumServicers)
+	servicers[0] = node.GetAddress()
+	for i := 1; i < len(servicers); i++ {
+		servicers[i] = getRandomValidatorAddress()
+	}
+	types.SetSession(types.Session{SessionHeader: proof.SessionHeader(), SessionServicers: servicers}, node.SessionStore)
+}
+
+func testWebsocketRelayAt(
+	t *testing.T,
+	ctx sdk.Ctx,
+	keeper Keeper,
+	session *types.WebsocketSession,
+	clientBlockHeight int64,
+	clientPrivateKey, appPrivateKey crypto.Ed25519PrivateKey,
+	nodePubKey crypto.PublicKey,
+	chain string,
+	geozone string,
+	numServicers int64,
+) sdk.Error {
+	relay := newTestRelayAt(t, ctx, keeper, clientBlockHeight, clientPrivateKey, appPrivateKey, nodePubKey, chain, geozone, numServicers, types.Payload{
+		Data: `{"jsonrpc":"2.0","method":"web3_clientVersion","params":[],"id":67}`,
+	})
+	cacheTestSession(relay.Proof)
+	return keeper.HandleWebsocketRelay(ctx, session, relay)
+}
+
+func nextWebsocketMessage(t *testing.T, session *types.WebsocketSession) types.WebsocketMessage {
+	select {
+	case m := <-session.Messages():
+		return m
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for a websocket message")
+		return types.WebsocketMessage{}
+	}
 }
 
 func TestKeeper_HandleWebsocketRelay(t *testing.T) {
 	ctx, keeper, kvkeys, clientPrivateKey, appPrivateKey, nodePubKey, chain, geoZone, numServicers :=
 		setupHandleWebsocketRelayTest(t)
+	// the requestor acts as its own client
+	clientPrivateKey = appPrivateKey
+
+	server := newWebsocketChainServer()
+	originalChains := keeper.GetHostedBlockchains().M
+	keeper.SetHostedBlockchains(map[string]types.HostedBlockchain{chain: {
+		ID:           chain,
+		WebSocketURL: "ws" + strings.TrimPrefix(server.URL, "http"),
+	}})
 
 	// Store the original allowances to clean up at the end of this test
 	originalClientBlockSyncAllowance := types.GlobalViperConfig.ClientBlockSyncAllowance
@@ -422,10 +488,13 @@ func TestKeeper_HandleWebsocketRelay(t *testing.T) {
 	blocksPerSesssion := keeper.BlocksPerSession(ctx)
 	latestSessionHeight := keeper.GetLatestSessionBlockHeight(ctx)
 
+	session := types.NewWebsocketSession()
 	t.Cleanup(func() {
+		session.Close()
+		server.Close()
+		keeper.SetHostedBlockchains(originalChains)
 		types.GlobalViperConfig.ClientBlockSyncAllowance = originalClientBlockSyncAllowance
 		types.GlobalViperConfig.ClientSessionSyncAllowance = originalClientSessionSyncAllowance
-		gock.Off() // Flush pending mocks after test execution
 	})
 
 	mockCtx := new(Ctx)
@@ -444,10 +513,11 @@ func TestKeeper_HandleWebsocketRelay(t *testing.T) {
 	}
 
 	// Case 1: Client is synced with Node --> Success
-	resChan, err := testWebsocketRelayAt(
+	err := testWebsocketRelayAt(
 		t,
 		mockCtx,
 		keeper,
+		session,
 		nodeBlockHeight,
 		clientPrivateKey,
 		appPrivateKey,
@@ -457,14 +527,18 @@ func TestKeeper_HandleWebsocketRelay(t *testing.T) {
 		numServicers,
 	)
 	assert.Nil(t, err)
-	assert.NotNil(t, resChan)
+	m := nextWebsocketMessage(t, session)
+	assert.Equal(t, types.WebsocketMessageResponse, m.Type)
+	assert.Equal(t, `{"id":67,"jsonrpc":"2.0","result":"Geth/v1.10.0"}`, m.Response.Response)
+	assert.NotEmpty(t, m.Response.Signature)
 
 	// Case 2: Test when the relay is out of sync with the client's session
 	types.GlobalViperConfig.ClientSessionSyncAllowance = 0
-	_, err = testWebsocketRelayAt(
+	err = testWebsocketRelayAt(
 		t,
 		mockCtx,
 		keeper,
+		session,
 		latestSessionHeight-blocksPerSesssion,
 		clientPrivateKey,
 		appPrivateKey,
@@ -474,13 +548,15 @@ func TestKeeper_HandleWebsocketRelay(t *testing.T) {
 		numServicers,
 	)
 	assert.NotNil(t, err)
+	assert.Equal(t, sdk.CodeType(types.CodeInvalidBlockHeightError), err.Code())
 
 	// Case 3: Test when the relay is within the client's session sync allowance
 	types.GlobalViperConfig.ClientSessionSyncAllowance = 1
-	resChan, err = testWebsocketRelayAt(
+	err = testWebsocketRelayAt(
 		t,
 		mockCtx,
 		keeper,
+		session,
 		latestSessionHeight-blocksPerSesssion,
 		clientPrivateKey,
 		appPrivateKey,
@@ -490,6 +566,70 @@ func TestKeeper_HandleWebsocketRelay(t *testing.T) {
 		numServicers,
 	)
 	assert.Nil(t, err)
-	assert.NotNil(t, resChan)
+	m = nextWebsocketMessage(t, session)
+	assert.Equal(t, types.WebsocketMessageResponse, m.Type)
+
+	// Case 4: Credits are validated like relays and kept for the subscription messages
+	credit := newTestRelayAt(t, mockCtx, keeper, nodeBlockHeight, clientPrivateKey, appPrivateKey, nodePubKey, chain, geoZone, numServicers, types.Payload{})
+	cacheTestSession(credit.Proof)
+	assert.Nil(t, keeper.HandleWebsocketCredits(mockCtx, session, []types.RelayProof{credit.Proof}))
+	assert.Equal(t, 1, session.Credits(chain))
+	err = keeper.HandleWebsocketCredits(mockCtx, session, []types.RelayProof{credit.Proof})
+	assert.NotNil(t, err)
+	assert.Equal(t, sdk.CodeType(types.CodeDuplicateProofError), err.Code())
+	credit.Proof.Signature = ""
+	assert.NotNil(t, keeper.HandleWebsocketCredits(mockCtx, session, []types.RelayProof{credit.Proof}))
+	assert.Equal(t, 1, session.Credits(chain))
+}
+
+func TestKeeper_HandleRelayBatch(t *testing.T) {
+	ctx, keeper, kvkeys, clientPrivateKey, appPrivateKey, nodePubKey, chain, geoZone, numServicers :=
+		setupHandleRelayTest(t)
+	// the requestor acts as its own client
+	clientPrivateKey = appPrivateKey
+
+	originalClientBlockSyncAllowance := types.GlobalViperConfig.ClientBlockSyncAllowance
+	types.GlobalViperConfig.ClientBlockSyncAllowance = 10000
+	t.Cleanup(func() {
+		types.GlobalViperConfig.ClientBlockSyncAllowance = originalClientBlockSyncAllowance
+		gock.Off() // Flush pending mocks after test execution
+	})
+
+	nodeBlockHeight := ctx.BlockHeight()
+	mockCtx := new(Ctx)
+	mockCtx.On("KVStore", kvkeys["pos"]).Return(ctx.KVStore(kvkeys["pos"]))
+	mockCtx.On("KVStore", kvkeys["params"]).Return(ctx.KVStore(kvkeys["params"]))
+	mockCtx.On("BlockHeight").Return(ctx.BlockHeight())
+	mockCtx.On("Logger").Return(ctx.Logger())
+	mockCtx.On("PrevCtx", mock.Anything).Return(ctx, nil)
+
+	relay := newTestRelayAt(t, mockCtx, keeper, nodeBlockHeight, clientPrivateKey, appPrivateKey, nodePubKey, chain, geoZone, numServicers, types.Payload{
+		Data: `[{"jsonrpc":"2.0","method":"eth_chainId","params":[],"id":1},{"jsonrpc":"2.0","method":"net_version","params":[],"id":2}]`,
+	})
+	cacheTestSession(relay.Proof)
+	// the batch is missing the proof of its second call
+	resp, err := keeper.HandleRelay(mockCtx, relay)
+	assert.Nil(t, resp)
+	assert.NotNil(t, err)
+	assert.Equal(t, sdk.CodeType(types.CodeBatchProofsError), err.Code())
+
+	batchProof := relay.Proof
+	batchProof.Entropy = rand.Int63()
+	clientSig, er := clientPrivateKey.Sign(batchProof.Hash())
+	assert.Nil(t, er)
+	batchProof.Signature = hex.EncodeToString(clientSig)
+	relay.BatchProofs = []types.RelayProof{batchProof}
 
+	// the upstream answers out of order
+	gock.New("https://www.google.com:443").
+		Post("/").
+		Reply(200).
+		BodyString(`[{"jsonrpc":"2.0","id":2,"result":"1"},{"jsonrpc":"2.0","id":1,"result":"0x1"}]`)
+	resp, err = keeper.HandleRelay(mockCtx, relay)
+	assert.Nil(t, err)
+	assert.NotNil(t, resp)
+	assert.Equal(t, `[{"jsonrpc":"2.0","id":1,"result":"0x1"},{"jsonrpc":"2.0","id":2,"result":"1"}]`, resp.Response)
+	// each call of the batch is stored as a relay
+	_, totalRelays := types.GetTotalProofs(relay.Proof.SessionHeader(), types.RelayEvidence, sdk.NewInt(10000), types.GetViperNode().EvidenceStore)
+	assert.Equal(t, int64(2), totalRelays)
 }
@@ -19,6 +19,10 @@ func (k Keeper) HandleDispatch(ctx sdk.Ctx, header types.SessionHeader) (*types.
 	if err != nil {
 		return nil, err
 	}
+	// refuse to dispatch a chain this node is hosting while it is out of sync
+	if !types.GlobalSyncMonitor().IsInSync(header.Chain) {
+		return nil, types.NewChainOutOfSyncError(types.ModuleName)
+	}
 	// get the session context
 	sessionCtx, er := ctx.PrevCtx(latestSessionBlockHeight)
 	if er != nil {
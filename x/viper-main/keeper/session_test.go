@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"testing"
 
+	sdk "github.com/vipernet-xyz/viper-network/types"
 	"github.com/vipernet-xyz/viper-network/x/viper-main/types"
 
 	"github.com/stretchr/testify/assert"
@@ -52,6 +53,31 @@ func TestKeeper_Dispatch(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestKeeper_DispatchOutOfSync(t *testing.T) {
+	ctx, _, _, _, keeper, keys, _ := createTestInput(t, false)
+	requestorPubKey := getRandomPrivateKey().PublicKey().RawString()
+	ethereum := hex.EncodeToString([]byte{01})
+	header := types.SessionHeader{
+		RequestorPubKey:    requestorPubKey,
+		Chain:              ethereum,
+		SessionBlockHeight: 977,
+		GeoZone:            hex.EncodeToString([]byte{01}),
+		NumServicers:       5,
+	}
+	mockCtx := new(Ctx)
+	mockCtx.On("KVStore", keeper.storeKey).Return(ctx.KVStore(keeper.storeKey))
+	mockCtx.On("KVStore", keys["pos"]).Return(ctx.KVStore(keys["pos"]))
+	mockCtx.On("KVStore", keys["params"]).Return(ctx.KVStore(keys["params"]))
+	mockCtx.On("PrevCtx", header.SessionBlockHeight).Return(ctx, nil)
+	mockCtx.On("BlockHeight").Return(ctx.BlockHeight())
+	mockCtx.On("Logger").Return(ctx.Logger())
+	types.GlobalSyncMonitor().SetStatus(ethereum, types.ChainSyncStatus{InSync: false, Height: 10, ReferenceHeight: 100})
+	defer types.GlobalSyncMonitor().SetStatus(ethereum, types.ChainSyncStatus{InSync: true})
+	_, err := keeper.HandleDispatch(mockCtx, header)
+	assert.NotNil(t, err)
+	assert.Equal(t, sdk.CodeType(types.CodeChainOutOfSyncError), err.Code())
+}
+
 func TestKeeper_IsSessionBlock(t *testing.T) {
 	notSessionContext, _, _, _, keeper, _, _ := createTestInput(t, false)
 	fmt.Println(t, keeper.IsSessionBlock(notSessionContext.WithBlockHeight(977)))
@@ -0,0 +1,92 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	sdk "github.com/vipernet-xyz/viper-network/types"
+	vc "github.com/vipernet-xyz/viper-network/x/viper-main/types"
+
+	"github.com/tendermint/tendermint/rpc/client"
+)
+
+// "TrackSubmissions" - Follows the submissions of the node: the included ones are dropped from the queue, the ones
+// rejected or evicted from the mempool are due again once backed off, and the ones whose window closed are failed.
+// Returns true if a submission is due at the block height
+func (k Keeper) TrackSubmissions(ctx sdk.Ctx, n client.Client, node *vc.ViperNode) (due bool) {
+	height := ctx.BlockHeight()
+	for _, s := range vc.GetSubmissions(node.SubmissionStore) {
+		if s.Status == vc.SubmissionFailed {
+			if height > s.ExpirationHeight+vc.SubmissionRetention {
+				vc.DeleteSubmission(s, node.SubmissionStore)
+			}
+			continue
+		}
+		if k.submissionIncluded(ctx, n, &s) {
+			vc.DeleteSubmission(s, node.SubmissionStore)
+			continue
+		}
+		if height > s.ExpirationHeight {
+			s.Fail(fmt.Sprintf("the submission window closed at height %d before the inclusion, last error: %s", s.ExpirationHeight, s.Error))
+			ctx.Logger().Error(fmt.Sprintf("the %s of %s for the session at height %d was not included: %s", s.Type, s.Node, s.SessionHeader.SessionBlockHeight, s.Error))
+		}
+		vc.SetSubmission(s, node.SubmissionStore)
+		if s.IsDue(height) {
+			due = true
+		}
+	}
+	return
+}
+
+// submissionIncluded returns true if the tx of the submission is in the tx indexer, or its effect is in the state
+// when the indexer doesn't know it. A tx included with an error is sent again on the next attempt
+func (k Keeper) submissionIncluded(ctx sdk.Ctx, n client.Client, s *vc.Submission) bool {
+	if s.TxHash != "" && n != nil {
+		if hash, err := hex.DecodeString(s.TxHash); err == nil {
+			if res, err := n.Tx(hash, false); err == nil && res != nil {
+				if res.TxResult.Code == 0 {
+					return true
+				}
+				s.TxHash = ""
+				s.Error = res.TxResult.Log
+			}
+		}
+	}
+	switch s.Type {
+	case vc.ClaimSubmission:
+		_, found := k.GetClaim(ctx, s.Node, s.SessionHeader, s.EvidenceType)
+		return found
+	case vc.ReportCardSubmission:
+		_, found := k.GetReportCard(ctx, s.ServicerAddress, s.SessionHeader, s.EvidenceType)
+		return found
+	case vc.ProofSubmission:
+		// the claim is removed once its proof is processed, or once it expires with the submission window
+		_, found := k.GetClaim(ctx, s.Node, s.SessionHeader, s.EvidenceType)
+		return !found && s.Attempts > 0 && ctx.BlockHeight() <= s.ExpirationHeight
+	}
+	return false
+}
+
+// dueSubmission returns the queued submission, or a new one, and whether it should be sent at the block height
+func dueSubmission(ctx sdk.Ctx, node *vc.ViperNode, submissionType vc.SubmissionType, header vc.SessionHeader, evidenceType vc.EvidenceType, servicerAddr sdk.Address, expirationHeight int64) (vc.Submission, bool) {
+	s, found := vc.GetSubmission(vc.KeyForSubmission(submissionType, header, evidenceType, servicerAddr), node.SubmissionStore)
+	if !found {
+		s = vc.NewSubmission(submissionType, node.GetAddress(), header, evidenceType, servicerAddr, expirationHeight)
+	}
+	return s, s.IsDue(ctx.BlockHeight())
+}
+
+// recordSubmission persists the broadcast of the submission, a tx rejected by the mempool is not tracked
+func recordSubmission(ctx sdk.Ctx, node *vc.ViperNode, s vc.Submission, res *sdk.TxResponse, err error) {
+	txHash := ""
+	if err == nil && res != nil {
+		if res.Code != 0 {
+			err = errors.New(res.RawLog)
+		} else {
+			txHash = res.TxHash
+		}
+	}
+	s.RecordAttempt(ctx.BlockHeight(), txHash, err)
+	vc.SetSubmission(s, node.SubmissionStore)
+}
@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"encoding/hex"
+	"errors"
+	"sync"
+	"testing"
+
+	sdk "github.com/vipernet-xyz/viper-network/types"
+	"github.com/vipernet-xyz/viper-network/x/viper-main/types"
+
+	"github.com/stretchr/testify/assert"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	db "github.com/tendermint/tm-db"
+)
+
+// txClient serves the txs of the indexer by hex hash, the other client calls are not expected
+type txClient struct {
+	client.Client
+	txs map[string]uint32
+}
+
+func (c txClient) Tx(hash []byte, _ bool) (*ctypes.ResultTx, error) {
+	code, ok := c.txs[hex.EncodeToString(hash)]
+	if !ok {
+		return nil, errors.New("tx not found")
+	}
+	return &ctypes.ResultTx{Hash: hash, TxResult: abci.ResponseDeliverTx{Code: code, Log: "invalid claim"}}, nil
+}
+
+func TestKeeper_TrackSubmissions(t *testing.T) {
+	ctx, _, _, _, keeper, _, _ := createTestInput(t, false)
+	ctx = ctx.WithBlockHeight(10)
+	node := &types.ViperNode{
+		PrivateKey:      getRandomPrivateKey(),
+		SubmissionStore: &types.CacheStorage{Cache: sdk.NewCache(1), DB: db.NewMemDB(), SealMap: &sync.Map{}},
+	}
+	newSubmission := func(height, expiration int64, txHash string) types.Submission {
+		header := types.SessionHeader{
+			RequestorPubKey:    getRandomPubKey().RawString(),
+			Chain:              getTestSupportedBlockchain(),
+			GeoZone:            "0001",
+			NumServicers:       1,
+			SessionBlockHeight: height,
+		}
+		s := types.NewSubmission(types.ClaimSubmission, node.GetAddress(), header, types.RelayEvidence, nil, expiration)
+		s.RecordAttempt(height, txHash, nil)
+		types.SetSubmission(s, node.SubmissionStore)
+		return s
+	}
+	included := newSubmission(1, 20, "aa")
+	rejected := newSubmission(2, 20, "bb")
+	pending := newSubmission(10, 20, "cc")
+	expired := newSubmission(3, 9, "")
+	n := txClient{txs: map[string]uint32{"aa": 0, "bb": 5}}
+
+	// the rejected tx is due again
+	assert.True(t, keeper.TrackSubmissions(ctx, n, node))
+	_, found := types.GetSubmission(included.Key(), node.SubmissionStore)
+	assert.False(t, found)
+	s, found := types.GetSubmission(rejected.Key(), node.SubmissionStore)
+	assert.True(t, found)
+	assert.Empty(t, s.TxHash)
+	assert.Equal(t, "invalid claim", s.Error)
+	assert.True(t, s.IsDue(ctx.BlockHeight()))
+	s, _ = types.GetSubmission(pending.Key(), node.SubmissionStore)
+	assert.Equal(t, types.SubmissionPending, s.Status)
+	assert.False(t, s.IsDue(ctx.BlockHeight()))
+	s, _ = types.GetSubmission(expired.Key(), node.SubmissionStore)
+	assert.Equal(t, types.SubmissionFailed, s.Status)
+
+	// the failed submissions are pruned after the retention
+	ctx = ctx.WithBlockHeight(expired.ExpirationHeight + types.SubmissionRetention + 1)
+	keeper.TrackSubmissions(ctx, n, node)
+	_, found = types.GetSubmission(expired.Key(), node.SubmissionStore)
+	assert.False(t, found)
+}
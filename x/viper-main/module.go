@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/rand"
+	"strconv"
 	"time"
 
 	"github.com/vipernet-xyz/viper-network/codec"
@@ -97,6 +98,16 @@ func (am AppModule) BeginBlock(ctx sdk.Ctx, req abci.RequestBeginBlock) {
 	ActivateAdditionalParameters(ctx, am)
 	// delete the expired claims
 	am.keeper.DeleteExpiredClaims(ctx)
+	// signal the start of a new session
+	if am.keeper.IsSessionBlock(ctx) {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeNewSession,
+				sdk.NewAttribute(types.AttributeKeySessionHeight, strconv.FormatInt(ctx.BlockHeight(), 10)),
+				sdk.NewAttribute(types.AttributeKeyBlocksPerSession, strconv.FormatInt(am.keeper.BlocksPerSession(ctx), 10)),
+			),
+		)
+	}
 }
 
 // ActivateAdditionalParameters activate additional parameters on their respective upgrade heights
@@ -134,15 +145,23 @@ func (am AppModule) EndBlock(ctx sdk.Ctx, _ abci.RequestEndBlock) []abci.Validat
 			return
 		}
 
+		// run the due sampling rounds of the fisherman
+		am.keeper.DispatchSampling(ctx)
+
 		for _, node := range types.GlobalViperNodes {
 			address := node.GetAddress()
-			if (ctx.BlockHeight()+int64(address[0]))%blocksPerSession == 1 && ctx.BlockHeight() != 1 {
+			// follow the submitted reportcards/claims/proofs, the dropped ones are due again once backed off
+			due := am.keeper.TrackSubmissions(ctx, am.keeper.TmNode, node)
+			sessionEnd := (ctx.BlockHeight()+int64(address[0]))%blocksPerSession == 1 && ctx.BlockHeight() != 1
+			if sessionEnd || due {
 				//auto send the reportcards
 				am.keeper.SendReportCardTx(ctx, am.keeper, am.keeper.TmNode, node, ReportCardTx)
 				// auto send the proofs
 				am.keeper.SendClaimTx(ctx, am.keeper, am.keeper.TmNode, node, ClaimTx)
 				// auto claim the proofs
 				am.keeper.SendProofTx(ctx, am.keeper.TmNode, node, ProofTx)
+			}
+			if sessionEnd {
 				// clear session cache and db
 				types.ClearSessionCache(node.SessionStore)
 			}
@@ -5,14 +5,20 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+
+	sdk "github.com/vipernet-xyz/viper-network/types"
 )
 
 var (
 	// A list of supported token versions
 	// Requires major (semantic) upgrade to update this list
-	SupportedTokenVersions = []string{"0.0.1"}
+	SupportedTokenVersions = []string{"0.0.1", ScopedTokenVersion}
 )
 
+// "ScopedTokenVersion" - The version of the AAT that is scoped by an expiration height,
+// the allowed chains and geozones and a per session relay cap of the client
+const ScopedTokenVersion = "0.0.2"
+
 // "VersionIsIncluded" - Returns if the version is included
 func (a AAT) VersionIsIncluded() bool {
 	// if version is empty return nil
@@ -54,6 +60,10 @@ func (a AAT) Bytes() []byte {
 		RequestorPublicKey: a.RequestorPublicKey,
 		ClientPublicKey:    a.ClientPublicKey,
 		Version:            a.Version,
+		ExpirationHeight:   a.ExpirationHeight,
+		Chains:             a.Chains,
+		GeoZones:           a.GeoZones,
+		MaxRelays:          a.MaxRelays,
 	})
 	if err != nil {
 		log.Fatal(fmt.Sprintf("an error occured hashing the aat:\n%v", err))
@@ -101,9 +111,66 @@ func (a AAT) ValidateMessage() error {
 	if err := PubKeyVerification(a.ClientPublicKey); err != nil {
 		return err
 	}
+	return a.ValidateScopeFields()
+}
+
+// "IsScoped" - Returns if the AAT is of the scoped version
+func (a AAT) IsScoped() bool {
+	return a.Version == ScopedTokenVersion
+}
+
+// "ValidateScopeFields" - Confirms the scope fields of the AAT are well formed for its version
+func (a AAT) ValidateScopeFields() error {
+	if !a.IsScoped() {
+		// only the scoped version may carry a scope
+		if a.ExpirationHeight != 0 || len(a.Chains) != 0 || len(a.GeoZones) != 0 || a.MaxRelays != 0 {
+			return InvalidTokenScopeError
+		}
+		return nil
+	}
+	if a.ExpirationHeight < 0 {
+		return NegativeTokenExpirationError
+	}
+	if a.MaxRelays < 0 {
+		return NegativeTokenRelayCapError
+	}
+	for _, chain := range a.Chains {
+		if err := NetworkIdentifierVerification(chain); err != nil {
+			return err
+		}
+	}
+	for _, geoZone := range a.GeoZones {
+		if err := GeoZoneIdentifierVerification(geoZone); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// "ValidateScope" - Confirms the AAT may be used on the chain and geozone at the block height;
+// an empty list of chains or geozones allows all of them and a zero expiration height never expires
+func (a AAT) ValidateScope(chain, geoZone string, blockHeight int64) sdk.Error {
+	if a.ExpirationHeight != 0 && blockHeight >= a.ExpirationHeight {
+		return NewExpiredTokenError(ModuleName)
+	}
+	if len(a.Chains) != 0 && !containsString(a.Chains, chain) {
+		return NewUnauthorizedTokenChainError(ModuleName)
+	}
+	if len(a.GeoZones) != 0 && !containsString(a.GeoZones, geoZone) {
+		return NewUnauthorizedTokenGeoZoneError(ModuleName)
+	}
 	return nil
 }
 
+func containsString(list []string, s string) bool {
+	for _, e := range list {
+		if e == s {
+			return true
+		}
+	}
+	return false
+}
+
 // "ValidateSignature" - Confirms the signature field of the AAT
 func (a AAT) ValidateSignature() error {
 	// check for valid signature
@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/vipernet-xyz/viper-network/codec"
+	sdk "github.com/vipernet-xyz/viper-network/types"
 )
 
 func TestAAT_VersionIsIncluded(t *testing.T) {
@@ -272,3 +274,104 @@ func TestAAT_Validate(t *testing.T) {
 	AAT.RequestorSignature = hex.EncodeToString(requestorSignature)
 	assert.Nil(t, AAT.Validate())
 }
+
+func TestAAT_Bytes(t *testing.T) {
+	requestorPrivKey := GetRandomPrivateKey()
+	clientPrivKey := GetRandomPrivateKey()
+	aat := AAT{
+		Version:            "0.0.1",
+		RequestorPublicKey: requestorPrivKey.PublicKey().RawString(),
+		ClientPublicKey:    clientPrivKey.PublicKey().RawString(),
+	}
+	// the unscoped tokens keep their bytes, and so their signatures
+	assert.NotContains(t, string(aat.Bytes()), "expiration_height")
+	scoped := aat
+	scoped.Version = ScopedTokenVersion
+	scoped.ExpirationHeight = 10
+	assert.Contains(t, string(scoped.Bytes()), `"expiration_height":10`)
+	assert.NotEqual(t, aat.HashString(), scoped.HashString())
+}
+
+func TestAAT_ValidateScopeFields(t *testing.T) {
+	requestorPrivKey := GetRandomPrivateKey()
+	clientPrivKey := GetRandomPrivateKey()
+	unscoped := AAT{
+		Version:            "0.0.1",
+		RequestorPublicKey: requestorPrivKey.PublicKey().RawString(),
+		ClientPublicKey:    clientPrivKey.PublicKey().RawString(),
+	}
+	scoped := unscoped
+	scoped.Version = ScopedTokenVersion
+	scoped.ExpirationHeight = 100
+	scoped.Chains = []string{"0001"}
+	scoped.GeoZones = []string{"0001"}
+	scoped.MaxRelays = 10
+	unscopedWithScope := scoped
+	unscopedWithScope.Version = "0.0.1"
+	negativeExpiration := scoped
+	negativeExpiration.ExpirationHeight = -1
+	negativeCap := scoped
+	negativeCap.MaxRelays = -1
+	invalidChain := scoped
+	invalidChain.Chains = []string{"not hex"}
+	invalidGeoZone := scoped
+	invalidGeoZone.GeoZones = []string{"0000000001"}
+	tests := []struct {
+		name     string
+		aat      AAT
+		hasError bool
+	}{
+		{"unscoped token", unscoped, false},
+		{"scoped token", scoped, false},
+		{"unscoped token with a scope", unscopedWithScope, true},
+		{"negative expiration height", negativeExpiration, true},
+		{"negative relay cap", negativeCap, true},
+		{"invalid chain", invalidChain, true},
+		{"invalid geozone", invalidGeoZone, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.hasError, tt.aat.ValidateScopeFields() != nil)
+		})
+	}
+}
+
+func TestAAT_ValidateScope(t *testing.T) {
+	aat := AAT{
+		Version:          ScopedTokenVersion,
+		ExpirationHeight: 100,
+		Chains:           []string{"0001"},
+		GeoZones:         []string{"0002"},
+	}
+	assert.Nil(t, aat.ValidateScope("0001", "0002", 99))
+	assert.Equal(t, sdk.CodeType(CodeExpiredTokenError), aat.ValidateScope("0001", "0002", 100).Code())
+	assert.Equal(t, sdk.CodeType(CodeUnauthorizedTokenChainError), aat.ValidateScope("0003", "0002", 99).Code())
+	assert.Equal(t, sdk.CodeType(CodeUnauthorizedTokenGeoZoneError), aat.ValidateScope("0001", "0003", 99).Code())
+	// an empty scope allows everything
+	assert.Nil(t, AAT{Version: ScopedTokenVersion}.ValidateScope("0003", "0003", 1000))
+}
+
+func TestValidateTokens(t *testing.T) {
+	ctx := newContext(t, false)
+	clientPubKey := GetRandomPrivateKey().PublicKey().RawString()
+	token := AAT{Version: ScopedTokenVersion, ClientPublicKey: clientPubKey, MaxRelays: 2}
+	proof := RelayProof{Blockchain: "0001", GeoZone: "0001", Token: token}
+	// the scoped tokens are rejected before the upgrade
+	codec.UpgradeFeatureMap[codec.TokenRevocationKey] = 0
+	err := validateTokens(ctx, Session{}, []RelayProof{proof}, Evidence{})
+	assert.Equal(t, sdk.CodeType(CodeInvalidTokenError), err.Code())
+	codec.UpgradeFeatureMap[codec.TokenRevocationKey] = -1
+	defer func() { codec.UpgradeFeatureMap[codec.TokenRevocationKey] = 0 }()
+	assert.Nil(t, validateTokens(ctx, Session{}, []RelayProof{proof}, Evidence{}))
+	// the clients revoked at the start of the session are rejected
+	err = validateTokens(ctx, Session{RevokedClients: []string{clientPubKey}}, []RelayProof{proof}, Evidence{})
+	assert.Equal(t, sdk.CodeType(CodeRevokedClientError), err.Code())
+	// the relays of the client in the evidence count toward the cap
+	evidence := Evidence{Proofs: Proofs{proof}}
+	assert.Nil(t, validateTokens(ctx, Session{}, []RelayProof{proof}, evidence))
+	err = validateTokens(ctx, Session{}, []RelayProof{proof, proof}, evidence)
+	assert.Equal(t, sdk.CodeType(CodeTokenRelayCapError), err.Code())
+	evidence.Proofs = append(evidence.Proofs, &proof)
+	err = validateTokens(ctx, Session{}, []RelayProof{proof}, evidence)
+	assert.Equal(t, sdk.CodeType(CodeTokenRelayCapError), err.Code())
+}
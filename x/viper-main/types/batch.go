@@ -0,0 +1,129 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+
+	sdk "github.com/vipernet-xyz/viper-network/types"
+)
+
+const (
+	DefaultBatchLimit = 100
+)
+
+// "GetBatchLimit" - Returns the maximum number of calls of a json-rpc batch relayed to the chain
+func (hb HostedBlockchain) GetBatchLimit() int {
+	if hb.BatchLimit <= 0 {
+		return DefaultBatchLimit
+	}
+	return hb.BatchLimit
+}
+
+// "BatchSize" - Returns the number of calls of a json-rpc batch payload, isBatch is false for any other payload
+func (p Payload) BatchSize() (size int, isBatch bool) {
+	data := bytes.TrimSpace([]byte(p.Data))
+	if len(data) == 0 || data[0] != '[' {
+		return 0, false
+	}
+	var calls []json.RawMessage
+	if err := json.Unmarshal(data, &calls); err != nil {
+		return 0, false
+	}
+	return len(calls), true
+}
+
+// "AllProofs" - Returns the proof of the relay followed by the proofs paying for the other calls of a batch
+func (r Relay) AllProofs() []RelayProof {
+	return append([]RelayProof{r.Proof}, r.BatchProofs...)
+}
+
+// "validateBatch" - Returns the number of relays the payload counts for, each call of a json-rpc batch is
+// a relay paid by its own proof of the same request
+func (r Relay) validateBatch(chain HostedBlockchain) (int64, sdk.Error) {
+	size, isBatch := r.Payload.BatchSize()
+	if !isBatch {
+		if len(r.BatchProofs) != 0 {
+			return 0, NewBatchProofsError(ModuleName)
+		}
+		return 1, nil
+	}
+	if size == 0 {
+		return 0, NewEmptyPayloadDataError(ModuleName)
+	}
+	if size > chain.GetBatchLimit() {
+		return 0, NewMaxBatchSizeError(ModuleName)
+	}
+	if len(r.BatchProofs) != size-1 {
+		return 0, NewBatchProofsError(ModuleName)
+	}
+	hashes := map[string]struct{}{r.Proof.HashString(): {}}
+	for _, p := range r.BatchProofs {
+		// the batch proofs are bound to the same request, session and servicer
+		if p.RequestHash != r.Proof.RequestHash || p.ServicerPubKey != r.Proof.ServicerPubKey || p.SessionHeader() != r.Proof.SessionHeader() {
+			return 0, NewBatchProofsError(ModuleName)
+		}
+		if _, found := hashes[p.HashString()]; found {
+			return 0, NewDuplicateProofError(ModuleName)
+		}
+		hashes[p.HashString()] = struct{}{}
+	}
+	return int64(size), nil
+}
+
+// "orderBatchResponse" - Orders the responses of a json-rpc batch like the calls of the request,
+// the response is returned as is if it is not a batch or already ordered
+func orderBatchResponse(request, response string) string {
+	var calls, responses []json.RawMessage
+	if err := json.Unmarshal([]byte(request), &calls); err != nil {
+		return response
+	}
+	if err := json.Unmarshal([]byte(response), &responses); err != nil {
+		return response
+	}
+	byID := make(map[string][]int)
+	for i, r := range responses {
+		id := batchCallID(r)
+		byID[id] = append(byID[id], i)
+	}
+	ordered := make([][]byte, 0, len(responses))
+	used := make([]bool, len(responses))
+	for _, c := range calls {
+		id := batchCallID(c)
+		// notifications (calls without an id) are not answered
+		if id == "" || id == "null" || len(byID[id]) == 0 {
+			continue
+		}
+		i := byID[id][0]
+		byID[id] = byID[id][1:]
+		used[i] = true
+		ordered = append(ordered, responses[i])
+	}
+	// responses not matching a call (e.g. parse errors with a null id) are kept at the end
+	for i, r := range responses {
+		if !used[i] {
+			ordered = append(ordered, r)
+		}
+	}
+	inOrder := true
+	for i := range ordered {
+		if !bytes.Equal(ordered[i], responses[i]) {
+			inOrder = false
+			break
+		}
+	}
+	if inOrder {
+		return response
+	}
+	// the raw responses are joined as is, re-encoding them would change the bytes returned by the chain
+	return "[" + string(bytes.Join(ordered, []byte(","))) + "]"
+}
+
+func batchCallID(call json.RawMessage) string {
+	var c struct {
+		ID json.RawMessage `json:"id"`
+	}
+	if err := json.Unmarshal(call, &c); err != nil {
+		return ""
+	}
+	return compactJSON(c.ID)
+}
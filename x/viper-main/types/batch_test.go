@@ -0,0 +1,110 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	sdk "github.com/vipernet-xyz/viper-network/types"
+)
+
+func TestPayload_BatchSize(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		size    int
+		isBatch bool
+	}{
+		{"single call", `{"jsonrpc":"2.0","method":"eth_chainId","id":1}`, 0, false},
+		{"batch", ` [{"method":"eth_chainId","id":1},{"method":"eth_blockNumber","id":2}]`, 2, true},
+		{"empty batch", `[]`, 0, true},
+		{"not json", `[not json`, 0, false},
+		{"rest", ``, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			size, isBatch := Payload{Data: tt.data}.BatchSize()
+			assert.Equal(t, tt.size, size)
+			assert.Equal(t, tt.isBatch, isBatch)
+		})
+	}
+}
+
+func TestRelay_ValidateBatch(t *testing.T) {
+	ethereum := hex.EncodeToString([]byte{01})
+	proof := RelayProof{Entropy: 1, Blockchain: ethereum, RequestHash: "abc", ServicerPubKey: "servicer", SessionBlockHeight: 1}
+	second, third := proof, proof
+	second.Entropy, third.Entropy = 2, 3
+	otherRequest := third
+	otherRequest.RequestHash = "def"
+	batch := Payload{Data: `[{"method":"eth_chainId","id":1},{"method":"eth_blockNumber","id":2},{"method":"net_version","id":3}]`}
+	chain := HostedBlockchain{ID: ethereum}
+	tests := []struct {
+		name   string
+		relay  Relay
+		chain  HostedBlockchain
+		relays int64
+		code   sdk.CodeType
+	}{
+		{"single call", Relay{Payload: Payload{Data: `{"id":1}`}, Proof: proof}, chain, 1, 0},
+		{"single call with batch proofs", Relay{Payload: Payload{Data: `{"id":1}`}, Proof: proof, BatchProofs: []RelayProof{second}}, chain, 0, CodeBatchProofsError},
+		{"batch", Relay{Payload: batch, Proof: proof, BatchProofs: []RelayProof{second, third}}, chain, 3, 0},
+		{"missing proof", Relay{Payload: batch, Proof: proof, BatchProofs: []RelayProof{second}}, chain, 0, CodeBatchProofsError},
+		{"proof of another request", Relay{Payload: batch, Proof: proof, BatchProofs: []RelayProof{second, otherRequest}}, chain, 0, CodeBatchProofsError},
+		{"duplicate proof", Relay{Payload: batch, Proof: proof, BatchProofs: []RelayProof{second, second}}, chain, 0, CodeDuplicateProofError},
+		{"over the batch limit", Relay{Payload: batch, Proof: proof, BatchProofs: []RelayProof{second, third}}, HostedBlockchain{ID: ethereum, BatchLimit: 2}, 0, CodeMaxBatchSizeError},
+		{"empty batch", Relay{Payload: Payload{Data: `[]`}, Proof: proof}, chain, 0, CodeEmptyPayloadDataError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			relays, err := tt.relay.validateBatch(tt.chain)
+			assert.Equal(t, tt.relays, relays)
+			if tt.code == 0 {
+				assert.Nil(t, err)
+			} else {
+				assert.NotNil(t, err)
+				assert.Equal(t, tt.code, err.Code())
+			}
+		})
+	}
+}
+
+func TestRelay_BatchProofsRoundTrip(t *testing.T) {
+	proof := RelayProof{Entropy: 1, RequestHash: "abc", ServicerPubKey: "servicer", SessionBlockHeight: 1}
+	second := proof
+	second.Entropy = 2
+	relay := Relay{
+		Payload:     Payload{Data: `[{"id":1},{"id":2}]`, Headers: map[string]string{"Content-Type": "application/json"}},
+		Meta:        RelayMeta{BlockHeight: 5},
+		Proof:       proof,
+		BatchProofs: []RelayProof{second},
+	}
+	bz, err := json.Marshal(relay)
+	assert.Nil(t, err)
+	var decoded Relay
+	assert.Nil(t, json.Unmarshal(bz, &decoded))
+	assert.Equal(t, relay, decoded)
+}
+
+func TestOrderBatchResponse(t *testing.T) {
+	request := `[{"method":"eth_chainId","id":1},{"method":"eth_subscription"},{"method":"eth_blockNumber","id":"b"},{"method":"net_version","id":3}]`
+	tests := []struct {
+		name     string
+		response string
+		expected string
+	}{
+		{"already ordered", `[{"id":1,"result":"0x1"}, {"id":"b","result":"0x2"}, {"id":3,"result":"1"}]`, `[{"id":1,"result":"0x1"}, {"id":"b","result":"0x2"}, {"id":3,"result":"1"}]`},
+		{"reordered", `[{"id":3,"result":"1"},{"id":1,"result":"0x1"},{"id":"b","result":"0x2"}]`, `[{"id":1,"result":"0x1"},{"id":"b","result":"0x2"},{"id":3,"result":"1"}]`},
+		{"unmatched responses last", `[{"id":null,"error":{"code":-32700}},{"id":3,"result":"1"},{"id":1,"result":"0x1"}]`, `[{"id":1,"result":"0x1"},{"id":3,"result":"1"},{"id":null,"error":{"code":-32700}}]`},
+		{"raw responses kept", `[{"id":3, "result": "\u003c"},{"result":1e3,"id":1}]`, `[{"result":1e3,"id":1},{"id":3, "result": "\u003c"}]`},
+		{"not a batch response", `{"error":"batches are not supported"}`, `{"error":"batches are not supported"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, orderBatchResponse(request, tt.response))
+		})
+	}
+	// single calls are returned as is
+	assert.Equal(t, `[1,2]`, orderBatchResponse(`{"id":1}`, `[1,2]`))
+}
@@ -547,9 +547,23 @@ func (ti *TestResultIt) Value() (result Result) {
 
 func ResultIterator(testStore *CacheStorage) TestResultIt {
 	it, _ := testStore.Iterator()
-	return TestResultIt{
+	ti := TestResultIt{
 		Iterator: it,
 	}
+	ti.skipSchedules()
+	return ti
+}
+
+// "Next" - Moves to the next result, the sampling schedules sharing the result db are skipped
+func (ti *TestResultIt) Next() {
+	ti.Iterator.Next()
+	ti.skipSchedules()
+}
+
+func (ti *TestResultIt) skipSchedules() {
+	for ti.Iterator.Valid() && isSamplingScheduleKey(ti.Iterator.Key()) {
+		ti.Iterator.Next()
+	}
 }
 
 // "GetTestResult" - Returns the TestResult object from a specific piece of GOBEvidence at a certain index
@@ -0,0 +1,226 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var globalSyncMonitor = NewSyncMonitor()
+
+const (
+	DefaultSyncCheckResultKey = "result"
+)
+
+// "SyncCheck" - The block height probe of a hosted blockchain
+type SyncCheck struct {
+	Payload       Payload  `json:"payload"`                  // request returning the head of the chain, e.g. eth_blockNumber
+	ResultKey     string   `json:"result_key,omitempty"`     // dot separated path to the height in the response, defaults to "result"
+	Allowance     int64    `json:"allowance,omitempty"`      // number of blocks the chain may lag behind the reference height
+	ReferenceURLs []string `json:"reference_urls,omitempty"` // trusted endpoints (e.g. other servicers' nodes) providing the reference height
+}
+
+// "ChainSyncStatus" - The result of the latest block height probe of a hosted blockchain
+type ChainSyncStatus struct {
+	InSync          bool      `json:"in_sync"`
+	Height          int64     `json:"height"`           // highest height reported by the upstreams
+	ReferenceHeight int64     `json:"reference_height"` // highest height reported by the upstreams and the references
+	LastChecked     time.Time `json:"last_checked"`
+	Error           string    `json:"error,omitempty"`
+}
+
+// "SyncMonitor" - Tracks whether the hosted blockchains are synced
+type SyncMonitor struct {
+	l        sync.Mutex
+	statuses map[string]ChainSyncStatus
+}
+
+// "NewSyncMonitor" - Returns a sync monitor without any status
+func NewSyncMonitor() *SyncMonitor {
+	return &SyncMonitor{statuses: make(map[string]ChainSyncStatus)}
+}
+
+// "GlobalSyncMonitor" - Returns the sync monitor used for relays and dispatches
+func GlobalSyncMonitor() *SyncMonitor {
+	return globalSyncMonitor
+}
+
+// "Status" - Returns the latest sync status of the chain
+func (m *SyncMonitor) Status(chainID string) (ChainSyncStatus, bool) {
+	m.l.Lock()
+	defer m.l.Unlock()
+	s, found := m.statuses[chainID]
+	return s, found
+}
+
+// "IsInSync" - Returns false only if the latest probe found the chain out of sync,
+// chains without a sync check (or not checked yet) are considered in sync
+func (m *SyncMonitor) IsInSync(chainID string) bool {
+	s, found := m.Status(chainID)
+	return !found || s.InSync
+}
+
+// "SetStatus" - Sets the sync status of the chain
+func (m *SyncMonitor) SetStatus(chainID string, status ChainSyncStatus) {
+	m.l.Lock()
+	defer m.l.Unlock()
+	m.statuses[chainID] = status
+}
+
+// "CheckChains" - Probes the head of every hosted chain with a sync check once
+func (m *SyncMonitor) CheckChains(hostedBlockchains *HostedBlockchains) {
+	// copy the chains so a hot reload is picked up on the next check without holding the lock
+	hostedBlockchains.L.Lock()
+	chains := make(map[string]HostedBlockchain, len(hostedBlockchains.M))
+	for k, v := range hostedBlockchains.M {
+		chains[k] = v
+	}
+	hostedBlockchains.L.Unlock()
+	var wg sync.WaitGroup
+	for _, chain := range chains {
+		if chain.SyncCheck == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(chain HostedBlockchain) {
+			defer wg.Done()
+			status := m.checkChain(chain)
+			m.SetStatus(chain.ID, status)
+			if sm := GlobalServiceMetric(); sm != nil {
+				sm.SetSyncStatusFor(chain.ID, status.Height, status.ReferenceHeight, status.InSync)
+			}
+		}(chain)
+	}
+	wg.Wait()
+	// remove the statuses of chains no longer hosted or checked
+	m.l.Lock()
+	defer m.l.Unlock()
+	for id := range m.statuses {
+		if chain, ok := chains[id]; !ok || chain.SyncCheck == nil {
+			delete(m.statuses, id)
+		}
+	}
+}
+
+func (m *SyncMonitor) checkChain(chain HostedBlockchain) ChainSyncStatus {
+	check := chain.SyncCheck
+	status := ChainSyncStatus{LastChecked: time.Now()}
+	// query the head of every upstream
+	heights := make(map[string]int64)
+	var lastErr error
+	for _, u := range chain.GetUpstreams() {
+		if u.HTTPURL == "" {
+			continue
+		}
+		h, err := queryBlockHeight(u.HTTPURL, u.BasicAuth, check)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		heights[u.id()] = h
+		if h > status.Height {
+			status.Height = h
+		}
+	}
+	if len(heights) == 0 {
+		if lastErr == nil {
+			lastErr = fmt.Errorf("no http upstream configured for chain %s", chain.ID)
+		}
+		status.Error = lastErr.Error()
+		return status
+	}
+	// the reference is the highest height known, including the trusted references
+	status.ReferenceHeight = status.Height
+	for _, ref := range check.ReferenceURLs {
+		h, err := queryBlockHeight(ref, BasicAuth{}, check)
+		if err != nil {
+			continue
+		}
+		if h > status.ReferenceHeight {
+			status.ReferenceHeight = h
+		}
+	}
+	// take the lagging upstreams out of rotation until they catch up
+	for id, h := range heights {
+		GlobalUpstreamTracker().SetLagging(chain.ID, id, h+check.Allowance < status.ReferenceHeight)
+	}
+	status.InSync = status.Height+check.Allowance >= status.ReferenceHeight
+	if !status.InSync {
+		status.Error = fmt.Sprintf("chain height %d is behind the reference height %d", status.Height, status.ReferenceHeight)
+	}
+	return status
+}
+
+// "StartSyncChecks" - Probes the hosted chains every interval until stop is closed
+func (m *SyncMonitor) StartSyncChecks(hostedBlockchains *HostedBlockchains, interval time.Duration) (stop chan struct{}) {
+	stop = make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				m.CheckChains(hostedBlockchains)
+			case <-stop:
+				return
+			}
+		}
+	}()
+	return stop
+}
+
+// "queryBlockHeight" - Executes the sync check against the url and parses the height out of the response
+func queryBlockHeight(url string, basicAuth BasicAuth, check *SyncCheck) (int64, error) {
+	method := check.Payload.Method
+	if method == "" {
+		method = DEFAULTHTTPMETHOD
+	}
+	res, status, err := executeHTTPRequestWithStatus(check.Payload.Data, upstreamURL(url, check.Payload.Path), GlobalViperConfig.UserAgent, basicAuth, method, check.Payload.Headers)
+	if err != nil {
+		return 0, err
+	}
+	if status >= 300 {
+		return 0, fmt.Errorf("unexpected status code %d from %s", status, upstreamLabel(url))
+	}
+	resultKey := check.ResultKey
+	if resultKey == "" {
+		resultKey = DefaultSyncCheckResultKey
+	}
+	return parseBlockHeight(res, resultKey)
+}
+
+// "parseBlockHeight" - Parses the height at the dot separated key of a json response,
+// the height may be a number, a decimal string or a hex string (e.g. "0x10")
+func parseBlockHeight(res, key string) (int64, error) {
+	var v interface{}
+	d := json.NewDecoder(strings.NewReader(res))
+	d.UseNumber()
+	if err := d.Decode(&v); err != nil {
+		return 0, fmt.Errorf("unable to decode the sync check response: %s", err.Error())
+	}
+	for _, k := range strings.Split(key, ".") {
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return 0, fmt.Errorf("the sync check response has no %s", key)
+		}
+		if v, ok = obj[k]; !ok {
+			return 0, fmt.Errorf("the sync check response has no %s", key)
+		}
+	}
+	var s string
+	switch h := v.(type) {
+	case json.Number:
+		s = h.String()
+	case string:
+		s = h
+	default:
+		return 0, fmt.Errorf("the sync check result %v is not a block height", v)
+	}
+	if strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X") {
+		return strconv.ParseInt(s[2:], 16, 64)
+	}
+	return strconv.ParseInt(s, 10, 64)
+}
@@ -0,0 +1,103 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseBlockHeight(t *testing.T) {
+	tests := []struct {
+		name     string
+		res      string
+		key      string
+		height   int64
+		hasError bool
+	}{
+		{"hex string", `{"jsonrpc":"2.0","id":1,"result":"0x10"}`, "result", 16, false},
+		{"decimal string", `{"result":{"sync_info":{"latest_block_height":"1234"}}}`, "result.sync_info.latest_block_height", 1234, false},
+		{"number", `{"height":99}`, "height", 99, false},
+		{"missing key", `{"error":"method not found"}`, "result", 0, true},
+		{"not a height", `{"result":true}`, "result", 0, true},
+		{"invalid json", `not json`, "result", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, err := parseBlockHeight(tt.res, tt.key)
+			assert.Equal(t, tt.hasError, err != nil)
+			assert.Equal(t, tt.height, h)
+		})
+	}
+}
+
+func newHeightServer(height int64) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"result":"0x%x"}`, height)))
+	}))
+}
+
+func TestSyncMonitor_CheckChains(t *testing.T) {
+	synced := newHeightServer(100)
+	defer synced.Close()
+	lagging := newHeightServer(50)
+	defer lagging.Close()
+	reference := newHeightServer(102)
+	defer reference.Close()
+	ethereum := hex.EncodeToString([]byte{01})
+	bitcoin := hex.EncodeToString([]byte{02})
+	check := &SyncCheck{
+		Payload:       Payload{Data: `{"jsonrpc":"2.0","method":"eth_blockNumber","params":[],"id":1}`},
+		Allowance:     5,
+		ReferenceURLs: []string{reference.URL},
+	}
+	hb := &HostedBlockchains{
+		M: map[string]HostedBlockchain{
+			ethereum: {ID: ethereum, HTTPURL: synced.URL, Upstreams: []Upstream{{HTTPURL: lagging.URL}}, SyncCheck: check},
+			bitcoin:  {ID: bitcoin, HTTPURL: lagging.URL, SyncCheck: check},
+		},
+		L: sync.Mutex{},
+	}
+	monitor := NewSyncMonitor()
+	monitor.CheckChains(hb)
+	// the chain is in sync as long as one upstream is within the allowance
+	status, found := monitor.Status(ethereum)
+	assert.True(t, found)
+	assert.True(t, status.InSync)
+	assert.Equal(t, int64(100), status.Height)
+	assert.Equal(t, int64(102), status.ReferenceHeight)
+	assert.True(t, monitor.IsInSync(ethereum))
+	// the lagging upstream is taken out of rotation
+	assert.False(t, GlobalUpstreamTracker().IsHealthy(ethereum, lagging.URL))
+	assert.True(t, GlobalUpstreamTracker().IsHealthy(ethereum, synced.URL))
+	assert.False(t, monitor.IsInSync(bitcoin))
+	// chains without a sync check are always in sync
+	hb.L.Lock()
+	hb.M = map[string]HostedBlockchain{ethereum: {ID: ethereum, HTTPURL: synced.URL}}
+	hb.L.Unlock()
+	monitor.CheckChains(hb)
+	_, found = monitor.Status(bitcoin)
+	assert.False(t, found)
+	assert.True(t, monitor.IsInSync(bitcoin))
+	GlobalUpstreamTracker().Prune(nil)
+}
+
+func TestSyncMonitor_UnreachableChain(t *testing.T) {
+	ethereum := hex.EncodeToString([]byte{01})
+	hb := &HostedBlockchains{
+		M: map[string]HostedBlockchain{
+			ethereum: {ID: ethereum, HTTPURL: "http://127.0.0.1:1", SyncCheck: &SyncCheck{}},
+		},
+		L: sync.Mutex{},
+	}
+	monitor := NewSyncMonitor()
+	monitor.CheckChains(hb)
+	status, found := monitor.Status(ethereum)
+	assert.True(t, found)
+	assert.False(t, status.InSync)
+	assert.NotEmpty(t, status.Error)
+}
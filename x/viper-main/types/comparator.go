@@ -0,0 +1,319 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+
+	sdk "github.com/vipernet-xyz/viper-network/types"
+)
+
+const (
+	ComparatorModeExact = "exact" // byte for byte equality
+	ComparatorModeJSON  = "json"  // canonical json equality, the default
+	ComparatorModeHead  = "head"  // the heights of head queries are within a number of blocks
+)
+
+var (
+	comparatorsL sync.Mutex
+	comparators  = make(map[string]ResponseComparator)
+)
+
+// "ResponseComparator" - Decides whether the response of a servicer to a sample relay matches the local response
+type ResponseComparator interface {
+	Match(request, local, remote string) bool
+}
+
+// "RegisterResponseComparator" - Registers a custom comparator for the chain, taking precedence over the sample pool config
+func RegisterResponseComparator(chainID string, c ResponseComparator) {
+	comparatorsL.Lock()
+	defer comparatorsL.Unlock()
+	if c == nil {
+		delete(comparators, chainID)
+		return
+	}
+	comparators[chainID] = c
+}
+
+// "GetResponseComparator" - Returns the registered comparator of the chain, else the comparator configured in
+// the sample pool, else canonical json comparison
+func GetResponseComparator(chainID string, config *ComparatorConfig) ResponseComparator {
+	comparatorsL.Lock()
+	defer comparatorsL.Unlock()
+	if c, ok := comparators[chainID]; ok {
+		return c
+	}
+	if config != nil {
+		return *config
+	}
+	return ComparatorConfig{}
+}
+
+// "ComparatorConfig" - The response comparison of a chain, configured alongside its sample relays in samplepool.json
+type ComparatorConfig struct {
+	Mode        string                      `json:"mode,omitempty"`         // exact, json (default) or head
+	IgnorePaths []string                    `json:"ignore_paths,omitempty"` // dot separated paths of non deterministic fields, "*" matches any key or index
+	Tolerances  map[string]float64          `json:"tolerances,omitempty"`   // path -> maximum absolute difference of a numeric (or hex) field
+	Blocks      int64                       `json:"blocks,omitempty"`       // head mode: maximum difference between the heights
+	HeightPath  string                      `json:"height_path,omitempty"`  // head mode: path of the height, defaults to "result"
+	Methods     map[string]ComparatorConfig `json:"methods,omitempty"`      // overrides per json-rpc method
+}
+
+// "Validate" - Validity check for the comparator config
+func (c ComparatorConfig) Validate() sdk.Error {
+	switch c.Mode {
+	case "", ComparatorModeExact, ComparatorModeJSON, ComparatorModeHead:
+	default:
+		return NewInvalidComparatorError(ModuleName)
+	}
+	if c.Blocks < 0 {
+		return NewInvalidComparatorError(ModuleName)
+	}
+	for _, tolerance := range c.Tolerances {
+		if tolerance < 0 {
+			return NewInvalidComparatorError(ModuleName)
+		}
+	}
+	for _, m := range c.Methods {
+		// method overrides are not nested
+		if len(m.Methods) != 0 {
+			return NewInvalidComparatorError(ModuleName)
+		}
+		if err := m.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// "Match" - Returns true if the responses match, json-rpc batches are compared element by element
+func (c ComparatorConfig) Match(request, local, remote string) bool {
+	if c.Mode == ComparatorModeExact && len(c.Methods) == 0 {
+		return local == remote
+	}
+	local, remote = decompressResponse(local), decompressResponse(remote)
+	l, lErr := decodeJSON(local)
+	r, rErr := decodeJSON(remote)
+	if lErr != nil || rErr != nil {
+		// not json, compare as text
+		return strings.TrimSpace(local) == strings.TrimSpace(remote)
+	}
+	var calls []json.RawMessage
+	if json.Unmarshal([]byte(request), &calls) == nil {
+		lBatch, lOK := l.([]interface{})
+		rBatch, rOK := r.([]interface{})
+		if !lOK || !rOK || len(lBatch) != len(rBatch) {
+			return false
+		}
+		// the responses of a batch are ordered like the calls, notifications are not answered
+		methods := make([]string, 0, len(calls))
+		for _, call := range calls {
+			if id := batchCallID(call); id != "" && id != "null" {
+				methods = append(methods, requestMethod(string(call)))
+			}
+		}
+		for i := range lBatch {
+			method := ""
+			if i < len(methods) {
+				method = methods[i]
+			}
+			if !c.forMethod(method).matchValue(lBatch[i], rBatch[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return c.forMethod(requestMethod(request)).matchValue(l, r)
+}
+
+func (c ComparatorConfig) forMethod(method string) ComparatorConfig {
+	if m, ok := c.Methods[method]; ok {
+		return m
+	}
+	return c
+}
+
+func (c ComparatorConfig) matchValue(local, remote interface{}) bool {
+	switch c.Mode {
+	case ComparatorModeExact:
+		l, _ := json.Marshal(local)
+		r, _ := json.Marshal(remote)
+		return bytes.Equal(l, r)
+	case ComparatorModeHead:
+		path := c.HeightPath
+		if path == "" {
+			path = DefaultSyncCheckResultKey
+		}
+		l, lOK := parseNumeric(valueAt(local, path))
+		r, rOK := parseNumeric(valueAt(remote, path))
+		return lOK && rOK && math.Abs(l-r) <= float64(c.Blocks)
+	default:
+		return c.compare(nil, local, remote)
+	}
+}
+
+// "compare" - Compares the canonical json values, skipping the ignored paths and allowing the numeric tolerances
+func (c ComparatorConfig) compare(path []string, local, remote interface{}) bool {
+	if c.ignored(path) {
+		return true
+	}
+	if tolerance, ok := c.tolerance(path); ok {
+		l, lOK := parseNumeric(local)
+		r, rOK := parseNumeric(remote)
+		if lOK && rOK {
+			return math.Abs(l-r) <= tolerance
+		}
+	}
+	switch l := local.(type) {
+	case map[string]interface{}:
+		r, ok := remote.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, lv := range l {
+			rv, found := r[k]
+			if !found && !c.ignored(append(path, k)) {
+				return false
+			}
+			if found && !c.compare(append(path, k), lv, rv) {
+				return false
+			}
+		}
+		for k := range r {
+			if _, found := l[k]; !found && !c.ignored(append(path, k)) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		r, ok := remote.([]interface{})
+		if !ok || len(l) != len(r) {
+			return false
+		}
+		for i := range l {
+			if !c.compare(append(path, strconv.Itoa(i)), l[i], r[i]) {
+				return false
+			}
+		}
+		return true
+	case json.Number:
+		r, ok := remote.(json.Number)
+		if !ok {
+			return false
+		}
+		// compare the values, e.g. 1.0 and 1 are the same number
+		lf, lErr := l.Float64()
+		rf, rErr := r.Float64()
+		if lErr != nil || rErr != nil {
+			return l == r
+		}
+		return lf == rf
+	default:
+		return local == remote
+	}
+}
+
+func (c ComparatorConfig) ignored(path []string) bool {
+	for _, p := range c.IgnorePaths {
+		if pathMatches(p, path) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c ComparatorConfig) tolerance(path []string) (float64, bool) {
+	for p, t := range c.Tolerances {
+		if pathMatches(p, path) {
+			return t, true
+		}
+	}
+	return 0, false
+}
+
+// "pathMatches" - Returns true if the dot separated pattern matches the path, "*" matches any key or index
+func pathMatches(pattern string, path []string) bool {
+	segments := strings.Split(pattern, ".")
+	if len(segments) != len(path) {
+		return false
+	}
+	for i, s := range segments {
+		if s != "*" && s != path[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func valueAt(v interface{}, path string) interface{} {
+	for _, k := range strings.Split(path, ".") {
+		switch obj := v.(type) {
+		case map[string]interface{}:
+			v = obj[k]
+		case []interface{}:
+			i, err := strconv.Atoi(k)
+			if err != nil || i < 0 || i >= len(obj) {
+				return nil
+			}
+			v = obj[i]
+		default:
+			return nil
+		}
+	}
+	return v
+}
+
+// "parseNumeric" - Parses numbers, decimal strings and hex strings (e.g. block heights)
+func parseNumeric(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	case string:
+		if strings.HasPrefix(n, "0x") || strings.HasPrefix(n, "0X") {
+			u, err := strconv.ParseUint(n[2:], 16, 64)
+			return float64(u), err == nil
+		}
+		f, err := strconv.ParseFloat(n, 64)
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+func decodeJSON(s string) (interface{}, error) {
+	var v interface{}
+	d := json.NewDecoder(strings.NewReader(s))
+	d.UseNumber()
+	if err := d.Decode(&v); err != nil {
+		return nil, err
+	}
+	// reject trailing data
+	if d.More() {
+		return nil, fmt.Errorf("unexpected data after the json value")
+	}
+	return v, nil
+}
+
+func decompressResponse(response string) string {
+	if !isPayloadCompressed(response) {
+		return response
+	}
+	decompressed, err := decompressPayload(response)
+	if err != nil {
+		return response
+	}
+	return decompressed
+}
+
+func requestMethod(request string) string {
+	var req struct {
+		Method string `json:"method"`
+	}
+	_ = json.Unmarshal([]byte(request), &req)
+	return req.Method
+}
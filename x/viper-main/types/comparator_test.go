@@ -0,0 +1,103 @@
+package types
+
+import (
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	sdk "github.com/vipernet-xyz/viper-network/types"
+)
+
+func TestComparatorConfig_Validate(t *testing.T) {
+	tests := []struct {
+		name       string
+		comparator ComparatorConfig
+		hasError   bool
+	}{
+		{"default", ComparatorConfig{}, false},
+		{"head", ComparatorConfig{Mode: ComparatorModeHead, Blocks: 2}, false},
+		{"method override", ComparatorConfig{Methods: map[string]ComparatorConfig{"eth_blockNumber": {Mode: ComparatorModeHead, Blocks: 2}}}, false},
+		{"unknown mode", ComparatorConfig{Mode: "fuzzy"}, true},
+		{"negative blocks", ComparatorConfig{Mode: ComparatorModeHead, Blocks: -1}, true},
+		{"negative tolerance", ComparatorConfig{Tolerances: map[string]float64{"result": -1}}, true},
+		{"nested method override", ComparatorConfig{Methods: map[string]ComparatorConfig{"a": {Methods: map[string]ComparatorConfig{"b": {}}}}}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.comparator.Validate()
+			assert.Equal(t, tt.hasError, err != nil)
+			if err != nil {
+				assert.Equal(t, sdk.CodeType(CodeInvalidComparatorError), err.Code())
+			}
+		})
+	}
+}
+
+func TestComparatorConfig_Match(t *testing.T) {
+	single := `{"jsonrpc":"2.0","method":"eth_getBlockByNumber","params":["latest",false],"id":1}`
+	tests := []struct {
+		name       string
+		comparator ComparatorConfig
+		request    string
+		local      string
+		remote     string
+		match      bool
+	}{
+		{"key order", ComparatorConfig{}, single, `{"id":1,"result":{"a":1,"b":"x"}}`, `{"result":{"b":"x","a":1.0},"id":1}`, true},
+		{"different value", ComparatorConfig{}, single, `{"id":1,"result":"0x1"}`, `{"id":1,"result":"0x2"}`, false},
+		{"missing key", ComparatorConfig{}, single, `{"id":1,"result":"0x1"}`, `{"id":1}`, false},
+		{"exact key order", ComparatorConfig{Mode: ComparatorModeExact}, single, `{"id":1,"result":"0x1"}`, `{"result":"0x1","id":1}`, false},
+		{"ignored path", ComparatorConfig{IgnorePaths: []string{"id", "result.*.timestamp"}}, single, `{"id":1,"result":[{"n":1,"timestamp":5}]}`, `{"id":7,"result":[{"n":1,"timestamp":9}]}`, true},
+		{"ignored path missing", ComparatorConfig{IgnorePaths: []string{"result.peers"}}, single, `{"result":{"n":1,"peers":3}}`, `{"result":{"n":1}}`, true},
+		{"within tolerance", ComparatorConfig{Tolerances: map[string]float64{"result.gasPrice": 10}}, single, `{"result":{"gasPrice":"0x64"}}`, `{"result":{"gasPrice":"0x6a"}}`, true},
+		{"outside tolerance", ComparatorConfig{Tolerances: map[string]float64{"result.gasPrice": 5}}, single, `{"result":{"gasPrice":"0x64"}}`, `{"result":{"gasPrice":"0x6a"}}`, false},
+		{"head within blocks", ComparatorConfig{Mode: ComparatorModeHead, Blocks: 2}, single, `{"id":1,"result":"0x10"}`, `{"id":1,"result":"0x12"}`, true},
+		{"head behind", ComparatorConfig{Mode: ComparatorModeHead, Blocks: 2}, single, `{"id":1,"result":"0x10"}`, `{"id":1,"result":"0xd"}`, false},
+		{"head path", ComparatorConfig{Mode: ComparatorModeHead, Blocks: 1, HeightPath: "result.number"}, single, `{"result":{"number":"0x10","hash":"a"}}`, `{"result":{"number":"0x11","hash":"b"}}`, true},
+		{"method override", ComparatorConfig{Methods: map[string]ComparatorConfig{"eth_getBlockByNumber": {Mode: ComparatorModeHead, Blocks: 1, HeightPath: "result.number"}}}, single, `{"result":{"number":"0x10","hash":"a"}}`, `{"result":{"number":"0x11","hash":"b"}}`, true},
+		{"not json", ComparatorConfig{}, `GET /status`, "ok\n", "ok", true},
+		{"not json mismatch", ComparatorConfig{}, `GET /status`, "ok", "bad gateway", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.match, tt.comparator.Match(tt.request, tt.local, tt.remote))
+		})
+	}
+}
+
+func TestComparatorConfig_MatchBatch(t *testing.T) {
+	request := `[{"method":"eth_chainId","id":1},{"method":"eth_subscription"},{"method":"eth_blockNumber","id":2}]`
+	c := ComparatorConfig{Methods: map[string]ComparatorConfig{"eth_blockNumber": {Mode: ComparatorModeHead, Blocks: 1}}}
+	local := `[{"id":1,"result":"0x1"},{"id":2,"result":"0x10"}]`
+	// the overrides apply per call of the batch
+	assert.True(t, c.Match(request, local, `[{"result":"0x1","id":1},{"id":2,"result":"0x11"}]`))
+	assert.False(t, c.Match(request, local, `[{"id":1,"result":"0x2"},{"id":2,"result":"0x10"}]`))
+	assert.False(t, c.Match(request, local, `[{"id":1,"result":"0x1"}]`))
+	assert.False(t, c.Match(request, local, `{"id":1,"result":"0x1"}`))
+}
+
+func TestComparatorConfig_MatchCompressed(t *testing.T) {
+	var b bytes.Buffer
+	w := gzip.NewWriter(&b)
+	_, err := w.Write([]byte(`{"result":"0x1","id":1}`))
+	assert.Nil(t, err)
+	assert.Nil(t, w.Close())
+	assert.True(t, ComparatorConfig{}.Match(`{"method":"eth_chainId","id":1}`, `{"id":1,"result":"0x1"}`, b.String()))
+}
+
+type alwaysMatch struct{}
+
+func (alwaysMatch) Match(request, local, remote string) bool { return true }
+
+func TestGetResponseComparator(t *testing.T) {
+	chain := "0021"
+	config := &ComparatorConfig{Mode: ComparatorModeExact}
+	assert.Equal(t, ComparatorConfig{}, GetResponseComparator(chain, nil))
+	assert.Equal(t, *config, GetResponseComparator(chain, config))
+	// registered comparators take precedence over the sample pool
+	RegisterResponseComparator(chain, alwaysMatch{})
+	assert.Equal(t, alwaysMatch{}, GetResponseComparator(chain, config))
+	RegisterResponseComparator(chain, nil)
+	assert.Equal(t, *config, GetResponseComparator(chain, config))
+}
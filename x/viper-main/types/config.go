@@ -26,8 +26,22 @@ var (
 
 // "InitConfig" - Initializes the cache for sessions, test and evidence
 func InitConfig(chains *HostedBlockchains, geozone *HostedGeoZones, logger log.Logger, c types.Config) {
+	GlobalUpstreamTracker().SetLimits(c.ViperConfig.UpstreamMaxFailures, time.Duration(c.ViperConfig.UpstreamCooldown)*time.Second)
+	GlobalRelayCache().SetLimits(c.ViperConfig.RelayCacheSize, time.Duration(c.ViperConfig.RelayCacheTTL)*time.Second)
+	GlobalRateLimiter().SetLimits(
+		RateLimit{Rate: float64(c.ViperConfig.RPCClientRateLimit), Burst: c.ViperConfig.RPCClientBurst},
+		RateLimit{Rate: float64(c.ViperConfig.RPCRequestorRateLimit), Burst: c.ViperConfig.RPCRequestorBurst},
+		RateLimit{Rate: float64(c.ViperConfig.RPCIPRateLimit), Burst: c.ViperConfig.RPCIPBurst},
+		c.ViperConfig.RPCRateLimitMaxKeys, c.ViperConfig.RPCMaxConcurrentRelays)
+	GlobalSamplingScheduler().Start(c.ViperConfig.SamplingWorkers, c.ViperConfig.MaxSamplingSessions)
+	if chains != nil && c.ViperConfig.UpstreamHealthInterval > 0 {
+		GlobalUpstreamTracker().StartUpstreamHealthChecks(chains, time.Duration(c.ViperConfig.UpstreamHealthInterval)*time.Second)
+	}
 	ConfigOnce.Do(func() {
 		InitGlobalServiceMetric(chains, logger, c.ViperConfig.PrometheusAddr, c.ViperConfig.PrometheusMaxOpenfiles)
+		if chains != nil && c.ViperConfig.ChainSyncInterval > 0 {
+			GlobalSyncMonitor().StartSyncChecks(chains, time.Duration(c.ViperConfig.ChainSyncInterval)*time.Second)
+		}
 	})
 	InitViperNodeCaches(c, logger)
 	GlobalViperConfig = c.ViperConfig
@@ -108,6 +108,19 @@ const (
 	CodeDuplicateTestResultError            = 102
 	CodeInvalidReportMerkleVerifyError      = 103
 	CodeNoReportCardError                   = 104
+	CodeChainOutOfSyncError                 = 105
+	CodeInvalidWebsocketFrameError          = 106
+	CodeInvalidCacheRuleError               = 107
+	CodeMaxBatchSizeError                   = 108
+	CodeBatchProofsError                    = 109
+	CodeInvalidComparatorError              = 110
+	CodeMaxSamplingSessionsError            = 111
+	CodeExpiredTokenError                   = 112
+	CodeUnauthorizedTokenChainError         = 113
+	CodeUnauthorizedTokenGeoZoneError       = 114
+	CodeRevokedClientError                  = 115
+	CodeTokenRelayCapError                  = 116
+	CodeRateLimitedError                    = 117
 )
 
 var (
@@ -116,6 +129,9 @@ var (
 	MissingRequestorPublicKeyError      = errors.New("the requestorlicaiton public key included in the AAT is not valid")
 	MissingClientPublicKeyError         = errors.New("the client public key included in the AAT is not valid")
 	InvalidTokenSignatureErorr          = errors.New("the requestor signature on the AAT is not valid")
+	InvalidTokenScopeError              = errors.New("the scope of the AAT is not valid for its version")
+	NegativeTokenExpirationError        = errors.New("the expiration height of the AAT is negative")
+	NegativeTokenRelayCapError          = errors.New("the relay cap of the AAT is negative")
 	NegativeICCounterError              = errors.New("the IC counter is less than 0")
 	MaximumEntropyError                 = errors.New("the entropy exceeds the maximum allowed relays")
 	NodeNotInSessionError               = errors.New("the servicer is not within the session")
@@ -217,6 +233,19 @@ var (
 	ReportCardNotFoundError             = errors.New("the report card for the servicer could not be found")
 	InvalidRCMerkleVerifyError          = errors.New("report card resulted in an invalid merkle Proof")
 	NoReportCardError                   = errors.New("no report card for the servicer submitted by the fisherman")
+	ChainOutOfSyncError                 = errors.New("the hosted blockchain is out of sync with the head of the chain")
+	InvalidWebsocketFrameError          = errors.New("the websocket frame is invalid, only json-rpc requests with an id can be relayed over a websocket session")
+	InvalidCacheRuleError               = errors.New("the cache rule of the hosted blockchain is invalid")
+	MaxBatchSizeError                   = errors.New("the json-rpc batch exceeds the maximum batch size of the hosted blockchain")
+	BatchProofsError                    = errors.New("every call of a json-rpc batch must be paid by its own relay proof of the same request")
+	InvalidComparatorError              = errors.New("the response comparator of the sample pool is invalid")
+	MaxSamplingSessionsError            = errors.New("the fisherman is already sampling the maximum number of sessions")
+	ExpiredTokenError                   = errors.New("the requestor authentication token is expired")
+	UnauthorizedTokenChainError         = errors.New("the requestor authentication token is not allowed on the blockchain")
+	UnauthorizedTokenGeoZoneError       = errors.New("the requestor authentication token is not allowed in the geozone")
+	RevokedClientError                  = errors.New("the client public key of the requestor authentication token was revoked by the requestor")
+	TokenRelayCapError                  = errors.New("the client exceeded the relay cap of the requestor authentication token for the session")
+	RateLimitedError                    = errors.New("the request exceeded the rate limit of the servicer")
 )
 
 func NewSealedEvidenceError(codespace sdk.CodespaceType) sdk.Error {
@@ -571,6 +600,58 @@ func NewNoReportCardError(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeNoReportCardError, NoReportCardError.Error())
 }
 
+func NewChainOutOfSyncError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeChainOutOfSyncError, ChainOutOfSyncError.Error())
+}
+
+func NewInvalidWebsocketFrameError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidWebsocketFrameError, InvalidWebsocketFrameError.Error())
+}
+
+func NewInvalidCacheRuleError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidCacheRuleError, InvalidCacheRuleError.Error())
+}
+
+func NewMaxBatchSizeError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeMaxBatchSizeError, MaxBatchSizeError.Error())
+}
+
+func NewBatchProofsError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeBatchProofsError, BatchProofsError.Error())
+}
+
+func NewInvalidComparatorError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeInvalidComparatorError, InvalidComparatorError.Error())
+}
+
+func NewMaxSamplingSessionsError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeMaxSamplingSessionsError, MaxSamplingSessionsError.Error())
+}
+
+func NewExpiredTokenError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeExpiredTokenError, ExpiredTokenError.Error())
+}
+
+func NewUnauthorizedTokenChainError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeUnauthorizedTokenChainError, UnauthorizedTokenChainError.Error())
+}
+
+func NewUnauthorizedTokenGeoZoneError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeUnauthorizedTokenGeoZoneError, UnauthorizedTokenGeoZoneError.Error())
+}
+
+func NewRevokedClientError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeRevokedClientError, RevokedClientError.Error())
+}
+
+func NewTokenRelayCapError(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeTokenRelayCapError, TokenRelayCapError.Error())
+}
+
+func NewRateLimitedError(codespace sdk.CodespaceType, limit string) sdk.Error {
+	return sdk.NewError(codespace, CodeRateLimitedError, fmt.Sprintf("%s: %s", RateLimitedError.Error(), limit))
+}
+
 func NewInvalidRCMerkleVerifyError(codespace sdk.CodespaceType) sdk.Error {
 	return sdk.NewError(codespace, CodeInvalidRCMerkleVerifyError, InvalidRCMerkleVerifyError.Error())
 }
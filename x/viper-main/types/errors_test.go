@@ -47,3 +47,31 @@ func TestInvalidHashLengthError(t *testing.T) {
 func TestInvalidRequestorPubKeyError(t *testing.T) {
 	assert.Equal(t, NewInvalidRequestorPubKeyError(ModuleName), sdk.NewError(ModuleName, CodeInvalidRequestorPubKeyError, InvalidRequestorPubKeyError.Error()))
 }
+
+func TestChainOutOfSyncError(t *testing.T) {
+	assert.Equal(t, NewChainOutOfSyncError(ModuleName), sdk.NewError(ModuleName, CodeChainOutOfSyncError, ChainOutOfSyncError.Error()))
+}
+
+func TestInvalidWebsocketFrameError(t *testing.T) {
+	assert.Equal(t, NewInvalidWebsocketFrameError(ModuleName), sdk.NewError(ModuleName, CodeInvalidWebsocketFrameError, InvalidWebsocketFrameError.Error()))
+}
+
+func TestInvalidCacheRuleError(t *testing.T) {
+	assert.Equal(t, NewInvalidCacheRuleError(ModuleName), sdk.NewError(ModuleName, CodeInvalidCacheRuleError, InvalidCacheRuleError.Error()))
+}
+
+func TestMaxBatchSizeError(t *testing.T) {
+	assert.Equal(t, NewMaxBatchSizeError(ModuleName), sdk.NewError(ModuleName, CodeMaxBatchSizeError, MaxBatchSizeError.Error()))
+}
+
+func TestBatchProofsError(t *testing.T) {
+	assert.Equal(t, NewBatchProofsError(ModuleName), sdk.NewError(ModuleName, CodeBatchProofsError, BatchProofsError.Error()))
+}
+
+func TestInvalidComparatorError(t *testing.T) {
+	assert.Equal(t, NewInvalidComparatorError(ModuleName), sdk.NewError(ModuleName, CodeInvalidComparatorError, InvalidComparatorError.Error()))
+}
+
+func TestMaxSamplingSessionsError(t *testing.T) {
+	assert.Equal(t, NewMaxSamplingSessionsError(ModuleName), sdk.NewError(ModuleName, CodeMaxSamplingSessionsError, MaxSamplingSessionsError.Error()))
+}
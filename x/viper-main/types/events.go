@@ -1,9 +1,21 @@
 package types
 
 const (
-	EventTypeClaim            = MsgClaimName // an event for emitting a claim message
-	EventTypeProof            = MsgProofName // an event for emitting a proof message
-	EventTypeSubmitReportCard = MsgSubmitReportCardName
-	AttributeKeyValidator     = "validator" // a validator attribute
-	AttributeKeyFisherman     = "fisherman"
+	EventTypeClaim               = MsgClaimName // an event for emitting a claim message
+	EventTypeProof               = MsgProofName // an event for emitting a proof message
+	EventTypeSubmitReportCard    = MsgSubmitReportCardName
+	EventTypeNewSession          = "new_session" // an event for emitting the first block of a session
+	AttributeKeyValidator        = "validator"   // a validator attribute
+	AttributeKeyFisherman        = "fisherman"
+	AttributeKeyChain            = "chain"          // the chain of the session
+	AttributeKeyGeoZone          = "geo_zone"       // the geozone of the session
+	AttributeKeySessionHeight    = "session_height" // the block height of the session
+	AttributeKeyEvidenceType     = "evidence_type"
+	AttributeKeyTotalProofs      = "total_proofs" // the number of relays or challenges claimed
+	AttributeKeyMinted           = "minted"       // the tokens minted for the proof
+	AttributeKeyBurned           = "burned"       // the tokens burned from the requestor for the proof
+	AttributeKeyLatency          = "latency_score"
+	AttributeKeyAvailability     = "availability_score"
+	AttributeKeyReliability      = "reliability_score"
+	AttributeKeyBlocksPerSession = "blocks_per_session"
 )
@@ -40,6 +40,25 @@ func (e *Evidence) GenerateMerkleRoot(height int64, maxRelays int64, storage *Ca
 	return
 }
 
+// "ClientRelays" - Returns the number of relay proofs of the client in the evidence
+func (e Evidence) ClientRelays(clientPubKey string) (relays int64) {
+	for _, p := range e.Proofs {
+		var token AAT
+		switch rp := p.(type) {
+		case RelayProof:
+			token = rp.Token
+		case *RelayProof:
+			token = rp.Token
+		default:
+			continue
+		}
+		if token.ClientPublicKey == clientPubKey {
+			relays++
+		}
+	}
+	return
+}
+
 // "AddProof" - Adds a proof obj to the GOBEvidence field
 func (e *Evidence) AddProof(p Proof) {
 	// add proof to GOBEvidence
@@ -48,6 +48,8 @@ type RequestorsKeeper interface {
 	TotalTokens(ctx sdk.Ctx) sdk.BigInt
 	JailRequestor(ctx sdk.Ctx, addr sdk.Address)
 	BurnRequestorStake(ctx sdk.Ctx, requestor requestorsTypes.Requestor, amount sdk.BigInt)
+	GetRevokedClients(ctx sdk.Ctx, requestorAddr sdk.Address) (clientPubKeys []string)
+	IsClientRevoked(ctx sdk.Ctx, requestorAddr sdk.Address, clientPubKey string) bool
 }
 
 type ViperKeeper interface {
@@ -19,24 +19,13 @@ import (
 
 func (r *Relayer) SendSampleRelay(blockHeight int64, Blockchain string, trigger FishermenTrigger, servicer exported.ValidatorI, fishermanValidator exported.ValidatorI, hostedBlockchains *HostedBlockchains) (*Output, error) {
 
-	// First, we will ensure SampleRelayPools is loaded
-	pools, err := LoadSampleRelayPool()
-
-	if err != nil {
-		return nil, fmt.Errorf("failed to load SampleRelayPools: %v", err)
-	}
-
 	start := time.Now()
-	//Get the appropriate relay pool for the blockchain
-	relayPool, exists := pools[Blockchain]
-	if !exists {
-		return nil, fmt.Errorf("no relay pool found for blockchain: %s", Blockchain)
+	//Select a payload of the sample pool of the blockchain
+	_, samplePayload, relayPool, err := SelectSample(Blockchain, hostedBlockchains, rand.New(rand.NewSource(time.Now().UnixNano())))
+	if err != nil {
+		return nil, err
 	}
 
-	//Select a random relay payload from the pool
-	randIndex := rand.Intn(len(relayPool.Payloads))
-	samplePayload := relayPool.Payloads[randIndex]
-
 	//Create a RelayMeta and RelayProof
 	relayMeta := &RelayMeta{
 		BlockHeight: blockHeight,
@@ -52,22 +41,29 @@ func (r *Relayer) SendSampleRelay(blockHeight int64, Blockchain string, trigger
 	}
 
 	//Generate entropy and signed proof bytes
-	entropy, err := rand1.Int(rand1.Reader, big.NewInt(math.MaxInt64))
-	if err != nil {
-		return nil, err
+	newProof := func() (*RelayProof, error) {
+		entropy, err := rand1.Int(rand1.Reader, big.NewInt(math.MaxInt64))
+		if err != nil {
+			return nil, err
+		}
+		proof := &RelayProof{
+			RequestHash:        reqHash,
+			Entropy:            entropy.Int64(),
+			SessionBlockHeight: blockHeight,
+			ServicerPubKey:     servicer.GetPublicKey().RawString(),
+			Blockchain:         Blockchain,
+			GeoZone:            trigger.Proof.GeoZone,
+			NumServicers:       trigger.Proof.NumServicers,
+			Token:              trigger.Proof.Token,
+			Signature:          "",
+		}
+		proof.Signature, err = r.getSignedProofBytes(proof, trigger.Account)
+		if err != nil {
+			return nil, err
+		}
+		return proof, nil
 	}
-
-	signedProofBytes, err := r.getSignedProofBytes(&RelayProof{
-		RequestHash:        reqHash,
-		Entropy:            entropy.Int64(),
-		SessionBlockHeight: blockHeight,
-		ServicerPubKey:     servicer.GetPublicKey().RawString(),
-		Blockchain:         Blockchain,
-		GeoZone:            trigger.Proof.GeoZone,
-		NumServicers:       trigger.Proof.NumServicers,
-		Token:              trigger.Proof.Token,
-		Signature:          "",
-	}, trigger.Account)
+	proof, err := newProof()
 	if err != nil {
 		return nil, err
 	}
@@ -76,17 +72,17 @@ func (r *Relayer) SendSampleRelay(blockHeight int64, Blockchain string, trigger
 	relay := RelayInput{
 		Payload: samplePayload,
 		Meta:    relayMeta,
-		Proof: &RelayProof{
-			RequestHash:        reqHash,
-			Entropy:            entropy.Int64(),
-			SessionBlockHeight: blockHeight,
-			ServicerPubKey:     servicer.GetPublicKey().RawString(),
-			Blockchain:         Blockchain,
-			GeoZone:            trigger.Proof.GeoZone,
-			NumServicers:       trigger.Proof.NumServicers,
-			Token:              trigger.Proof.Token,
-			Signature:          signedProofBytes,
-		},
+		Proof:   proof,
+	}
+	// every other call of a batch sample is paid by its own proof
+	if size, isBatch := (Payload{Data: samplePayload.Data}).BatchSize(); isBatch {
+		for i := 1; i < size; i++ {
+			batchProof, err := newProof()
+			if err != nil {
+				return nil, err
+			}
+			relay.BatchProofs = append(relay.BatchProofs, *batchProof)
+		}
 	}
 
 	// Send the relay to the servicer and measure its latency
@@ -122,8 +118,8 @@ func (r *Relayer) SendSampleRelay(blockHeight int64, Blockchain string, trigger
 		}
 
 		sevicerAvailability = true
-		// Check if the response from the servicer matches the local response
-		servicerReliability = (relayOutput.Response == localResp)
+		// Check if the response from the servicer matches the local response using the comparator of the chain
+		servicerReliability = GetResponseComparator(Blockchain, relayPool.Comparator).Match(samplePayload.Data, localResp, relayOutput.Response)
 	}
 
 	return &Output{
@@ -286,9 +282,10 @@ type relayProofForSignature struct {
 
 // RelayInput represents input needed to do a Relay to Viper
 type RelayInput struct {
-	Payload *RelayPayload `json:"payload"`
-	Meta    *RelayMeta    `json:"meta"`
-	Proof   *RelayProof   `json:"proof"`
+	Payload     *RelayPayload `json:"payload"`
+	Meta        *RelayMeta    `json:"meta"`
+	Proof       *RelayProof   `json:"proof"`
+	BatchProofs []RelayProof  `json:"batch_proofs,omitempty"`
 }
 
 func Shuffle(proofs []Test, rng *rand.Rand) {
@@ -71,6 +71,10 @@ func TestRelayer_SendSampleRelay(t *testing.T) {
 			"signature": "abfabfabfabfabfabfabfabfabfabfabfabfabfabfabfabfabfabfabfabfabfabf"
 		  }`),
 	)
+	// the local chain of the fisherman answers the same
+	httpmock.RegisterResponder(http.MethodPost, "https://localchain.com:8545",
+		httpmock.NewStringResponder(http.StatusOK, `{"jsonrpc":"2.0","id":3905054414,"result":"0xdd03e4"}`))
+	hostedBlockchains := &HostedBlockchains{M: map[string]HostedBlockchain{"0002": {ID: "0002", HTTPURL: "https://localchain.com:8545"}}}
 
 	reqPubKey := getRandomPubKey()
 	clientPubKey := getRandomPubKey()
@@ -103,6 +107,13 @@ func TestRelayer_SendSampleRelay(t *testing.T) {
 	// Create a relayer instance
 	relayer := NewRelayer(signer, sender)
 
+	previous := GlobalSamplePools()
+	defer SetSamplePools(previous)
+	SetSamplePools(&SamplePools{M: map[string]SamplePool{"0002": {
+		Blockchain: "0002",
+		Payloads:   []SamplePayload{{RelayPayload: RelayPayload{Data: `{"jsonrpc":"2.0","method":"eth_blockNumber","params":[],"id":1}`, Method: "POST"}}},
+	}}})
+
 	// Call SendSampleRelay
 	output, err := relayer.SendSampleRelay(123, "0002", FishermenTrigger{
 		Proof: RelayProof{
@@ -121,7 +132,8 @@ func TestRelayer_SendSampleRelay(t *testing.T) {
 			GeoZone:      "US",
 			NumServicers: 5,
 		},
-	}, servicer, fisherman, &HostedBlockchains{})
+		Account: Account{Address: signer.address, PublicKey: signer.publicKey, PrivateKey: signer.privateKey},
+	}, servicer, fisherman, hostedBlockchains)
 
 	// Assertions
 	c.NoError(err)
@@ -129,7 +141,7 @@ func TestRelayer_SendSampleRelay(t *testing.T) {
 	c.NotNil(output.RelayOutput)
 	c.NotNil(output.Proof)
 	c.NotNil(output.Latency)
-	c.NotNil(output.Reliability)
+	c.True(output.Reliability)
 
 	// Mock verification
 	calls := httpmock.GetCallCountInfo()
@@ -8,10 +8,47 @@ import (
 
 // HostedBlockchain" - An object that represents a local hosted non-native blockchain
 type HostedBlockchain struct {
-	ID           string    `json:"id"`            // network identifier of the hosted blockchain
-	HTTPURL      string    `json:"url"`           // url of the hosted blockchain
-	WebSocketURL string    `json:"websocket_url"` // websocket URL for subscribing to events on the
-	BasicAuth    BasicAuth `json:"basic_auth"`    // basic http authentication optinal
+	ID           string           `json:"id"`                     // network identifier of the hosted blockchain
+	HTTPURL      string           `json:"url"`                    // url of the hosted blockchain
+	WebSocketURL string           `json:"websocket_url"`          // websocket URL for subscribing to events on the
+	BasicAuth    BasicAuth        `json:"basic_auth"`             // basic http authentication optinal
+	Weight       int              `json:"weight,omitempty"`       // relative weight of the primary url when upstreams are set
+	Upstreams    []Upstream       `json:"upstreams,omitempty"`    // additional upstream endpoints serving the same chain
+	HealthCheck  *Payload         `json:"health_check,omitempty"` // optional request used to actively probe the upstreams
+	SyncCheck    *SyncCheck       `json:"sync_check,omitempty"`   // optional block height probe used to refuse relays while out of sync
+	SyncStatus   *ChainSyncStatus `json:"sync_status,omitempty"`  // latest result of the sync check, only set on query results
+	CacheRules   []CacheRule      `json:"cache_rules,omitempty"`  // optional rules opting deterministic requests into the response cache
+	BatchLimit   int              `json:"batch_limit,omitempty"`  // maximum number of calls of a json-rpc batch, defaults to 100
+}
+
+// "Upstream" - A single endpoint of a hosted blockchain, relays are balanced between the upstreams by weight
+type Upstream struct {
+	HTTPURL      string    `json:"url"`                     // url of the upstream
+	WebSocketURL string    `json:"websocket_url,omitempty"` // websocket url of the upstream
+	Weight       int       `json:"weight,omitempty"`        // relative weight of the upstream, defaults to 1
+	BasicAuth    BasicAuth `json:"basic_auth"`              // basic http authentication optional
+}
+
+// "GetWeight" - Returns the weight of the upstream, defaulting to 1
+func (u Upstream) GetWeight() int {
+	if u.Weight <= 0 {
+		return 1
+	}
+	return u.Weight
+}
+
+// "GetUpstreams" - Returns every endpoint of the hosted blockchain, the primary url first
+func (hb HostedBlockchain) GetUpstreams() []Upstream {
+	upstreams := make([]Upstream, 0, len(hb.Upstreams)+1)
+	if hb.HTTPURL != "" || hb.WebSocketURL != "" {
+		upstreams = append(upstreams, Upstream{
+			HTTPURL:      hb.HTTPURL,
+			WebSocketURL: hb.WebSocketURL,
+			Weight:       hb.Weight,
+			BasicAuth:    hb.BasicAuth,
+		})
+	}
+	return append(upstreams, hb.Upstreams...)
 }
 
 type BasicAuth struct {
@@ -70,9 +107,22 @@ func (c *HostedBlockchains) Validate() error {
 	// Loop through all of the chains
 	for _, chain := range c.M {
 		// Validate not empty
-		if chain.ID == "" || (chain.HTTPURL == "" && chain.WebSocketURL == "") {
+		if chain.ID == "" || len(chain.GetUpstreams()) == 0 || chain.Weight < 0 || chain.BatchLimit < 0 {
 			return NewInvalidHostedChainError(ModuleName)
 		}
+		// Validate the upstreams
+		for _, u := range chain.Upstreams {
+			if (u.HTTPURL == "" && u.WebSocketURL == "") || u.Weight < 0 {
+				return NewInvalidHostedChainError(ModuleName)
+			}
+		}
+
+		// Validate the cache rules
+		for _, r := range chain.CacheRules {
+			if err := r.Validate(); err != nil {
+				return err
+			}
+		}
 
 		// Validate the network identifier
 		if err := NetworkIdentifierVerification(chain.ID); err != nil {
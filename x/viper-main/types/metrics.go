@@ -42,6 +42,26 @@ const (
 	AvailabilityHistHelp    = "the availability score for: "
 	ReliabilityHistName     = "reliability_score_for_"
 	ReliabilityHistHelp     = "the reliability score for: "
+	UpstreamRelayCountName  = "upstream_relay_count_for_"
+	UpstreamRelayCountHelp  = "the number of relays executed per upstream endpoint against: "
+	UpstreamErrCountName    = "upstream_err_count_for_"
+	UpstreamErrCountHelp    = "the number of failed relays per upstream endpoint against: "
+	UpstreamRelayHistName   = "upstream_relay_time_for_"
+	UpstreamRelayHistHelp   = "the relay time in ms per upstream endpoint executed against: "
+	UpstreamHealthName      = "upstream_health_for_"
+	UpstreamHealthHelp      = "the health (1 healthy, 0 unhealthy) per upstream endpoint of: "
+	SyncHeightName          = "sync_height_for_"
+	SyncHeightHelp          = "the block height reported by the upstreams of: "
+	SyncReferenceHeightName = "sync_reference_height_for_"
+	SyncReferenceHeightHelp = "the reference block height used for the sync check of: "
+	InSyncName              = "in_sync_for_"
+	InSyncHelp              = "whether (1) or not (0) the hosted chain is in sync: "
+	CacheHitCountName       = "cache_hit_count_for_"
+	CacheHitCountHelp       = "the number of relays answered from the response cache for: "
+	CacheMissCountName      = "cache_miss_count_for_"
+	CacheMissCountHelp      = "the number of cacheable relays executed against the upstream for: "
+	RateLimitedCountName    = "rate_limited_count_for_"
+	RateLimitedCountHelp    = "the number of client requests rejected by the rate limits of the servicer for: "
 )
 
 type ServiceMetrics struct {
@@ -289,6 +309,106 @@ func (sm *ServiceMetrics) AddReportCardMetric(networkID string, LatencyScore, Av
 	sm.NonNativeChains[networkID] = nnc
 }
 
+// AddUpstreamRelayFor records a relay attempt against a single upstream endpoint of the chain
+func (sm *ServiceMetrics) AddUpstreamRelayFor(networkID, upstream string, relayTime float64, failed bool) {
+	sm.l.Lock()
+	defer sm.l.Unlock()
+	// attempt to locate nn chain
+	nnc, ok := sm.NonNativeChains[networkID]
+	if !ok {
+		sm.tmLogger.Error("unable to find corresponding networkID in service metrics: ", networkID)
+		sm.NonNativeChains[networkID] = NewServiceMetricsFor(networkID)
+		return
+	}
+	labels := []string{"upstream", upstream}
+	nnc.UpstreamRelayCount.With(labels...).Add(1)
+	nnc.UpstreamRelayTime.With(labels...).Observe(relayTime)
+	if failed {
+		nnc.UpstreamErrCount.With(labels...).Add(1)
+	}
+	// update nnc
+	sm.NonNativeChains[networkID] = nnc
+}
+
+// SetUpstreamHealthFor sets the health gauge of a single upstream endpoint of the chain
+func (sm *ServiceMetrics) SetUpstreamHealthFor(networkID, upstream string, healthy bool) {
+	sm.l.Lock()
+	defer sm.l.Unlock()
+	// attempt to locate nn chain
+	nnc, ok := sm.NonNativeChains[networkID]
+	if !ok {
+		sm.tmLogger.Error("unable to find corresponding networkID in service metrics: ", networkID)
+		sm.NonNativeChains[networkID] = NewServiceMetricsFor(networkID)
+		return
+	}
+	health := float64(0)
+	if healthy {
+		health = 1
+	}
+	nnc.UpstreamHealth.With("upstream", upstream).Set(health)
+	// update nnc
+	sm.NonNativeChains[networkID] = nnc
+}
+
+// SetSyncStatusFor sets the result of the latest sync check of the chain
+func (sm *ServiceMetrics) SetSyncStatusFor(networkID string, height, referenceHeight int64, inSync bool) {
+	sm.l.Lock()
+	defer sm.l.Unlock()
+	// attempt to locate nn chain
+	nnc, ok := sm.NonNativeChains[networkID]
+	if !ok {
+		sm.tmLogger.Error("unable to find corresponding networkID in service metrics: ", networkID)
+		sm.NonNativeChains[networkID] = NewServiceMetricsFor(networkID)
+		return
+	}
+	synced := float64(0)
+	if inSync {
+		synced = 1
+	}
+	nnc.SyncHeight.Set(float64(height))
+	nnc.SyncReferenceHeight.Set(float64(referenceHeight))
+	nnc.InSync.Set(synced)
+	// update nnc
+	sm.NonNativeChains[networkID] = nnc
+}
+
+// AddCacheLookupFor records a response cache lookup of a cacheable relay of the chain
+func (sm *ServiceMetrics) AddCacheLookupFor(networkID string, hit bool) {
+	sm.l.Lock()
+	defer sm.l.Unlock()
+	// attempt to locate nn chain
+	nnc, ok := sm.NonNativeChains[networkID]
+	if !ok {
+		sm.tmLogger.Error("unable to find corresponding networkID in service metrics: ", networkID)
+		sm.NonNativeChains[networkID] = NewServiceMetricsFor(networkID)
+		return
+	}
+	if hit {
+		nnc.CacheHitCount.Add(1)
+		sm.CacheHitCount.Add(1)
+	} else {
+		nnc.CacheMissCount.Add(1)
+		sm.CacheMissCount.Add(1)
+	}
+	// update nnc
+	sm.NonNativeChains[networkID] = nnc
+}
+
+// AddRateLimitedFor records a client request of the chain rejected by the limit. The chain of the request is not
+// validated yet, so an unknown chain is only recorded in the total metrics
+func (sm *ServiceMetrics) AddRateLimitedFor(networkID, limit string) {
+	sm.l.Lock()
+	defer sm.l.Unlock()
+	sm.RateLimitedCount.With("limit", limit).Add(1)
+	nnc, ok := sm.NonNativeChains[networkID]
+	if !ok {
+		return
+	}
+	nnc.RateLimitedCount.With("limit", limit).Add(1)
+	// update nnc
+	sm.NonNativeChains[networkID] = nnc
+}
+
 func KeyForServiceMetrics() []byte {
 	return []byte(ServiceMetricsKey)
 }
@@ -322,6 +442,20 @@ type ServiceMetric struct {
 	LatencyScore      metrics.Histogram `json:"avg_latency_score"`
 	AvailabilityScore metrics.Histogram `json:"avg_availability_score"`
 	ReliabilityScore  metrics.Histogram `json:"avg_reliability_score"`
+	// per upstream endpoint metrics
+	UpstreamRelayCount metrics.Counter   `json:"upstream_relay_count"`
+	UpstreamErrCount   metrics.Counter   `json:"upstream_err_count"`
+	UpstreamRelayTime  metrics.Histogram `json:"upstream_relay_time"`
+	UpstreamHealth     metrics.Gauge     `json:"upstream_health"`
+	// sync check metrics
+	SyncHeight          metrics.Gauge `json:"sync_height"`
+	SyncReferenceHeight metrics.Gauge `json:"sync_reference_height"`
+	InSync              metrics.Gauge `json:"in_sync"`
+	// response cache metrics
+	CacheHitCount  metrics.Counter `json:"cache_hit_count"`
+	CacheMissCount metrics.Counter `json:"cache_miss_count"`
+	// rate limit metrics
+	RateLimitedCount metrics.Counter `json:"rate_limited_count"`
 }
 
 func NewServiceMetricsFor(networkID string) ServiceMetric {
@@ -417,17 +551,97 @@ func NewServiceMetricsFor(networkID string) ServiceMetric {
 		Buckets:     stdPrometheus.LinearBuckets(1, 20, 20),
 	}, append(labels, "validator_address"))
 
+	// upstream relay counter metric
+	upstreamRelayCounter := prometheus.NewCounterFrom(stdPrometheus.CounterOpts{
+		Namespace: ModuleName,
+		Subsystem: ServiceMetricsNamespace,
+		Name:      UpstreamRelayCountName + networkID,
+		Help:      UpstreamRelayCountHelp + networkID,
+	}, append(labels, "upstream"))
+	// upstream err counter metric
+	upstreamErrCounter := prometheus.NewCounterFrom(stdPrometheus.CounterOpts{
+		Namespace: ModuleName,
+		Subsystem: ServiceMetricsNamespace,
+		Name:      UpstreamErrCountName + networkID,
+		Help:      UpstreamErrCountHelp + networkID,
+	}, append(labels, "upstream"))
+	// upstream relay time histogram metric
+	upstreamRelayTime := prometheus.NewHistogramFrom(stdPrometheus.HistogramOpts{
+		Namespace:   ModuleName,
+		Subsystem:   ServiceMetricsNamespace,
+		Name:        UpstreamRelayHistName + networkID,
+		Help:        UpstreamRelayHistHelp + networkID,
+		ConstLabels: nil,
+		Buckets:     stdPrometheus.LinearBuckets(1, 20, 20),
+	}, append(labels, "upstream"))
+	// upstream health gauge metric
+	upstreamHealth := prometheus.NewGaugeFrom(stdPrometheus.GaugeOpts{
+		Namespace: ModuleName,
+		Subsystem: ServiceMetricsNamespace,
+		Name:      UpstreamHealthName + networkID,
+		Help:      UpstreamHealthHelp + networkID,
+	}, append(labels, "upstream"))
+	// sync check gauge metrics
+	syncHeight := prometheus.NewGaugeFrom(stdPrometheus.GaugeOpts{
+		Namespace: ModuleName,
+		Subsystem: ServiceMetricsNamespace,
+		Name:      SyncHeightName + networkID,
+		Help:      SyncHeightHelp + networkID,
+	}, labels)
+	syncReferenceHeight := prometheus.NewGaugeFrom(stdPrometheus.GaugeOpts{
+		Namespace: ModuleName,
+		Subsystem: ServiceMetricsNamespace,
+		Name:      SyncReferenceHeightName + networkID,
+		Help:      SyncReferenceHeightHelp + networkID,
+	}, labels)
+	inSync := prometheus.NewGaugeFrom(stdPrometheus.GaugeOpts{
+		Namespace: ModuleName,
+		Subsystem: ServiceMetricsNamespace,
+		Name:      InSyncName + networkID,
+		Help:      InSyncHelp + networkID,
+	}, labels)
+	// response cache counter metrics
+	cacheHitCounter := prometheus.NewCounterFrom(stdPrometheus.CounterOpts{
+		Namespace: ModuleName,
+		Subsystem: ServiceMetricsNamespace,
+		Name:      CacheHitCountName + networkID,
+		Help:      CacheHitCountHelp + networkID,
+	}, labels)
+	cacheMissCounter := prometheus.NewCounterFrom(stdPrometheus.CounterOpts{
+		Namespace: ModuleName,
+		Subsystem: ServiceMetricsNamespace,
+		Name:      CacheMissCountName + networkID,
+		Help:      CacheMissCountHelp + networkID,
+	}, labels)
+	// rate limited counter metric
+	rateLimitedCounter := prometheus.NewCounterFrom(stdPrometheus.CounterOpts{
+		Namespace: ModuleName,
+		Subsystem: ServiceMetricsNamespace,
+		Name:      RateLimitedCountName + networkID,
+		Help:      RateLimitedCountHelp + networkID,
+	}, append(labels, "limit"))
+
 	return ServiceMetric{
-		RelayCount:        relayCounter,
-		ChallengeCount:    challengeCounter,
-		ErrCount:          errCounter,
-		AverageRelayTime:  avgRelayTime,
-		TotalSessions:     totalSessions,
-		UVIPREarned:       uVIPREarned,
-		AverageClaimTime:  avgClaimTime,
-		AverageProofTime:  avgProofTime,
-		LatencyScore:      LatencyScore,
-		AvailabilityScore: AvailabilityScore,
-		ReliabilityScore:  ReliabilityScore,
+		RelayCount:          relayCounter,
+		ChallengeCount:      challengeCounter,
+		ErrCount:            errCounter,
+		AverageRelayTime:    avgRelayTime,
+		TotalSessions:       totalSessions,
+		UVIPREarned:         uVIPREarned,
+		AverageClaimTime:    avgClaimTime,
+		AverageProofTime:    avgProofTime,
+		LatencyScore:        LatencyScore,
+		AvailabilityScore:   AvailabilityScore,
+		ReliabilityScore:    ReliabilityScore,
+		UpstreamRelayCount:  upstreamRelayCounter,
+		UpstreamErrCount:    upstreamErrCounter,
+		UpstreamRelayTime:   upstreamRelayTime,
+		UpstreamHealth:      upstreamHealth,
+		SyncHeight:          syncHeight,
+		SyncReferenceHeight: syncReferenceHeight,
+		InSync:              inSync,
+		CacheHitCount:       cacheHitCounter,
+		CacheMissCount:      cacheMissCounter,
+		RateLimitedCount:    rateLimitedCounter,
 	}
 }
@@ -0,0 +1,207 @@
+package types
+
+import (
+	"sync"
+	"time"
+
+	crypto "github.com/vipernet-xyz/viper-network/crypto/codec"
+	sdk "github.com/vipernet-xyz/viper-network/types"
+)
+
+var globalRateLimiter = NewRateLimiter()
+
+const (
+	RateLimitClient      = "client"
+	RateLimitRequestor   = "requestor"
+	RateLimitIP          = "ip"
+	RateLimitConcurrency = "concurrency"
+)
+
+// "RateLimit" - A token bucket refilled at Rate tokens per second up to Burst tokens, a rate of 0 disables the limit
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+func (l RateLimit) enabled() bool {
+	return l.Rate > 0 && l.Burst > 0
+}
+
+// "RateLimiter" - Limits the client requests of the servicer rpc with token buckets keyed by client public key,
+// requestor public key and source ip, and caps the concurrent relays per chain. The limiter is shared by the viper
+// nodes of the process: the client and requestor buckets of a relay are kept per servicer so that, in lean mode,
+// every node enforces its own limits, while the ip buckets and the concurrency caps protect the process as a whole
+type RateLimiter struct {
+	l                   sync.Mutex
+	limits              map[string]RateLimit
+	buckets             *sdk.Cache // nil while disabled
+	maxConcurrentRelays int
+	inFlight            map[string]int
+	now                 func() time.Time
+}
+
+type tokenBucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// "NewRateLimiter" - Returns a disabled rate limiter, enabled by SetLimits
+func NewRateLimiter() *RateLimiter {
+	return &RateLimiter{
+		limits:   make(map[string]RateLimit),
+		inFlight: make(map[string]int),
+		now:      time.Now,
+	}
+}
+
+// "GlobalRateLimiter" - Returns the rate limiter shared by the viper nodes
+func GlobalRateLimiter() *RateLimiter {
+	return globalRateLimiter
+}
+
+// "SetLimits" - Sets the rate limits, the maximum number of tracked keys and the maximum number of concurrent relays
+// per chain. A maxKeys of 0 disables the rate limits and a maxConcurrentRelays of 0 disables the concurrency caps
+func (rl *RateLimiter) SetLimits(client, requestor, ip RateLimit, maxKeys, maxConcurrentRelays int) {
+	rl.l.Lock()
+	defer rl.l.Unlock()
+	rl.limits = map[string]RateLimit{
+		RateLimitClient:    client,
+		RateLimitRequestor: requestor,
+		RateLimitIP:        ip,
+	}
+	rl.maxConcurrentRelays = maxConcurrentRelays
+	switch {
+	case maxKeys <= 0:
+		rl.buckets = nil
+	case rl.buckets == nil:
+		rl.buckets = sdk.NewCache(maxKeys)
+	default:
+		rl.buckets.Resize(maxKeys)
+	}
+}
+
+// "AllowIP" - Takes a token of the bucket of the source ip of the request
+func (rl *RateLimiter) AllowIP(ip string) sdk.Error {
+	rl.l.Lock()
+	defer rl.l.Unlock()
+	return rl.take("", RateLimitIP, ip)
+}
+
+// "AllowRelay" - Takes a token of the client and requestor buckets of the relay proof, kept per servicer. The proof
+// must be authenticated first, so that the buckets of a client can't be drained with forged proofs, and no token is
+// taken unless both buckets allow the relay
+func (rl *RateLimiter) AllowRelay(proof RelayProof) sdk.Error {
+	servicer := servicerKey(proof.ServicerPubKey)
+	rl.l.Lock()
+	defer rl.l.Unlock()
+	client := rl.bucket(RateLimitClient, servicer+proof.Token.ClientPublicKey)
+	if client != nil && client.tokens < 1 {
+		return rateLimited(proof.Blockchain, RateLimitClient)
+	}
+	requestor := rl.bucket(RateLimitRequestor, servicer+proof.Token.RequestorPublicKey)
+	if requestor != nil && requestor.tokens < 1 {
+		return rateLimited(proof.Blockchain, RateLimitRequestor)
+	}
+	for _, b := range []*tokenBucket{client, requestor} {
+		if b != nil {
+			b.tokens--
+		}
+	}
+	return nil
+}
+
+// "AllowDispatch" - Takes a token of the requestor bucket of the session header. A dispatch is answered the same
+// by every node of the process, so the bucket is shared by them
+func (rl *RateLimiter) AllowDispatch(header SessionHeader) sdk.Error {
+	rl.l.Lock()
+	defer rl.l.Unlock()
+	return rl.take(header.Chain, RateLimitRequestor, header.RequestorPubKey)
+}
+
+// "AcquireRelay" - Reserves a concurrent relay of the chain, the returned func releases it
+func (rl *RateLimiter) AcquireRelay(chain string) (release func(), err sdk.Error) {
+	rl.l.Lock()
+	defer rl.l.Unlock()
+	if rl.maxConcurrentRelays <= 0 {
+		return func() {}, nil
+	}
+	if rl.inFlight[chain] >= rl.maxConcurrentRelays {
+		return nil, rateLimited(chain, RateLimitConcurrency)
+	}
+	rl.inFlight[chain]++
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			rl.l.Lock()
+			defer rl.l.Unlock()
+			if rl.inFlight[chain]--; rl.inFlight[chain] <= 0 {
+				delete(rl.inFlight, chain)
+			}
+		})
+	}, nil
+}
+
+// servicerKey returns the prefix of the client and requestor buckets of a relay: the servicer in lean mode, when it
+// is a node of the process, so that a client can't get fresh buckets by naming arbitrary servicers
+func servicerKey(servicerPubKey string) string {
+	if !GlobalViperConfig.LeanViper {
+		return ""
+	}
+	pk, err := crypto.NewPublicKey(servicerPubKey)
+	if err != nil {
+		return ""
+	}
+	if _, ok := GlobalViperNodes[sdk.GetAddress(pk).String()]; !ok {
+		return ""
+	}
+	return servicerPubKey + "/"
+}
+
+// take consumes a token of the bucket of the key, the caller must hold the lock
+func (rl *RateLimiter) take(chain, limit, key string) sdk.Error {
+	b := rl.bucket(limit, key)
+	if b == nil {
+		return nil
+	}
+	if b.tokens < 1 {
+		return rateLimited(chain, limit)
+	}
+	b.tokens--
+	return nil
+}
+
+// bucket returns the bucket of the key refilled up to now, or nil if the limit is disabled; the caller must hold
+// the lock
+func (rl *RateLimiter) bucket(limit, key string) *tokenBucket {
+	l := rl.limits[limit]
+	if rl.buckets == nil || !l.enabled() || key == "" {
+		return nil
+	}
+	now := rl.now()
+	cacheKey := limit + "/" + key
+	var b *tokenBucket
+	if v, ok := rl.buckets.Get(cacheKey); ok {
+		b = v.(*tokenBucket)
+		b.tokens += now.Sub(b.last).Seconds() * l.Rate
+		if b.tokens > float64(l.Burst) {
+			b.tokens = float64(l.Burst)
+		}
+		b.last = now
+	} else {
+		b = &tokenBucket{tokens: float64(l.Burst), last: now}
+		rl.buckets.Add(cacheKey, b)
+	}
+	return b
+}
+
+// rateLimited records the rejected request in the service metrics, once they are initialized, and returns the error
+func rateLimited(chain, limit string) sdk.Error {
+	if sm := GlobalServiceMetric(); sm != nil {
+		if GlobalViperConfig.LeanViper {
+			go sm.AddRateLimitedFor(chain, limit)
+		} else {
+			sm.AddRateLimitedFor(chain, limit)
+		}
+	}
+	return NewRateLimitedError(ModuleName, limit)
+}
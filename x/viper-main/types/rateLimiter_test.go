@@ -0,0 +1,92 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter_Allow(t *testing.T) {
+	rl := NewRateLimiter()
+	now := time.Now()
+	rl.now = func() time.Time { return now }
+	proof := RelayProof{
+		Blockchain:     "0001",
+		ServicerPubKey: "servicer",
+		Token:          AAT{RequestorPublicKey: "requestor", ClientPublicKey: "client"},
+	}
+	// disabled until the limits are set
+	for i := 0; i < 10; i++ {
+		assert.Nil(t, rl.AllowRelay(proof))
+		assert.Nil(t, rl.AllowIP("127.0.0.1"))
+	}
+	rl.SetLimits(RateLimit{Rate: 1, Burst: 2}, RateLimit{}, RateLimit{Rate: 1, Burst: 1}, 100, 0)
+	// the burst is allowed, then the bucket refills at the rate
+	assert.Nil(t, rl.AllowRelay(proof))
+	assert.Nil(t, rl.AllowRelay(proof))
+	err := rl.AllowRelay(proof)
+	assert.NotNil(t, err)
+	assert.True(t, ErrorIsRateLimited(err))
+	assert.False(t, ErrorWarrantsDispatch(err))
+	now = now.Add(time.Second)
+	assert.Nil(t, rl.AllowRelay(proof))
+	assert.NotNil(t, rl.AllowRelay(proof))
+	// the buckets are kept per client
+	other := proof
+	other.Token.ClientPublicKey = "other"
+	assert.Nil(t, rl.AllowRelay(other))
+	// the requestor limit is disabled
+	assert.Nil(t, rl.AllowDispatch(SessionHeader{RequestorPubKey: "requestor", Chain: "0001"}))
+	// the ip buckets
+	assert.Nil(t, rl.AllowIP("127.0.0.1"))
+	assert.NotNil(t, rl.AllowIP("127.0.0.1"))
+	assert.Nil(t, rl.AllowIP("127.0.0.2"))
+	// a maxKeys of 0 disables the rate limits
+	rl.SetLimits(RateLimit{Rate: 1, Burst: 2}, RateLimit{}, RateLimit{Rate: 1, Burst: 1}, 0, 0)
+	assert.Nil(t, rl.AllowIP("127.0.0.1"))
+}
+
+func TestRateLimiter_AllowRelayTakesBothBuckets(t *testing.T) {
+	rl := NewRateLimiter()
+	now := time.Now()
+	rl.now = func() time.Time { return now }
+	proof := RelayProof{
+		Blockchain: "0001",
+		Token:      AAT{RequestorPublicKey: "requestor", ClientPublicKey: "client"},
+	}
+	other := proof
+	other.Token.ClientPublicKey = "other"
+	rl.SetLimits(RateLimit{Rate: 1, Burst: 1}, RateLimit{Rate: 1, Burst: 1}, RateLimit{}, 100, 0)
+	assert.Nil(t, rl.AllowRelay(other))
+	// the requestor bucket rejects the relay without taking the token of the client
+	err := rl.AllowRelay(proof)
+	assert.True(t, ErrorIsRateLimited(err))
+	now = now.Add(time.Second)
+	assert.Nil(t, rl.AllowRelay(proof))
+}
+
+func TestRateLimiter_AcquireRelay(t *testing.T) {
+	rl := NewRateLimiter()
+	rl.SetLimits(RateLimit{}, RateLimit{}, RateLimit{}, 0, 2)
+	release1, err := rl.AcquireRelay("0001")
+	assert.Nil(t, err)
+	release2, err := rl.AcquireRelay("0001")
+	assert.Nil(t, err)
+	_, err = rl.AcquireRelay("0001")
+	assert.True(t, ErrorIsRateLimited(err))
+	// the caps are per chain
+	release3, err := rl.AcquireRelay("0002")
+	assert.Nil(t, err)
+	// releasing twice frees a single relay
+	release1()
+	release1()
+	release4, err := rl.AcquireRelay("0001")
+	assert.Nil(t, err)
+	_, err = rl.AcquireRelay("0001")
+	assert.NotNil(t, err)
+	release2()
+	release3()
+	release4()
+	assert.Empty(t, rl.inFlight)
+}
@@ -0,0 +1,229 @@
+package types
+
+import (
+	"encoding/json"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	sdk "github.com/vipernet-xyz/viper-network/types"
+)
+
+var globalRelayCache = NewRelayCache()
+
+// "CacheRule" - Opts the json-rpc requests of a method into the response cache of the hosted blockchain
+type CacheRule struct {
+	Method        string `json:"method"`                   // json-rpc method, e.g. eth_chainId
+	BlockParam    *int   `json:"block_param,omitempty"`    // index of the block number param, the request is cached only once the block is final
+	FinalityDepth int64  `json:"finality_depth,omitempty"` // number of blocks behind the synced height after which a block is final
+	TTL           int64  `json:"ttl,omitempty"`            // seconds, defaults to and is capped by the relay_cache_ttl
+}
+
+// "Validate" - Validity check for the cache rule
+func (r CacheRule) Validate() sdk.Error {
+	if r.Method == "" || r.TTL < 0 || r.FinalityDepth < 0 {
+		return NewInvalidCacheRuleError(ModuleName)
+	}
+	if r.BlockParam == nil && r.FinalityDepth != 0 {
+		return NewInvalidCacheRuleError(ModuleName)
+	}
+	if r.BlockParam != nil && *r.BlockParam < 0 {
+		return NewInvalidCacheRuleError(ModuleName)
+	}
+	return nil
+}
+
+// "RelayCache" - Caches the upstream responses of deterministic requests, the cache sits below the relay
+// handling so cached responses are signed and stored as evidence like any other relay
+type RelayCache struct {
+	l     sync.RWMutex
+	cache *sdk.Cache // nil while disabled
+	ttl   time.Duration
+}
+
+type cachedResponse struct {
+	response string
+	expires  time.Time
+}
+
+// "NewRelayCache" - Returns a disabled relay cache, enabled by SetLimits
+func NewRelayCache() *RelayCache {
+	return &RelayCache{}
+}
+
+// "GlobalRelayCache" - Returns the relay cache shared by the hosted blockchains
+func GlobalRelayCache() *RelayCache {
+	return globalRelayCache
+}
+
+// "SetLimits" - Sets the maximum number of entries and the maximum age of an entry, a size of 0 disables the cache
+func (c *RelayCache) SetLimits(size int, ttl time.Duration) {
+	c.l.Lock()
+	defer c.l.Unlock()
+	c.ttl = ttl
+	switch {
+	case size <= 0 || ttl <= 0:
+		c.cache = nil
+	case c.cache == nil:
+		c.cache = sdk.NewCache(size)
+	default:
+		c.cache.Resize(size)
+	}
+}
+
+// "Get" - Returns the cached response of the payload
+func (c *RelayCache) Get(chainID string, payload Payload) (string, bool) {
+	c.l.RLock()
+	defer c.l.RUnlock()
+	if c.cache == nil {
+		return "", false
+	}
+	key := relayCacheKey(chainID, payload)
+	v, ok := c.cache.Get(key)
+	if !ok {
+		return "", false
+	}
+	entry := v.(cachedResponse)
+	if time.Now().After(entry.expires) {
+		c.cache.Remove(key)
+		return "", false
+	}
+	return entry.response, true
+}
+
+// "Set" - Caches the response of the payload, the ttl is capped by the ttl of the cache
+func (c *RelayCache) Set(chainID string, payload Payload, response string, ttl time.Duration) {
+	c.l.RLock()
+	defer c.l.RUnlock()
+	if c.cache == nil {
+		return
+	}
+	if ttl <= 0 || ttl > c.ttl {
+		ttl = c.ttl
+	}
+	c.cache.Add(relayCacheKey(chainID, payload), cachedResponse{response: response, expires: time.Now().Add(ttl)})
+}
+
+// "Len" - Returns the number of cached responses
+func (c *RelayCache) Len() int {
+	c.l.RLock()
+	defer c.l.RUnlock()
+	if c.cache == nil {
+		return 0
+	}
+	return c.cache.Len()
+}
+
+// "Purge" - Removes every cached response
+func (c *RelayCache) Purge() {
+	c.l.RLock()
+	defer c.l.RUnlock()
+	if c.cache != nil {
+		c.cache.Purge()
+	}
+}
+
+func relayCacheKey(chainID string, payload Payload) string {
+	return chainID + "|" + payload.HashString()
+}
+
+// "CacheTTL" - Returns the ttl of the response of the payload and whether the payload is cacheable at all
+func (hb HostedBlockchain) CacheTTL(payload Payload) (time.Duration, bool) {
+	if len(hb.CacheRules) == 0 {
+		return 0, false
+	}
+	var req struct {
+		Method string          `json:"method"`
+		Params json.RawMessage `json:"params"`
+	}
+	// batches and non json-rpc payloads are never cached
+	if err := json.Unmarshal([]byte(payload.Data), &req); err != nil || req.Method == "" {
+		return 0, false
+	}
+	for _, r := range hb.CacheRules {
+		if r.Method != req.Method {
+			continue
+		}
+		if r.BlockParam != nil && !isFinalBlockParam(hb.ID, req.Params, *r.BlockParam, r.FinalityDepth) {
+			return 0, false
+		}
+		return time.Duration(r.TTL) * time.Second, true
+	}
+	return 0, false
+}
+
+// "isFinalBlockParam" - Returns true if the block param is at least finalityDepth blocks behind the synced height,
+// block tags other than "earliest" move with the head of the chain and are never final
+func isFinalBlockParam(chainID string, rawParams json.RawMessage, index int, finalityDepth int64) bool {
+	var params []json.RawMessage
+	if err := json.Unmarshal(rawParams, &params); err != nil || index >= len(params) {
+		return false
+	}
+	var v interface{}
+	d := json.NewDecoder(strings.NewReader(string(params[index])))
+	d.UseNumber()
+	if err := d.Decode(&v); err != nil {
+		return false
+	}
+	var block int64
+	var err error
+	switch b := v.(type) {
+	case json.Number:
+		block, err = b.Int64()
+	case string:
+		switch {
+		case b == "earliest":
+			return true
+		case strings.HasPrefix(b, "0x") || strings.HasPrefix(b, "0X"):
+			block, err = strconv.ParseInt(b[2:], 16, 64)
+		default:
+			block, err = strconv.ParseInt(b, 10, 64)
+		}
+	default:
+		return false
+	}
+	if err != nil {
+		return false
+	}
+	// the head of the chain is only known for chains with a sync check
+	status, found := GlobalSyncMonitor().Status(chainID)
+	if !found || status.Height == 0 {
+		return false
+	}
+	return block <= status.Height-finalityDepth
+}
+
+// "isCacheableResponse" - Only successful json-rpc responses with a result are cached,
+// e.g. a null transaction may be found later
+func isCacheableResponse(res string) bool {
+	var resp struct {
+		Result json.RawMessage `json:"result"`
+		Error  json.RawMessage `json:"error"`
+	}
+	if err := json.Unmarshal([]byte(res), &resp); err != nil {
+		return false
+	}
+	return len(resp.Result) != 0 && string(resp.Result) != "null" && (len(resp.Error) == 0 || string(resp.Error) == "null")
+}
+
+// "executeCachedHTTPRequest" - Answers cacheable requests from the relay cache, executing the others against the upstreams
+func executeCachedHTTPRequest(chain HostedBlockchain, payload Payload, userAgent string) (string, error) {
+	ttl, cacheable := chain.CacheTTL(payload)
+	if !cacheable {
+		return executeUpstreamHTTPRequest(chain, payload, userAgent)
+	}
+	cache := GlobalRelayCache()
+	res, hit := cache.Get(chain.ID, payload)
+	if sm := GlobalServiceMetric(); sm != nil {
+		sm.AddCacheLookupFor(chain.ID, hit)
+	}
+	if hit {
+		return res, nil
+	}
+	res, err := executeUpstreamHTTPRequest(chain, payload, userAgent)
+	if err == nil && isCacheableResponse(res) {
+		cache.Set(chain.ID, payload, res, ttl)
+	}
+	return res, err
+}
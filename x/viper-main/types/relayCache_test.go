@@ -0,0 +1,134 @@
+package types
+
+import (
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheRule_Validate(t *testing.T) {
+	zero, negative := 0, -1
+	tests := []struct {
+		name     string
+		rule     CacheRule
+		hasError bool
+	}{
+		{"method only", CacheRule{Method: "eth_chainId"}, false},
+		{"finalized block", CacheRule{Method: "eth_getBlockByNumber", BlockParam: &zero, FinalityDepth: 12, TTL: 30}, false},
+		{"no method", CacheRule{TTL: 30}, true},
+		{"negative ttl", CacheRule{Method: "eth_chainId", TTL: -1}, true},
+		{"finality without block param", CacheRule{Method: "eth_getBlockByNumber", FinalityDepth: 12}, true},
+		{"negative block param", CacheRule{Method: "eth_getBlockByNumber", BlockParam: &negative}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.hasError, tt.rule.Validate() != nil)
+		})
+	}
+}
+
+func TestHostedBlockchain_CacheTTL(t *testing.T) {
+	ethereum := hex.EncodeToString([]byte{0x61})
+	zero := 0
+	hb := HostedBlockchain{
+		ID: ethereum,
+		CacheRules: []CacheRule{
+			{Method: "eth_chainId", TTL: 30},
+			{Method: "eth_getBlockByNumber", BlockParam: &zero, FinalityDepth: 10},
+		},
+	}
+	request := func(data string) Payload { return Payload{Data: data} }
+	// the head of the chain is unknown until the sync check ran
+	_, cacheable := hb.CacheTTL(request(`{"method":"eth_getBlockByNumber","params":["0x10",false]}`))
+	assert.False(t, cacheable)
+	GlobalSyncMonitor().SetStatus(ethereum, ChainSyncStatus{InSync: true, Height: 100})
+	defer GlobalSyncMonitor().CheckChains(&HostedBlockchains{M: map[string]HostedBlockchain{}})
+	tests := []struct {
+		name      string
+		data      string
+		ttl       time.Duration
+		cacheable bool
+	}{
+		{"static method", `{"jsonrpc":"2.0","method":"eth_chainId","params":[],"id":1}`, 30 * time.Second, true},
+		{"final hex block", `{"method":"eth_getBlockByNumber","params":["0x5a",false]}`, 0, true},
+		{"final decimal block", `{"method":"eth_getBlockByNumber","params":[90,false]}`, 0, true},
+		{"earliest block", `{"method":"eth_getBlockByNumber","params":["earliest",false]}`, 0, true},
+		{"block within the finality depth", `{"method":"eth_getBlockByNumber","params":["0x5b",false]}`, 0, false},
+		{"latest block", `{"method":"eth_getBlockByNumber","params":["latest",false]}`, 0, false},
+		{"missing block param", `{"method":"eth_getBlockByNumber","params":[]}`, 0, false},
+		{"other method", `{"method":"eth_blockNumber","params":[]}`, 0, false},
+		{"batch", `[{"method":"eth_chainId","params":[]}]`, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ttl, cacheable := hb.CacheTTL(request(tt.data))
+			assert.Equal(t, tt.cacheable, cacheable)
+			assert.Equal(t, tt.ttl, ttl)
+		})
+	}
+}
+
+func TestIsCacheableResponse(t *testing.T) {
+	assert.True(t, isCacheableResponse(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
+	assert.True(t, isCacheableResponse(`{"jsonrpc":"2.0","id":1,"result":{"number":"0x1"},"error":null}`))
+	assert.False(t, isCacheableResponse(`{"jsonrpc":"2.0","id":1,"result":null}`))
+	assert.False(t, isCacheableResponse(`{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"header not found"}}`))
+	assert.False(t, isCacheableResponse(`bad gateway`))
+}
+
+func TestRelayCache_Expiry(t *testing.T) {
+	cache := NewRelayCache()
+	payload := Payload{Data: `{"method":"eth_chainId"}`}
+	// disabled until the limits are set
+	cache.Set("0001", payload, `{"result":"0x1"}`, 0)
+	_, found := cache.Get("0001", payload)
+	assert.False(t, found)
+	cache.SetLimits(10, time.Hour)
+	cache.Set("0001", payload, `{"result":"0x1"}`, time.Millisecond)
+	res, found := cache.Get("0001", payload)
+	assert.True(t, found)
+	assert.Equal(t, `{"result":"0x1"}`, res)
+	// entries are keyed per chain
+	_, found = cache.Get("0002", payload)
+	assert.False(t, found)
+	time.Sleep(5 * time.Millisecond)
+	_, found = cache.Get("0001", payload)
+	assert.False(t, found)
+	assert.Equal(t, 0, cache.Len())
+}
+
+func TestExecuteCachedHTTPRequest(t *testing.T) {
+	// the cache returns the bytes of the upstream as is, they must not be sorted by the global config
+	sortResponses := GlobalViperConfig.JSONSortRelayResponses
+	GlobalViperConfig.JSONSortRelayResponses = false
+	defer func() { GlobalViperConfig.JSONSortRelayResponses = sortResponses }()
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		_, _ = w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":"0x1"}`))
+	}))
+	defer server.Close()
+	ethereum := hex.EncodeToString([]byte{0x62})
+	chain := HostedBlockchain{ID: ethereum, HTTPURL: server.URL, CacheRules: []CacheRule{{Method: "eth_chainId"}}}
+	defer GlobalRelayCache().Purge()
+	cacheable := Payload{Data: `{"jsonrpc":"2.0","method":"eth_chainId","params":[],"id":1}`, Method: DEFAULTHTTPMETHOD}
+	for i := 0; i < 3; i++ {
+		res, err := executeCachedHTTPRequest(chain, cacheable, "")
+		assert.Nil(t, err)
+		assert.Equal(t, `{"jsonrpc":"2.0","id":1,"result":"0x1"}`, res)
+	}
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+	// requests without a rule always reach the upstream
+	other := Payload{Data: `{"jsonrpc":"2.0","method":"eth_blockNumber","params":[],"id":1}`, Method: DEFAULTHTTPMETHOD}
+	for i := 0; i < 2; i++ {
+		_, err := executeCachedHTTPRequest(chain, other, "")
+		assert.Nil(t, err)
+	}
+	assert.Equal(t, int32(3), atomic.LoadInt32(&calls))
+	GlobalUpstreamTracker().Prune(nil)
+}
@@ -3,18 +3,56 @@ package types
 import (
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
-	"os"
-	fp "path/filepath"
+	"math/rand"
+	"strconv"
+	"strings"
 	"sync"
+	"time"
 
 	sdk "github.com/vipernet-xyz/viper-network/types"
 )
 
+const (
+	SampleCategoryRead    = "read"    // reads near the head of the chain, the default
+	SampleCategoryArchive = "archive" // reads of historical state
+	SampleCategoryTrace   = "trace"   // heavy debug and trace calls
+
+	DefaultSampleWeight = 1
+
+	SampleTemplateHead       = "{{head}}"        // hex height of the synced head of the chain
+	SampleTemplateHeadNumber = "{{head_number}}" // decimal height of the synced head of the chain
+	SampleTemplateRecentTx   = "{{recent_tx}}"   // a recent transaction hash of the chain
+
+	DefaultTxSourcePath     = "result.transactions"
+	DefaultTxSourceInterval = 60 // seconds
+)
+
+var (
+	globalSamplePools = &SamplePools{M: make(map[string]SamplePool)}
+	globalRecentTxs   = &recentTxs{m: make(map[string]recentTxsEntry)}
+)
+
+// SamplePayload - A sample relay payload of the pool, weighted within its category
+type SamplePayload struct {
+	RelayPayload
+	Weight   int    `json:"weight,omitempty"`   // relative frequency of the payload, defaults to 1
+	Category string `json:"category,omitempty"` // read (default), archive or trace
+}
+
+// TxSource - A request to the local chain returning recent transaction hashes for the {{recent_tx}} template
+type TxSource struct {
+	Payload  RelayPayload `json:"payload"`            // may use the {{head}} templates
+	Path     string       `json:"path,omitempty"`     // path of the hashes in the response, defaults to result.transactions
+	Interval int64        `json:"interval,omitempty"` // seconds between refreshes, defaults to 60
+}
+
 // SamplePool - An object that represents a sample pool for a blockchain
 type SamplePool struct {
-	Blockchain string         `json:"blockchain"`
-	Payloads   []RelayPayload `json:"payloads"`
+	Blockchain string            `json:"blockchain"`
+	Payloads   []SamplePayload   `json:"payloads"`
+	Categories map[string]int    `json:"categories,omitempty"` // share of each category, payloads are weighted only if empty
+	Comparator *ComparatorConfig `json:"comparator,omitempty"`
+	TxSource   *TxSource         `json:"tx_source,omitempty"`
 }
 
 // SamplePools - An object that represents the sample pools hosted
@@ -23,6 +61,19 @@ type SamplePools struct {
 	L sync.Mutex
 }
 
+// GlobalSamplePools - Returns the sample pools used by the fisherman, loaded and hot reloaded by the app
+func GlobalSamplePools() *SamplePools {
+	return globalSamplePools
+}
+
+// SetSamplePools - Sets the sample pools used by the fisherman
+func SetSamplePools(sp *SamplePools) {
+	if sp == nil {
+		sp = &SamplePools{M: make(map[string]SamplePool)}
+	}
+	globalSamplePools = sp
+}
+
 // Contains - Checks if the sample pool exists within the HostedSamplePools object
 func (sp *SamplePools) Contains(blockchain string) bool {
 	sp.L.Lock()
@@ -50,64 +101,279 @@ func (sp *SamplePools) Validate() error {
 	defer sp.L.Unlock()
 	// Loop through all the sample pools
 	for _, pool := range sp.M {
-		// Validate not empty
-		if pool.Blockchain == "" {
+		if err := pool.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate - Validates the sample pool
+func (pool SamplePool) Validate() sdk.Error {
+	// Validate not empty
+	if pool.Blockchain == "" {
+		return NewInvalidSampleError(ModuleName)
+	}
+	for _, p := range pool.Payloads {
+		if p.Weight < 0 || !isSampleCategory(p.getCategory()) {
 			return NewInvalidSampleError(ModuleName)
 		}
 	}
+	for category, share := range pool.Categories {
+		if share < 0 || !isSampleCategory(category) {
+			return NewInvalidSampleError(ModuleName)
+		}
+	}
+	if pool.TxSource != nil && (pool.TxSource.Payload.Data == "" && pool.TxSource.Payload.Path == "" || pool.TxSource.Interval < 0) {
+		return NewInvalidSampleError(ModuleName)
+	}
+	if pool.Comparator != nil {
+		if err := pool.Comparator.Validate(); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
-type RelayPool struct {
-	Blockchain string
-	Payloads   []*RelayPayload
+func isSampleCategory(category string) bool {
+	switch category {
+	case SampleCategoryRead, SampleCategoryArchive, SampleCategoryTrace:
+		return true
+	}
+	return false
 }
 
-var (
-	GlobalConfig     sdk.Config
-	FS               = string(fp.Separator)
-	SampleRelayPools map[string]*RelayPool
-)
+func (p SamplePayload) getCategory() string {
+	if p.Category == "" {
+		return SampleCategoryRead
+	}
+	return p.Category
+}
 
-func LoadSampleRelayPool() (map[string]*RelayPool, error) {
-	// create the sample pool path
-	home, _ := os.UserHomeDir()
-	var samplePoolPath = home + FS + sdk.DefaultDDName + FS + sdk.ConfigDirName + FS + "samplepool.json"
-	// if file exists, open; else, create and open
-	var jsonFile *os.File
-	var bz []byte
+func (p SamplePayload) getWeight() int {
+	if p.Weight == 0 {
+		return DefaultSampleWeight
+	}
+	return p.Weight
+}
 
-	// reopen the file to read into the variable
-	jsonFile, err := os.OpenFile(samplePoolPath, os.O_RDONLY, os.ModePerm)
-	if err != nil {
-		return nil, fmt.Errorf("Error opening samplepool.json: %v", err)
+// SampleVars - The values substituted into templated sample payloads
+type SampleVars struct {
+	Head      int64    // 0 while the head of the chain is unknown
+	RecentTxs []string // empty while no recent transaction is known
+}
+
+// Render - Returns the payload with the templates substituted, false if a template value is unknown
+func (p SamplePayload) Render(vars SampleVars, rng *rand.Rand) (*RelayPayload, bool) {
+	rp := p.RelayPayload
+	var ok bool
+	if rp.Data, ok = renderSampleTemplate(rp.Data, vars, rng); !ok {
+		return nil, false
+	}
+	if rp.Path, ok = renderSampleTemplate(rp.Path, vars, rng); !ok {
+		return nil, false
 	}
+	return &rp, true
+}
 
-	bz, err = ioutil.ReadAll(jsonFile)
+func renderSampleTemplate(s string, vars SampleVars, rng *rand.Rand) (string, bool) {
+	if !strings.Contains(s, "{{") {
+		return s, true
+	}
+	if strings.Contains(s, SampleTemplateHead) || strings.Contains(s, SampleTemplateHeadNumber) {
+		if vars.Head <= 0 {
+			return "", false
+		}
+		s = strings.ReplaceAll(s, SampleTemplateHead, "0x"+strconv.FormatInt(vars.Head, 16))
+		s = strings.ReplaceAll(s, SampleTemplateHeadNumber, strconv.FormatInt(vars.Head, 10))
+	}
+	if strings.Contains(s, SampleTemplateRecentTx) {
+		if len(vars.RecentTxs) == 0 {
+			return "", false
+		}
+		s = strings.ReplaceAll(s, SampleTemplateRecentTx, vars.RecentTxs[rng.Intn(len(vars.RecentTxs))])
+	}
+	return s, true
+}
+
+// Pick - Selects a payload of the pool, first a category by its share and then a payload by its weight,
+// payloads whose templates can't be rendered are skipped
+func (pool SamplePool) Pick(vars SampleVars, rng *rand.Rand) (*SamplePayload, *RelayPayload, bool) {
+	type candidate struct {
+		sample  *SamplePayload
+		payload *RelayPayload
+	}
+	byCategory := make(map[string][]candidate)
+	for i := range pool.Payloads {
+		p := &pool.Payloads[i]
+		if p.getWeight() <= 0 {
+			continue
+		}
+		rendered, ok := p.Render(vars, rng)
+		if !ok {
+			continue
+		}
+		byCategory[p.getCategory()] = append(byCategory[p.getCategory()], candidate{p, rendered})
+	}
+	var candidates []candidate
+	if len(pool.Categories) == 0 {
+		for _, c := range []string{SampleCategoryRead, SampleCategoryArchive, SampleCategoryTrace} {
+			candidates = append(candidates, byCategory[c]...)
+		}
+	} else {
+		// categories are sorted so the selection only depends on the rng
+		categories := make([]string, 0, len(pool.Categories))
+		shares := make([]int, 0, len(pool.Categories))
+		for _, c := range []string{SampleCategoryRead, SampleCategoryArchive, SampleCategoryTrace} {
+			if share := pool.Categories[c]; share > 0 && len(byCategory[c]) != 0 {
+				categories = append(categories, c)
+				shares = append(shares, share)
+			}
+		}
+		i, ok := pickWeighted(shares, rng)
+		if !ok {
+			return nil, nil, false
+		}
+		candidates = byCategory[categories[i]]
+	}
+	weights := make([]int, len(candidates))
+	for i, c := range candidates {
+		weights[i] = c.sample.getWeight()
+	}
+	i, ok := pickWeighted(weights, rng)
+	if !ok {
+		return nil, nil, false
+	}
+	return candidates[i].sample, candidates[i].payload, true
+}
+
+func pickWeighted(weights []int, rng *rand.Rand) (int, bool) {
+	total := 0
+	for _, w := range weights {
+		total += w
+	}
+	if total <= 0 {
+		return 0, false
+	}
+	n := rng.Intn(total)
+	for i, w := range weights {
+		if n < w {
+			return i, true
+		}
+		n -= w
+	}
+	return 0, false
+}
+
+// SelectSample - Picks a sample payload of the chain, rendering the templates with the synced head and the
+// recent transactions of the local chain
+func SelectSample(blockchain string, hostedBlockchains *HostedBlockchains, rng *rand.Rand) (*SamplePayload, *RelayPayload, *SamplePool, error) {
+	pool, err := GlobalSamplePools().GetSamplePool(blockchain)
 	if err != nil {
-		return nil, fmt.Errorf("Error reading samplepool.json: %v", err)
+		return nil, nil, nil, fmt.Errorf("no relay pool found for blockchain: %s", blockchain)
+	}
+	vars := SampleVars{}
+	if status, found := GlobalSyncMonitor().Status(blockchain); found {
+		vars.Head = status.Height
 	}
+	if pool.TxSource != nil && hostedBlockchains != nil {
+		vars.RecentTxs = globalRecentTxs.get(blockchain, *pool.TxSource, vars, hostedBlockchains, rng)
+	}
+	sample, payload, ok := pool.Pick(vars, rng)
+	if !ok {
+		return nil, nil, nil, fmt.Errorf("no sample payload available for blockchain: %s", blockchain)
+	}
+	return sample, payload, &pool, nil
+}
 
-	// close the file
-	err = jsonFile.Close()
+type recentTxsEntry struct {
+	hashes  []string
+	updated time.Time
+}
+
+// recentTxs - The recent transaction hashes of the chains, refreshed from the tx source of the sample pool
+type recentTxs struct {
+	l sync.Mutex
+	m map[string]recentTxsEntry
+}
+
+func (r *recentTxs) get(chainID string, source TxSource, vars SampleVars, hostedBlockchains *HostedBlockchains, rng *rand.Rand) []string {
+	interval := source.Interval
+	if interval == 0 {
+		interval = DefaultTxSourceInterval
+	}
+	r.l.Lock()
+	entry, found := r.m[chainID]
+	r.l.Unlock()
+	if found && time.Since(entry.updated) < time.Duration(interval)*time.Second {
+		return entry.hashes
+	}
+	hashes, err := fetchRecentTxs(chainID, source, vars, hostedBlockchains, rng)
 	if err != nil {
-		return nil, fmt.Errorf("Error closing samplepool.json: %v", err)
+		// keep the previous hashes until the source answers again
+		return entry.hashes
 	}
+	r.l.Lock()
+	r.m[chainID] = recentTxsEntry{hashes: hashes, updated: time.Now()}
+	r.l.Unlock()
+	return hashes
+}
 
-	// Unmarshal the JSON array into a slice of RelayPool
-	var relayPools []RelayPool
-	err = json.Unmarshal(bz, &relayPools)
+func fetchRecentTxs(chainID string, source TxSource, vars SampleVars, hostedBlockchains *HostedBlockchains, rng *rand.Rand) ([]string, error) {
+	chain, err := hostedBlockchains.GetChain(chainID)
 	if err != nil {
-		return nil, fmt.Errorf("Error unmarshaling samplepool.json: %v", err)
+		return nil, err
+	}
+	payload, ok := SamplePayload{RelayPayload: source.Payload}.Render(SampleVars{Head: vars.Head}, rng)
+	if !ok {
+		return nil, fmt.Errorf("the head of chain %s is unknown", chainID)
 	}
+	res, er := executeUpstreamHTTPRequest(chain, Payload{Data: payload.Data, Method: payload.Method, Path: payload.Path, Headers: payload.Headers}, "")
+	if er != nil {
+		return nil, er
+	}
+	return parseTxHashes(res, source.Path)
+}
 
-	// Convert the slice of RelayPool into the required map structure
-	resultMap := make(map[string]*RelayPool)
-	for _, pool := range relayPools {
-		// Create a new variable inside the loop to take its address
-		p := pool
-		resultMap[p.Blockchain] = &p
+// parseTxHashes - Returns the hashes at the path of the response, either strings or objects with a hash
+func parseTxHashes(res, path string) ([]string, error) {
+	if path == "" {
+		path = DefaultTxSourcePath
+	}
+	v, err := decodeJSON(res)
+	if err != nil {
+		return nil, err
+	}
+	list, ok := valueAt(v, path).([]interface{})
+	if !ok {
+		return nil, fmt.Errorf("no transactions found at %s", path)
 	}
+	hashes := make([]string, 0, len(list))
+	for _, tx := range list {
+		switch t := tx.(type) {
+		case string:
+			hashes = append(hashes, t)
+		case map[string]interface{}:
+			if h, ok := t["hash"].(string); ok {
+				hashes = append(hashes, h)
+			}
+		}
+	}
+	return hashes, nil
+}
 
-	return resultMap, nil
+// ParseSamplePools - Parses the sample pools of samplepool.json
+func ParseSamplePools(bz []byte) (map[string]SamplePool, error) {
+	var pools []SamplePool
+	if err := json.Unmarshal(bz, &pools); err != nil {
+		return nil, err
+	}
+	m := make(map[string]SamplePool, len(pools))
+	for _, pool := range pools {
+		if err := pool.Validate(); err != nil {
+			return nil, fmt.Errorf("invalid sample pool %s: %s", pool.Blockchain, err.Error())
+		}
+		m[pool.Blockchain] = pool
+	}
+	return m, nil
 }
@@ -0,0 +1,123 @@
+package types
+
+import (
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSamplePools(t *testing.T) {
+	bz := []byte(`[{"blockchain":"0002","payloads":[{"data":"{\"method\":\"eth_chainId\"}","method":"POST","path":"","headers":null,"weight":2,"category":"archive"}],"categories":{"read":9,"archive":1}}]`)
+	pools, err := ParseSamplePools(bz)
+	assert.Nil(t, err)
+	pool := pools["0002"]
+	assert.Len(t, pool.Payloads, 1)
+	assert.Equal(t, `{"method":"eth_chainId"}`, pool.Payloads[0].Data)
+	assert.Equal(t, "POST", pool.Payloads[0].Method)
+	assert.Equal(t, 2, pool.Payloads[0].Weight)
+	assert.Equal(t, SampleCategoryArchive, pool.Payloads[0].Category)
+	// invalid pools are rejected
+	_, err = ParseSamplePools([]byte(`[{"blockchain":"0002","payloads":[{"data":"{}","category":"writes"}]}]`))
+	assert.NotNil(t, err)
+	_, err = ParseSamplePools([]byte(`[{"blockchain":"0002","payloads":[{"data":"{}","weight":-1}]}]`))
+	assert.NotNil(t, err)
+	_, err = ParseSamplePools([]byte(`{"0002":{}}`))
+	assert.NotNil(t, err)
+}
+
+func TestSamplePayload_Render(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	p := SamplePayload{RelayPayload: RelayPayload{Data: `{"params":["{{head}}",{{head_number}},"{{recent_tx}}"]}`, Path: "/blocks/{{head_number}}"}}
+	rendered, ok := p.Render(SampleVars{Head: 255, RecentTxs: []string{"0xabc"}}, rng)
+	assert.True(t, ok)
+	assert.Equal(t, `{"params":["0xff",255,"0xabc"]}`, rendered.Data)
+	assert.Equal(t, "/blocks/255", rendered.Path)
+	// unknown values can't be rendered
+	_, ok = p.Render(SampleVars{RecentTxs: []string{"0xabc"}}, rng)
+	assert.False(t, ok)
+	_, ok = p.Render(SampleVars{Head: 255}, rng)
+	assert.False(t, ok)
+	// the source payload is not modified
+	assert.Equal(t, "/blocks/{{head_number}}", p.Path)
+}
+
+func TestSamplePool_Pick(t *testing.T) {
+	payload := func(data string, weight int, category string) SamplePayload {
+		return SamplePayload{RelayPayload: RelayPayload{Data: data}, Weight: weight, Category: category}
+	}
+	pool := SamplePool{
+		Blockchain: "0002",
+		Payloads: []SamplePayload{
+			payload("a", 3, ""),
+			payload("b", 0, SampleCategoryRead),
+			payload("trace", 1, SampleCategoryTrace),
+			payload(`{{recent_tx}}`, 100, ""),
+		},
+	}
+	rng := rand.New(rand.NewSource(1))
+	counts := make(map[string]int)
+	for i := 0; i < 5000; i++ {
+		_, p, ok := pool.Pick(SampleVars{}, rng)
+		assert.True(t, ok)
+		counts[p.Data]++
+	}
+	// the templated payload is skipped without recent transactions, the others are picked by weight
+	assert.Equal(t, 0, counts[`{{recent_tx}}`])
+	assert.InDelta(t, 3000, counts["a"], 250)
+	assert.InDelta(t, 1000, counts["b"], 250)
+	assert.InDelta(t, 1000, counts["trace"], 250)
+	// categories are picked by share before the payloads
+	pool.Categories = map[string]int{SampleCategoryRead: 1, SampleCategoryTrace: 1}
+	counts = make(map[string]int)
+	for i := 0; i < 5000; i++ {
+		sample, _, ok := pool.Pick(SampleVars{}, rng)
+		assert.True(t, ok)
+		counts[sample.getCategory()]++
+	}
+	assert.InDelta(t, 2500, counts[SampleCategoryTrace], 250)
+	// no payload of a category with a share
+	pool.Categories = map[string]int{SampleCategoryArchive: 1}
+	_, _, ok := pool.Pick(SampleVars{}, rng)
+	assert.False(t, ok)
+}
+
+func TestSelectSample(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"id":1,"result":{"number":"0x10","transactions":[{"hash":"0xaaa"},{"hash":"0xbbb"}]}}`))
+	}))
+	defer server.Close()
+	chain := "0031"
+	hb := &HostedBlockchains{M: map[string]HostedBlockchain{chain: {ID: chain, HTTPURL: server.URL}}}
+	previous := GlobalSamplePools()
+	defer SetSamplePools(previous)
+	SetSamplePools(&SamplePools{M: map[string]SamplePool{chain: {
+		Blockchain: chain,
+		Payloads:   []SamplePayload{{RelayPayload: RelayPayload{Data: `{"method":"eth_getTransactionByHash","params":["{{recent_tx}}"]}`}}},
+		TxSource:   &TxSource{Payload: RelayPayload{Data: `{"method":"eth_getBlockByNumber","params":["latest",false]}`, Method: DEFAULTHTTPMETHOD}},
+	}}})
+	rng := rand.New(rand.NewSource(1))
+	_, payload, pool, err := SelectSample(chain, hb, rng)
+	assert.Nil(t, err)
+	assert.Equal(t, chain, pool.Blockchain)
+	assert.Contains(t, []string{
+		`{"method":"eth_getTransactionByHash","params":["0xaaa"]}`,
+		`{"method":"eth_getTransactionByHash","params":["0xbbb"]}`,
+	}, payload.Data)
+	_, _, _, err = SelectSample("0032", hb, rng)
+	assert.NotNil(t, err)
+	GlobalUpstreamTracker().Prune(nil)
+}
+
+func TestParseTxHashes(t *testing.T) {
+	hashes, err := parseTxHashes(`{"result":{"transactions":["0x1","0x2"]}}`, "")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"0x1", "0x2"}, hashes)
+	hashes, err = parseTxHashes(`{"result":[{"hash":"0x3"}]}`, "result")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"0x3"}, hashes)
+	_, err = parseTxHashes(`{"result":null}`, "")
+	assert.NotNil(t, err)
+}
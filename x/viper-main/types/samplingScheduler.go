@@ -0,0 +1,321 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	sdk "github.com/vipernet-xyz/viper-network/types"
+)
+
+const (
+	DefaultSamplingWorkers     = 10
+	DefaultMaxSamplingSessions = 100
+	// the interval between the sampling rounds of a session is randomized so servicers can't anticipate them
+	MinSamplingInterval = 15 * time.Second
+	MaxSamplingInterval = 35 * time.Second
+)
+
+var (
+	SamplingScheduleKey     = []byte("sampling_schedule/") // prefix of the schedules in the result db
+	globalSamplingScheduler = NewSamplingScheduler()
+)
+
+// "SamplingSchedule" - The sampling of the servicers of a session by the fisherman, persisted between the rounds
+type SamplingSchedule struct {
+	SessionHeader SessionHeader     `json:"session_header"`
+	Token         AAT               `json:"token"` // the token paying for the sample relays, it only holds public keys and signatures
+	Account       Account           `json:"-"`     // the account signing the sample relays, never persisted
+	Servicers     []sdk.Address     `json:"servicers"`
+	EndHeight     int64             `json:"end_height"` // last block of the session
+	Rounds        int64             `json:"rounds"`
+	NextRound     time.Time         `json:"next_round"`
+	Availability  map[string][]bool `json:"availability"` // servicer address -> availability of its sample relays
+}
+
+// "SamplingStatus" - The progress of a sampling schedule
+type SamplingStatus struct {
+	SessionHeader SessionHeader `json:"session_header"`
+	Servicers     int           `json:"servicers"`
+	EndHeight     int64         `json:"end_height"`
+	Rounds        int64         `json:"rounds"`
+	NextRound     time.Time     `json:"next_round"`
+	Running       bool          `json:"running"`
+}
+
+// "SamplingRound" - Sends one round of sample relays to the servicers of the schedule, updating its availability
+type SamplingRound func(schedule *SamplingSchedule)
+
+// "Trigger" - Returns the trigger the sample relays of the schedule are built from, signed by the account of the schedule
+// or by the signer of the node once the schedule is resumed from the result db
+func (s SamplingSchedule) Trigger(signer *Signer) FishermenTrigger {
+	account := s.Account
+	if account.PrivateKey == "" {
+		account = *signer.GetAccount()
+	}
+	return FishermenTrigger{
+		Proof: RelayProof{
+			SessionBlockHeight: s.SessionHeader.SessionBlockHeight,
+			Blockchain:         s.SessionHeader.Chain,
+			GeoZone:            s.SessionHeader.GeoZone,
+			NumServicers:       s.SessionHeader.NumServicers,
+			Token:              s.Token,
+		},
+		Account: account,
+	}
+}
+
+// "KeyForSamplingSchedule" - Generates the key of the schedule in the result db
+func KeyForSamplingSchedule(header SessionHeader) []byte {
+	return append(append([]byte{}, SamplingScheduleKey...), header.Hash()...)
+}
+
+func isSamplingScheduleKey(key []byte) bool {
+	return len(key) == len(SamplingScheduleKey)+HashLength && bytes.HasPrefix(key, SamplingScheduleKey)
+}
+
+type samplingEntry struct {
+	schedule SamplingSchedule
+	store    *CacheStorage
+	running  bool
+}
+
+type samplingJob struct {
+	entry *samplingEntry
+	round SamplingRound
+}
+
+// "SamplingScheduler" - Runs the sampling rounds of the fisherman on a bounded pool of workers, the schedules are
+// persisted in the result db so the sampling resumes after a restart
+type SamplingScheduler struct {
+	l           sync.Mutex
+	schedules   map[string]*samplingEntry // session header hash -> schedule
+	resumed     map[*CacheStorage]bool
+	maxSessions int
+	jobs        chan samplingJob
+	quit        chan struct{}
+	wg          sync.WaitGroup
+	started     bool
+}
+
+// "NewSamplingScheduler" - Returns a stopped sampling scheduler
+func NewSamplingScheduler() *SamplingScheduler {
+	return &SamplingScheduler{
+		schedules:   make(map[string]*samplingEntry),
+		resumed:     make(map[*CacheStorage]bool),
+		maxSessions: DefaultMaxSamplingSessions,
+	}
+}
+
+// "GlobalSamplingScheduler" - Returns the sampling scheduler of the fisherman
+func GlobalSamplingScheduler() *SamplingScheduler {
+	return globalSamplingScheduler
+}
+
+// "Start" - Starts the workers, the limits of a running scheduler are updated
+func (s *SamplingScheduler) Start(workers, maxSessions int) {
+	s.l.Lock()
+	defer s.l.Unlock()
+	if maxSessions <= 0 {
+		maxSessions = DefaultMaxSamplingSessions
+	}
+	s.maxSessions = maxSessions
+	if s.started {
+		return
+	}
+	if workers <= 0 {
+		workers = DefaultSamplingWorkers
+	}
+	s.jobs = make(chan samplingJob, workers)
+	s.quit = make(chan struct{})
+	for i := 0; i < workers; i++ {
+		s.wg.Add(1)
+		go s.work(s.jobs, s.quit)
+	}
+	s.started = true
+}
+
+// "Stop" - Stops the workers once their rounds are done and persists the schedules, which resume on the next start
+func (s *SamplingScheduler) Stop() {
+	s.l.Lock()
+	if !s.started {
+		s.l.Unlock()
+		return
+	}
+	s.started = false
+	close(s.quit)
+	s.l.Unlock()
+	s.wg.Wait()
+	s.l.Lock()
+	defer s.l.Unlock()
+	for _, e := range s.schedules {
+		e.running = false
+		persistSamplingSchedule(e)
+	}
+}
+
+// "Add" - Schedules the sampling of a session, false if the session is already sampled
+func (s *SamplingScheduler) Add(schedule SamplingSchedule, store *CacheStorage) (bool, sdk.Error) {
+	s.l.Lock()
+	defer s.l.Unlock()
+	key := schedule.SessionHeader.HashString()
+	if _, found := s.schedules[key]; found {
+		return false, nil
+	}
+	if len(s.schedules) >= s.maxSessions {
+		return false, NewMaxSamplingSessionsError(ModuleName)
+	}
+	if schedule.Availability == nil {
+		schedule.Availability = make(map[string][]bool)
+	}
+	e := &samplingEntry{schedule: schedule, store: store}
+	s.schedules[key] = e
+	persistSamplingSchedule(e)
+	return true, nil
+}
+
+// "Resume" - Loads the schedules persisted in the result db, once per store
+func (s *SamplingScheduler) Resume(store *CacheStorage) {
+	if store == nil || store.DB == nil {
+		return
+	}
+	s.l.Lock()
+	defer s.l.Unlock()
+	if s.resumed[store] {
+		return
+	}
+	s.resumed[store] = true
+	it, err := store.DB.Iterator(SamplingScheduleKey, sdk.PrefixEndBytes(SamplingScheduleKey))
+	if err != nil {
+		return
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		if !isSamplingScheduleKey(it.Key()) {
+			continue
+		}
+		var schedule SamplingSchedule
+		if err := json.Unmarshal(it.Value(), &schedule); err != nil {
+			continue
+		}
+		key := schedule.SessionHeader.HashString()
+		if _, found := s.schedules[key]; found {
+			continue
+		}
+		if schedule.Availability == nil {
+			schedule.Availability = make(map[string][]bool)
+		}
+		s.schedules[key] = &samplingEntry{schedule: schedule, store: store}
+	}
+}
+
+// "Dispatch" - Queues the due rounds on the workers and drops the schedules of the sessions that ended before the height,
+// rounds not accepted by the busy workers are retried on the next dispatch
+func (s *SamplingScheduler) Dispatch(height int64, round SamplingRound) {
+	s.l.Lock()
+	defer s.l.Unlock()
+	if !s.started {
+		return
+	}
+	now := time.Now()
+	for key, e := range s.schedules {
+		if e.running {
+			continue
+		}
+		if height > e.schedule.EndHeight {
+			delete(s.schedules, key)
+			deleteSamplingSchedule(e)
+			continue
+		}
+		if now.Before(e.schedule.NextRound) {
+			continue
+		}
+		select {
+		case s.jobs <- samplingJob{entry: e, round: round}:
+			e.running = true
+		default:
+			return
+		}
+	}
+}
+
+func (s *SamplingScheduler) work(jobs chan samplingJob, quit chan struct{}) {
+	defer s.wg.Done()
+	for {
+		select {
+		case <-quit:
+			return
+		case job := <-jobs:
+			s.l.Lock()
+			schedule := copySamplingSchedule(job.entry.schedule)
+			s.l.Unlock()
+			job.round(&schedule)
+			schedule.Rounds++
+			schedule.NextRound = time.Now().Add(MinSamplingInterval + time.Duration(rand.Int63n(int64(MaxSamplingInterval-MinSamplingInterval))))
+			s.l.Lock()
+			job.entry.schedule = schedule
+			job.entry.running = false
+			// the schedule may have been dropped while the round ran
+			if _, found := s.schedules[schedule.SessionHeader.HashString()]; found {
+				persistSamplingSchedule(job.entry)
+			}
+			s.l.Unlock()
+		}
+	}
+}
+
+// "List" - Returns the progress of the active schedules, ordered by session height
+func (s *SamplingScheduler) List() []SamplingStatus {
+	s.l.Lock()
+	defer s.l.Unlock()
+	res := make([]SamplingStatus, 0, len(s.schedules))
+	for _, e := range s.schedules {
+		res = append(res, SamplingStatus{
+			SessionHeader: e.schedule.SessionHeader,
+			Servicers:     len(e.schedule.Servicers),
+			EndHeight:     e.schedule.EndHeight,
+			Rounds:        e.schedule.Rounds,
+			NextRound:     e.schedule.NextRound,
+			Running:       e.running,
+		})
+	}
+	sort.Slice(res, func(i, j int) bool {
+		if res[i].SessionHeader.SessionBlockHeight != res[j].SessionHeader.SessionBlockHeight {
+			return res[i].SessionHeader.SessionBlockHeight < res[j].SessionHeader.SessionBlockHeight
+		}
+		return res[i].SessionHeader.HashString() < res[j].SessionHeader.HashString()
+	})
+	return res
+}
+
+func copySamplingSchedule(schedule SamplingSchedule) SamplingSchedule {
+	availability := make(map[string][]bool, len(schedule.Availability))
+	for addr, results := range schedule.Availability {
+		availability[addr] = append([]bool{}, results...)
+	}
+	schedule.Availability = availability
+	schedule.Servicers = append([]sdk.Address{}, schedule.Servicers...)
+	return schedule
+}
+
+// the schedules are written to the db directly, the cache of the store is only flushed on iteration
+func persistSamplingSchedule(e *samplingEntry) {
+	if e.store == nil || e.store.DB == nil {
+		return
+	}
+	bz, err := json.Marshal(e.schedule)
+	if err != nil {
+		return
+	}
+	_ = e.store.DB.Set(KeyForSamplingSchedule(e.schedule.SessionHeader), bz)
+}
+
+func deleteSamplingSchedule(e *samplingEntry) {
+	if e.store == nil || e.store.DB == nil {
+		return
+	}
+	_ = e.store.DB.Delete(KeyForSamplingSchedule(e.schedule.SessionHeader))
+}
@@ -0,0 +1,186 @@
+package types
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	db "github.com/tendermint/tm-db"
+	sdk "github.com/vipernet-xyz/viper-network/types"
+)
+
+func newSamplingTestStore() *CacheStorage {
+	return &CacheStorage{Cache: sdk.NewCache(10), DB: db.NewMemDB(), SealMap: &sync.Map{}}
+}
+
+func newTestSamplingSchedule(height int64) SamplingSchedule {
+	requestor := getRandomPubKey().RawString()
+	return SamplingSchedule{
+		SessionHeader: SessionHeader{
+			RequestorPubKey:    requestor,
+			Chain:              "0001",
+			GeoZone:            "0001",
+			NumServicers:       1,
+			SessionBlockHeight: height,
+		},
+		Token:     AAT{Version: "0.0.1", RequestorPublicKey: requestor, ClientPublicKey: getRandomPubKey().RawString()},
+		Account:   Account{PrivateKey: "secret"},
+		Servicers: []sdk.Address{getRandomValidatorAddress()},
+		EndHeight: height + 3,
+	}
+}
+
+func waitForRounds(t *testing.T, s *SamplingScheduler, rounds int64) {
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		done := true
+		for _, status := range s.List() {
+			if status.Rounds < rounds || status.Running {
+				done = false
+			}
+		}
+		if done {
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatal("the sampling rounds did not run")
+}
+
+func TestSamplingScheduler_Add(t *testing.T) {
+	s := NewSamplingScheduler()
+	s.Start(1, 2)
+	defer s.Stop()
+	store := newSamplingTestStore()
+	first := newTestSamplingSchedule(1)
+	added, err := s.Add(first, store)
+	assert.Nil(t, err)
+	assert.True(t, added)
+	// the sessions are sampled once
+	added, err = s.Add(first, store)
+	assert.Nil(t, err)
+	assert.False(t, added)
+	_, err = s.Add(newTestSamplingSchedule(1), store)
+	assert.Nil(t, err)
+	_, err = s.Add(newTestSamplingSchedule(1), store)
+	assert.NotNil(t, err)
+	assert.Equal(t, sdk.CodeType(CodeMaxSamplingSessionsError), err.Code())
+	assert.Len(t, s.List(), 2)
+}
+
+func TestSamplingScheduler_Dispatch(t *testing.T) {
+	s := NewSamplingScheduler()
+	s.Start(2, 10)
+	defer s.Stop()
+	store := newSamplingTestStore()
+	schedule := newTestSamplingSchedule(1)
+	_, err := s.Add(schedule, store)
+	assert.Nil(t, err)
+	servicer := schedule.Servicers[0].String()
+	var l sync.Mutex
+	calls := 0
+	round := func(schedule *SamplingSchedule) {
+		l.Lock()
+		calls++
+		l.Unlock()
+		schedule.Availability[servicer] = append(schedule.Availability[servicer], true)
+	}
+	s.Dispatch(1, round)
+	waitForRounds(t, s, 1)
+	// the next round is not due yet
+	s.Dispatch(2, round)
+	waitForRounds(t, s, 1)
+	l.Lock()
+	assert.Equal(t, 1, calls)
+	l.Unlock()
+	status := s.List()[0]
+	assert.Equal(t, int64(1), status.Rounds)
+	assert.True(t, status.NextRound.After(time.Now().Add(MinSamplingInterval-time.Second)))
+	// the progress is persisted
+	resumed := NewSamplingScheduler()
+	resumed.Resume(store)
+	assert.Len(t, resumed.List(), 1)
+	assert.Equal(t, status.Rounds, resumed.List()[0].Rounds)
+	assert.True(t, status.NextRound.Equal(resumed.List()[0].NextRound))
+	resumed.l.Lock()
+	assert.Equal(t, []bool{true}, resumed.schedules[schedule.SessionHeader.HashString()].schedule.Availability[servicer])
+	resumed.l.Unlock()
+	// the schedule is dropped once the session ended
+	s.Dispatch(schedule.EndHeight+1, round)
+	assert.Empty(t, s.List())
+	it, er := store.DB.Iterator(nil, nil)
+	assert.Nil(t, er)
+	assert.False(t, it.Valid())
+	it.Close()
+}
+
+func TestSamplingScheduler_Resume(t *testing.T) {
+	store := newSamplingTestStore()
+	s := NewSamplingScheduler()
+	s.Start(1, 10)
+	schedule := newTestSamplingSchedule(5)
+	_, err := s.Add(schedule, store)
+	assert.Nil(t, err)
+	s.Stop()
+	// no round runs while stopped
+	s.Dispatch(5, func(*SamplingSchedule) { t.Fatal("the scheduler is stopped") })
+	restarted := NewSamplingScheduler()
+	restarted.Start(1, 10)
+	defer restarted.Stop()
+	restarted.Resume(store)
+	statuses := restarted.List()
+	assert.Len(t, statuses, 1)
+	assert.Equal(t, schedule.SessionHeader, statuses[0].SessionHeader)
+	assert.Equal(t, schedule.EndHeight, statuses[0].EndHeight)
+	// the token is persisted to resume the sampling, the account is not
+	restarted.l.Lock()
+	resumed := restarted.schedules[schedule.SessionHeader.HashString()].schedule
+	restarted.l.Unlock()
+	assert.Equal(t, schedule.Token, resumed.Token)
+	assert.Empty(t, resumed.Account)
+	bz, er := store.DB.Get(KeyForSamplingSchedule(schedule.SessionHeader))
+	assert.Nil(t, er)
+	assert.NotContains(t, string(bz), "secret")
+	done := make(chan struct{})
+	restarted.Dispatch(5, func(*SamplingSchedule) { close(done) })
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("the resumed schedule did not run")
+	}
+}
+
+func TestSamplingSchedule_Trigger(t *testing.T) {
+	node := GetRandomPrivateKey()
+	signer, err := NewSignerFromPrivateKey(node.RawString())
+	assert.Nil(t, err)
+	schedule := newTestSamplingSchedule(5)
+	trigger := schedule.Trigger(signer)
+	assert.Equal(t, schedule.Account, trigger.Account)
+	assert.Equal(t, schedule.Token, trigger.Proof.Token)
+	assert.Equal(t, schedule.SessionHeader, trigger.SessionHeader())
+	// a resumed schedule is signed by the node
+	schedule.Account = Account{}
+	assert.Equal(t, *signer.GetAccount(), schedule.Trigger(signer).Account)
+}
+
+func TestResultIterator_SkipsSamplingSchedules(t *testing.T) {
+	store := newSamplingTestStore()
+	schedule := newTestSamplingSchedule(1)
+	persistSamplingSchedule(&samplingEntry{schedule: schedule, store: store})
+	result := Result{
+		SessionHeader: schedule.SessionHeader,
+		ServicerAddr:  schedule.Servicers[0],
+		EvidenceType:  FishermanTestEvidence,
+	}
+	SetResult(result, store)
+	iter := ResultIterator(store)
+	defer iter.Close()
+	var results []Result
+	for ; iter.Valid(); iter.Next() {
+		results = append(results, iter.Value())
+	}
+	assert.Len(t, results, 1)
+	assert.Equal(t, result.ServicerAddr, results[0].ServicerAddr)
+}
@@ -12,8 +12,7 @@ import (
 	"strings"
 	"time"
 
-	"github.com/gorilla/websocket"
-
+	"github.com/vipernet-xyz/viper-network/codec"
 	crypto "github.com/vipernet-xyz/viper-network/crypto/codec"
 	sdk "github.com/vipernet-xyz/viper-network/types"
 	"github.com/vipernet-xyz/viper-network/x/servicers/exported"
@@ -26,6 +25,8 @@ type Relay struct {
 	Payload Payload    `json:"payload"` // the data payload of the request
 	Meta    RelayMeta  `json:"meta"`    // metadata for the relay request
 	Proof   RelayProof `json:"proof"`   // the authentication scheme needed for work
+	// the proofs paying for the calls of a json-rpc batch after the first one
+	BatchProofs []RelayProof `json:"batch_proofs,omitempty"`
 }
 
 // "Validate" - Checks the validity of a relay request using store data
@@ -43,9 +44,15 @@ func (r *Relay) Validate(ctx sdk.Ctx, posKeeper PosKeeper, requestorsKeeper Requ
 		return sdk.ZeroInt(), NewRequestHashError(ModuleName)
 	}
 	// ensure the blockchain is supported locally
-	if !hb.Contains(r.Proof.Blockchain) {
+	chain, err := hb.GetChain(r.Proof.Blockchain)
+	if err != nil {
 		return sdk.ZeroInt(), NewUnsupportedBlockchainNodeError(ModuleName)
 	}
+	// each call of a json-rpc batch counts as a relay
+	relays, err := r.validateBatch(chain)
+	if err != nil {
+		return sdk.ZeroInt(), err
+	}
 	// ensure session block height == one in the relay proof
 	if r.Proof.SessionBlockHeight != sessionBlockHeight {
 		return sdk.ZeroInt(), NewInvalidBlockHeightError(ModuleName)
@@ -78,17 +85,21 @@ func (r *Relay) Validate(ctx sdk.Ctx, posKeeper PosKeeper, requestorsKeeper Requ
 		return sdk.ZeroInt(), NewSealedEvidenceError(ModuleName)
 	}
 	// get evidence key by proof
-	if !IsUniqueProof(r.Proof, evidence) {
-		return sdk.ZeroInt(), NewDuplicateProofError(ModuleName)
+	for _, p := range r.AllProofs() {
+		if !IsUniqueProof(p, evidence) {
+			return sdk.ZeroInt(), NewDuplicateProofError(ModuleName)
+		}
 	}
 	// validate not over service
-	if sdk.NewInt(totalRelays).GTE(maxPossibleRelays) {
+	if sdk.NewInt(totalRelays + relays).GT(maxPossibleRelays) {
 		return sdk.ZeroInt(), NewOverServiceError(ModuleName)
 	}
 	// validate the Proof
 	nodeAddr := node.GetAddress()
-	if err := r.Proof.ValidateLocal(app.GetChains(), int(sessionNodeCount), sessionBlockHeight, nodeAddr); err != nil {
-		return sdk.ZeroInt(), err
+	for _, p := range r.AllProofs() {
+		if err := p.ValidateLocal(app.GetChains(), int(sessionNodeCount), sessionBlockHeight, nodeAddr); err != nil {
+			return sdk.ZeroInt(), err
+		}
 	}
 	// check cache
 	session, found := GetSession(header, node.SessionStore)
@@ -117,6 +128,8 @@ func (r *Relay) Validate(ctx sdk.Ctx, posKeeper PosKeeper, requestorsKeeper Requ
 		if er != nil {
 			return sdk.ZeroInt(), er
 		}
+		// snapshot the clients revoked by the requestor at the start of the session
+		session.RevokedClients = requestorsKeeper.GetRevokedClients(sessionCtx, app.GetAddress())
 		// add to cache
 		SetSession(session, node.SessionStore)
 	}
@@ -125,6 +138,10 @@ func (r *Relay) Validate(ctx sdk.Ctx, posKeeper PosKeeper, requestorsKeeper Requ
 	if err != nil {
 		return sdk.ZeroInt(), err
 	}
+	// validate the tokens of the proofs
+	if err := validateTokens(ctx, session, r.AllProofs(), evidence); err != nil {
+		return sdk.ZeroInt(), err
+	}
 	// if the payload method is empty, set it to the default
 	if r.Payload.Method == "" {
 		r.Payload.Method = DEFAULTHTTPMETHOD
@@ -132,7 +149,7 @@ func (r *Relay) Validate(ctx sdk.Ctx, posKeeper PosKeeper, requestorsKeeper Requ
 	return maxPossibleRelays, nil
 }
 
-// "ValidateWebsocket" - Checks the validity of a relay request using store data
+// "ValidateWebsocket" - Checks the validity of a websocket relay request using store data
 func (r *Relay) ValidateWebsocket(ctx sdk.Ctx, posKeeper PosKeeper, requestorsKeeper RequestorsKeeper, viperKeeper ViperKeeper, hb *HostedBlockchains, sessionBlockHeight int64, node *ViperNode) (maxPossibleRelays sdk.BigInt, header SessionHeader, err sdk.Error) {
 	// validate payload
 	if err := r.Payload.Validate(); err != nil {
@@ -146,12 +163,17 @@ func (r *Relay) ValidateWebsocket(ctx sdk.Ctx, posKeeper PosKeeper, requestorsKe
 	if r.Proof.RequestHash != r.RequestHashString() {
 		return sdk.ZeroInt(), SessionHeader{}, NewRequestHashError(ModuleName)
 	}
+	return r.Proof.ValidateWebsocket(ctx, posKeeper, requestorsKeeper, viperKeeper, hb, sessionBlockHeight, node)
+}
+
+// "ValidateWebsocket" - Checks the validity of a relay proof paying for work done over a websocket relay session
+func (rp RelayProof) ValidateWebsocket(ctx sdk.Ctx, posKeeper PosKeeper, requestorsKeeper RequestorsKeeper, viperKeeper ViperKeeper, hb *HostedBlockchains, sessionBlockHeight int64, node *ViperNode) (maxPossibleRelays sdk.BigInt, header SessionHeader, err sdk.Error) {
 	// ensure the blockchain is supported locally
-	if !hb.Contains(r.Proof.Blockchain) {
+	if !hb.Contains(rp.Blockchain) {
 		return sdk.ZeroInt(), SessionHeader{}, NewUnsupportedBlockchainNodeError(ModuleName)
 	}
 	// ensure session block height == one in the relay proof
-	if r.Proof.SessionBlockHeight != sessionBlockHeight {
+	if rp.SessionBlockHeight != sessionBlockHeight {
 		return sdk.ZeroInt(), SessionHeader{}, NewInvalidBlockHeightError(ModuleName)
 	}
 	// get the session context
@@ -160,21 +182,21 @@ func (r *Relay) ValidateWebsocket(ctx sdk.Ctx, posKeeper PosKeeper, requestorsKe
 		return sdk.ZeroInt(), SessionHeader{}, sdk.ErrInternal(er.Error())
 	}
 	// get the application that staked on behalf of the client
-	app, found := GetRequestorFromPublicKey(sessionCtx, requestorsKeeper, r.Proof.Token.RequestorPublicKey)
+	app, found := GetRequestorFromPublicKey(sessionCtx, requestorsKeeper, rp.Token.RequestorPublicKey)
 	if !found {
 		return sdk.ZeroInt(), SessionHeader{}, NewRequestorNotFoundError(ModuleName)
 	}
 	// get session node count from that session height
-	sessionNodeCount := int64(r.Proof.NumServicers)
+	sessionNodeCount := int64(rp.NumServicers)
 	// get max possible relays
 	maxPossibleRelays = MaxPossibleRelays(app, sessionNodeCount)
 	// generate the session header
 	header = SessionHeader{
-		RequestorPubKey:    r.Proof.Token.RequestorPublicKey,
-		Chain:              r.Proof.Blockchain,
-		GeoZone:            r.Proof.GeoZone,
-		NumServicers:       r.Proof.NumServicers,
-		SessionBlockHeight: r.Proof.SessionBlockHeight,
+		RequestorPubKey:    rp.Token.RequestorPublicKey,
+		Chain:              rp.Blockchain,
+		GeoZone:            rp.GeoZone,
+		NumServicers:       rp.NumServicers,
+		SessionBlockHeight: rp.SessionBlockHeight,
 	}
 	// validate unique relay
 	evidence, totalRelays := GetTotalProofs(header, RelayEvidence, maxPossibleRelays, node.EvidenceStore)
@@ -182,7 +204,7 @@ func (r *Relay) ValidateWebsocket(ctx sdk.Ctx, posKeeper PosKeeper, requestorsKe
 		return sdk.ZeroInt(), SessionHeader{}, NewSealedEvidenceError(ModuleName)
 	}
 	// get evidence key by proof
-	if !IsUniqueProof(r.Proof, evidence) {
+	if !IsUniqueProof(rp, evidence) {
 		return sdk.ZeroInt(), SessionHeader{}, NewDuplicateProofError(ModuleName)
 	}
 	// validate not over service
@@ -191,7 +213,7 @@ func (r *Relay) ValidateWebsocket(ctx sdk.Ctx, posKeeper PosKeeper, requestorsKe
 	}
 	// validate the Proof
 	nodeAddr := node.GetAddress()
-	if err := r.Proof.ValidateLocal(app.GetChains(), int(sessionNodeCount), sessionBlockHeight, nodeAddr); err != nil {
+	if err := rp.ValidateLocal(app.GetChains(), int(sessionNodeCount), sessionBlockHeight, nodeAddr); err != nil {
 		return sdk.ZeroInt(), SessionHeader{}, err
 	}
 	// check cache
@@ -207,6 +229,8 @@ func (r *Relay) ValidateWebsocket(ctx sdk.Ctx, posKeeper PosKeeper, requestorsKe
 		if er != nil {
 			return sdk.ZeroInt(), SessionHeader{}, er
 		}
+		// snapshot the clients revoked by the requestor at the start of the session
+		session.RevokedClients = requestorsKeeper.GetRevokedClients(sessionCtx, app.GetAddress())
 		// add to cache
 		SetSession(session, node.SessionStore)
 	}
@@ -215,8 +239,40 @@ func (r *Relay) ValidateWebsocket(ctx sdk.Ctx, posKeeper PosKeeper, requestorsKe
 	if err != nil {
 		return sdk.ZeroInt(), SessionHeader{}, err
 	}
+	// validate the token of the proof
+	if err := validateTokens(ctx, session, []RelayProof{rp}, evidence); err != nil {
+		return sdk.ZeroInt(), SessionHeader{}, err
+	}
+
+	return maxPossibleRelays, header, nil
+}
 
-	return maxPossibleRelays, SessionHeader{}, nil
+// "validateTokens" - Checks the tokens of the relay proofs: scoped tokens once activated by an upgrade, against the
+// clients revoked at the start of the session, the scope of the tokens and their relay cap for the session
+func validateTokens(ctx sdk.Ctx, session Session, proofs []RelayProof, evidence Evidence) sdk.Error {
+	relays := make(map[string]int64)
+	for _, p := range proofs {
+		if p.Token.IsScoped() && !ModuleCdc.IsAfterNamedFeatureActivationHeight(ctx.BlockHeight(), codec.TokenRevocationKey) {
+			return NewInvalidTokenError(ModuleName, UnsupportedTokenVersionError)
+		}
+		if session.IsClientRevoked(p.Token.ClientPublicKey) {
+			return NewRevokedClientError(ModuleName)
+		}
+		if err := p.Token.ValidateScope(p.Blockchain, p.GeoZone, ctx.BlockHeight()); err != nil {
+			return err
+		}
+		relays[p.Token.ClientPublicKey]++
+	}
+	// the relays of the client already in the evidence count toward the cap
+	for _, p := range proofs {
+		if p.Token.MaxRelays == 0 {
+			continue
+		}
+		if evidence.ClientRelays(p.Token.ClientPublicKey)+relays[p.Token.ClientPublicKey] > p.Token.MaxRelays {
+			return NewTokenRelayCapError(ModuleName)
+		}
+	}
+	return nil
 }
 
 // "Execute" - Attempts to do a request on the non-native blockchain specified
@@ -228,18 +284,16 @@ func (r Relay) Execute(hostedBlockchains *HostedBlockchains, address *sdk.Addres
 		addServiceMetricErrorFor(r.Proof.Blockchain, address)
 		return "", err
 	}
-	url := strings.Trim(chain.HTTPURL, `/`)
-	if len(r.Payload.Path) > 0 {
-		url = url + "/" + strings.Trim(r.Payload.Path, `/`)
-	}
-	// do basic http request on the relay
-	res, er := executeHTTPRequest(r.Payload.Data, url, GlobalViperConfig.UserAgent, chain.BasicAuth, r.Payload.Method, r.Payload.Headers)
+	// do basic http request on the relay, answering deterministic requests from the relay cache
+	// and failing over between the upstreams of the chain
+	res, er := executeCachedHTTPRequest(chain, r.Payload, GlobalViperConfig.UserAgent)
 	if er != nil {
 		// metric track
 		addServiceMetricErrorFor(r.Proof.Blockchain, address)
 		return res, NewHTTPExecutionError(ModuleName, er)
 	}
-	return res, nil
+	// the responses of a batch are returned in the order of the calls
+	return orderBatchResponse(r.Payload.Data, res), nil
 }
 
 func (r RelayInput) ExecuteLocal(hostedBlockchains *HostedBlockchains, address *sdk.Address) (string, sdk.Error) {
@@ -250,19 +304,16 @@ func (r RelayInput) ExecuteLocal(hostedBlockchains *HostedBlockchains, address *
 		addServiceMetricErrorFor(r.Proof.Blockchain, address)
 		return "", err
 	}
-	url := strings.Trim(chain.HTTPURL, `/`)
-	if len(r.Payload.Path) > 0 {
-		url = url + "/" + strings.Trim(r.Payload.Path, `/`)
-	}
-
-	// do basic http request on the relay
-	res, er := executeHTTPRequest(r.Payload.Data, url, GlobalViperConfig.UserAgent, chain.BasicAuth, r.Payload.Method, r.Payload.Headers)
+	// do basic http request on the relay, answering deterministic requests from the relay cache
+	// and failing over between the upstreams of the chain
+	res, er := executeCachedHTTPRequest(chain, Payload{Data: r.Payload.Data, Method: r.Payload.Method, Path: r.Payload.Path, Headers: r.Payload.Headers}, GlobalViperConfig.UserAgent)
 	if er != nil {
 		// metric track
 		addServiceMetricErrorFor(r.Proof.Blockchain, address)
 		return res, NewHTTPExecutionError(ModuleName, er)
 	}
-	return res, nil
+	// the responses of a batch are returned in the order of the calls
+	return orderBatchResponse(r.Payload.Data, res), nil
 }
 
 // "Bytes" - Returns the bytes representation of the Relay
@@ -419,6 +470,12 @@ type DispatchSession struct {
 
 // "executeHTTPRequest" takes in the raw JSON string and forwards it to the RPC endpoint
 func executeHTTPRequest(payload, url, userAgent string, basicAuth BasicAuth, method string, headers map[string]string) (string, error) {
+	res, _, err := executeHTTPRequestWithStatus(payload, url, userAgent, basicAuth, method, headers)
+	return res, err
+}
+
+// "executeHTTPRequestWithStatus" forwards the payload to the RPC endpoint and also returns the http status code of the response
+func executeHTTPRequestWithStatus(payload, url, userAgent string, basicAuth BasicAuth, method string, headers map[string]string) (string, int, error) {
 	// Check if the payload is compressed
 	isCompressed := isPayloadCompressed(payload)
 
@@ -426,7 +483,7 @@ func executeHTTPRequest(payload, url, userAgent string, basicAuth BasicAuth, met
 	if isCompressed {
 		decodedPayload, err := decompressPayload(payload)
 		if err != nil {
-			return "", err
+			return "", 0, err
 		}
 		payload = decodedPayload
 	}
@@ -434,7 +491,7 @@ func executeHTTPRequest(payload, url, userAgent string, basicAuth BasicAuth, met
 	// Generate an HTTP request
 	req, err := http.NewRequest(method, url, bytes.NewBuffer([]byte(payload)))
 	if err != nil {
-		return "", err
+		return "", 0, err
 	}
 
 	if basicAuth.Username != "" {
@@ -460,7 +517,7 @@ func executeHTTPRequest(payload, url, userAgent string, basicAuth BasicAuth, met
 	// Execute the request
 	resp, err := (&http.Client{Timeout: globalRPCTimeout * time.Millisecond}).Do(req)
 	if err != nil {
-		return "", err
+		return "", 0, err
 	}
 
 	defer resp.Body.Close()
@@ -471,14 +528,14 @@ func executeHTTPRequest(payload, url, userAgent string, basicAuth BasicAuth, met
 	// Read the response body
 	body, err := ioutil.ReadAll(resp.Body)
 	if err != nil {
-		return "", err
+		return "", 0, err
 	}
 
 	// Compress the response body if the payload was not already compressed
 	if isCompressed && !isResponseCompressed {
 		body, err = compressResponse(string(body))
 		if err != nil {
-			return "", err
+			return "", 0, err
 		}
 	}
 
@@ -487,112 +544,7 @@ func executeHTTPRequest(payload, url, userAgent string, basicAuth BasicAuth, met
 	}
 
 	// Return the response
-	return string(body), nil
-}
-
-// ExecuteWebSocket - Attempts to do a WebSocket request on the non-native blockchain specified
-func (r Relay) ExecuteWebSocket(hostedBlockchains *HostedBlockchains, address *sdk.Address) (chan *RelayResponse, sdk.Error) {
-	// Create a channel for sending multiple relay responses
-	resChan := make(chan *RelayResponse)
-
-	// Retrieve the hosted blockchain URL requested
-	chain, err := hostedBlockchains.GetChain(r.Proof.Blockchain)
-	if err != nil {
-		// Metric track
-		addServiceMetricErrorFor(r.Proof.Blockchain, address)
-
-		// Send an error response to the WebSocket client
-		close(resChan)
-		return resChan, err
-	}
-
-	// Construct WebSocket URL
-	url := strings.Trim(chain.WebSocketURL, "/")
-	if len(r.Payload.Path) > 0 {
-		url = url + "/" + strings.Trim(r.Payload.Path, "/")
-	}
-
-	// Execute the WebSocket request asynchronously
-	go func() {
-		defer close(resChan)
-		// Use the gorilla websocket Dialer
-		dialer := websocket.Dialer{}
-		conn, _, err := dialer.Dial(url, nil)
-		if err != nil {
-			// Metric track
-			addServiceMetricErrorFor(r.Proof.Blockchain, address)
-
-			// Send an error response to the WebSocket client
-			res := &RelayResponse{
-				Response: fmt.Sprintf("Error connecting to WebSocket: %s", err.Error()),
-			}
-			resChan <- res
-			return
-		}
-
-		// Execute the WebSocket request concurrently
-		go func() {
-			// Write the data to the WebSocket connection
-			err := conn.WriteMessage(websocket.TextMessage, []byte(r.Payload.Data))
-			if err != nil {
-				// Metric track
-				addServiceMetricErrorFor(r.Proof.Blockchain, address)
-
-				// Send an error response to the WebSocket client
-				res := &RelayResponse{
-					Response: fmt.Sprintf("Error writing to WebSocket: %s", err.Error()),
-				}
-				resChan <- res
-				return
-			}
-		}()
-
-		// Read and handle WebSocket responses
-		for {
-			_, response, err := conn.ReadMessage()
-			if err != nil {
-				// Metric track
-				addServiceMetricErrorFor(r.Proof.Blockchain, address)
-
-				// Send an error response to the WebSocket client
-				res := &RelayResponse{
-					Response: fmt.Sprintf("Error reading from WebSocket: %s", err.Error()),
-				}
-				resChan <- res
-				break
-			}
-
-			// Process the WebSocket response
-			res := &RelayResponse{
-				Response: string(response),
-			}
-			resChan <- res
-		}
-
-		// Close the WebSocket connection
-		conn.Close()
-	}()
-
-	// Return the channel for WebSocket responses
-	return resChan, nil
-}
-
-// executeWebSocketRequest - Executes a WebSocket request with the provided data
-func executeWebSocketRequest(conn *websocket.Conn, data string) error {
-	// Write the data to the WebSocket connection
-	err := conn.WriteMessage(websocket.TextMessage, []byte(data))
-	if err != nil {
-		return err
-	}
-
-	// Read the WebSocket response
-	_, response, err := conn.ReadMessage()
-	if err != nil {
-		return err
-	}
-
-	// Return the WebSocket response
-	return conn.WriteMessage(websocket.TextMessage, response)
+	return string(body), resp.StatusCode, nil
 }
 
 // Check if the payload is compressed
@@ -651,8 +603,13 @@ func sortJSONResponse(response string) string {
 	return string(bz)
 }
 
+// "ErrorWarrantsDispatch" - Returns true if the error is resolved by a new dispatch of the session. A rate limited
+// request is not: the client should back off or use another servicer of the session
 func ErrorWarrantsDispatch(err error) bool {
-	cErr := err.(sdk.Error)
+	cErr, ok := err.(sdk.Error)
+	if !ok || ErrorIsRateLimited(cErr) {
+		return false
+	}
 	if cErr.Code() == NewOverServiceError(ModuleName).Code() ||
 		cErr.Code() == NewInvalidBlockHeightError(ModuleName).Code() ||
 		cErr.Code() == NewInvalidSessionError(ModuleName).Code() ||
@@ -662,6 +619,12 @@ func ErrorWarrantsDispatch(err error) bool {
 	return false
 }
 
+// "ErrorIsRateLimited" - Returns true if the request was rejected by the rate limits of the servicer
+func ErrorIsRateLimited(err error) bool {
+	cErr, ok := err.(sdk.Error)
+	return ok && cErr.Codespace() == ModuleName && cErr.Code() == CodeRateLimitedError
+}
+
 func addServiceMetricErrorFor(blockchain string, address *sdk.Address) {
 	if GlobalViperConfig.LeanViper {
 		go GlobalServiceMetric().AddErrorFor(blockchain, address)
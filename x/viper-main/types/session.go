@@ -108,6 +108,16 @@ func (s Session) Validate(servicer sdk.Address, requestor requestorexported.Requ
 	return nil
 }
 
+// "IsClientRevoked" - Returns if the requestor revoked the client public key before the start of the session
+func (s Session) IsClientRevoked(clientPubKey string) bool {
+	for _, c := range s.RevokedClients {
+		if c == clientPubKey {
+			return true
+		}
+	}
+	return false
+}
+
 var _ CacheObject = Session{} // satisfies the cache object interface
 
 func (s Session) MarshalObject() ([]byte, error) {
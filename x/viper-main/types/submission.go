@@ -0,0 +1,160 @@
+package types
+
+import (
+	"encoding/json"
+	"sort"
+
+	sdk "github.com/vipernet-xyz/viper-network/types"
+)
+
+const (
+	ClaimSubmission      SubmissionType = "claim"
+	ProofSubmission      SubmissionType = "proof"
+	ReportCardSubmission SubmissionType = "report_card"
+
+	SubmissionPending SubmissionStatus = "pending" // waiting for the inclusion of the tx or for the next attempt
+	SubmissionFailed  SubmissionStatus = "failed"  // the submission window closed before the inclusion
+
+	// the attempts are spaced by BaseSubmissionBackoff blocks, doubled after every attempt up to MaxSubmissionBackoff
+	BaseSubmissionBackoff = 1
+	MaxSubmissionBackoff  = 16
+	// the failed submissions are kept for inspection during SubmissionRetention blocks after their window closed
+	SubmissionRetention = 1000
+)
+
+var SubmissionKey = []byte("submission/") // prefix of the submissions in the submission db
+
+type SubmissionType string
+
+type SubmissionStatus string
+
+// "Submission" - A claim, proof or report card tx of the node, tracked until it is included or its window closes
+type Submission struct {
+	Type              SubmissionType   `json:"type"`
+	Node              sdk.Address      `json:"node"`
+	SessionHeader     SessionHeader    `json:"session_header"`
+	EvidenceType      EvidenceType     `json:"evidence_type"`
+	ServicerAddress   sdk.Address      `json:"servicer_address,omitempty"` // the servicer of a report card
+	Status            SubmissionStatus `json:"status"`
+	TxHash            string           `json:"tx_hash,omitempty"` // the last broadcast tx, empty when it was rejected
+	Attempts          int64            `json:"attempts"`
+	SubmittedHeight   int64            `json:"submitted_height"`
+	NextAttemptHeight int64            `json:"next_attempt_height"`
+	ExpirationHeight  int64            `json:"expiration_height"` // the last height the tx may be included at
+	Error             string           `json:"error,omitempty"`
+}
+
+// "NewSubmission" - Returns the pending submission of the node, which may be included until the expiration height
+func NewSubmission(submissionType SubmissionType, node sdk.Address, header SessionHeader, evidenceType EvidenceType, servicerAddr sdk.Address, expirationHeight int64) Submission {
+	return Submission{
+		Type:             submissionType,
+		Node:             node,
+		SessionHeader:    header,
+		EvidenceType:     evidenceType,
+		ServicerAddress:  servicerAddr,
+		Status:           SubmissionPending,
+		ExpirationHeight: expirationHeight,
+	}
+}
+
+// "KeyForSubmission" - Generates the key of the submission in the submission db
+func KeyForSubmission(submissionType SubmissionType, header SessionHeader, evidenceType EvidenceType, servicerAddr sdk.Address) []byte {
+	key := append(append([]byte{}, SubmissionKey...), submissionType...)
+	key = append(append(key, '/'), header.Hash()...)
+	key = append(key, byte(evidenceType))
+	return append(key, servicerAddr...)
+}
+
+// "Key" - Returns the key of the submission in the submission db
+func (s Submission) Key() []byte {
+	return KeyForSubmission(s.Type, s.SessionHeader, s.EvidenceType, s.ServicerAddress)
+}
+
+// "IsDue" - Returns true if the pending submission should be (re)sent at the height
+func (s Submission) IsDue(height int64) bool {
+	return s.Status == SubmissionPending && height <= s.ExpirationHeight && height >= s.NextAttemptHeight
+}
+
+// "RecordAttempt" - Records the broadcast of the submission at the height, the next attempt is backed off
+func (s *Submission) RecordAttempt(height int64, txHash string, err error) {
+	s.Attempts++
+	s.SubmittedHeight = height
+	s.TxHash = txHash
+	s.Error = ""
+	if err != nil {
+		s.Error = err.Error()
+	}
+	backoff := int64(BaseSubmissionBackoff)
+	for i := int64(1); i < s.Attempts && backoff < MaxSubmissionBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > MaxSubmissionBackoff {
+		backoff = MaxSubmissionBackoff
+	}
+	s.NextAttemptHeight = height + backoff
+}
+
+// "Fail" - Marks the submission as failed, it is no longer sent
+func (s *Submission) Fail(reason string) {
+	s.Status = SubmissionFailed
+	s.Error = reason
+}
+
+// "GetSubmission" - Returns the submission of the key from the submission store
+func GetSubmission(key []byte, store *CacheStorage) (s Submission, found bool) {
+	if store == nil || store.DB == nil {
+		return
+	}
+	bz, _ := store.DB.Get(key)
+	if len(bz) == 0 {
+		return
+	}
+	if err := json.Unmarshal(bz, &s); err != nil {
+		return Submission{}, false
+	}
+	return s, true
+}
+
+// "SetSubmission" - Persists the submission, written to the db directly so it survives a crash
+func SetSubmission(s Submission, store *CacheStorage) {
+	if store == nil || store.DB == nil {
+		return
+	}
+	bz, err := json.Marshal(s)
+	if err != nil {
+		return
+	}
+	_ = store.DB.Set(s.Key(), bz)
+}
+
+// "DeleteSubmission" - Removes the submission from the submission store
+func DeleteSubmission(s Submission, store *CacheStorage) {
+	if store == nil || store.DB == nil {
+		return
+	}
+	_ = store.DB.Delete(s.Key())
+}
+
+// "GetSubmissions" - Returns the submissions of the store, ordered by session height
+func GetSubmissions(store *CacheStorage) (submissions []Submission) {
+	submissions = make([]Submission, 0)
+	if store == nil || store.DB == nil {
+		return
+	}
+	it, err := store.DB.Iterator(SubmissionKey, sdk.PrefixEndBytes(SubmissionKey))
+	if err != nil {
+		return
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var s Submission
+		if err := json.Unmarshal(it.Value(), &s); err != nil {
+			continue
+		}
+		submissions = append(submissions, s)
+	}
+	sort.SliceStable(submissions, func(i, j int) bool {
+		return submissions[i].SessionHeader.SessionBlockHeight < submissions[j].SessionHeader.SessionBlockHeight
+	})
+	return
+}
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	sdk "github.com/vipernet-xyz/viper-network/types"
+)
+
+func newTestSubmission(submissionType SubmissionType, height int64) Submission {
+	header := SessionHeader{
+		RequestorPubKey:    getRandomPubKey().RawString(),
+		Chain:              "0001",
+		GeoZone:            "0001",
+		NumServicers:       1,
+		SessionBlockHeight: height,
+	}
+	return NewSubmission(submissionType, getRandomValidatorAddress(), header, RelayEvidence, nil, height+10)
+}
+
+func TestSubmission_RecordAttempt(t *testing.T) {
+	s := newTestSubmission(ClaimSubmission, 1)
+	assert.True(t, s.IsDue(1))
+	// the backoff doubles after every attempt up to the max
+	expected := []int64{1, 2, 4, 8, 16, 16}
+	for i, backoff := range expected {
+		s.RecordAttempt(10, "", errors.New("mempool is full"))
+		assert.Equal(t, int64(i+1), s.Attempts)
+		assert.Equal(t, 10+backoff, s.NextAttemptHeight)
+		assert.Equal(t, "mempool is full", s.Error)
+	}
+	s.ExpirationHeight = 40
+	s.RecordAttempt(20, "ABCD", nil)
+	assert.Equal(t, "ABCD", s.TxHash)
+	assert.Empty(t, s.Error)
+	assert.False(t, s.IsDue(35))
+	assert.True(t, s.IsDue(36))
+	// never due once the window closed or once failed
+	assert.False(t, s.IsDue(41))
+	s.Fail("window closed")
+	assert.Equal(t, SubmissionFailed, s.Status)
+	assert.False(t, s.IsDue(36))
+}
+
+func TestSubmission_GetSetDelete(t *testing.T) {
+	store := newSamplingTestStore()
+	later := newTestSubmission(ProofSubmission, 5)
+	earlier := newTestSubmission(ClaimSubmission, 1)
+	card := newTestSubmission(ReportCardSubmission, 1)
+	card.ServicerAddress = getRandomValidatorAddress()
+	for _, s := range []Submission{later, earlier, card} {
+		SetSubmission(s, store)
+	}
+	s, found := GetSubmission(earlier.Key(), store)
+	assert.True(t, found)
+	assert.Equal(t, earlier, s)
+	// the report cards are kept per servicer
+	_, found = GetSubmission(KeyForSubmission(ReportCardSubmission, card.SessionHeader, RelayEvidence, getRandomValidatorAddress()), store)
+	assert.False(t, found)
+	submissions := GetSubmissions(store)
+	assert.Len(t, submissions, 3)
+	assert.Equal(t, later, submissions[2])
+	DeleteSubmission(earlier, store)
+	_, found = GetSubmission(earlier.Key(), store)
+	assert.False(t, found)
+	assert.Len(t, GetSubmissions(store), 2)
+	// a missing store holds nothing
+	assert.Empty(t, GetSubmissions(nil))
+	_, found = GetSubmission(later.Key(), &CacheStorage{Cache: sdk.NewCache(1)})
+	assert.False(t, found)
+}
@@ -0,0 +1,423 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/vipernet-xyz/viper-network/types"
+)
+
+var globalUpstreamTracker = NewUpstreamTracker()
+
+const (
+	// weight of the newest sample in the exponentially weighted latency average
+	upstreamLatencyDecay = 0.3
+)
+
+// "UpstreamStatus" - The passive and active health information of a single upstream endpoint
+type UpstreamStatus struct {
+	ConsecutiveFailures int       `json:"consecutive_failures"`
+	LastFailure         time.Time `json:"last_failure"`
+	Latency             float64   `json:"latency"` // moving average of the response time in ms
+	Lagging             bool      `json:"lagging"` // set by the sync monitor when the upstream is behind the chain head
+}
+
+// "UpstreamTracker" - Tracks the health of every upstream endpoint of the hosted blockchains
+// statuses are keyed by chain and url so they survive a hot reload of the chains
+type UpstreamTracker struct {
+	l           sync.Mutex
+	statuses    map[string]UpstreamStatus
+	maxFailures int
+	cooldown    time.Duration
+	stop        chan struct{} // closed to stop the running health checks
+}
+
+// "NewUpstreamTracker" - Returns an empty upstream tracker using the default limits
+func NewUpstreamTracker() *UpstreamTracker {
+	return &UpstreamTracker{
+		statuses:    make(map[string]UpstreamStatus),
+		maxFailures: types.DefaultUpstreamMaxFailures,
+		cooldown:    types.DefaultUpstreamCooldown * time.Second,
+	}
+}
+
+// "GlobalUpstreamTracker" - Returns the upstream tracker used for relays
+func GlobalUpstreamTracker() *UpstreamTracker {
+	return globalUpstreamTracker
+}
+
+// "SetLimits" - Sets the number of consecutive failures after which an upstream is taken out of
+// rotation and the cooldown after which it is tried again; non positive values keep the defaults
+func (t *UpstreamTracker) SetLimits(maxFailures int, cooldown time.Duration) {
+	t.l.Lock()
+	defer t.l.Unlock()
+	if maxFailures > 0 {
+		t.maxFailures = maxFailures
+	}
+	if cooldown > 0 {
+		t.cooldown = cooldown
+	}
+}
+
+func upstreamKey(chainID, url string) string {
+	return chainID + "|" + url
+}
+
+// "id" - Returns the url the upstream is tracked by
+func (u Upstream) id() string {
+	if u.HTTPURL != "" {
+		return u.HTTPURL
+	}
+	return u.WebSocketURL
+}
+
+// "RecordSuccess" - Records a successful request against the upstream
+func (t *UpstreamTracker) RecordSuccess(chainID, url string, latency time.Duration) {
+	t.l.Lock()
+	defer t.l.Unlock()
+	key := upstreamKey(chainID, url)
+	s := t.statuses[key]
+	ms := float64(latency) / float64(time.Millisecond)
+	if s.Latency == 0 {
+		s.Latency = ms
+	} else {
+		s.Latency = upstreamLatencyDecay*ms + (1-upstreamLatencyDecay)*s.Latency
+	}
+	s.ConsecutiveFailures = 0
+	t.statuses[key] = s
+}
+
+// "RecordFailure" - Records a failed request against the upstream
+func (t *UpstreamTracker) RecordFailure(chainID, url string) {
+	t.l.Lock()
+	defer t.l.Unlock()
+	key := upstreamKey(chainID, url)
+	s := t.statuses[key]
+	s.ConsecutiveFailures++
+	s.LastFailure = time.Now()
+	t.statuses[key] = s
+}
+
+// "markDown" - Takes the upstream out of rotation until the cooldown passes or a request succeeds
+func (t *UpstreamTracker) markDown(chainID, url string) {
+	t.l.Lock()
+	defer t.l.Unlock()
+	key := upstreamKey(chainID, url)
+	s := t.statuses[key]
+	if s.ConsecutiveFailures < t.maxFailures {
+		s.ConsecutiveFailures = t.maxFailures
+	}
+	s.LastFailure = time.Now()
+	t.statuses[key] = s
+}
+
+// "SetLagging" - Takes the upstream out of rotation while it is behind the head of the chain
+func (t *UpstreamTracker) SetLagging(chainID, url string, lagging bool) {
+	t.l.Lock()
+	defer t.l.Unlock()
+	key := upstreamKey(chainID, url)
+	s := t.statuses[key]
+	s.Lagging = lagging
+	t.statuses[key] = s
+}
+
+// "Status" - Returns the tracked status of the upstream
+func (t *UpstreamTracker) Status(chainID, url string) (UpstreamStatus, bool) {
+	t.l.Lock()
+	defer t.l.Unlock()
+	s, found := t.statuses[upstreamKey(chainID, url)]
+	return s, found
+}
+
+// "IsHealthy" - Returns false if the upstream is lagging or failed too many times in a row and is still cooling down
+func (t *UpstreamTracker) IsHealthy(chainID, url string) bool {
+	t.l.Lock()
+	defer t.l.Unlock()
+	return t.isHealthy(t.statuses[upstreamKey(chainID, url)])
+}
+
+func (t *UpstreamTracker) isHealthy(s UpstreamStatus) bool {
+	if s.Lagging {
+		return false
+	}
+	return s.ConsecutiveFailures < t.maxFailures || time.Since(s.LastFailure) > t.cooldown
+}
+
+// "Order" - Returns the upstreams in the order they should be tried: the healthy upstreams shuffled by
+// weight (favoring lower latency) followed by the unhealthy upstreams, least recently failed first
+func (t *UpstreamTracker) Order(chainID string, upstreams []Upstream) []Upstream {
+	t.l.Lock()
+	defer t.l.Unlock()
+	var healthy, unhealthy []Upstream
+	var healthyStatuses []UpstreamStatus
+	fastest := 0.0
+	for _, u := range upstreams {
+		s := t.statuses[upstreamKey(chainID, u.id())]
+		if !t.isHealthy(s) {
+			unhealthy = append(unhealthy, u)
+			continue
+		}
+		healthy = append(healthy, u)
+		healthyStatuses = append(healthyStatuses, s)
+		if s.Latency > 0 && (fastest == 0 || s.Latency < fastest) {
+			fastest = s.Latency
+		}
+	}
+	// effective weight of each healthy upstream, scaled down by how much slower it is than the fastest one
+	weights := make([]float64, len(healthy))
+	for i, u := range healthy {
+		weights[i] = float64(u.GetWeight())
+		if l := healthyStatuses[i].Latency; l > 0 && fastest > 0 {
+			weights[i] *= fastest / l
+		}
+	}
+	// weighted random selection without replacement
+	res := make([]Upstream, 0, len(upstreams))
+	for len(healthy) > 0 {
+		total := 0.0
+		for _, w := range weights {
+			total += w
+		}
+		r := rand.Float64() * total
+		i := 0
+		for ; i < len(weights)-1; i++ {
+			r -= weights[i]
+			if r < 0 {
+				break
+			}
+		}
+		res = append(res, healthy[i])
+		healthy = append(healthy[:i], healthy[i+1:]...)
+		weights = append(weights[:i], weights[i+1:]...)
+	}
+	sort.SliceStable(unhealthy, func(i, j int) bool {
+		return t.statuses[upstreamKey(chainID, unhealthy[i].id())].LastFailure.Before(t.statuses[upstreamKey(chainID, unhealthy[j].id())].LastFailure)
+	})
+	return append(res, unhealthy...)
+}
+
+// "Prune" - Removes the statuses of upstreams no longer hosted
+func (t *UpstreamTracker) Prune(chains map[string]HostedBlockchain) {
+	t.l.Lock()
+	defer t.l.Unlock()
+	hosted := make(map[string]struct{})
+	for _, chain := range chains {
+		for _, u := range chain.GetUpstreams() {
+			hosted[upstreamKey(chain.ID, u.id())] = struct{}{}
+		}
+	}
+	for key := range t.statuses {
+		if _, ok := hosted[key]; !ok {
+			delete(t.statuses, key)
+		}
+	}
+}
+
+// "CheckUpstreams" - Actively probes every http upstream of the hosted chains once
+func (t *UpstreamTracker) CheckUpstreams(hostedBlockchains *HostedBlockchains) {
+	// copy the chains so a hot reload is picked up on the next check without holding the lock
+	hostedBlockchains.L.Lock()
+	chains := make(map[string]HostedBlockchain, len(hostedBlockchains.M))
+	for k, v := range hostedBlockchains.M {
+		chains[k] = v
+	}
+	hostedBlockchains.L.Unlock()
+	var wg sync.WaitGroup
+	for _, chain := range chains {
+		for _, u := range chain.GetUpstreams() {
+			if u.HTTPURL == "" {
+				continue
+			}
+			wg.Add(1)
+			go func(chain HostedBlockchain, u Upstream) {
+				defer wg.Done()
+				t.checkUpstream(chain, u)
+			}(chain, u)
+		}
+	}
+	wg.Wait()
+	t.Prune(chains)
+}
+
+func (t *UpstreamTracker) checkUpstream(chain HostedBlockchain, u Upstream) {
+	// without a configured health check any response that is not a server error is considered healthy
+	check, maxStatus := Payload{Method: "GET"}, 500
+	if chain.HealthCheck != nil {
+		check, maxStatus = *chain.HealthCheck, 300
+		if check.Method == "" {
+			check.Method = DEFAULTHTTPMETHOD
+		}
+	}
+	start := time.Now()
+	_, status, err := executeHTTPRequestWithStatus(check.Data, upstreamURL(u.HTTPURL, check.Path), GlobalViperConfig.UserAgent, u.BasicAuth, check.Method, check.Headers)
+	if err != nil || status >= maxStatus {
+		t.markDown(chain.ID, u.HTTPURL)
+	} else {
+		t.RecordSuccess(chain.ID, u.HTTPURL, time.Since(start))
+	}
+	setUpstreamHealthMetric(chain.ID, u.HTTPURL, t.IsHealthy(chain.ID, u.HTTPURL))
+}
+
+// "StartUpstreamHealthChecks" - Probes the upstreams of the hosted chains every interval until they are stopped,
+// the health checks already running are stopped first
+func (t *UpstreamTracker) StartUpstreamHealthChecks(hostedBlockchains *HostedBlockchains, interval time.Duration) {
+	stop := make(chan struct{})
+	t.l.Lock()
+	if t.stop != nil {
+		close(t.stop)
+	}
+	t.stop = stop
+	t.l.Unlock()
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				t.CheckUpstreams(hostedBlockchains)
+			case <-stop:
+				return
+			}
+		}
+	}()
+}
+
+// "StopUpstreamHealthChecks" - Stops the running health checks, if any
+func (t *UpstreamTracker) StopUpstreamHealthChecks() {
+	t.l.Lock()
+	defer t.l.Unlock()
+	if t.stop != nil {
+		close(t.stop)
+		t.stop = nil
+	}
+}
+
+// "upstreamURL" - Joins the upstream url and the relay path
+func upstreamURL(base, path string) string {
+	u := strings.Trim(base, `/`)
+	if len(path) > 0 {
+		u = u + "/" + strings.Trim(path, `/`)
+	}
+	return u
+}
+
+// "upstreamLabel" - Returns the host of the upstream, so that credentials in the path or query are not exported as metrics
+func upstreamLabel(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		return "invalid"
+	}
+	return u.Host
+}
+
+// "isUpstreamFailure" - Transport errors, server errors and rate limiting count against the health of the upstream
+func isUpstreamFailure(status int, err error) bool {
+	return err != nil || status >= 500 || status == http.StatusTooManyRequests
+}
+
+// "isUpstreamRetriable" - Only the failures where the upstream can't have executed the request are retried on the
+// next upstream: the connection could not be established or the request was refused. A request that timed out may
+// already be executed (e.g. eth_sendRawTransaction) so it is not sent again
+func isUpstreamRetriable(status int, err error) bool {
+	if err != nil {
+		var opErr *net.OpError
+		return errors.As(err, &opErr) && opErr.Op == "dial"
+	}
+	return status == http.StatusTooManyRequests || status == http.StatusBadGateway || status == http.StatusServiceUnavailable
+}
+
+// "executeUpstreamHTTPRequest" - Executes the payload against the upstreams of the chain, retrying on the next
+// upstream when one could not execute it. Otherwise, or if every upstream fails, the result of the last attempt is returned
+func executeUpstreamHTTPRequest(chain HostedBlockchain, payload Payload, userAgent string) (res string, err error) {
+	tracker := GlobalUpstreamTracker()
+	attempted := false
+	for _, u := range tracker.Order(chain.ID, chain.GetUpstreams()) {
+		if u.HTTPURL == "" {
+			continue
+		}
+		attempted = true
+		start := time.Now()
+		var status int
+		res, status, err = executeHTTPRequestWithStatus(payload.Data, upstreamURL(u.HTTPURL, payload.Path), userAgent, u.BasicAuth, payload.Method, payload.Headers)
+		elapsed := time.Since(start)
+		failed := isUpstreamFailure(status, err)
+		if failed {
+			tracker.RecordFailure(chain.ID, u.HTTPURL)
+		} else {
+			tracker.RecordSuccess(chain.ID, u.HTTPURL, elapsed)
+		}
+		addUpstreamMetricFor(chain.ID, u.HTTPURL, elapsed, failed, tracker.IsHealthy(chain.ID, u.HTTPURL))
+		if !failed {
+			return res, nil
+		}
+		if !isUpstreamRetriable(status, err) {
+			return res, err
+		}
+	}
+	if !attempted {
+		return "", fmt.Errorf("no http upstream configured for chain %s", chain.ID)
+	}
+	return res, err
+}
+
+func addUpstreamMetricFor(chainID, upstream string, elapsed time.Duration, failed, healthy bool) {
+	sm := GlobalServiceMetric()
+	if sm == nil {
+		return
+	}
+	label := upstreamLabel(upstream)
+	relayTime := float64(elapsed) / float64(time.Millisecond)
+	if GlobalViperConfig.LeanViper {
+		go func() {
+			sm.AddUpstreamRelayFor(chainID, label, relayTime, failed)
+			sm.SetUpstreamHealthFor(chainID, label, healthy)
+		}()
+	} else {
+		sm.AddUpstreamRelayFor(chainID, label, relayTime, failed)
+		sm.SetUpstreamHealthFor(chainID, label, healthy)
+	}
+}
+
+func setUpstreamHealthMetric(chainID, upstream string, healthy bool) {
+	sm := GlobalServiceMetric()
+	if sm == nil {
+		return
+	}
+	sm.SetUpstreamHealthFor(chainID, upstreamLabel(upstream), healthy)
+}
+
+// "dialWebSocketUpstream" - Dials the websocket upstreams of the chain in order, returning the first established connection
+func dialWebSocketUpstream(chain HostedBlockchain, path string) (conn *websocket.Conn, err error) {
+	tracker := GlobalUpstreamTracker()
+	err = fmt.Errorf("no websocket upstream configured for chain %s", chain.ID)
+	for _, u := range tracker.Order(chain.ID, chain.GetUpstreams()) {
+		if u.WebSocketURL == "" {
+			continue
+		}
+		// Use the gorilla websocket Dialer
+		dialer := websocket.Dialer{}
+		start := time.Now()
+		conn, _, err = dialer.Dial(upstreamURL(u.WebSocketURL, path), nil)
+		elapsed := time.Since(start)
+		if err != nil {
+			tracker.RecordFailure(chain.ID, u.id())
+		} else {
+			tracker.RecordSuccess(chain.ID, u.id(), elapsed)
+		}
+		addUpstreamMetricFor(chain.ID, u.WebSocketURL, elapsed, err != nil, tracker.IsHealthy(chain.ID, u.id()))
+		if err == nil {
+			return conn, nil
+		}
+	}
+	return nil, err
+}
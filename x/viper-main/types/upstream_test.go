@@ -0,0 +1,262 @@
+package types
+
+import (
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHostedBlockchain_GetUpstreams(t *testing.T) {
+	ethereum := hex.EncodeToString([]byte{01})
+	hb := HostedBlockchain{
+		ID:      ethereum,
+		HTTPURL: "https://primary.example.com",
+		Upstreams: []Upstream{
+			{HTTPURL: "https://backup.example.com", Weight: 3},
+		},
+	}
+	upstreams := hb.GetUpstreams()
+	assert.Len(t, upstreams, 2)
+	assert.Equal(t, "https://primary.example.com", upstreams[0].HTTPURL)
+	assert.Equal(t, 1, upstreams[0].GetWeight())
+	assert.Equal(t, 3, upstreams[1].GetWeight())
+	// no primary url
+	hb.HTTPURL = ""
+	assert.Len(t, hb.GetUpstreams(), 1)
+}
+
+func TestHostedBlockchains_ValidateUpstreams(t *testing.T) {
+	ethereum := hex.EncodeToString([]byte{01})
+	onlyUpstreams := HostedBlockchain{
+		ID:        ethereum,
+		Upstreams: []Upstream{{HTTPURL: "https://backup.example.com"}},
+	}
+	emptyUpstream := HostedBlockchain{
+		ID:        ethereum,
+		HTTPURL:   "https://primary.example.com",
+		Upstreams: []Upstream{{Weight: 1}},
+	}
+	negativeWeight := HostedBlockchain{
+		ID:        ethereum,
+		HTTPURL:   "https://primary.example.com",
+		Upstreams: []Upstream{{HTTPURL: "https://backup.example.com", Weight: -1}},
+	}
+	tests := []struct {
+		name     string
+		chain    HostedBlockchain
+		hasError bool
+	}{
+		{"only upstreams", onlyUpstreams, false},
+		{"upstream without url", emptyUpstream, true},
+		{"negative weight", negativeWeight, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hb := HostedBlockchains{M: map[string]HostedBlockchain{tt.chain.ID: tt.chain}}
+			assert.Equal(t, tt.hasError, hb.Validate() != nil)
+		})
+	}
+}
+
+func TestUpstreamTracker_PassiveHealth(t *testing.T) {
+	tracker := NewUpstreamTracker()
+	tracker.SetLimits(2, time.Hour)
+	chain, url := "0001", "https://primary.example.com"
+	assert.True(t, tracker.IsHealthy(chain, url))
+	tracker.RecordFailure(chain, url)
+	assert.True(t, tracker.IsHealthy(chain, url))
+	tracker.RecordFailure(chain, url)
+	assert.False(t, tracker.IsHealthy(chain, url))
+	// a success puts the upstream back in rotation
+	tracker.RecordSuccess(chain, url, 10*time.Millisecond)
+	assert.True(t, tracker.IsHealthy(chain, url))
+	s, found := tracker.Status(chain, url)
+	assert.True(t, found)
+	assert.Equal(t, 0, s.ConsecutiveFailures)
+	assert.Equal(t, float64(10), s.Latency)
+}
+
+func TestUpstreamTracker_Cooldown(t *testing.T) {
+	tracker := NewUpstreamTracker()
+	tracker.SetLimits(1, time.Millisecond)
+	chain, url := "0001", "https://primary.example.com"
+	tracker.RecordFailure(chain, url)
+	time.Sleep(5 * time.Millisecond)
+	assert.True(t, tracker.IsHealthy(chain, url))
+}
+
+func TestUpstreamTracker_Order(t *testing.T) {
+	tracker := NewUpstreamTracker()
+	tracker.SetLimits(1, time.Hour)
+	chain := "0001"
+	down := Upstream{HTTPURL: "https://down.example.com", Weight: 1000}
+	heavy := Upstream{HTTPURL: "https://heavy.example.com", Weight: 1000}
+	light := Upstream{HTTPURL: "https://light.example.com", Weight: 1}
+	tracker.RecordFailure(chain, down.HTTPURL)
+	heavyFirst := 0
+	for i := 0; i < 100; i++ {
+		order := tracker.Order(chain, []Upstream{down, light, heavy})
+		assert.Len(t, order, 3)
+		// unhealthy upstreams are always tried last
+		assert.Equal(t, down, order[2])
+		if order[0] == heavy {
+			heavyFirst++
+		}
+	}
+	assert.True(t, heavyFirst > 90)
+}
+
+func TestUpstreamTracker_Prune(t *testing.T) {
+	tracker := NewUpstreamTracker()
+	ethereum := hex.EncodeToString([]byte{01})
+	tracker.RecordFailure(ethereum, "https://primary.example.com")
+	tracker.RecordFailure(ethereum, "https://removed.example.com")
+	tracker.Prune(map[string]HostedBlockchain{ethereum: {ID: ethereum, HTTPURL: "https://primary.example.com"}})
+	_, found := tracker.Status(ethereum, "https://primary.example.com")
+	assert.True(t, found)
+	_, found = tracker.Status(ethereum, "https://removed.example.com")
+	assert.False(t, found)
+}
+
+func TestExecuteUpstreamHTTPRequest_Failover(t *testing.T) {
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer down.Close()
+	up := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"result":"0x1"}`))
+	}))
+	defer up.Close()
+	ethereum := hex.EncodeToString([]byte{01})
+	chain := HostedBlockchain{
+		ID:        ethereum,
+		HTTPURL:   down.URL,
+		Weight:    1000,
+		Upstreams: []Upstream{{HTTPURL: up.URL}},
+	}
+	for i := 0; i < 5; i++ {
+		res, err := executeUpstreamHTTPRequest(chain, Payload{Data: `{"method":"eth_blockNumber"}`, Method: DEFAULTHTTPMETHOD}, "")
+		assert.Nil(t, err)
+		assert.Equal(t, `{"result":"0x1"}`, res)
+	}
+	assert.False(t, GlobalUpstreamTracker().IsHealthy(ethereum, down.URL))
+	assert.True(t, GlobalUpstreamTracker().IsHealthy(ethereum, up.URL))
+	GlobalUpstreamTracker().Prune(nil)
+}
+
+func TestExecuteUpstreamHTTPRequest_AllDown(t *testing.T) {
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = w.Write([]byte("internal error"))
+	}))
+	defer down.Close()
+	ethereum := hex.EncodeToString([]byte{01})
+	chain := HostedBlockchain{ID: ethereum, HTTPURL: down.URL}
+	// with a single upstream the response is returned as is
+	res, err := executeUpstreamHTTPRequest(chain, Payload{Data: "{}", Method: DEFAULTHTTPMETHOD}, "")
+	assert.Nil(t, err)
+	assert.Equal(t, "internal error", res)
+	// an unreachable upstream returns the transport error
+	chain.HTTPURL = "http://127.0.0.1:1"
+	_, err = executeUpstreamHTTPRequest(chain, Payload{Data: "{}", Method: DEFAULTHTTPMETHOD}, "")
+	assert.NotNil(t, err)
+	GlobalUpstreamTracker().Prune(nil)
+}
+
+func TestUpstreamTracker_CheckUpstreams(t *testing.T) {
+	healthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer healthy.Close()
+	unhealthy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer unhealthy.Close()
+	ethereum := hex.EncodeToString([]byte{01})
+	hb := &HostedBlockchains{
+		M: map[string]HostedBlockchain{ethereum: {
+			ID:          ethereum,
+			HTTPURL:     healthy.URL,
+			Upstreams:   []Upstream{{HTTPURL: unhealthy.URL}},
+			HealthCheck: &Payload{Data: `{"method":"eth_syncing"}`},
+		}},
+		L: sync.Mutex{},
+	}
+	tracker := NewUpstreamTracker()
+	tracker.CheckUpstreams(hb)
+	assert.True(t, tracker.IsHealthy(ethereum, healthy.URL))
+	assert.False(t, tracker.IsHealthy(ethereum, unhealthy.URL))
+	// a hot reload removing the upstream is picked up on the next check
+	hb.L.Lock()
+	hb.M = map[string]HostedBlockchain{ethereum: {ID: ethereum, HTTPURL: healthy.URL}}
+	hb.L.Unlock()
+	tracker.CheckUpstreams(hb)
+	_, found := tracker.Status(ethereum, unhealthy.URL)
+	assert.False(t, found)
+}
+
+func TestExecuteUpstreamHTTPRequest_NoFailoverOnTimeout(t *testing.T) {
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(200 * time.Millisecond)
+	}))
+	defer slow.Close()
+	var l sync.Mutex
+	hits := 0
+	up := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		l.Lock()
+		hits++
+		l.Unlock()
+		_, _ = w.Write([]byte(`{"result":"0x1"}`))
+	}))
+	defer up.Close()
+	SetRPCTimeout(50)
+	defer SetRPCTimeout(DefaultRPCTimeout)
+	ethereum := hex.EncodeToString([]byte{01})
+	chain := HostedBlockchain{ID: ethereum, HTTPURL: slow.URL, Upstreams: []Upstream{{HTTPURL: up.URL}}}
+	// the healthy upstream is tried last
+	GlobalUpstreamTracker().markDown(ethereum, up.URL)
+	// the timed out request may be executed already, it is not sent to the next upstream
+	_, err := executeUpstreamHTTPRequest(chain, Payload{Data: `{"method":"eth_sendRawTransaction"}`, Method: DEFAULTHTTPMETHOD}, "")
+	assert.NotNil(t, err)
+	assert.Zero(t, hits)
+	// an upstream that can't be dialed is skipped
+	chain.HTTPURL = "http://127.0.0.1:1"
+	res, err := executeUpstreamHTTPRequest(chain, Payload{Data: `{"method":"eth_sendRawTransaction"}`, Method: DEFAULTHTTPMETHOD}, "")
+	assert.Nil(t, err)
+	assert.Equal(t, `{"result":"0x1"}`, res)
+	assert.Equal(t, 1, hits)
+	GlobalUpstreamTracker().Prune(nil)
+}
+
+func TestUpstreamTracker_StartUpstreamHealthChecks(t *testing.T) {
+	var l sync.Mutex
+	probes := 0
+	upstream := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		l.Lock()
+		probes++
+		l.Unlock()
+	}))
+	defer upstream.Close()
+	ethereum := hex.EncodeToString([]byte{01})
+	hb := &HostedBlockchains{M: map[string]HostedBlockchain{ethereum: {ID: ethereum, HTTPURL: upstream.URL}}, L: sync.Mutex{}}
+	tracker := NewUpstreamTracker()
+	// starting again replaces the running health checks
+	tracker.StartUpstreamHealthChecks(hb, 20*time.Millisecond)
+	tracker.StartUpstreamHealthChecks(hb, 20*time.Millisecond)
+	time.Sleep(110 * time.Millisecond)
+	tracker.StopUpstreamHealthChecks()
+	time.Sleep(30 * time.Millisecond)
+	l.Lock()
+	stopped := probes
+	l.Unlock()
+	assert.True(t, stopped > 0 && stopped <= 6, stopped)
+	time.Sleep(60 * time.Millisecond)
+	l.Lock()
+	defer l.Unlock()
+	assert.Equal(t, stopped, probes)
+}
@@ -78,6 +78,7 @@ type Session struct {
 	SessionKey       SessionKey           `protobuf:"bytes,2,opt,name=sessionKey,proto3,casttype=SessionKey" json:"key"`
 	SessionServicers SessionServicers     `protobuf:"bytes,3,rep,name=sessionServicers,proto3" json:"servicers"`
 	SessionFishermen SessionFishermen     `protobuf:"bytes,4,rep,name=sessionFishermen,proto3" json:"fishermen"`
+	RevokedClients   []string             `protobuf:"bytes,5,rep,name=revokedClients,proto3" json:"revoked_clients,omitempty"`
 }
 
 func (m *Session) Reset()      { *m = Session{} }
@@ -452,7 +453,11 @@ type AAT struct {
 	Version           string `protobuf:"bytes,1,opt,name=version,proto3" json:"version"`
 	RequestorPublicKey string `protobuf:"bytes,2,opt,name=requestorPublicKey,proto3" json:"requestor_pub_key"`
 	ClientPublicKey   string `protobuf:"bytes,3,opt,name=clientPublicKey,proto3" json:"client_pub_key"`
-	RequestorSignature string `protobuf:"bytes,4,opt,name=requestorSignature,proto3" json:"signature"`
+	RequestorSignature string   `protobuf:"bytes,4,opt,name=requestorSignature,proto3" json:"signature"`
+	ExpirationHeight   int64    `protobuf:"varint,5,opt,name=expirationHeight,proto3" json:"expiration_height,omitempty"`
+	Chains             []string `protobuf:"bytes,6,rep,name=chains,proto3" json:"chains,omitempty"`
+	GeoZones           []string `protobuf:"bytes,7,rep,name=geoZones,proto3" json:"geo_zones,omitempty"`
+	MaxRelays          int64    `protobuf:"varint,8,opt,name=maxRelays,proto3" json:"max_relays,omitempty"`
 }
 
 func (m *AAT) Reset()      { *m = AAT{} }
@@ -1090,6 +1095,14 @@ func (this *Session) Equal(that interface{}) bool {
 			return false
 		}
 	}
+	if len(this.RevokedClients) != len(that1.RevokedClients) {
+		return false
+	}
+	for i := range this.RevokedClients {
+		if this.RevokedClients[i] != that1.RevokedClients[i] {
+			return false
+		}
+	}
 	return true
 }
 func (this *MsgClaim) Equal(that interface{}) bool {
@@ -1433,6 +1446,28 @@ func (this *AAT) Equal(that interface{}) bool {
 	if this.RequestorSignature != that1.RequestorSignature {
 		return false
 	}
+	if this.ExpirationHeight != that1.ExpirationHeight {
+		return false
+	}
+	if len(this.Chains) != len(that1.Chains) {
+		return false
+	}
+	for i := range this.Chains {
+		if this.Chains[i] != that1.Chains[i] {
+			return false
+		}
+	}
+	if len(this.GeoZones) != len(that1.GeoZones) {
+		return false
+	}
+	for i := range this.GeoZones {
+		if this.GeoZones[i] != that1.GeoZones[i] {
+			return false
+		}
+	}
+	if this.MaxRelays != that1.MaxRelays {
+		return false
+	}
 	return true
 }
 func (this *MerkleProof) Equal(that interface{}) bool {
@@ -1712,12 +1747,13 @@ func (this *Session) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
+	s := make([]string, 0, 9)
 	s = append(s, "&types.Session{")
 	s = append(s, "SessionHeader: "+strings.Replace(this.SessionHeader.GoString(), `&`, ``, 1)+",\n")
 	s = append(s, "SessionKey: "+fmt.Sprintf("%#v", this.SessionKey)+",\n")
 	s = append(s, "SessionServicers: "+fmt.Sprintf("%#v", this.SessionServicers)+",\n")
 	s = append(s, "SessionFishermen: "+fmt.Sprintf("%#v", this.SessionFishermen)+",\n")
+	s = append(s, "RevokedClients: "+fmt.Sprintf("%#v", this.RevokedClients)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -1853,12 +1889,16 @@ func (this *AAT) GoString() string {
 	if this == nil {
 		return "nil"
 	}
-	s := make([]string, 0, 8)
+	s := make([]string, 0, 12)
 	s = append(s, "&types.AAT{")
 	s = append(s, "Version: "+fmt.Sprintf("%#v", this.Version)+",\n")
 	s = append(s, "RequestorPublicKey: "+fmt.Sprintf("%#v", this.RequestorPublicKey)+",\n")
 	s = append(s, "ClientPublicKey: "+fmt.Sprintf("%#v", this.ClientPublicKey)+",\n")
 	s = append(s, "RequestorSignature: "+fmt.Sprintf("%#v", this.RequestorSignature)+",\n")
+	s = append(s, "ExpirationHeight: "+fmt.Sprintf("%#v", this.ExpirationHeight)+",\n")
+	s = append(s, "Chains: "+fmt.Sprintf("%#v", this.Chains)+",\n")
+	s = append(s, "GeoZones: "+fmt.Sprintf("%#v", this.GeoZones)+",\n")
+	s = append(s, "MaxRelays: "+fmt.Sprintf("%#v", this.MaxRelays)+",\n")
 	s = append(s, "}")
 	return strings.Join(s, "")
 }
@@ -2071,6 +2111,15 @@ func (m *Session) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RevokedClients) > 0 {
+		for iNdEx := len(m.RevokedClients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RevokedClients[iNdEx])
+			copy(dAtA[i:], m.RevokedClients[iNdEx])
+			i = encodeVarintVipernet(dAtA, i, uint64(len(m.RevokedClients[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if len(m.SessionFishermen) > 0 {
 		for iNdEx := len(m.SessionFishermen) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.SessionFishermen[iNdEx])
@@ -2591,6 +2640,34 @@ func (m *AAT) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxRelays != 0 {
+		i = encodeVarintVipernet(dAtA, i, uint64(m.MaxRelays))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.GeoZones) > 0 {
+		for iNdEx := len(m.GeoZones) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.GeoZones[iNdEx])
+			copy(dAtA[i:], m.GeoZones[iNdEx])
+			i = encodeVarintVipernet(dAtA, i, uint64(len(m.GeoZones[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Chains) > 0 {
+		for iNdEx := len(m.Chains) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Chains[iNdEx])
+			copy(dAtA[i:], m.Chains[iNdEx])
+			i = encodeVarintVipernet(dAtA, i, uint64(len(m.Chains[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.ExpirationHeight != 0 {
+		i = encodeVarintVipernet(dAtA, i, uint64(m.ExpirationHeight))
+		i--
+		dAtA[i] = 0x28
+	}
 	if len(m.RequestorSignature) > 0 {
 		i -= len(m.RequestorSignature)
 		copy(dAtA[i:], m.RequestorSignature)
@@ -3176,6 +3253,12 @@ func (m *Session) Size() (n int) {
 			n += 1 + l + sovVipernet(uint64(l))
 		}
 	}
+	if len(m.RevokedClients) > 0 {
+		for _, s := range m.RevokedClients {
+			l = len(s)
+			n += 1 + l + sovVipernet(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -3395,6 +3478,24 @@ func (m *AAT) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovVipernet(uint64(l))
 	}
+	if m.ExpirationHeight != 0 {
+		n += 1 + sovVipernet(uint64(m.ExpirationHeight))
+	}
+	if len(m.Chains) > 0 {
+		for _, s := range m.Chains {
+			l = len(s)
+			n += 1 + l + sovVipernet(uint64(l))
+		}
+	}
+	if len(m.GeoZones) > 0 {
+		for _, s := range m.GeoZones {
+			l = len(s)
+			n += 1 + l + sovVipernet(uint64(l))
+		}
+	}
+	if m.MaxRelays != 0 {
+		n += 1 + sovVipernet(uint64(m.MaxRelays))
+	}
 	return n
 }
 
@@ -3618,6 +3719,7 @@ func (this *Session) String() string {
 		`SessionKey:` + fmt.Sprintf("%v", this.SessionKey) + `,`,
 		`SessionServicers:` + fmt.Sprintf("%v", this.SessionServicers) + `,`,
 		`SessionFishermen:` + fmt.Sprintf("%v", this.SessionFishermen) + `,`,
+		`RevokedClients:` + fmt.Sprintf("%v", this.RevokedClients) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -3747,6 +3849,10 @@ func (this *AAT) String() string {
 		`RequestorPublicKey:` + fmt.Sprintf("%v", this.RequestorPublicKey) + `,`,
 		`ClientPublicKey:` + fmt.Sprintf("%v", this.ClientPublicKey) + `,`,
 		`RequestorSignature:` + fmt.Sprintf("%v", this.RequestorSignature) + `,`,
+		`ExpirationHeight:` + fmt.Sprintf("%v", this.ExpirationHeight) + `,`,
+		`Chains:` + fmt.Sprintf("%v", this.Chains) + `,`,
+		`GeoZones:` + fmt.Sprintf("%v", this.GeoZones) + `,`,
+		`MaxRelays:` + fmt.Sprintf("%v", this.MaxRelays) + `,`,
 		`}`,
 	}, "")
 	return s
@@ -4230,6 +4336,38 @@ func (m *Session) Unmarshal(dAtA []byte) error {
 			m.SessionFishermen = append(m.SessionFishermen, make([]byte, postIndex-iNdEx))
 			copy(m.SessionFishermen[len(m.SessionFishermen)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedClients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVipernet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVipernet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVipernet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevokedClients = append(m.RevokedClients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVipernet(dAtA[iNdEx:])
@@ -5745,6 +5883,108 @@ func (m *AAT) Unmarshal(dAtA []byte) error {
 			}
 			m.RequestorSignature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationHeight", wireType)
+			}
+			m.ExpirationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVipernet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chains", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVipernet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVipernet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVipernet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Chains = append(m.Chains, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GeoZones", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVipernet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthVipernet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthVipernet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GeoZones = append(m.GeoZones, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRelays", wireType)
+			}
+			m.MaxRelays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowVipernet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRelays |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipVipernet(dAtA[iNdEx:])
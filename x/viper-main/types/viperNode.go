@@ -23,6 +23,7 @@ type ViperNode struct {
 	EvidenceStore   *CacheStorage
 	SessionStore    *CacheStorage
 	TestStore       *CacheStorage
+	SubmissionStore *CacheStorage
 	DoCacheInitOnce sync.Once
 }
 
@@ -52,24 +53,32 @@ func AddViperNodeByFilePVKey(fpvKey privval.FilePVKey, logger log.Logger) {
 	AddViperNode(key, logger)
 }
 
-// InitViperNodeCache adds a ViperNode with its SessionStore and EvidenceStore initialized
+// InitViperNodeCache adds a ViperNode with its SessionStore, EvidenceStore, TestStore and SubmissionStore initialized
 func InitViperNodeCache(node *ViperNode, c sdk.Config, logger log.Logger) {
 	node.DoCacheInitOnce.Do(func() {
 		evidenceDbName := c.ViperConfig.EvidenceDBName
 		resultDbName := c.ViperConfig.ResultDBName
+		submissionDbName := c.ViperConfig.SubmissionDBName
+		if submissionDbName == "" {
+			// configs written before the submission db
+			submissionDbName = sdk.DefaultSubmissionDBName
+		}
 		address := node.GetAddress().String()
 		// In LeanViper, we create a evidence store on disk with suffix of the node's address
 		if c.ViperConfig.LeanViper {
 			evidenceDbName = evidenceDbName + "_" + address
 			resultDbName = resultDbName + "_" + address
+			submissionDbName = submissionDbName + "_" + address
 		}
 		logger.Info("Initializing " + address + " session, evidence and test cache")
 		node.EvidenceStore = &CacheStorage{}
 		node.SessionStore = &CacheStorage{}
 		node.TestStore = &CacheStorage{}
+		node.SubmissionStore = &CacheStorage{}
 		node.EvidenceStore.Init(c.ViperConfig.DataDir, evidenceDbName, c.TendermintConfig.LevelDBOptions, c.ViperConfig.MaxEvidenceCacheEntires, false)
 		node.SessionStore.Init(c.ViperConfig.DataDir, "", c.TendermintConfig.LevelDBOptions, c.ViperConfig.MaxSessionCacheEntries, true)
 		node.TestStore.Init(c.ViperConfig.DataDir, resultDbName, c.TendermintConfig.LevelDBOptions, c.ViperConfig.MaxResultCacheEntires, false)
+		node.SubmissionStore.Init(c.ViperConfig.DataDir, submissionDbName, c.TendermintConfig.LevelDBOptions, 1, false)
 
 		// Set the GOBSession and GOBEvidence Global for backwards compatibility for pre-LeanViper
 		if GlobalSessionCache == nil {
@@ -102,7 +111,7 @@ func CleanViperNodes() {
 		if n == nil {
 			continue
 		}
-		cacheToClean := []*CacheStorage{n.EvidenceStore, n.SessionStore, n.TestStore}
+		cacheToClean := []*CacheStorage{n.EvidenceStore, n.SessionStore, n.TestStore, n.SubmissionStore}
 		for _, r := range cacheToClean {
 			if r == nil {
 				continue
@@ -0,0 +1,531 @@
+package types
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/websocket"
+
+	sdk "github.com/vipernet-xyz/viper-network/types"
+)
+
+var globalWebsocketPool = NewWebsocketPool()
+
+const (
+	// frames sent by the client
+	WebsocketFrameRelay  = "relay"  // a json-rpc request forwarded to the chain, paid by the relay proof
+	WebsocketFrameCredit = "credit" // proofs paying for the subscription messages pushed to the client
+	// messages sent to the client
+	WebsocketMessageResponse       = "response"        // the signed response to a relay frame
+	WebsocketMessageSubscription   = "subscription"    // a signed subscription message pushed by the chain
+	WebsocketMessageCredit         = "credit"          // the number of credits left after a credit frame
+	WebsocketMessageCreditRequired = "credit_required" // subscription messages are held until the client sends credit
+	WebsocketMessageError          = "error"
+
+	// number of messages buffered for a client before the session is dropped
+	websocketSessionBufferSize = 256
+	// number of subscription messages per chain held while waiting for credit
+	maxPendingWebsocketMessages = 100
+)
+
+// "WebsocketFrame" - A frame sent by the client of a websocket relay session
+type WebsocketFrame struct {
+	Type   string       `json:"type"`
+	Relay  *Relay       `json:"relay,omitempty"`
+	Proofs []RelayProof `json:"proofs,omitempty"`
+}
+
+// "WebsocketMessage" - A message sent to the client of a websocket relay session
+type WebsocketMessage struct {
+	Type      string            `json:"type"`
+	Response  *RelayResponse    `json:"response,omitempty"`
+	Chain     string            `json:"chain,omitempty"`
+	Credits   int               `json:"credits,omitempty"`
+	Error     string            `json:"error,omitempty"`
+	Code      sdk.CodeType      `json:"code,omitempty"`
+	Codespace sdk.CodespaceType `json:"codespace,omitempty"`
+	Dispatch  *DispatchResponse `json:"dispatch,omitempty"`
+}
+
+// "WebsocketCredit" - A validated proof paying for a single subscription message
+type WebsocketCredit struct {
+	Proof             RelayProof
+	MaxPossibleRelays sdk.BigInt
+	Node              *ViperNode
+}
+
+// "bill" - Records the credit as relay evidence and signs the pushed message with it
+func (c WebsocketCredit) bill(message string) (*RelayResponse, sdk.Error) {
+	evidence, totalRelays := GetTotalProofs(c.Proof.SessionHeader(), RelayEvidence, c.MaxPossibleRelays, c.Node.EvidenceStore)
+	if c.Node.EvidenceStore.IsSealed(evidence) {
+		return nil, NewSealedEvidenceError(ModuleName)
+	}
+	if !IsUniqueProof(c.Proof, evidence) {
+		return nil, NewDuplicateProofError(ModuleName)
+	}
+	if sdk.NewInt(totalRelays).GTE(c.MaxPossibleRelays) {
+		return nil, NewOverServiceError(ModuleName)
+	}
+	c.Proof.Store(c.MaxPossibleRelays, c.Node.EvidenceStore)
+	return signRelayResponse(c.Node, c.Proof, message)
+}
+
+func signRelayResponse(node *ViperNode, proof RelayProof, response string) (*RelayResponse, sdk.Error) {
+	resp := &RelayResponse{
+		Response: response,
+		Proof:    proof,
+	}
+	sig, er := node.PrivateKey.Sign(resp.Hash())
+	if er != nil {
+		return nil, NewKeybaseError(ModuleName, er)
+	}
+	resp.Signature = hex.EncodeToString(sig)
+	return resp, nil
+}
+
+// "WebsocketSession" - A client websocket connection, its requests and subscriptions are multiplexed
+// over the pooled upstream connections of the chains
+type WebsocketSession struct {
+	l             sync.Mutex
+	out           chan WebsocketMessage
+	done          chan struct{}
+	closeOnce     sync.Once
+	credits       map[string][]WebsocketCredit // chain -> credits
+	pending       map[string][]string          // chain -> subscription messages waiting for credit
+	subscriptions []sessionSubscription
+}
+
+type sessionSubscription struct {
+	upstream    *upstreamWebsocket
+	id          string
+	unsubscribe string
+}
+
+// "NewWebsocketSession" - Returns a new websocket relay session
+func NewWebsocketSession() *WebsocketSession {
+	return &WebsocketSession{
+		out:     make(chan WebsocketMessage, websocketSessionBufferSize),
+		done:    make(chan struct{}),
+		credits: make(map[string][]WebsocketCredit),
+		pending: make(map[string][]string),
+	}
+}
+
+// "Messages" - Returns the messages to be written to the client
+func (s *WebsocketSession) Messages() <-chan WebsocketMessage {
+	return s.out
+}
+
+// "Done" - Closed when the session is closed
+func (s *WebsocketSession) Done() <-chan struct{} {
+	return s.done
+}
+
+// "Close" - Closes the session and the subscriptions it opened upstream
+func (s *WebsocketSession) Close() {
+	s.closeOnce.Do(func() {
+		s.l.Lock()
+		subscriptions := s.subscriptions
+		s.subscriptions = nil
+		s.l.Unlock()
+		close(s.done)
+		for _, sub := range subscriptions {
+			sub.upstream.unsubscribe(sub)
+		}
+	})
+}
+
+// "Send" - Queues the message for the client, the session is dropped if the client does not keep up
+func (s *WebsocketSession) Send(m WebsocketMessage) {
+	select {
+	case <-s.done:
+	case s.out <- m:
+	default:
+		go s.Close()
+	}
+}
+
+// "SendError" - Queues the error for the client
+func (s *WebsocketSession) SendError(err error, dispatch *DispatchResponse) {
+	m := WebsocketMessage{Type: WebsocketMessageError, Error: err.Error(), Dispatch: dispatch}
+	if e, ok := err.(sdk.Error); ok {
+		m.Code, m.Codespace = e.Code(), e.Codespace()
+	}
+	s.Send(m)
+}
+
+// "AddCredit" - Adds a validated credit to the session and flushes the subscription messages waiting for it
+func (s *WebsocketSession) AddCredit(c WebsocketCredit) sdk.Error {
+	s.l.Lock()
+	defer s.l.Unlock()
+	chain := c.Proof.Blockchain
+	hash := c.Proof.HashString()
+	for _, existing := range s.credits[chain] {
+		if existing.Proof.HashString() == hash {
+			return NewDuplicateProofError(ModuleName)
+		}
+	}
+	s.credits[chain] = append(s.credits[chain], c)
+	pending := s.pending[chain]
+	s.pending[chain] = nil
+	for _, message := range pending {
+		s.push(chain, message)
+	}
+	return nil
+}
+
+// "Credits" - Returns the number of unused credits of the chain
+func (s *WebsocketSession) Credits(chain string) int {
+	s.l.Lock()
+	defer s.l.Unlock()
+	return len(s.credits[chain])
+}
+
+// "Push" - Delivers a subscription message to the client, paying for it with the next credit of the chain
+func (s *WebsocketSession) Push(chain, message string) {
+	s.l.Lock()
+	defer s.l.Unlock()
+	select {
+	case <-s.done:
+		return
+	default:
+	}
+	s.push(chain, message)
+}
+
+func (s *WebsocketSession) push(chain, message string) {
+	for len(s.credits[chain]) > 0 {
+		credit := s.credits[chain][0]
+		s.credits[chain] = s.credits[chain][1:]
+		resp, err := credit.bill(message)
+		if err != nil {
+			s.SendError(err, nil)
+			continue
+		}
+		addr := credit.Node.GetAddress()
+		addServiceMetricRelayFor(chain, &addr)
+		s.Send(WebsocketMessage{Type: WebsocketMessageSubscription, Response: resp, Chain: chain})
+		return
+	}
+	// hold the message until the client sends credit, dropping the oldest
+	if len(s.pending[chain]) == 0 {
+		s.Send(WebsocketMessage{Type: WebsocketMessageCreditRequired, Chain: chain})
+	}
+	if len(s.pending[chain]) >= maxPendingWebsocketMessages {
+		s.pending[chain] = s.pending[chain][1:]
+	}
+	s.pending[chain] = append(s.pending[chain], message)
+}
+
+// "Forward" - Forwards the json-rpc request of the relay to the pooled upstream connection of the chain,
+// the signed response is delivered asynchronously to the session
+func (s *WebsocketSession) Forward(hostedBlockchains *HostedBlockchains, relay Relay, node *ViperNode) sdk.Error {
+	var req map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(relay.Payload.Data), &req); err != nil {
+		return NewInvalidWebsocketFrameError(ModuleName)
+	}
+	id, hasID := req["id"]
+	var method string
+	if err := json.Unmarshal(req["method"], &method); err != nil || !hasID {
+		return NewInvalidWebsocketFrameError(ModuleName)
+	}
+	p := pendingRequest{session: s, id: id, proof: relay.Proof, node: node}
+	if isUnsubscribeMethod(method) {
+		var params []json.RawMessage
+		if err := json.Unmarshal(req["params"], &params); err == nil && len(params) > 0 {
+			p.unsubscribe = compactJSON(params[0])
+		}
+	} else if isSubscribeMethod(method) {
+		p.subscribe = unsubscribeMethod(method)
+	}
+	upstream, err := globalWebsocketPool.get(hostedBlockchains, relay.Proof.Blockchain, relay.Payload.Path)
+	if err != nil {
+		return NewHTTPExecutionError(ModuleName, err)
+	}
+	if err := upstream.send(req, p); err != nil {
+		return NewHTTPExecutionError(ModuleName, err)
+	}
+	return nil
+}
+
+func (s *WebsocketSession) respond(p pendingRequest, response string) {
+	resp, err := signRelayResponse(p.node, p.proof, response)
+	if err != nil {
+		s.SendError(err, nil)
+		return
+	}
+	s.Send(WebsocketMessage{Type: WebsocketMessageResponse, Response: resp, Chain: p.proof.Blockchain})
+}
+
+func (s *WebsocketSession) addSubscription(sub sessionSubscription) bool {
+	s.l.Lock()
+	defer s.l.Unlock()
+	select {
+	case <-s.done:
+		return false
+	default:
+	}
+	s.subscriptions = append(s.subscriptions, sub)
+	return true
+}
+
+func (s *WebsocketSession) removeSubscription(upstream *upstreamWebsocket, id string) {
+	s.l.Lock()
+	defer s.l.Unlock()
+	for i, sub := range s.subscriptions {
+		if sub.upstream == upstream && sub.id == id {
+			s.subscriptions = append(s.subscriptions[:i], s.subscriptions[i+1:]...)
+			return
+		}
+	}
+}
+
+func (s *WebsocketSession) upstreamClosed(upstream *upstreamWebsocket) {
+	s.l.Lock()
+	subscriptions := s.subscriptions[:0]
+	for _, sub := range s.subscriptions {
+		if sub.upstream != upstream {
+			subscriptions = append(subscriptions, sub)
+		}
+	}
+	s.subscriptions = subscriptions
+	s.l.Unlock()
+	s.SendError(NewHTTPExecutionError(ModuleName, fmt.Errorf("the upstream websocket connection of chain %s closed, its subscriptions were dropped", upstream.chainID)), nil)
+}
+
+// "WebsocketPool" - The upstream websocket connections shared by the websocket relay sessions
+type WebsocketPool struct {
+	l     sync.Mutex
+	conns map[string]*upstreamWebsocket
+}
+
+// "NewWebsocketPool" - Returns an empty websocket pool
+func NewWebsocketPool() *WebsocketPool {
+	return &WebsocketPool{conns: make(map[string]*upstreamWebsocket)}
+}
+
+// "get" - Returns the pooled upstream connection of the chain, dialing it if needed
+func (p *WebsocketPool) get(hostedBlockchains *HostedBlockchains, chainID, path string) (*upstreamWebsocket, error) {
+	key := chainID + "|" + path
+	p.l.Lock()
+	defer p.l.Unlock()
+	if upstream, ok := p.conns[key]; ok {
+		return upstream, nil
+	}
+	chain, err := hostedBlockchains.GetChain(chainID)
+	if err != nil {
+		return nil, err
+	}
+	conn, er := dialWebSocketUpstream(chain, path)
+	if er != nil {
+		return nil, er
+	}
+	upstream := &upstreamWebsocket{
+		chainID:       chainID,
+		conn:          conn,
+		requests:      make(map[uint64]pendingRequest),
+		subscriptions: make(map[string]*WebsocketSession),
+	}
+	p.conns[key] = upstream
+	go upstream.read(func() {
+		p.l.Lock()
+		defer p.l.Unlock()
+		if p.conns[key] == upstream {
+			delete(p.conns, key)
+		}
+	})
+	return upstream, nil
+}
+
+type pendingRequest struct {
+	session     *WebsocketSession // nil for requests issued by the pool
+	id          json.RawMessage   // the id of the client request
+	proof       RelayProof
+	node        *ViperNode
+	subscribe   string // the unsubscribe method, set when the request opens a subscription
+	unsubscribe string // the subscription id, set when the request closes a subscription
+}
+
+// "upstreamWebsocket" - A pooled upstream connection, request ids are rewritten so the requests of
+// different sessions do not collide and subscription messages are routed by subscription id
+type upstreamWebsocket struct {
+	chainID       string
+	conn          *websocket.Conn
+	writeL        sync.Mutex
+	l             sync.Mutex
+	nextID        uint64
+	closed        bool
+	requests      map[uint64]pendingRequest
+	subscriptions map[string]*WebsocketSession
+}
+
+func (u *upstreamWebsocket) send(req map[string]json.RawMessage, p pendingRequest) error {
+	u.l.Lock()
+	if u.closed {
+		u.l.Unlock()
+		return fmt.Errorf("the upstream websocket connection of chain %s is closed", u.chainID)
+	}
+	u.nextID++
+	id := u.nextID
+	u.requests[id] = p
+	u.l.Unlock()
+	req["id"] = json.RawMessage(strconv.FormatUint(id, 10))
+	bz, err := json.Marshal(req)
+	if err == nil {
+		u.writeL.Lock()
+		err = u.conn.WriteMessage(websocket.TextMessage, bz)
+		u.writeL.Unlock()
+	}
+	if err != nil {
+		u.l.Lock()
+		delete(u.requests, id)
+		u.l.Unlock()
+		// the read loop cleans up once the connection is closed
+		_ = u.conn.Close()
+	}
+	return err
+}
+
+// "unsubscribe" - Closes the subscription upstream on behalf of a closed session
+func (u *upstreamWebsocket) unsubscribe(sub sessionSubscription) {
+	u.l.Lock()
+	delete(u.subscriptions, sub.id)
+	u.l.Unlock()
+	req := map[string]json.RawMessage{
+		"jsonrpc": json.RawMessage(`"2.0"`),
+		"method":  json.RawMessage(strconv.Quote(sub.unsubscribe)),
+		"params":  json.RawMessage("[" + sub.id + "]"),
+	}
+	_ = u.send(req, pendingRequest{})
+}
+
+func (u *upstreamWebsocket) read(onClose func()) {
+	for {
+		_, bz, err := u.conn.ReadMessage()
+		if err != nil {
+			break
+		}
+		var msg map[string]json.RawMessage
+		if err := json.Unmarshal(bz, &msg); err != nil {
+			continue
+		}
+		if rawID, ok := msg["id"]; ok && string(rawID) != "null" {
+			u.handleResponse(rawID, msg)
+			continue
+		}
+		var params struct {
+			Subscription json.RawMessage `json:"subscription"`
+		}
+		if err := json.Unmarshal(msg["params"], &params); err != nil || len(params.Subscription) == 0 {
+			continue
+		}
+		u.l.Lock()
+		session, ok := u.subscriptions[compactJSON(params.Subscription)]
+		u.l.Unlock()
+		if ok {
+			session.Push(u.chainID, string(bz))
+		}
+	}
+	// drop the connection from the pool and notify the sessions subscribed through it
+	_ = u.conn.Close()
+	onClose()
+	u.l.Lock()
+	u.closed = true
+	sessions := make(map[*WebsocketSession]struct{})
+	for _, session := range u.subscriptions {
+		sessions[session] = struct{}{}
+	}
+	for _, p := range u.requests {
+		if p.session != nil {
+			sessions[p.session] = struct{}{}
+		}
+	}
+	u.subscriptions = make(map[string]*WebsocketSession)
+	u.requests = make(map[uint64]pendingRequest)
+	u.l.Unlock()
+	for session := range sessions {
+		session.upstreamClosed(u)
+	}
+}
+
+func (u *upstreamWebsocket) handleResponse(rawID json.RawMessage, msg map[string]json.RawMessage) {
+	id, err := strconv.ParseUint(string(rawID), 10, 64)
+	if err != nil {
+		return
+	}
+	u.l.Lock()
+	p, ok := u.requests[id]
+	delete(u.requests, id)
+	u.l.Unlock()
+	if !ok || p.session == nil {
+		return
+	}
+	result, succeeded := msg["result"]
+	switch {
+	case succeeded && p.subscribe != "":
+		sub := sessionSubscription{upstream: u, id: compactJSON(result), unsubscribe: p.subscribe}
+		if !p.session.addSubscription(sub) {
+			// the session closed while subscribing
+			u.unsubscribe(sub)
+			return
+		}
+		u.l.Lock()
+		u.subscriptions[sub.id] = p.session
+		u.l.Unlock()
+	case succeeded && p.unsubscribe != "":
+		u.l.Lock()
+		if session, ok := u.subscriptions[p.unsubscribe]; ok && session == p.session {
+			delete(u.subscriptions, p.unsubscribe)
+		}
+		u.l.Unlock()
+		p.session.removeSubscription(u, p.unsubscribe)
+	}
+	// restore the id of the client request
+	msg["id"] = p.id
+	bz, err := json.Marshal(msg)
+	if err != nil {
+		return
+	}
+	p.session.respond(p, string(bz))
+}
+
+func isUnsubscribeMethod(method string) bool {
+	return strings.HasSuffix(method, "unsubscribe") || strings.HasSuffix(method, "Unsubscribe")
+}
+
+func isSubscribeMethod(method string) bool {
+	return !isUnsubscribeMethod(method) && (strings.HasSuffix(method, "subscribe") || strings.HasSuffix(method, "Subscribe"))
+}
+
+// "unsubscribeMethod" - Returns the method closing subscriptions opened by the method, e.g. eth_subscribe -> eth_unsubscribe
+func unsubscribeMethod(method string) string {
+	if strings.HasSuffix(method, "Subscribe") {
+		return strings.TrimSuffix(method, "Subscribe") + "Unsubscribe"
+	}
+	return strings.TrimSuffix(method, "subscribe") + "unsubscribe"
+}
+
+func compactJSON(raw json.RawMessage) string {
+	var b bytes.Buffer
+	if err := json.Compact(&b, raw); err != nil {
+		return string(raw)
+	}
+	return b.String()
+}
+
+func addServiceMetricRelayFor(blockchain string, address *sdk.Address) {
+	sm := GlobalServiceMetric()
+	if sm == nil {
+		return
+	}
+	if GlobalViperConfig.LeanViper {
+		go sm.AddRelayFor(blockchain, address)
+	} else {
+		sm.AddRelayFor(blockchain, address)
+	}
+}
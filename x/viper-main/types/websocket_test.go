@@ -0,0 +1,144 @@
+package types
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+
+	sdk "github.com/vipernet-xyz/viper-network/types"
+)
+
+// newWebsocketChainServer mocks a chain websocket endpoint, subscriptions are answered with
+// the subscription id "0xabc" followed by a single notification
+func newWebsocketChainServer(unsubscribed chan string) *httptest.Server {
+	upgrader := websocket.Upgrader{}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		for {
+			var req struct {
+				ID     json.RawMessage   `json:"id"`
+				Method string            `json:"method"`
+				Params []json.RawMessage `json:"params"`
+			}
+			if err := conn.ReadJSON(&req); err != nil {
+				return
+			}
+			switch req.Method {
+			case "eth_subscribe":
+				_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":`+string(req.ID)+`,"result":"0xabc"}`))
+				_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","method":"eth_subscription","params":{"subscription":"0xabc","result":{"number":"0x1"}}}`))
+			case "eth_unsubscribe":
+				unsubscribed <- string(req.Params[0])
+				_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":`+string(req.ID)+`,"result":true}`))
+			default:
+				_ = conn.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":`+string(req.ID)+`,"result":"0x1"}`))
+			}
+		}
+	}))
+}
+
+func nextWebsocketMessage(t *testing.T, session *WebsocketSession) WebsocketMessage {
+	select {
+	case m := <-session.Messages():
+		return m
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for a websocket message")
+		return WebsocketMessage{}
+	}
+}
+
+func TestWebsocketSession_Subscription(t *testing.T) {
+	unsubscribed := make(chan string, 1)
+	server := newWebsocketChainServer(unsubscribed)
+	defer server.Close()
+	chain := hex.EncodeToString([]byte{0x51})
+	hb := &HostedBlockchains{
+		M: map[string]HostedBlockchain{chain: {ID: chain, WebSocketURL: "ws" + strings.TrimPrefix(server.URL, "http")}},
+		L: sync.Mutex{},
+	}
+	node := GetViperNode()
+	relay := Relay{
+		Payload: Payload{Data: `{"jsonrpc":"2.0","method":"eth_subscribe","params":["newHeads"],"id":7}`},
+		Proof:   RelayProof{Blockchain: chain, Entropy: 1},
+	}
+	session := NewWebsocketSession()
+	assert.Nil(t, session.Forward(hb, relay, node))
+	// the response carries the id of the client request
+	m := nextWebsocketMessage(t, session)
+	assert.Equal(t, WebsocketMessageResponse, m.Type)
+	assert.Equal(t, `{"id":7,"jsonrpc":"2.0","result":"0xabc"}`, m.Response.Response)
+	assert.Equal(t, relay.Proof, m.Response.Proof)
+	// the notification is held until the client pays for it
+	m = nextWebsocketMessage(t, session)
+	assert.Equal(t, WebsocketMessageCreditRequired, m.Type)
+	assert.Equal(t, chain, m.Chain)
+	credit := WebsocketCredit{Proof: RelayProof{Blockchain: chain, Entropy: 2}, MaxPossibleRelays: sdk.NewInt(10), Node: node}
+	assert.Nil(t, session.AddCredit(credit))
+	m = nextWebsocketMessage(t, session)
+	assert.Equal(t, WebsocketMessageSubscription, m.Type)
+	assert.Contains(t, m.Response.Response, `"subscription":"0xabc"`)
+	assert.Equal(t, credit.Proof, m.Response.Proof)
+	assert.Equal(t, 0, session.Credits(chain))
+	// closing the session closes its subscriptions upstream
+	session.Close()
+	select {
+	case id := <-unsubscribed:
+		assert.Equal(t, `"0xabc"`, id)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the subscription was not closed upstream")
+	}
+}
+
+func TestWebsocketSession_AddCredit(t *testing.T) {
+	chain := hex.EncodeToString([]byte{0x52})
+	node := GetViperNode()
+	session := NewWebsocketSession()
+	credit := WebsocketCredit{Proof: RelayProof{Blockchain: chain, Entropy: 3}, MaxPossibleRelays: sdk.NewInt(10), Node: node}
+	assert.Nil(t, session.AddCredit(credit))
+	err := session.AddCredit(credit)
+	assert.NotNil(t, err)
+	assert.Equal(t, sdk.CodeType(CodeDuplicateProofError), err.Code())
+	assert.Equal(t, 1, session.Credits(chain))
+	session.Push(chain, `{"params":{"subscription":"0x1"}}`)
+	m := nextWebsocketMessage(t, session)
+	assert.Equal(t, WebsocketMessageSubscription, m.Type)
+	// the proof was stored as relay evidence, it can not pay twice
+	assert.Nil(t, session.AddCredit(credit))
+	session.Push(chain, `{"params":{"subscription":"0x1"}}`)
+	m = nextWebsocketMessage(t, session)
+	assert.Equal(t, WebsocketMessageError, m.Type)
+	assert.Equal(t, sdk.CodeType(CodeDuplicateProofError), m.Code)
+	m = nextWebsocketMessage(t, session)
+	assert.Equal(t, WebsocketMessageCreditRequired, m.Type)
+}
+
+func TestWebsocketSession_ForwardInvalidFrame(t *testing.T) {
+	hb := &HostedBlockchains{M: map[string]HostedBlockchain{}, L: sync.Mutex{}}
+	session := NewWebsocketSession()
+	for _, data := range []string{`not json`, `{"method":"eth_subscribe","params":[]}`, `{"id":1,"params":[]}`} {
+		err := session.Forward(hb, Relay{Payload: Payload{Data: data}}, GetViperNode())
+		assert.NotNil(t, err)
+		assert.Equal(t, sdk.CodeType(CodeInvalidWebsocketFrameError), err.Code())
+	}
+}
+
+func TestUnsubscribeMethod(t *testing.T) {
+	assert.True(t, isSubscribeMethod("eth_subscribe"))
+	assert.True(t, isSubscribeMethod("logsSubscribe"))
+	assert.False(t, isSubscribeMethod("eth_unsubscribe"))
+	assert.True(t, isUnsubscribeMethod("logsUnsubscribe"))
+	assert.Equal(t, "eth_unsubscribe", unsubscribeMethod("eth_subscribe"))
+	assert.Equal(t, "logsUnsubscribe", unsubscribeMethod("logsSubscribe"))
+}